@@ -11,8 +11,10 @@ import (
 	"github.com/clipperhouse/jargon"
 	"github.com/clipperhouse/jargon/filters/ascii"
 	"github.com/clipperhouse/jargon/filters/contractions"
+	"github.com/clipperhouse/jargon/filters/language"
 	"github.com/clipperhouse/jargon/filters/stackoverflow"
 	"github.com/clipperhouse/jargon/filters/stemmer"
+	"github.com/clipperhouse/jargon/filters/stopwords"
 	"github.com/spf13/afero"
 )
 
@@ -36,9 +38,16 @@ func main() {
 	flag.Bool("contractions", false, "a filter to expand contractions, e.g. Would've → Would have")
 	flag.Bool("ascii", false, "a filter to replace diacritics with ascii equivalents, e.g. café → cafe")
 	flag.Bool("stem", false, "a filter to stem words using snowball stemmer, e.g. management|manager → manag")
-	lang := flag.String("lang", "english", "language of input, relevant when used with -stem. options:\n"+strings.Join(langs, ", "))
+	flag.Bool("stop", false, "a filter to remove common words (stop words), e.g. the, of, and")
+	lang := flag.String("lang", "english", "language of input, by name or code (e.g. en), relevant when used with -contractions, -stem or -stop.\n"+
+		"Use auto to detect the language of each sentence. options:\n"+
+		"-contractions: "+strings.Join(contractions.Languages(), ", ")+" (English for others)\n"+
+		"-stem: "+strings.Join(stemmer.Languages(), ", ")+"\n"+
+		"-stop: "+strings.Join(stopwords.Languages(), ", "))
 
 	html := flag.Bool("html", false, "parse input as html (keep tags whole)")
+	markdown := flag.Bool("markdown", false, "parse input as markdown (keep markup and code whole)")
+	xml := flag.Bool("xml", false, "parse input as xml (keep tags whole)")
 	filein := flag.String("file", "", "input file path (if none, stdin is used as input)")
 	fileout := flag.String("out", "", "output file path (if none, stdout is used as input)")
 	flag.Bool("lemmas", false, "only return tokens that have been changed by a filter (lemmatized)")
@@ -57,10 +66,12 @@ func main() {
 	}
 
 	c := config{
-		Fs:    afero.NewOsFs(),
-		HTML:  *html,
-		Count: *count,
-		Lines: *lines,
+		Fs:       afero.NewOsFs(),
+		HTML:     *html,
+		Markdown: *markdown,
+		XML:      *xml,
+		Count:    *count,
+		Lines:    *lines,
 	}
 
 	//
@@ -117,10 +128,12 @@ func main() {
 type config struct {
 	Fs afero.Fs
 
-	HTML    bool
-	Count   bool
-	Lines   bool
-	Filters []jargon.Filter
+	HTML     bool
+	Markdown bool
+	XML      bool
+	Count    bool
+	Lines    bool
+	Filters  []jargon.Filter
 
 	Filein, Fileout   afero.File
 	Pipedin, Pipedout bool
@@ -174,16 +187,27 @@ var filterMap = map[string]jargon.Filter{
 	"-distinct":     (*jargon.TokenStream).Distinct,
 	"-stack":        stackoverflow.Tags,
 	"-stem":         stemmer.English,
+	"-stop":         stopwords.English,
 }
 
-var langs = []string{"english", "french", "norwegian", "russian", "spanish", "swedish"}
-var stemmerMap = map[string]jargon.Filter{
-	"english":   stemmer.English,
-	"french":    stemmer.French,
-	"norwegian": stemmer.Norwegian,
-	"russian":   stemmer.Russian,
-	"spanish":   stemmer.Spanish,
-	"swedish":   stemmer.Swedish,
+// langFilters are filters which depend on the -lang flag, and the func to look up the filter by language
+var langFilters = map[string]func(lang string) (jargon.Filter, bool){
+	"-contractions": contractions.Language,
+	"-stem":         stemmer.Language,
+	"-stop":         stopwords.Language,
+}
+
+// langFallbacks are langFilters which fall back to their filter in filterMap for languages they don't know, e.g.
+// English contractions are expanded in Spanish text, which has no elisions to expand
+var langFallbacks = map[string]bool{
+	"-contractions": true,
+}
+
+// langOptions are the languages available to each of langFilters, for usage and errors
+var langOptions = map[string][]string{
+	"-contractions": contractions.Languages(),
+	"-stem":         stemmer.Languages(),
+	"-stop":         stopwords.Languages(),
 }
 
 func setFilters(c *config, args []string, lang string) error {
@@ -191,13 +215,21 @@ func setFilters(c *config, args []string, lang string) error {
 	for _, arg := range args {
 		filter, found := filterMap[arg]
 		if found {
-			if arg == "-stem" && lang != "" {
+			byLang, ok := langFilters[arg]
+			if ok && langFallbacks[arg] {
+				byLang = fallback(byLang, filter)
+			}
+
+			if ok && lang == "auto" {
+				// Detect the language of each sentence, and look up the filter for it
+				filter = language.NewRouter(byLang)
+			} else if ok && lang != "" {
 				// Look for a language specification
-				stem, found := stemmerMap[lang]
+				f, found := byLang(lang)
 				if found {
-					filter = stem
+					filter = f
 				} else {
-					err := fmt.Errorf("lang %q is not known by %s; options are %s", lang, flag.CommandLine.Name(), strings.Join(langs, ", "))
+					err := fmt.Errorf("lang %q is not known by %s %s; options are %s", lang, flag.CommandLine.Name(), arg, strings.Join(langOptions[arg], ", "))
 					return err
 				}
 			}
@@ -208,6 +240,16 @@ func setFilters(c *config, args []string, lang string) error {
 	return nil
 }
 
+// fallback looks up a filter by language, returning the given filter for languages which the lookup doesn't know
+func fallback(lookup func(lang string) (jargon.Filter, bool), filter jargon.Filter) func(lang string) (jargon.Filter, bool) {
+	return func(lang string) (jargon.Filter, bool) {
+		if f, found := lookup(lang); found {
+			return f, true
+		}
+		return filter, true
+	}
+}
+
 func setOutput(c *config, fileout string) error {
 	if fileout != "" {
 		file, err := c.Fs.Create(fileout)
@@ -282,12 +324,25 @@ func execute(c *config) error {
 	}
 
 	var tokens *jargon.TokenStream
-	if c.HTML {
+	switch {
+	case c.HTML:
 		tokens = jargon.TokenizeHTML(c.Reader)
-	} else {
+	case c.Markdown:
+		tokens = jargon.TokenizeMarkdown(c.Reader)
+	case c.XML:
+		tokens = jargon.TokenizeXML(c.Reader)
+	default:
 		tokens = jargon.Tokenize(c.Reader)
 	}
 
+	if c.HTML && !c.Count && !c.Lines {
+		// Tokens created by filters have no original text, so must be escaped
+		if _, err := (jargon.HTMLWriter{Unwrapped: true}).WriteHTML(c.Writer, tokens, c.Filters...); err != nil {
+			return err
+		}
+		return c.Writer.Flush()
+	}
+
 	for _, f := range c.Filters {
 		tokens = f(tokens)
 	}
@@ -311,7 +366,8 @@ func execute(c *config) error {
 	// Write all
 	for tokens.Scan() {
 		token := tokens.Token()
-		_, err := c.Writer.WriteString(token.String())
+		// Raw, so that escaped HTML stays escaped
+		_, err := c.Writer.WriteString(token.Raw())
 		if err != nil {
 			return err
 		}
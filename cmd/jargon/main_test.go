@@ -1,9 +1,12 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"errors"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/clipperhouse/jargon"
@@ -11,6 +14,7 @@ import (
 	"github.com/clipperhouse/jargon/filters/contractions"
 	"github.com/clipperhouse/jargon/filters/stackoverflow"
 	"github.com/clipperhouse/jargon/filters/stemmer"
+	"github.com/clipperhouse/jargon/filters/width"
 	"github.com/spf13/afero"
 )
 
@@ -187,6 +191,120 @@ func TestFilters(t *testing.T) {
 	}
 }
 
+func TestFilterOutputs(t *testing.T) {
+	type test struct {
+		// input
+		args  []string
+		lang  string
+		input string
+
+		// expected
+		err    bool
+		output string
+	}
+
+	// Filters are compared by their output, since different filters may share a function pointer
+	tests := []test{
+		{
+			args:  []string{"-stack", "-stem", "-ascii", "-contractions"},
+			lang:  "",
+			input: "We don't use Ruby on Rails in the cafés",
+
+			err:    false,
+			output: "we do not use ruby-on-rails in the cafe",
+		},
+		{
+			args:  []string{"-stem"},
+			lang:  "spanish",
+			input: "los gatos estaban corriendo",
+
+			err:    false,
+			output: "los gat estab corr",
+		},
+		{
+			args:  []string{"-stem", "-stop"},
+			lang:  "fr",
+			input: "les chats et les chiens mangeaient",
+
+			err:    false,
+			output: " chat   chien mang",
+		},
+		{
+			args:  []string{"-stop"},
+			lang:  "german",
+			input: "der Hund und die Katze, the dog and the cat",
+
+			err:    false,
+			output: " Hund   Katze, the dog and the cat",
+		},
+		{
+			args:  []string{"-stem", "-stop"},
+			lang:  "de",
+			input: "die Häuser und die Katzen",
+
+			err:    false,
+			output: " haus   katz",
+		},
+		{
+			args:  []string{"-contractions", "-stem"},
+			lang:  "auto",
+			input: "I don't like running in the mountains. Nous n'aimons pas les chats.",
+
+			err:    false,
+			output: "i do not like run in the mountain. nous ne aimon pas le chat.",
+		},
+		{
+			// Languages without elisions fall back to English contractions
+			args:  []string{"-contractions"},
+			lang:  "german",
+			input: "Ich don't know",
+
+			err:    false,
+			output: "Ich do not know",
+		},
+		{
+			args:  []string{"-stem", "-contractions"},
+			lang:  "spanish",
+			input: "los gatos don't corren",
+
+			err:    false,
+			output: "los gat do not corr",
+		},
+		{
+			args: []string{"-stem"},
+			lang: "foo",
+
+			err: true,
+		},
+	}
+	for _, test := range tests {
+		c, err := testConfig()
+		if err != nil {
+			t.Error()
+		}
+
+		err = setFilters(&c, test.args, test.lang)
+		if (err != nil) != test.err {
+			t.Errorf("expected err %v, got %v", test.err, err)
+		}
+		if test.err {
+			continue
+		}
+
+		tokens := jargon.TokenizeString(test.input)
+		for _, f := range c.Filters {
+			tokens = f(tokens)
+		}
+		got, err := tokens.String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.output {
+			t.Errorf("args: %v, lang: %s, given %q, expected %q, got %q", test.args, test.lang, test.input, test.output, got)
+		}
+	}
+}
+
 func TestOutput(t *testing.T) {
 	type test struct {
 		// input
@@ -243,3 +361,51 @@ func TestOutput(t *testing.T) {
 		}
 	}
 }
+
+func TestExecuteHTML(t *testing.T) {
+	// Escaped HTML must be written as it was read, not decoded
+	h := `<p title="a &quot;b&quot;">x &lt;script&gt;alert(1)&lt;/script&gt; AT&amp;T &mdash; Tom & Jerry</p>`
+
+	var out bytes.Buffer
+	c := config{
+		HTML:   true,
+		Reader: bufio.NewReader(strings.NewReader(h)),
+		Writer: bufio.NewWriter(&out),
+	}
+
+	if err := execute(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := out.String(); got != h {
+		t.Errorf("expected %q, got %q", h, got)
+	}
+
+	// Tokens created by filters are escaped
+	tests := []struct {
+		filter          jargon.Filter
+		given, expected string
+	}{
+		{width.Fold, `<p>&#xFF1C;script&#xFF1E;alert(1)&#xFF1C;/script&#xFF1E;</p>`, `<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>`},
+		// Folding a tag leaves it as markup
+		{ascii.Fold, `<img src="café.png"><p>café</p>`, `<img src="cafe.png"><p>cafe</p>`},
+	}
+
+	for _, test := range tests {
+		out.Reset()
+		c := config{
+			HTML:    true,
+			Filters: []jargon.Filter{test.filter},
+			Reader:  bufio.NewReader(strings.NewReader(test.given)),
+			Writer:  bufio.NewWriter(&out),
+		}
+
+		if err := execute(&c); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := out.String(); got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+}
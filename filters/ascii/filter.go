@@ -5,6 +5,7 @@ package ascii
 import (
 	"github.com/clipperhouse/jargon"
 	"github.com/clipperhouse/jargon/filters/mapper"
+	"github.com/clipperhouse/jargon/tokenqueue"
 )
 
 /*
@@ -37,3 +38,61 @@ func folder(token *jargon.Token) *jargon.Token {
 	}
 	return token
 }
+
+// PreserveOriginal folds as Fold does, and also emits the original token, following the folded token, with a
+// position increment of 0 (i.e. at the same position), as Lucene's preserveOriginal option does. Example:
+// café → cafe café
+var PreserveOriginal = NewFilter(nil, true)
+
+// NewFilter creates a folding filter, using a language-specific table, such as German, over the default folding.
+// A nil table folds as Fold does. If preserveOriginal is true, the original of a folded token is emitted after it; see PreserveOriginal.
+func NewFilter(table Table, preserveOriginal bool) jargon.Filter {
+	fold := func(token *jargon.Token) *jargon.Token {
+		fold, folded := table.FoldString(token.String())
+		if folded {
+			return jargon.NewToken(fold, true)
+		}
+		return token
+	}
+
+	if !preserveOriginal {
+		return mapper.NewFilter(fold)
+	}
+
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		t := &tokens{
+			incoming: incoming,
+			outgoing: tokenqueue.New(),
+			fold:     fold,
+		}
+		return jargon.NewTokenStream(t.next)
+	}
+}
+
+type tokens struct {
+	incoming *jargon.TokenStream
+	outgoing *tokenqueue.TokenQueue
+	fold     func(*jargon.Token) *jargon.Token
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.incoming.Next()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	folded := t.fold(token)
+	if folded == token {
+		return token, nil
+	}
+
+	t.outgoing.Push(token.WithPositionIncrement(0))
+	return folded.WithPositionIncrement(token.PositionIncrement()), nil
+}
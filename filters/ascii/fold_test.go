@@ -22,6 +22,8 @@ package ascii
 import (
 	"strings"
 	"testing"
+
+	"github.com/clipperhouse/jargon"
 )
 
 func TestLatin1Accents(t *testing.T) {
@@ -66,3 +68,85 @@ func TestLatin1Accents(t *testing.T) {
 		}
 	}
 }
+
+func TestTables(t *testing.T) {
+	tests := []struct {
+		table           Table
+		given, expected string
+	}{
+		{German, "Müller", "Mueller"},
+		{German, "MÜLLER", "MUELLER"},
+		{German, "Übermäßig", "Uebermaessig"},
+		{German, "Ü", "Ue"},
+		{German, "Crème brûlée", "Creme brulee"},
+		{Danish, "Ærø", "Aeroe"},
+		{Danish, "Århus", "Aarhus"},
+		{Danish, "BLÅBÆR", "BLAABAER"},
+		{Norwegian, "Tromsø", "Tromsoe"},
+		{Swedish, "Malmö", "Malmoe"},
+		{Swedish, "Åre", "Aare"},
+		{Swedish, "Ærø", "AEro"},
+		{nil, "Müller", "Muller"},
+	}
+
+	for _, test := range tests {
+		got, folded := test.table.FoldString(test.given)
+		if !folded {
+			t.Errorf("expected %q to fold", test.given)
+		}
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+
+	got, folded := German.FoldString("Mueller")
+	if folded || got != "Mueller" {
+		t.Errorf("expected %q not to fold, got %q", "Mueller", got)
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	for _, lang := range []string{"german", "de", "Danish", "no", "sv"} {
+		if _, found := Language(lang); !found {
+			t.Errorf("expected to find %q", lang)
+		}
+	}
+
+	if _, found := Language("klingon"); found {
+		t.Errorf("expected not to find klingon")
+	}
+
+	filter, _ := Language("de")
+	got, err := jargon.TokenizeString("Jürgen Müller").Filter(filter).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if expected := "Juergen Mueller"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestPreserveOriginal(t *testing.T) {
+	tokens, err := jargon.TokenizeString("un café noir").Filter(PreserveOriginal).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := []string{"un", " ", "cafe", "café", " ", "noir"}
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d", len(expected), len(tokens))
+	}
+
+	for i, token := range tokens {
+		if token.String() != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], token)
+		}
+	}
+
+	if !tokens[2].IsLemma() || tokens[2].PositionIncrement() != 1 {
+		t.Errorf("expected the folded token to be a lemma at the next position")
+	}
+	if tokens[3].IsLemma() || tokens[3].PositionIncrement() != 0 {
+		t.Errorf("expected the original to be at the same position as the folded token")
+	}
+}
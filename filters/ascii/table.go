@@ -0,0 +1,115 @@
+package ascii
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/language"
+)
+
+// Table is a language-specific folding, which takes precedence over FoldString; other characters are folded as by FoldString.
+// Uppercase replacements are Title case, e.g. Ü → Ue, and become UPPER case in all-caps words, e.g. MÜLLER → MUELLER.
+type Table map[rune]string
+
+// German folds umlauts to their two-letter equivalents, e.g. Müller → Mueller, Straße → Strasse
+var German = Table{
+	'Ä': "Ae", 'ä': "ae",
+	'Ö': "Oe", 'ö': "oe",
+	'Ü': "Ue", 'ü': "ue",
+	'ẞ': "Ss", 'ß': "ss",
+}
+
+// Danish folds æ, ø and å to their two-letter equivalents, e.g. Ærø → Aeroe, Århus → Aarhus
+var Danish = Table{
+	'Æ': "Ae", 'æ': "ae",
+	'Ø': "Oe", 'ø': "oe",
+	'Å': "Aa", 'å': "aa",
+}
+
+// Norwegian folds as Danish does, e.g. Tromsø → Tromsoe
+var Norwegian = Danish
+
+// Swedish folds å, ä and ö to their two-letter equivalents, e.g. Malmö → Malmoe, Åre → Aare
+var Swedish = Table{
+	'Å': "Aa", 'å': "aa",
+	'Ä': "Ae", 'ä': "ae",
+	'Ö': "Oe", 'ö': "oe",
+}
+
+// FoldString folds s using the table, and FoldString for characters not in the table
+func (table Table) FoldString(s string) (string, bool) {
+	var b strings.Builder
+	start := 0
+
+	for i, r := range s {
+		replacement, found := table[r]
+		if !found {
+			continue
+		}
+
+		// Fold the text preceding
+		fold, _ := FoldString(s[start:i])
+		b.WriteString(fold)
+
+		if unicode.IsUpper(r) && isUpperContext(s, i) {
+			replacement = strings.ToUpper(replacement)
+		}
+		b.WriteString(replacement)
+
+		start = i + utf8.RuneLen(r)
+	}
+
+	if start == 0 {
+		// Nothing found in the table
+		return FoldString(s)
+	}
+
+	fold, _ := FoldString(s[start:])
+	b.WriteString(fold)
+
+	return b.String(), true
+}
+
+// isUpperContext determines whether the (uppercase) rune at i is part of an all-caps word, by looking at the next letter,
+// or the previous one if the next is not a letter
+func isUpperContext(s string, i int) bool {
+	_, size := utf8.DecodeRuneInString(s[i:])
+	next, _ := utf8.DecodeRuneInString(s[i+size:])
+	if unicode.IsLetter(next) {
+		return unicode.IsUpper(next)
+	}
+
+	previous, _ := utf8.DecodeLastRuneInString(s[:i])
+	return unicode.IsLetter(previous) && unicode.IsUpper(previous)
+}
+
+var tables = map[string]Table{
+	"danish":    Danish,
+	"german":    German,
+	"norwegian": Norwegian,
+	"swedish":   Swedish,
+}
+
+// Language returns a folding filter for a language, by name (such as "german") or ISO 639-1 code (such as "de").
+// found will be false if the language is not available.
+func Language(lang string) (filter jargon.Filter, found bool) {
+	table, found := tables[language.Name(lang)]
+	if !found {
+		return nil, false
+	}
+
+	return NewFilter(table, false), true
+}
+
+// Languages returns the names of languages with specific folding tables, sorted
+func Languages() []string {
+	result := make([]string, 0, len(tables))
+	for lang := range tables {
+		result = append(result, lang)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,78 @@
+// Package casefold provides filters for Unicode case folding and lowercasing, which handle cases that
+// strings.ToLower does not, such as German ß, Turkish dotless ı and Greek final sigma.
+// Keywords (see jargon.Token.IsKeyword) are left alone.
+package casefold
+
+import (
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/mapper"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// Fold applies Unicode full case folding, which is intended for case-insensitive matching, rather than display. Examples:
+// Straße → strasse
+// ΟΔΟΣ → οδοσ
+// ǅ → dž
+var Fold = newFilter(func() cases.Caser { return cases.Fold() }, false)
+
+// Lower lowercases tokens, using language-independent Unicode rules, e.g. ΟΔΟΣ → οδος (with final sigma)
+var Lower = NewLower(language.Und)
+
+// Smart lowercases tokens, as Lower does, but leaves all-caps acronyms alone, e.g. NASA or HTML. Single capitals,
+// such as I or A, are lowercased.
+var Smart = NewSmart(language.Und)
+
+// NewLower creates a filter which lowercases tokens by the rules of a language, for example language.Turkish,
+// where DİYARBAKIR → diyarbakır
+func NewLower(tag language.Tag) jargon.Filter {
+	return newFilter(func() cases.Caser { return cases.Lower(tag) }, false)
+}
+
+// NewSmart creates a filter which lowercases tokens by the rules of a language, but leaves all-caps acronyms alone
+func NewSmart(tag language.Tag) jargon.Filter {
+	return newFilter(func() cases.Caser { return cases.Lower(tag) }, true)
+}
+
+// newFilter creates a filter using a caser. A Caser is stateful and should not be shared, so each token stream gets its own.
+func newFilter(caser func() cases.Caser, smart bool) jargon.Filter {
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		c := caser()
+		f := func(token *jargon.Token) *jargon.Token {
+			if token.IsSpace() || token.IsPunct() || token.IsKeyword() {
+				return token
+			}
+
+			s := token.String()
+			if smart && isAcronym(s) {
+				return token
+			}
+
+			cased := c.String(s)
+			if cased == s {
+				return token
+			}
+
+			return jargon.NewTokenOfKind(cased, true, token.Kind())
+		}
+
+		return mapper.NewFilter(f)(incoming)
+	}
+}
+
+// isAcronym determines whether s has at least two letters, all of which are uppercase
+func isAcronym(s string) bool {
+	letters := 0
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if !unicode.IsUpper(r) {
+			return false
+		}
+		letters++
+	}
+	return letters > 1
+}
@@ -0,0 +1,66 @@
+package casefold_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/casefold"
+	"github.com/clipperhouse/jargon/filters/keywords"
+	"golang.org/x/text/language"
+)
+
+func TestFilters(t *testing.T) {
+	type test struct {
+		filter          jargon.Filter
+		given, expected string
+	}
+
+	tests := []test{
+		{casefold.Fold, "Die Straße", "die strasse"},
+		{casefold.Fold, "ΟΔΟΣ", "οδοσ"},
+		{casefold.Lower, "ΟΔΟΣ", "οδος"},
+		{casefold.Lower, "Die Straße", "die straße"},
+		{casefold.Lower, "NASA and I", "nasa and i"},
+		{casefold.NewLower(language.Turkish), "DİYARBAKIR", "diyarbakır"},
+		{casefold.Lower, "DİYARBAKIR", "di̇yarbakir"},
+		{casefold.Smart, "NASA and I Went To the ISS.", "NASA and i went to the ISS."},
+		{casefold.Smart, "HTML5 and iPhone", "HTML5 and iphone"},
+		{casefold.NewSmart(language.Turkish), "IŞIK ve Işık", "IŞIK ve ışık"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(test.given).Filter(test.filter).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	tokens, err := jargon.TokenizeString("Hello world").Filter(casefold.Lower).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	// Only changed tokens are lemmas
+	expected := []bool{true, false, false}
+	for i, token := range tokens {
+		if token.IsLemma() != expected[i] {
+			t.Errorf("expected %q IsLemma to be %t", token, expected[i])
+		}
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	marked := keywords.NewFilter([]string{"US"}, false)
+	got, err := jargon.TokenizeString("US Army").Filter(marked, casefold.Fold).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if expected := "US army"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,64 @@
+// Package confusables maps tokens to their Unicode "skeleton", per UTS #39 (Unicode Security Mechanisms), such that
+// visually confusable strings, like "pаypal" with a Cyrillic а, have the same skeleton as "paypal". It is intended for
+// detecting spoofing and impersonation, for example in usernames and hashtags.
+//
+// A skeleton is for comparison, not display: it is not necessarily readable, e.g. "m" becomes "rn".
+package confusables
+
+import (
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/mapper"
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:generate go run generate/main.go
+
+// Skeleton maps tokens to their confusable skeleton, e.g. pаypal (with a Cyrillic а) → paypal
+var Skeleton = NewFilter(false)
+
+// NewFilter creates a skeleton filter. If mixedOnly is true, only tokens which mix scripts (see IsMixedScript) are mapped,
+// which avoids changing ordinary words in a single script, e.g. Cyrillic.
+func NewFilter(mixedOnly bool) jargon.Filter {
+	f := func(token *jargon.Token) *jargon.Token {
+		if token.IsSpace() || token.IsPunct() {
+			return token
+		}
+
+		s := token.String()
+		if mixedOnly && !isMixedScript(s) {
+			return token
+		}
+
+		skeleton := SkeletonString(s)
+		if skeleton == s {
+			return token
+		}
+
+		return jargon.NewTokenOfKind(skeleton, true, token.Kind())
+	}
+
+	return mapper.NewFilter(f)
+}
+
+// SkeletonString returns the skeleton of s: NFD, then each character replaced by its prototype, then NFD again
+func SkeletonString(s string) string {
+	s = norm.NFD.String(s)
+
+	var b strings.Builder
+	for _, r := range s {
+		if prototype, found := prototypes[r]; found {
+			b.WriteString(prototype)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return norm.NFD.String(b.String())
+}
+
+// Confusable determines whether a and b are visually confusable, i.e. have the same skeleton
+func Confusable(a, b string) bool {
+	return SkeletonString(a) == SkeletonString(b)
+}
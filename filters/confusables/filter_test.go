@@ -0,0 +1,93 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/confusables"
+)
+
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"pаypal", "paypal"}, // Cyrillic а
+		{"PАYPАL", "PAYPAL"}, // Cyrillic А
+		{"ρaypal", "paypal"}, // Greek ρ
+		{"ｐａｙｐａｌ", "paypal"},
+		{"paypa1", "paypal"},
+		{"rnicrosoft", "microsoft"},
+		{"G00GLE", "GOOGLE"},
+		{"𝐚𝐩𝐩𝐥𝐞", "apple"},
+		{"ꓑAYꓑAL", "PAYPAL"}, // Lisu ꓑ
+		{"ᎠELL", "DELL"},     // Cherokee Ꭰ
+	}
+
+	for _, test := range tests {
+		if !confusables.Confusable(test.a, test.b) {
+			t.Errorf("expected %q and %q to be confusable, got skeletons %q and %q",
+				test.a, test.b, confusables.SkeletonString(test.a), confusables.SkeletonString(test.b))
+		}
+	}
+
+	if confusables.Confusable("paypal", "paypals") {
+		t.Errorf("expected paypal and paypals not to be confusable")
+	}
+
+	// Skeletons are NFD
+	if got, expected := confusables.SkeletonString("é"), "é"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	given := "Pay pаypal, not Москва"
+
+	got, err := jargon.TokenizeString(given).Filter(confusables.Skeleton).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	skeleton := got[2]
+	if skeleton.String() != confusables.SkeletonString("paypal") || !skeleton.IsLemma() {
+		t.Errorf("expected a skeleton lemma, got %q", skeleton)
+	}
+
+	// Only mixed script
+	s, err := jargon.TokenizeString(given).Filter(confusables.NewFilter(true)).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "Pay " + confusables.SkeletonString("pаypal") + ", not Москва"; s != expected {
+		t.Errorf("expected %q, got %q", expected, s)
+	}
+}
+
+func TestIsMixedScript(t *testing.T) {
+	tests := map[string]bool{
+		"paypal":   false,
+		"pаypal":   true,
+		"Москва":   false,
+		"ρaypal":   true,
+		"abc123":   false,
+		"日本語のテキスト": false,
+		"한국어漢字":    false,
+		"日本語abc":   true,
+		"café":     false,
+	}
+
+	for s, expected := range tests {
+		token := jargon.NewToken(s, false)
+		if got := confusables.IsMixedScript(token); got != expected {
+			t.Errorf("expected IsMixedScript(%q) to be %t", s, expected)
+		}
+	}
+
+	mixed, err := jargon.TokenizeString("paypal pаypal").Where(confusables.IsMixedScript).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mixed) != 1 || mixed[0].String() != "pаypal" {
+		t.Errorf("expected one mixed-script token, got %q", mixed)
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	err := write()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// checksum is the SHA-256 of generate/confusables.txt, so that it isn't changed by accident. Update it when replacing the file.
+const checksum = "e9a736c428de6f8c86c677a676f1bd93682a2a6d24a6cdf72e606e288d9ae6fa"
+
+// verify checks data against checksum
+func verify(data []byte) error {
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != checksum {
+		return fmt.Errorf("expected confusables.txt to have SHA-256 %s, got %s", checksum, got)
+	}
+	return nil
+}
+
+type mapping struct {
+	Source  rune
+	Target  string
+	Comment string
+}
+
+// parse reads the confusables.txt format: source ; target ; type # comment
+// where source is a hex code point and target is a space-separated sequence of hex code points
+func parse(r io.Reader) ([]mapping, error) {
+	var result []mapping
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\uFEFF") // the original begins with a BOM
+
+		data, comment, _ := strings.Cut(line, "#")
+		if strings.TrimSpace(data) == "" {
+			continue
+		}
+
+		fields := strings.Split(data, ";")
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("expected at least two fields in %q", line)
+		}
+
+		source, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 16, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		var target strings.Builder
+		for _, hex := range strings.Fields(fields[1]) {
+			r, err := strconv.ParseUint(hex, 16, 32)
+			if err != nil {
+				return nil, err
+			}
+			target.WriteRune(rune(r))
+		}
+
+		// The comment is of the form ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A	#
+		comment = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(comment), "#"))
+		if _, names, found := strings.Cut(comment, ") "); found {
+			comment = names
+		}
+
+		result = append(result, mapping{
+			Source:  rune(source),
+			Target:  target.String(),
+			Comment: comment,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Source < result[j].Source
+	})
+
+	return result, nil
+}
+
+func write() error {
+	data, err := os.ReadFile("generate/confusables.txt")
+	if err != nil {
+		return err
+	}
+
+	if err := verify(data); err != nil {
+		return err
+	}
+
+	mappings, err := parse(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	var source bytes.Buffer
+
+	tmplErr := tmpl.Execute(&source, mappings)
+	if tmplErr != nil {
+		return tmplErr
+	}
+
+	formatted, fmtErr := format.Source(source.Bytes())
+	if fmtErr != nil {
+		return fmtErr
+	}
+
+	out, createErr := os.Create("generated.go")
+	if createErr != nil {
+		return createErr
+	}
+	defer out.Close()
+
+	_, writeErr := out.Write(formatted)
+	if writeErr != nil {
+		return writeErr
+	}
+
+	return nil
+}
+
+var tmpl = template.Must(template.New("").Parse(`
+package confusables
+
+// This file is generated from generate/confusables.txt. Best not to modify it, as it will likely be overwritten.
+
+// prototypes maps confusable characters to their prototype sequences
+var prototypes = map[rune]string{
+{{- range . }}
+	{{ printf "0x%04X" .Source }}: {{ printf "%q" .Target }}, // {{ .Comment }}
+{{- end }}
+}
+`))
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	data := "\uFEFF# comment\n\n0430 ;\t0061 ;\tMA\t# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A\t# \n" +
+		"006D ;\t0072 006E ;\tMA\t# ( m → rn ) LATIN SMALL LETTER M → LATIN SMALL LETTER R + LATIN SMALL LETTER N\t# \n"
+
+	mappings, err := parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(mappings) != 2 {
+		t.Fatalf("expected 2 mappings, got %d", len(mappings))
+	}
+
+	// Sorted by source
+	if m := mappings[0]; m.Source != 'm' || m.Target != "rn" {
+		t.Errorf("expected m → rn, got %q → %q", m.Source, m.Target)
+	}
+	if m := mappings[1]; m.Source != 'а' || m.Target != "a" || m.Comment != "CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A" {
+		t.Errorf("unexpected mapping %+v", m)
+	}
+
+	if _, err := parse(strings.NewReader("0430 0061\n")); err == nil {
+		t.Errorf("expected an error for a malformed line")
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	data, err := os.ReadFile("confusables.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verify(data); err != nil {
+		t.Error(err)
+	}
+
+	if err := verify(append(data, '\n')); err == nil {
+		t.Errorf("expected an error for changed data")
+	}
+}
+
+func TestData(t *testing.T) {
+	f, err := os.Open("confusables.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	mappings, err := parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[rune]bool)
+	for _, m := range mappings {
+		if seen[m.Source] {
+			t.Errorf("duplicate source %U", m.Source)
+		}
+		seen[m.Source] = true
+
+		if m.Target == "" {
+			t.Errorf("empty target for %U", m.Source)
+		}
+	}
+}
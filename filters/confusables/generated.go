@@ -0,0 +1,6572 @@
+package confusables
+
+// This file is generated from generate/confusables.txt. Best not to modify it, as it will likely be overwritten.
+
+// prototypes maps confusable characters to their prototype sequences
+var prototypes = map[rune]string{
+	0x0022:  "''",                 // QUOTATION MARK → APOSTROPHE + APOSTROPHE
+	0x0025:  "º/₀",                // PERCENT SIGN → MASCULINE ORDINAL INDICATOR + SOLIDUS + SUBSCRIPT ZERO
+	0x0030:  "O",                  // DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x0031:  "l",                  // DIGIT ONE → LATIN SMALL LETTER L
+	0x0049:  "l",                  // LATIN CAPITAL LETTER I → LATIN SMALL LETTER L
+	0x0060:  "'",                  // GRAVE ACCENT → APOSTROPHE
+	0x006D:  "rn",                 // LATIN SMALL LETTER M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x007C:  "l",                  // VERTICAL LINE → LATIN SMALL LETTER L
+	0x00A0:  " ",                  // NO-BREAK SPACE → SPACE
+	0x00A2:  "c̸",                 // CENT SIGN → LATIN SMALL LETTER C + COMBINING LONG SOLIDUS OVERLAY
+	0x00A5:  "Y̵",                 // YEN SIGN → LATIN CAPITAL LETTER Y + COMBINING SHORT STROKE OVERLAY
+	0x00AF:  "ˉ",                  // MACRON → MODIFIER LETTER MACRON
+	0x00B4:  "'",                  // ACUTE ACCENT → APOSTROPHE
+	0x00B5:  "μ",                  // MICRO SIGN → GREEK SMALL LETTER MU
+	0x00B8:  ",",                  // CEDILLA → COMMA
+	0x00C6:  "AE",                 // LATIN CAPITAL LETTER AE → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER E
+	0x00C7:  "C̦",                 // LATIN CAPITAL LETTER C WITH CEDILLA → LATIN CAPITAL LETTER C + COMBINING COMMA BELOW
+	0x00D0:  "D̵",                 // LATIN CAPITAL LETTER ETH → LATIN CAPITAL LETTER D + COMBINING SHORT STROKE OVERLAY
+	0x00D7:  "x",                  // MULTIPLICATION SIGN → LATIN SMALL LETTER X
+	0x00D8:  "O̸",                 // LATIN CAPITAL LETTER O WITH STROKE → LATIN CAPITAL LETTER O + COMBINING LONG SOLIDUS OVERLAY
+	0x00E6:  "ae",                 // LATIN SMALL LETTER AE → LATIN SMALL LETTER A + LATIN SMALL LETTER E
+	0x00E7:  "c̦",                 // LATIN SMALL LETTER C WITH CEDILLA → LATIN SMALL LETTER C + COMBINING COMMA BELOW
+	0x00F0:  "∂̵",                 // LATIN SMALL LETTER ETH → PARTIAL DIFFERENTIAL + COMBINING SHORT STROKE OVERLAY
+	0x00F6:  "ة",                  // LATIN SMALL LETTER O WITH DIAERESIS → ARABIC LETTER TEH MARBUTA
+	0x00F8:  "o̸",                 // LATIN SMALL LETTER O WITH STROKE → LATIN SMALL LETTER O + COMBINING LONG SOLIDUS OVERLAY
+	0x00FE:  "p",                  // LATIN SMALL LETTER THORN → LATIN SMALL LETTER P
+	0x0110:  "D̵",                 // LATIN CAPITAL LETTER D WITH STROKE → LATIN CAPITAL LETTER D + COMBINING SHORT STROKE OVERLAY
+	0x0111:  "d̵",                 // LATIN SMALL LETTER D WITH STROKE → LATIN SMALL LETTER D + COMBINING SHORT STROKE OVERLAY
+	0x011A:  "Ĕ",                  // LATIN CAPITAL LETTER E WITH CARON → LATIN CAPITAL LETTER E WITH BREVE
+	0x011B:  "ĕ",                  // LATIN SMALL LETTER E WITH CARON → LATIN SMALL LETTER E WITH BREVE
+	0x0126:  "H̵",                 // LATIN CAPITAL LETTER H WITH STROKE → LATIN CAPITAL LETTER H + COMBINING SHORT STROKE OVERLAY
+	0x0127:  "h̵",                 // LATIN SMALL LETTER H WITH STROKE → LATIN SMALL LETTER H + COMBINING SHORT STROKE OVERLAY
+	0x0131:  "i",                  // LATIN SMALL LETTER DOTLESS I → LATIN SMALL LETTER I
+	0x0132:  "lJ",                 // LATIN CAPITAL LIGATURE IJ → LATIN SMALL LETTER L + LATIN CAPITAL LETTER J
+	0x0133:  "ij",                 // LATIN SMALL LIGATURE IJ → LATIN SMALL LETTER I + LATIN SMALL LETTER J
+	0x013F:  "l·",                 // LATIN CAPITAL LETTER L WITH MIDDLE DOT → LATIN SMALL LETTER L + MIDDLE DOT
+	0x0140:  "l·",                 // LATIN SMALL LETTER L WITH MIDDLE DOT → LATIN SMALL LETTER L + MIDDLE DOT
+	0x0141:  "L̸",                 // LATIN CAPITAL LETTER L WITH STROKE → LATIN CAPITAL LETTER L + COMBINING LONG SOLIDUS OVERLAY
+	0x0142:  "l̸",                 // LATIN SMALL LETTER L WITH STROKE → LATIN SMALL LETTER L + COMBINING LONG SOLIDUS OVERLAY
+	0x0146:  "ɲ",                  // LATIN SMALL LETTER N WITH CEDILLA → LATIN SMALL LETTER N WITH LEFT HOOK
+	0x0149:  "'n",                 // LATIN SMALL LETTER N PRECEDED BY APOSTROPHE → APOSTROPHE + LATIN SMALL LETTER N
+	0x014B:  "n̩",                 // LATIN SMALL LETTER ENG → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x0150:  "Ö",                  // LATIN CAPITAL LETTER O WITH DOUBLE ACUTE → LATIN CAPITAL LETTER O WITH DIAERESIS
+	0x0152:  "OE",                 // LATIN CAPITAL LIGATURE OE → LATIN CAPITAL LETTER O + LATIN CAPITAL LETTER E
+	0x0153:  "oe",                 // LATIN SMALL LIGATURE OE → LATIN SMALL LETTER O + LATIN SMALL LETTER E
+	0x0163:  "ƫ",                  // LATIN SMALL LETTER T WITH CEDILLA → LATIN SMALL LETTER T WITH PALATAL HOOK
+	0x0166:  "T̵",                 // LATIN CAPITAL LETTER T WITH STROKE → LATIN CAPITAL LETTER T + COMBINING SHORT STROKE OVERLAY
+	0x0167:  "t̵",                 // LATIN SMALL LETTER T WITH STROKE → LATIN SMALL LETTER T + COMBINING SHORT STROKE OVERLAY
+	0x017F:  "f",                  // LATIN SMALL LETTER LONG S → LATIN SMALL LETTER F
+	0x0180:  "b̵",                 // LATIN SMALL LETTER B WITH STROKE → LATIN SMALL LETTER B + COMBINING SHORT STROKE OVERLAY
+	0x0181:  "'B",                 // LATIN CAPITAL LETTER B WITH HOOK → APOSTROPHE + LATIN CAPITAL LETTER B
+	0x0182:  "b̄",                 // LATIN CAPITAL LETTER B WITH TOPBAR → LATIN SMALL LETTER B + COMBINING MACRON
+	0x0183:  "b̄",                 // LATIN SMALL LETTER B WITH TOPBAR → LATIN SMALL LETTER B + COMBINING MACRON
+	0x0184:  "b",                  // LATIN CAPITAL LETTER TONE SIX → LATIN SMALL LETTER B
+	0x0187:  "C'",                 // LATIN CAPITAL LETTER C WITH HOOK → LATIN CAPITAL LETTER C + APOSTROPHE
+	0x0189:  "D̵",                 // LATIN CAPITAL LETTER AFRICAN D → LATIN CAPITAL LETTER D + COMBINING SHORT STROKE OVERLAY
+	0x018A:  "'D",                 // LATIN CAPITAL LETTER D WITH HOOK → APOSTROPHE + LATIN CAPITAL LETTER D
+	0x018C:  "d̄",                 // LATIN SMALL LETTER D WITH TOPBAR → LATIN SMALL LETTER D + COMBINING MACRON
+	0x018D:  "g",                  // LATIN SMALL LETTER TURNED DELTA → LATIN SMALL LETTER G
+	0x0191:  "F̦",                 // LATIN CAPITAL LETTER F WITH HOOK → LATIN CAPITAL LETTER F + COMBINING COMMA BELOW
+	0x0192:  "f",                  // LATIN SMALL LETTER F WITH HOOK → LATIN SMALL LETTER F
+	0x0193:  "G'",                 // LATIN CAPITAL LETTER G WITH HOOK → LATIN CAPITAL LETTER G + APOSTROPHE
+	0x0196:  "l",                  // LATIN CAPITAL LETTER IOTA → LATIN SMALL LETTER L
+	0x0197:  "l̵",                 // LATIN CAPITAL LETTER I WITH STROKE → LATIN SMALL LETTER L + COMBINING SHORT STROKE OVERLAY
+	0x0198:  "K'",                 // LATIN CAPITAL LETTER K WITH HOOK → LATIN CAPITAL LETTER K + APOSTROPHE
+	0x0199:  "k̔",                 // LATIN SMALL LETTER K WITH HOOK → LATIN SMALL LETTER K + COMBINING REVERSED COMMA ABOVE
+	0x019A:  "l̵",                 // LATIN SMALL LETTER L WITH BAR → LATIN SMALL LETTER L + COMBINING SHORT STROKE OVERLAY
+	0x019B:  "λ̸",                 // LATIN SMALL LETTER LAMBDA WITH STROKE → GREEK SMALL LETTER LAMDA + COMBINING LONG SOLIDUS OVERLAY
+	0x019D:  "N̦",                 // LATIN CAPITAL LETTER N WITH LEFT HOOK → LATIN CAPITAL LETTER N + COMBINING COMMA BELOW
+	0x019E:  "n̩",                 // LATIN SMALL LETTER N WITH LONG RIGHT LEG → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x019F:  "O̵",                 // LATIN CAPITAL LETTER O WITH MIDDLE TILDE → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x01A0:  "O'",                 // LATIN CAPITAL LETTER O WITH HORN → LATIN CAPITAL LETTER O + APOSTROPHE
+	0x01A1:  "o'",                 // LATIN SMALL LETTER O WITH HORN → LATIN SMALL LETTER O + APOSTROPHE
+	0x01A4:  "'P",                 // LATIN CAPITAL LETTER P WITH HOOK → APOSTROPHE + LATIN CAPITAL LETTER P
+	0x01A5:  "p̔",                 // LATIN SMALL LETTER P WITH HOOK → LATIN SMALL LETTER P + COMBINING REVERSED COMMA ABOVE
+	0x01A6:  "R",                  // LATIN LETTER YR → LATIN CAPITAL LETTER R
+	0x01A7:  "2",                  // LATIN CAPITAL LETTER TONE TWO → DIGIT TWO
+	0x01AC:  "'T",                 // LATIN CAPITAL LETTER T WITH HOOK → APOSTROPHE + LATIN CAPITAL LETTER T
+	0x01AD:  "t̔",                 // LATIN SMALL LETTER T WITH HOOK → LATIN SMALL LETTER T + COMBINING REVERSED COMMA ABOVE
+	0x01AE:  "T̨",                 // LATIN CAPITAL LETTER T WITH RETROFLEX HOOK → LATIN CAPITAL LETTER T + COMBINING OGONEK
+	0x01B3:  "'Y",                 // LATIN CAPITAL LETTER Y WITH HOOK → APOSTROPHE + LATIN CAPITAL LETTER Y
+	0x01B4:  "y̔",                 // LATIN SMALL LETTER Y WITH HOOK → LATIN SMALL LETTER Y + COMBINING REVERSED COMMA ABOVE
+	0x01B5:  "Z̵",                 // LATIN CAPITAL LETTER Z WITH STROKE → LATIN CAPITAL LETTER Z + COMBINING SHORT STROKE OVERLAY
+	0x01B6:  "z̵",                 // LATIN SMALL LETTER Z WITH STROKE → LATIN SMALL LETTER Z + COMBINING SHORT STROKE OVERLAY
+	0x01B7:  "3",                  // LATIN CAPITAL LETTER EZH → DIGIT THREE
+	0x01BB:  "2̵",                 // LATIN LETTER TWO WITH STROKE → DIGIT TWO + COMBINING SHORT STROKE OVERLAY
+	0x01BC:  "5",                  // LATIN CAPITAL LETTER TONE FIVE → DIGIT FIVE
+	0x01BD:  "s",                  // LATIN SMALL LETTER TONE FIVE → LATIN SMALL LETTER S
+	0x01BF:  "p",                  // LATIN LETTER WYNN → LATIN SMALL LETTER P
+	0x01C0:  "l",                  // LATIN LETTER DENTAL CLICK → LATIN SMALL LETTER L
+	0x01C1:  "ll",                 // LATIN LETTER LATERAL CLICK → LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x01C3:  "!",                  // LATIN LETTER RETROFLEX CLICK → EXCLAMATION MARK
+	0x01C4:  "DŽ",                 // LATIN CAPITAL LETTER DZ WITH CARON → LATIN CAPITAL LETTER D + LATIN CAPITAL LETTER Z WITH CARON
+	0x01C5:  "Dž",                 // LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON → LATIN CAPITAL LETTER D + LATIN SMALL LETTER Z WITH CARON
+	0x01C6:  "dž",                 // LATIN SMALL LETTER DZ WITH CARON → LATIN SMALL LETTER D + LATIN SMALL LETTER Z WITH CARON
+	0x01C7:  "LJ",                 // LATIN CAPITAL LETTER LJ → LATIN CAPITAL LETTER L + LATIN CAPITAL LETTER J
+	0x01C8:  "Lj",                 // LATIN CAPITAL LETTER L WITH SMALL LETTER J → LATIN CAPITAL LETTER L + LATIN SMALL LETTER J
+	0x01C9:  "lj",                 // LATIN SMALL LETTER LJ → LATIN SMALL LETTER L + LATIN SMALL LETTER J
+	0x01CA:  "NJ",                 // LATIN CAPITAL LETTER NJ → LATIN CAPITAL LETTER N + LATIN CAPITAL LETTER J
+	0x01CB:  "Nj",                 // LATIN CAPITAL LETTER N WITH SMALL LETTER J → LATIN CAPITAL LETTER N + LATIN SMALL LETTER J
+	0x01CC:  "nj",                 // LATIN SMALL LETTER NJ → LATIN SMALL LETTER N + LATIN SMALL LETTER J
+	0x01CD:  "Ă",                  // LATIN CAPITAL LETTER A WITH CARON → LATIN CAPITAL LETTER A WITH BREVE
+	0x01CE:  "ă",                  // LATIN SMALL LETTER A WITH CARON → LATIN SMALL LETTER A WITH BREVE
+	0x01CF:  "Ĭ",                  // LATIN CAPITAL LETTER I WITH CARON → LATIN CAPITAL LETTER I WITH BREVE
+	0x01D0:  "ĭ",                  // LATIN SMALL LETTER I WITH CARON → LATIN SMALL LETTER I WITH BREVE
+	0x01D1:  "Ŏ",                  // LATIN CAPITAL LETTER O WITH CARON → LATIN CAPITAL LETTER O WITH BREVE
+	0x01D2:  "ŏ",                  // LATIN SMALL LETTER O WITH CARON → LATIN SMALL LETTER O WITH BREVE
+	0x01D3:  "Ŭ",                  // LATIN CAPITAL LETTER U WITH CARON → LATIN CAPITAL LETTER U WITH BREVE
+	0x01D4:  "ŭ",                  // LATIN SMALL LETTER U WITH CARON → LATIN SMALL LETTER U WITH BREVE
+	0x01E4:  "G̵",                 // LATIN CAPITAL LETTER G WITH STROKE → LATIN CAPITAL LETTER G + COMBINING SHORT STROKE OVERLAY
+	0x01E5:  "g̵",                 // LATIN SMALL LETTER G WITH STROKE → LATIN SMALL LETTER G + COMBINING SHORT STROKE OVERLAY
+	0x01E6:  "Ğ",                  // LATIN CAPITAL LETTER G WITH CARON → LATIN CAPITAL LETTER G WITH BREVE
+	0x01E7:  "ğ",                  // LATIN SMALL LETTER G WITH CARON → LATIN SMALL LETTER G WITH BREVE
+	0x01F1:  "DZ",                 // LATIN CAPITAL LETTER DZ → LATIN CAPITAL LETTER D + LATIN CAPITAL LETTER Z
+	0x01F2:  "Dz",                 // LATIN CAPITAL LETTER D WITH SMALL LETTER Z → LATIN CAPITAL LETTER D + LATIN SMALL LETTER Z
+	0x01F3:  "dz",                 // LATIN SMALL LETTER DZ → LATIN SMALL LETTER D + LATIN SMALL LETTER Z
+	0x01F5:  "ģ",                  // LATIN SMALL LETTER G WITH ACUTE → LATIN SMALL LETTER G WITH CEDILLA
+	0x01FE:  "Ó̸",                // LATIN CAPITAL LETTER O WITH STROKE AND ACUTE → LATIN CAPITAL LETTER O + COMBINING LONG SOLIDUS OVERLAY + COMBINING ACUTE ACCENT
+	0x021A:  "Ţ",                  // LATIN CAPITAL LETTER T WITH COMMA BELOW → LATIN CAPITAL LETTER T WITH CEDILLA
+	0x021B:  "ƫ",                  // LATIN SMALL LETTER T WITH COMMA BELOW → LATIN SMALL LETTER T WITH PALATAL HOOK
+	0x021C:  "3",                  // LATIN CAPITAL LETTER YOGH → DIGIT THREE
+	0x0222:  "8",                  // LATIN CAPITAL LETTER OU → DIGIT EIGHT
+	0x0223:  "8",                  // LATIN SMALL LETTER OU → DIGIT EIGHT
+	0x0224:  "Z̦",                 // LATIN CAPITAL LETTER Z WITH HOOK → LATIN CAPITAL LETTER Z + COMBINING COMMA BELOW
+	0x0225:  "z̦",                 // LATIN SMALL LETTER Z WITH HOOK → LATIN SMALL LETTER Z + COMBINING COMMA BELOW
+	0x0226:  "Å",                  // LATIN CAPITAL LETTER A WITH DOT ABOVE → LATIN CAPITAL LETTER A WITH RING ABOVE
+	0x0227:  "å",                  // LATIN SMALL LETTER A WITH DOT ABOVE → LATIN SMALL LETTER A WITH RING ABOVE
+	0x023C:  "c̸",                 // LATIN SMALL LETTER C WITH STROKE → LATIN SMALL LETTER C + COMBINING LONG SOLIDUS OVERLAY
+	0x023E:  "T̸",                 // LATIN CAPITAL LETTER T WITH DIAGONAL STROKE → LATIN CAPITAL LETTER T + COMBINING LONG SOLIDUS OVERLAY
+	0x0241:  "?",                  // LATIN CAPITAL LETTER GLOTTAL STOP → QUESTION MARK
+	0x0244:  "U̵",                 // LATIN CAPITAL LETTER U BAR → LATIN CAPITAL LETTER U + COMBINING SHORT STROKE OVERLAY
+	0x0246:  "E̸",                 // LATIN CAPITAL LETTER E WITH STROKE → LATIN CAPITAL LETTER E + COMBINING LONG SOLIDUS OVERLAY
+	0x0247:  "e̸",                 // LATIN SMALL LETTER E WITH STROKE → LATIN SMALL LETTER E + COMBINING LONG SOLIDUS OVERLAY
+	0x0248:  "J̵",                 // LATIN CAPITAL LETTER J WITH STROKE → LATIN CAPITAL LETTER J + COMBINING SHORT STROKE OVERLAY
+	0x0249:  "j̵",                 // LATIN SMALL LETTER J WITH STROKE → LATIN SMALL LETTER J + COMBINING SHORT STROKE OVERLAY
+	0x024D:  "r̵",                 // LATIN SMALL LETTER R WITH STROKE → LATIN SMALL LETTER R + COMBINING SHORT STROKE OVERLAY
+	0x024E:  "Y̵",                 // LATIN CAPITAL LETTER Y WITH STROKE → LATIN CAPITAL LETTER Y + COMBINING SHORT STROKE OVERLAY
+	0x024F:  "y̵",                 // LATIN SMALL LETTER Y WITH STROKE → LATIN SMALL LETTER Y + COMBINING SHORT STROKE OVERLAY
+	0x0251:  "a",                  // LATIN SMALL LETTER ALPHA → LATIN SMALL LETTER A
+	0x0253:  "b̔",                 // LATIN SMALL LETTER B WITH HOOK → LATIN SMALL LETTER B + COMBINING REVERSED COMMA ABOVE
+	0x0256:  "d̨",                 // LATIN SMALL LETTER D WITH TAIL → LATIN SMALL LETTER D + COMBINING OGONEK
+	0x0257:  "d̔",                 // LATIN SMALL LETTER D WITH HOOK → LATIN SMALL LETTER D + COMBINING REVERSED COMMA ABOVE
+	0x0259:  "ǝ",                  // LATIN SMALL LETTER SCHWA → LATIN SMALL LETTER TURNED E
+	0x025A:  "ǝ˞",                 // LATIN SMALL LETTER SCHWA WITH HOOK → LATIN SMALL LETTER TURNED E + MODIFIER LETTER RHOTIC HOOK
+	0x025B:  "ꞓ",                  // LATIN SMALL LETTER OPEN E → LATIN SMALL LETTER C WITH BAR
+	0x0260:  "g̔",                 // LATIN SMALL LETTER G WITH HOOK → LATIN SMALL LETTER G + COMBINING REVERSED COMMA ABOVE
+	0x0261:  "g",                  // LATIN SMALL LETTER SCRIPT G → LATIN SMALL LETTER G
+	0x0263:  "y",                  // LATIN SMALL LETTER GAMMA → LATIN SMALL LETTER Y
+	0x0266:  "h̔",                 // LATIN SMALL LETTER H WITH HOOK → LATIN SMALL LETTER H + COMBINING REVERSED COMMA ABOVE
+	0x0268:  "i̵",                 // LATIN SMALL LETTER I WITH STROKE → LATIN SMALL LETTER I + COMBINING SHORT STROKE OVERLAY
+	0x0269:  "i",                  // LATIN SMALL LETTER IOTA → LATIN SMALL LETTER I
+	0x026A:  "i",                  // LATIN LETTER SMALL CAPITAL I → LATIN SMALL LETTER I
+	0x026B:  "l̴",                 // LATIN SMALL LETTER L WITH MIDDLE TILDE → LATIN SMALL LETTER L + COMBINING TILDE OVERLAY
+	0x026D:  "l̨",                 // LATIN SMALL LETTER L WITH RETROFLEX HOOK → LATIN SMALL LETTER L + COMBINING OGONEK
+	0x026E:  "lȝ",                 // LATIN SMALL LETTER LEZH → LATIN SMALL LETTER L + LATIN SMALL LETTER YOGH
+	0x026F:  "w",                  // LATIN SMALL LETTER TURNED M → LATIN SMALL LETTER W
+	0x0271:  "rn̦",                // LATIN SMALL LETTER M WITH HOOK → LATIN SMALL LETTER R + LATIN SMALL LETTER N + COMBINING COMMA BELOW
+	0x0273:  "n̨",                 // LATIN SMALL LETTER N WITH RETROFLEX HOOK → LATIN SMALL LETTER N + COMBINING OGONEK
+	0x0275:  "o̵",                 // LATIN SMALL LETTER BARRED O → LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x0276:  "oᴇ",                 // LATIN LETTER SMALL CAPITAL OE → LATIN SMALL LETTER O + LATIN LETTER SMALL CAPITAL E
+	0x027C:  "r̩",                 // LATIN SMALL LETTER R WITH LONG LEG → LATIN SMALL LETTER R + COMBINING VERTICAL LINE BELOW
+	0x027D:  "r̨",                 // LATIN SMALL LETTER R WITH TAIL → LATIN SMALL LETTER R + COMBINING OGONEK
+	0x0282:  "s̨",                 // LATIN SMALL LETTER S WITH HOOK → LATIN SMALL LETTER S + COMBINING OGONEK
+	0x028B:  "u",                  // LATIN SMALL LETTER V WITH HOOK → LATIN SMALL LETTER U
+	0x028F:  "y",                  // LATIN LETTER SMALL CAPITAL Y → LATIN SMALL LETTER Y
+	0x0290:  "z̨",                 // LATIN SMALL LETTER Z WITH RETROFLEX HOOK → LATIN SMALL LETTER Z + COMBINING OGONEK
+	0x0292:  "ȝ",                  // LATIN SMALL LETTER EZH → LATIN SMALL LETTER YOGH
+	0x0294:  "?",                  // LATIN LETTER GLOTTAL STOP → QUESTION MARK
+	0x0295:  "꟎",                  // ( ʕ → ꟎ )
+	0x02A0:  "q̔",                 // LATIN SMALL LETTER Q WITH HOOK → LATIN SMALL LETTER Q + COMBINING REVERSED COMMA ABOVE
+	0x02A3:  "dz",                 // LATIN SMALL LETTER DZ DIGRAPH → LATIN SMALL LETTER D + LATIN SMALL LETTER Z
+	0x02A4:  "dȝ",                 // LATIN SMALL LETTER DEZH DIGRAPH → LATIN SMALL LETTER D + LATIN SMALL LETTER YOGH
+	0x02A5:  "dʑ",                 // LATIN SMALL LETTER DZ DIGRAPH WITH CURL → LATIN SMALL LETTER D + LATIN SMALL LETTER Z WITH CURL
+	0x02A6:  "ts",                 // LATIN SMALL LETTER TS DIGRAPH → LATIN SMALL LETTER T + LATIN SMALL LETTER S
+	0x02A7:  "tʃ",                 // LATIN SMALL LETTER TESH DIGRAPH → LATIN SMALL LETTER T + LATIN SMALL LETTER ESH
+	0x02A8:  "tɕ",                 // LATIN SMALL LETTER TC DIGRAPH WITH CURL → LATIN SMALL LETTER T + LATIN SMALL LETTER C WITH CURL
+	0x02A9:  "fn̩",                // LATIN SMALL LETTER FENG DIGRAPH → LATIN SMALL LETTER F + LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x02AA:  "ls",                 // LATIN SMALL LETTER LS DIGRAPH → LATIN SMALL LETTER L + LATIN SMALL LETTER S
+	0x02AB:  "lz",                 // LATIN SMALL LETTER LZ DIGRAPH → LATIN SMALL LETTER L + LATIN SMALL LETTER Z
+	0x02B3:  "ᣴ",                  // MODIFIER LETTER SMALL R → CANADIAN SYLLABICS BEAVER DENE R
+	0x02B9:  "'",                  // MODIFIER LETTER PRIME → APOSTROPHE
+	0x02BA:  "''",                 // MODIFIER LETTER DOUBLE PRIME → APOSTROPHE + APOSTROPHE
+	0x02BB:  "'",                  // MODIFIER LETTER TURNED COMMA → APOSTROPHE
+	0x02BC:  "'",                  // MODIFIER LETTER APOSTROPHE → APOSTROPHE
+	0x02BD:  "'",                  // MODIFIER LETTER REVERSED COMMA → APOSTROPHE
+	0x02BE:  "'",                  // MODIFIER LETTER RIGHT HALF RING → APOSTROPHE
+	0x02BF:  "ՙ",                  // MODIFIER LETTER LEFT HALF RING → ARMENIAN MODIFIER LETTER LEFT HALF RING
+	0x02C2:  "<",                  // MODIFIER LETTER LEFT ARROWHEAD → LESS-THAN SIGN
+	0x02C3:  ">",                  // MODIFIER LETTER RIGHT ARROWHEAD → GREATER-THAN SIGN
+	0x02C4:  "^",                  // MODIFIER LETTER UP ARROWHEAD → CIRCUMFLEX ACCENT
+	0x02C6:  "^",                  // MODIFIER LETTER CIRCUMFLEX ACCENT → CIRCUMFLEX ACCENT
+	0x02C8:  "'",                  // MODIFIER LETTER VERTICAL LINE → APOSTROPHE
+	0x02CA:  "'",                  // MODIFIER LETTER ACUTE ACCENT → APOSTROPHE
+	0x02CB:  "'",                  // MODIFIER LETTER GRAVE ACCENT → APOSTROPHE
+	0x02D0:  ":",                  // MODIFIER LETTER TRIANGULAR COLON → COLON
+	0x02D3:  "ՙ",                  // MODIFIER LETTER CENTRED LEFT HALF RING → ARMENIAN MODIFIER LETTER LEFT HALF RING
+	0x02D7:  "-",                  // MODIFIER LETTER MINUS SIGN → HYPHEN-MINUS
+	0x02D8:  "ˇ",                  // BREVE → CARON
+	0x02D9:  "ॱ",                  // DOT ABOVE → DEVANAGARI SIGN HIGH SPACING DOT
+	0x02DA:  "°",                  // RING ABOVE → DEGREE SIGN
+	0x02DB:  "i",                  // OGONEK → LATIN SMALL LETTER I
+	0x02DC:  "~",                  // SMALL TILDE → TILDE
+	0x02DD:  "''",                 // DOUBLE ACUTE ACCENT → APOSTROPHE + APOSTROPHE
+	0x02E1:  "ᣳ",                  // MODIFIER LETTER SMALL L → CANADIAN SYLLABICS BEAVER DENE L
+	0x02E2:  "ᣵ",                  // MODIFIER LETTER SMALL S → CANADIAN SYLLABICS CARRIER DENTAL S
+	0x02E4:  "ˁ",                  // MODIFIER LETTER SMALL REVERSED GLOTTAL STOP → MODIFIER LETTER REVERSED GLOTTAL STOP
+	0x02EE:  "''",                 // MODIFIER LETTER DOUBLE APOSTROPHE → APOSTROPHE + APOSTROPHE
+	0x02F4:  "'",                  // MODIFIER LETTER MIDDLE GRAVE ACCENT → APOSTROPHE
+	0x02F6:  "''",                 // MODIFIER LETTER MIDDLE DOUBLE ACUTE ACCENT → APOSTROPHE + APOSTROPHE
+	0x02F8:  ":",                  // MODIFIER LETTER RAISED COLON → COLON
+	0x02FB:  "˪",                  // MODIFIER LETTER BEGIN LOW TONE → MODIFIER LETTER YIN DEPARTING TONE MARK
+	0x0305:  "̄",                  // COMBINING OVERLINE → COMBINING MACRON
+	0x030C:  "̆",                  // COMBINING CARON → COMBINING BREVE
+	0x030D:  "ٰ",                  // COMBINING VERTICAL LINE ABOVE → ARABIC LETTER SUPERSCRIPT ALEF
+	0x0310:  "̆̇",                 // COMBINING CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0311:  "̂",                  // COMBINING INVERTED BREVE → COMBINING CIRCUMFLEX ACCENT
+	0x0315:  "̓",                  // COMBINING COMMA ABOVE RIGHT → COMBINING COMMA ABOVE
+	0x0317:  "ِ",                  // COMBINING ACUTE ACCENT BELOW → ARABIC KASRA
+	0x031A:  "᫩",                  // ( ̚ → ᫩ )
+	0x0320:  "̱",                  // COMBINING MINUS SIGN BELOW → COMBINING MACRON BELOW
+	0x0321:  "̦",                  // COMBINING PALATALIZED HOOK BELOW → COMBINING COMMA BELOW
+	0x0322:  "̨",                  // COMBINING RETROFLEX HOOK BELOW → COMBINING OGONEK
+	0x0327:  "̦",                  // COMBINING CEDILLA → COMBINING COMMA BELOW
+	0x0336:  "̵",                  // COMBINING LONG STROKE OVERLAY → COMBINING SHORT STROKE OVERLAY
+	0x0337:  "̸",                  // COMBINING SHORT SOLIDUS OVERLAY → COMBINING LONG SOLIDUS OVERLAY
+	0x0339:  "̦",                  // COMBINING RIGHT HALF RING BELOW → COMBINING COMMA BELOW
+	0x0340:  "̀",                  // COMBINING GRAVE TONE MARK → COMBINING GRAVE ACCENT
+	0x0341:  "́",                  // COMBINING ACUTE TONE MARK → COMBINING ACUTE ACCENT
+	0x0342:  "̃",                  // COMBINING GREEK PERISPOMENI → COMBINING TILDE
+	0x0343:  "̓",                  // COMBINING GREEK KORONIS → COMBINING COMMA ABOVE
+	0x0345:  "̨",                  // COMBINING GREEK YPOGEGRAMMENI → COMBINING OGONEK
+	0x0347:  "̳",                  // COMBINING EQUALS SIGN BELOW → COMBINING DOUBLE LOW LINE
+	0x0348:  "𐻺",                  // ( ͈ → 𐻺 )
+	0x0357:  "͐",                  // COMBINING RIGHT HALF RING ABOVE → COMBINING RIGHT ARROWHEAD ABOVE
+	0x0358:  "̇",                  // COMBINING DOT ABOVE RIGHT → COMBINING DOT ABOVE
+	0x0366:  "̊",                  // COMBINING LATIN SMALL LETTER O → COMBINING RING ABOVE
+	0x036E:  "̆",                  // COMBINING LATIN SMALL LETTER V → COMBINING BREVE
+	0x0370:  "Ⱶ",                  // GREEK CAPITAL LETTER HETA → LATIN CAPITAL LETTER HALF H
+	0x0374:  "'",                  // GREEK NUMERAL SIGN → APOSTROPHE
+	0x0375:  "ˏ",                  // GREEK LOWER NUMERAL SIGN → MODIFIER LETTER LOW ACUTE ACCENT
+	0x0376:  "И",                  // GREEK CAPITAL LETTER PAMPHYLIAN DIGAMMA → CYRILLIC CAPITAL LETTER I
+	0x0377:  "ᴎ",                  // GREEK SMALL LETTER PAMPHYLIAN DIGAMMA → LATIN LETTER SMALL CAPITAL REVERSED N
+	0x037A:  "i",                  // GREEK YPOGEGRAMMENI → LATIN SMALL LETTER I
+	0x037B:  "ɔ",                  // GREEK SMALL REVERSED LUNATE SIGMA SYMBOL → LATIN SMALL LETTER OPEN O
+	0x037D:  "ꜿ",                  // GREEK SMALL REVERSED DOTTED LUNATE SIGMA SYMBOL → LATIN SMALL LETTER REVERSED C WITH DOT
+	0x037E:  ";",                  // GREEK QUESTION MARK → SEMICOLON
+	0x037F:  "J",                  // GREEK CAPITAL LETTER YOT → LATIN CAPITAL LETTER J
+	0x0384:  "'",                  // GREEK TONOS → APOSTROPHE
+	0x0387:  "·",                  // GREEK ANO TELEIA → MIDDLE DOT
+	0x0391:  "A",                  // GREEK CAPITAL LETTER ALPHA → LATIN CAPITAL LETTER A
+	0x0392:  "B",                  // GREEK CAPITAL LETTER BETA → LATIN CAPITAL LETTER B
+	0x0395:  "E",                  // GREEK CAPITAL LETTER EPSILON → LATIN CAPITAL LETTER E
+	0x0396:  "Z",                  // GREEK CAPITAL LETTER ZETA → LATIN CAPITAL LETTER Z
+	0x0397:  "H",                  // GREEK CAPITAL LETTER ETA → LATIN CAPITAL LETTER H
+	0x0398:  "O̵",                 // GREEK CAPITAL LETTER THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x0399:  "l",                  // GREEK CAPITAL LETTER IOTA → LATIN SMALL LETTER L
+	0x039A:  "K",                  // GREEK CAPITAL LETTER KAPPA → LATIN CAPITAL LETTER K
+	0x039B:  "Ʌ",                  // GREEK CAPITAL LETTER LAMDA → LATIN CAPITAL LETTER TURNED V
+	0x039C:  "M",                  // GREEK CAPITAL LETTER MU → LATIN CAPITAL LETTER M
+	0x039D:  "N",                  // GREEK CAPITAL LETTER NU → LATIN CAPITAL LETTER N
+	0x039F:  "O",                  // GREEK CAPITAL LETTER OMICRON → LATIN CAPITAL LETTER O
+	0x03A1:  "P",                  // GREEK CAPITAL LETTER RHO → LATIN CAPITAL LETTER P
+	0x03A3:  "Ʃ",                  // GREEK CAPITAL LETTER SIGMA → LATIN CAPITAL LETTER ESH
+	0x03A4:  "T",                  // GREEK CAPITAL LETTER TAU → LATIN CAPITAL LETTER T
+	0x03A5:  "Y",                  // GREEK CAPITAL LETTER UPSILON → LATIN CAPITAL LETTER Y
+	0x03A7:  "X",                  // GREEK CAPITAL LETTER CHI → LATIN CAPITAL LETTER X
+	0x03B1:  "a",                  // GREEK SMALL LETTER ALPHA → LATIN SMALL LETTER A
+	0x03B2:  "ß",                  // GREEK SMALL LETTER BETA → LATIN SMALL LETTER SHARP S
+	0x03B3:  "y",                  // GREEK SMALL LETTER GAMMA → LATIN SMALL LETTER Y
+	0x03B4:  "ẟ",                  // GREEK SMALL LETTER DELTA → LATIN SMALL LETTER DELTA
+	0x03B5:  "ꞓ",                  // GREEK SMALL LETTER EPSILON → LATIN SMALL LETTER C WITH BAR
+	0x03B7:  "n̩",                 // GREEK SMALL LETTER ETA → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x03B8:  "O̵",                 // GREEK SMALL LETTER THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x03B9:  "i",                  // GREEK SMALL LETTER IOTA → LATIN SMALL LETTER I
+	0x03BA:  "ĸ",                  // GREEK SMALL LETTER KAPPA → LATIN SMALL LETTER KRA
+	0x03BD:  "v",                  // GREEK SMALL LETTER NU → LATIN SMALL LETTER V
+	0x03BF:  "o",                  // GREEK SMALL LETTER OMICRON → LATIN SMALL LETTER O
+	0x03C1:  "p",                  // GREEK SMALL LETTER RHO → LATIN SMALL LETTER P
+	0x03C3:  "o",                  // GREEK SMALL LETTER SIGMA → LATIN SMALL LETTER O
+	0x03C4:  "ᴛ",                  // GREEK SMALL LETTER TAU → LATIN LETTER SMALL CAPITAL T
+	0x03C5:  "u",                  // GREEK SMALL LETTER UPSILON → LATIN SMALL LETTER U
+	0x03C6:  "ɸ",                  // GREEK SMALL LETTER PHI → LATIN SMALL LETTER PHI
+	0x03D0:  "ß",                  // GREEK BETA SYMBOL → LATIN SMALL LETTER SHARP S
+	0x03D1:  "O̵",                 // GREEK THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x03D2:  "Y",                  // GREEK UPSILON WITH HOOK SYMBOL → LATIN CAPITAL LETTER Y
+	0x03D5:  "ɸ",                  // GREEK PHI SYMBOL → LATIN SMALL LETTER PHI
+	0x03D6:  "π",                  // GREEK PI SYMBOL → GREEK SMALL LETTER PI
+	0x03DB:  "ς",                  // GREEK SMALL LETTER STIGMA → GREEK SMALL LETTER FINAL SIGMA
+	0x03DC:  "F",                  // GREEK LETTER DIGAMMA → LATIN CAPITAL LETTER F
+	0x03E4:  "Ч",                  // COPTIC CAPITAL LETTER FEI → CYRILLIC CAPITAL LETTER CHE
+	0x03E5:  "ч",                  // COPTIC SMALL LETTER FEI → CYRILLIC SMALL LETTER CHE
+	0x03E8:  "2",                  // COPTIC CAPITAL LETTER HORI → DIGIT TWO
+	0x03E9:  "ƨ",                  // COPTIC SMALL LETTER HORI → LATIN SMALL LETTER TONE TWO
+	0x03EC:  "6",                  // COPTIC CAPITAL LETTER SHIMA → DIGIT SIX
+	0x03ED:  "o",                  // COPTIC SMALL LETTER SHIMA → LATIN SMALL LETTER O
+	0x03F0:  "ĸ",                  // GREEK KAPPA SYMBOL → LATIN SMALL LETTER KRA
+	0x03F1:  "p",                  // GREEK RHO SYMBOL → LATIN SMALL LETTER P
+	0x03F2:  "c",                  // GREEK LUNATE SIGMA SYMBOL → LATIN SMALL LETTER C
+	0x03F3:  "j",                  // GREEK LETTER YOT → LATIN SMALL LETTER J
+	0x03F4:  "O̵",                 // GREEK CAPITAL THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x03F5:  "ꞓ",                  // GREEK LUNATE EPSILON SYMBOL → LATIN SMALL LETTER C WITH BAR
+	0x03F7:  "Þ",                  // GREEK CAPITAL LETTER SHO → LATIN CAPITAL LETTER THORN
+	0x03F8:  "p",                  // GREEK SMALL LETTER SHO → LATIN SMALL LETTER P
+	0x03F9:  "C",                  // GREEK CAPITAL LUNATE SIGMA SYMBOL → LATIN CAPITAL LETTER C
+	0x03FA:  "M",                  // GREEK CAPITAL LETTER SAN → LATIN CAPITAL LETTER M
+	0x03FD:  "Ɔ",                  // GREEK CAPITAL REVERSED LUNATE SIGMA SYMBOL → LATIN CAPITAL LETTER OPEN O
+	0x03FF:  "Ꜿ",                  // GREEK CAPITAL REVERSED DOTTED LUNATE SIGMA SYMBOL → LATIN CAPITAL LETTER REVERSED C WITH DOT
+	0x0404:  "Ꞓ",                  // CYRILLIC CAPITAL LETTER UKRAINIAN IE → LATIN CAPITAL LETTER C WITH BAR
+	0x0405:  "S",                  // CYRILLIC CAPITAL LETTER DZE → LATIN CAPITAL LETTER S
+	0x0406:  "l",                  // CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I → LATIN SMALL LETTER L
+	0x0408:  "J",                  // CYRILLIC CAPITAL LETTER JE → LATIN CAPITAL LETTER J
+	0x0410:  "A",                  // CYRILLIC CAPITAL LETTER A → LATIN CAPITAL LETTER A
+	0x0411:  "b̄",                 // CYRILLIC CAPITAL LETTER BE → LATIN SMALL LETTER B + COMBINING MACRON
+	0x0412:  "B",                  // CYRILLIC CAPITAL LETTER VE → LATIN CAPITAL LETTER B
+	0x0413:  "Γ",                  // CYRILLIC CAPITAL LETTER GHE → GREEK CAPITAL LETTER GAMMA
+	0x0415:  "E",                  // CYRILLIC CAPITAL LETTER IE → LATIN CAPITAL LETTER E
+	0x0417:  "3",                  // CYRILLIC CAPITAL LETTER ZE → DIGIT THREE
+	0x0419:  "Ѝ",                  // CYRILLIC CAPITAL LETTER SHORT I → CYRILLIC CAPITAL LETTER I WITH GRAVE
+	0x041A:  "K",                  // CYRILLIC CAPITAL LETTER KA → LATIN CAPITAL LETTER K
+	0x041B:  "Ʌ",                  // CYRILLIC CAPITAL LETTER EL → LATIN CAPITAL LETTER TURNED V
+	0x041C:  "M",                  // CYRILLIC CAPITAL LETTER EM → LATIN CAPITAL LETTER M
+	0x041D:  "H",                  // CYRILLIC CAPITAL LETTER EN → LATIN CAPITAL LETTER H
+	0x041E:  "O",                  // CYRILLIC CAPITAL LETTER O → LATIN CAPITAL LETTER O
+	0x041F:  "Π",                  // CYRILLIC CAPITAL LETTER PE → GREEK CAPITAL LETTER PI
+	0x0420:  "P",                  // CYRILLIC CAPITAL LETTER ER → LATIN CAPITAL LETTER P
+	0x0421:  "C",                  // CYRILLIC CAPITAL LETTER ES → LATIN CAPITAL LETTER C
+	0x0422:  "T",                  // CYRILLIC CAPITAL LETTER TE → LATIN CAPITAL LETTER T
+	0x0423:  "Y",                  // CYRILLIC CAPITAL LETTER U → LATIN CAPITAL LETTER Y
+	0x0424:  "Φ",                  // CYRILLIC CAPITAL LETTER EF → GREEK CAPITAL LETTER PHI
+	0x0425:  "X",                  // CYRILLIC CAPITAL LETTER HA → LATIN CAPITAL LETTER X
+	0x042B:  "bl",                 // CYRILLIC CAPITAL LETTER YERU → LATIN SMALL LETTER B + LATIN SMALL LETTER L
+	0x042C:  "b",                  // CYRILLIC CAPITAL LETTER SOFT SIGN → LATIN SMALL LETTER B
+	0x042E:  "lO",                 // CYRILLIC CAPITAL LETTER YU → LATIN SMALL LETTER L + LATIN CAPITAL LETTER O
+	0x0430:  "a",                  // CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A
+	0x0431:  "6",                  // CYRILLIC SMALL LETTER BE → DIGIT SIX
+	0x0432:  "ʙ",                  // CYRILLIC SMALL LETTER VE → LATIN LETTER SMALL CAPITAL B
+	0x0433:  "r",                  // CYRILLIC SMALL LETTER GHE → LATIN SMALL LETTER R
+	0x0435:  "e",                  // CYRILLIC SMALL LETTER IE → LATIN SMALL LETTER E
+	0x0437:  "ɜ",                  // CYRILLIC SMALL LETTER ZE → LATIN SMALL LETTER REVERSED OPEN E
+	0x0438:  "ᴎ",                  // CYRILLIC SMALL LETTER I → LATIN LETTER SMALL CAPITAL REVERSED N
+	0x043A:  "ĸ",                  // CYRILLIC SMALL LETTER KA → LATIN SMALL LETTER KRA
+	0x043C:  "ʍ",                  // CYRILLIC SMALL LETTER EM → LATIN SMALL LETTER TURNED W
+	0x043D:  "ʜ",                  // CYRILLIC SMALL LETTER EN → LATIN LETTER SMALL CAPITAL H
+	0x043E:  "o",                  // CYRILLIC SMALL LETTER O → LATIN SMALL LETTER O
+	0x043F:  "π",                  // CYRILLIC SMALL LETTER PE → GREEK SMALL LETTER PI
+	0x0440:  "p",                  // CYRILLIC SMALL LETTER ER → LATIN SMALL LETTER P
+	0x0441:  "c",                  // CYRILLIC SMALL LETTER ES → LATIN SMALL LETTER C
+	0x0442:  "ᴛ",                  // CYRILLIC SMALL LETTER TE → LATIN LETTER SMALL CAPITAL T
+	0x0443:  "y",                  // CYRILLIC SMALL LETTER U → LATIN SMALL LETTER Y
+	0x0444:  "ɸ",                  // CYRILLIC SMALL LETTER EF → LATIN SMALL LETTER PHI
+	0x0445:  "x",                  // CYRILLIC SMALL LETTER HA → LATIN SMALL LETTER X
+	0x0448:  "w",                  // CYRILLIC SMALL LETTER SHA → LATIN SMALL LETTER W
+	0x044A:  "ˉb",                 // CYRILLIC SMALL LETTER HARD SIGN → MODIFIER LETTER MACRON + LATIN SMALL LETTER B
+	0x044B:  "ƅi",                 // CYRILLIC SMALL LETTER YERU → LATIN SMALL LETTER TONE SIX + LATIN SMALL LETTER I
+	0x044C:  "ƅ",                  // CYRILLIC SMALL LETTER SOFT SIGN → LATIN SMALL LETTER TONE SIX
+	0x044F:  "ᴙ",                  // CYRILLIC SMALL LETTER YA → LATIN LETTER SMALL CAPITAL REVERSED R
+	0x0454:  "ꞓ",                  // CYRILLIC SMALL LETTER UKRAINIAN IE → LATIN SMALL LETTER C WITH BAR
+	0x0455:  "s",                  // CYRILLIC SMALL LETTER DZE → LATIN SMALL LETTER S
+	0x0456:  "i",                  // CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I → LATIN SMALL LETTER I
+	0x0458:  "j",                  // CYRILLIC SMALL LETTER JE → LATIN SMALL LETTER J
+	0x045B:  "h̵",                 // CYRILLIC SMALL LETTER TSHE → LATIN SMALL LETTER H + COMBINING SHORT STROKE OVERLAY
+	0x045D:  "й",                  // CYRILLIC SMALL LETTER I WITH GRAVE → CYRILLIC SMALL LETTER SHORT I
+	0x045F:  "u̩",                 // CYRILLIC SMALL LETTER DZHE → LATIN SMALL LETTER U + COMBINING VERTICAL LINE BELOW
+	0x0461:  "w",                  // CYRILLIC SMALL LETTER OMEGA → LATIN SMALL LETTER W
+	0x0462:  "b̵",                 // CYRILLIC CAPITAL LETTER YAT → LATIN SMALL LETTER B + COMBINING SHORT STROKE OVERLAY
+	0x0463:  "b̵",                 // CYRILLIC SMALL LETTER YAT → LATIN SMALL LETTER B + COMBINING SHORT STROKE OVERLAY
+	0x0470:  "Ψ",                  // CYRILLIC CAPITAL LETTER PSI → GREEK CAPITAL LETTER PSI
+	0x0471:  "ψ",                  // CYRILLIC SMALL LETTER PSI → GREEK SMALL LETTER PSI
+	0x0472:  "O̵",                 // CYRILLIC CAPITAL LETTER FITA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x0473:  "o̵",                 // CYRILLIC SMALL LETTER FITA → LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x0474:  "V",                  // CYRILLIC CAPITAL LETTER IZHITSA → LATIN CAPITAL LETTER V
+	0x0475:  "v",                  // CYRILLIC SMALL LETTER IZHITSA → LATIN SMALL LETTER V
+	0x047C:  "Ѡ҆҇",                // CYRILLIC CAPITAL LETTER OMEGA WITH TITLO → CYRILLIC CAPITAL LETTER OMEGA + COMBINING CYRILLIC PSILI PNEUMATA + COMBINING CYRILLIC POKRYTIE
+	0x047D:  "w҆҇",                // CYRILLIC SMALL LETTER OMEGA WITH TITLO → LATIN SMALL LETTER W + COMBINING CYRILLIC PSILI PNEUMATA + COMBINING CYRILLIC POKRYTIE
+	0x048A:  "Ѝ̦",                 // CYRILLIC CAPITAL LETTER SHORT I WITH TAIL → CYRILLIC CAPITAL LETTER I WITH GRAVE + COMBINING COMMA BELOW
+	0x048B:  "й̦",                 // CYRILLIC SMALL LETTER SHORT I WITH TAIL → CYRILLIC SMALL LETTER SHORT I + COMBINING COMMA BELOW
+	0x048C:  "b̵",                 // CYRILLIC CAPITAL LETTER SEMISOFT SIGN → LATIN SMALL LETTER B + COMBINING SHORT STROKE OVERLAY
+	0x048D:  "b̵",                 // CYRILLIC SMALL LETTER SEMISOFT SIGN → LATIN SMALL LETTER B + COMBINING SHORT STROKE OVERLAY
+	0x0490:  "Γ'",                 // CYRILLIC CAPITAL LETTER GHE WITH UPTURN → GREEK CAPITAL LETTER GAMMA + APOSTROPHE
+	0x0491:  "r'",                 // CYRILLIC SMALL LETTER GHE WITH UPTURN → LATIN SMALL LETTER R + APOSTROPHE
+	0x0492:  "Γ̵",                 // CYRILLIC CAPITAL LETTER GHE WITH STROKE → GREEK CAPITAL LETTER GAMMA + COMBINING SHORT STROKE OVERLAY
+	0x0493:  "r̵",                 // CYRILLIC SMALL LETTER GHE WITH STROKE → LATIN SMALL LETTER R + COMBINING SHORT STROKE OVERLAY
+	0x0496:  "Ж̩",                 // CYRILLIC CAPITAL LETTER ZHE WITH DESCENDER → CYRILLIC CAPITAL LETTER ZHE + COMBINING VERTICAL LINE BELOW
+	0x0497:  "ж̩",                 // CYRILLIC SMALL LETTER ZHE WITH DESCENDER → CYRILLIC SMALL LETTER ZHE + COMBINING VERTICAL LINE BELOW
+	0x0498:  "3̦",                 // CYRILLIC CAPITAL LETTER ZE WITH DESCENDER → DIGIT THREE + COMBINING COMMA BELOW
+	0x0499:  "ɜ̦",                 // CYRILLIC SMALL LETTER ZE WITH DESCENDER → LATIN SMALL LETTER REVERSED OPEN E + COMBINING COMMA BELOW
+	0x049A:  "K̩",                 // CYRILLIC CAPITAL LETTER KA WITH DESCENDER → LATIN CAPITAL LETTER K + COMBINING VERTICAL LINE BELOW
+	0x049B:  "ĸ̩",                 // CYRILLIC SMALL LETTER KA WITH DESCENDER → LATIN SMALL LETTER KRA + COMBINING VERTICAL LINE BELOW
+	0x049E:  "K̵",                 // CYRILLIC CAPITAL LETTER KA WITH STROKE → LATIN CAPITAL LETTER K + COMBINING SHORT STROKE OVERLAY
+	0x049F:  "ĸ̵",                 // CYRILLIC SMALL LETTER KA WITH STROKE → LATIN SMALL LETTER KRA + COMBINING SHORT STROKE OVERLAY
+	0x04A2:  "H̩",                 // CYRILLIC CAPITAL LETTER EN WITH DESCENDER → LATIN CAPITAL LETTER H + COMBINING VERTICAL LINE BELOW
+	0x04A3:  "ʜ̩",                 // CYRILLIC SMALL LETTER EN WITH DESCENDER → LATIN LETTER SMALL CAPITAL H + COMBINING VERTICAL LINE BELOW
+	0x04AA:  "C̦",                 // CYRILLIC CAPITAL LETTER ES WITH DESCENDER → LATIN CAPITAL LETTER C + COMBINING COMMA BELOW
+	0x04AB:  "c̦",                 // CYRILLIC SMALL LETTER ES WITH DESCENDER → LATIN SMALL LETTER C + COMBINING COMMA BELOW
+	0x04AC:  "T̩",                 // CYRILLIC CAPITAL LETTER TE WITH DESCENDER → LATIN CAPITAL LETTER T + COMBINING VERTICAL LINE BELOW
+	0x04AD:  "ᴛ̩",                 // CYRILLIC SMALL LETTER TE WITH DESCENDER → LATIN LETTER SMALL CAPITAL T + COMBINING VERTICAL LINE BELOW
+	0x04AE:  "Y",                  // CYRILLIC CAPITAL LETTER STRAIGHT U → LATIN CAPITAL LETTER Y
+	0x04AF:  "y",                  // CYRILLIC SMALL LETTER STRAIGHT U → LATIN SMALL LETTER Y
+	0x04B0:  "Y̵",                 // CYRILLIC CAPITAL LETTER STRAIGHT U WITH STROKE → LATIN CAPITAL LETTER Y + COMBINING SHORT STROKE OVERLAY
+	0x04B1:  "y̵",                 // CYRILLIC SMALL LETTER STRAIGHT U WITH STROKE → LATIN SMALL LETTER Y + COMBINING SHORT STROKE OVERLAY
+	0x04B2:  "X̩",                 // CYRILLIC CAPITAL LETTER HA WITH DESCENDER → LATIN CAPITAL LETTER X + COMBINING VERTICAL LINE BELOW
+	0x04BB:  "h",                  // CYRILLIC SMALL LETTER SHHA → LATIN SMALL LETTER H
+	0x04BD:  "e",                  // CYRILLIC SMALL LETTER ABKHASIAN CHE → LATIN SMALL LETTER E
+	0x04BE:  "Ҽ̨",                 // CYRILLIC CAPITAL LETTER ABKHASIAN CHE WITH DESCENDER → CYRILLIC CAPITAL LETTER ABKHASIAN CHE + COMBINING OGONEK
+	0x04BF:  "ę",                 // CYRILLIC SMALL LETTER ABKHASIAN CHE WITH DESCENDER → LATIN SMALL LETTER E + COMBINING OGONEK
+	0x04C0:  "l",                  // CYRILLIC LETTER PALOCHKA → LATIN SMALL LETTER L
+	0x04C5:  "Ʌ̦",                 // CYRILLIC CAPITAL LETTER EL WITH TAIL → LATIN CAPITAL LETTER TURNED V + COMBINING COMMA BELOW
+	0x04C6:  "л̦",                 // CYRILLIC SMALL LETTER EL WITH TAIL → CYRILLIC SMALL LETTER EL + COMBINING COMMA BELOW
+	0x04C7:  "H̦",                 // CYRILLIC CAPITAL LETTER EN WITH HOOK → LATIN CAPITAL LETTER H + COMBINING COMMA BELOW
+	0x04C8:  "ʜ̦",                 // CYRILLIC SMALL LETTER EN WITH HOOK → LATIN LETTER SMALL CAPITAL H + COMBINING COMMA BELOW
+	0x04C9:  "H̦",                 // CYRILLIC CAPITAL LETTER EN WITH TAIL → LATIN CAPITAL LETTER H + COMBINING COMMA BELOW
+	0x04CA:  "ʜ̦",                 // CYRILLIC SMALL LETTER EN WITH TAIL → LATIN LETTER SMALL CAPITAL H + COMBINING COMMA BELOW
+	0x04CB:  "Ҷ",                  // CYRILLIC CAPITAL LETTER KHAKASSIAN CHE → CYRILLIC CAPITAL LETTER CHE WITH DESCENDER
+	0x04CC:  "ҷ",                  // CYRILLIC SMALL LETTER KHAKASSIAN CHE → CYRILLIC SMALL LETTER CHE WITH DESCENDER
+	0x04CD:  "M̦",                 // CYRILLIC CAPITAL LETTER EM WITH TAIL → LATIN CAPITAL LETTER M + COMBINING COMMA BELOW
+	0x04CE:  "ʍ̦",                 // CYRILLIC SMALL LETTER EM WITH TAIL → LATIN SMALL LETTER TURNED W + COMBINING COMMA BELOW
+	0x04CF:  "l",                  // CYRILLIC SMALL LETTER PALOCHKA → LATIN SMALL LETTER L
+	0x04D4:  "AE",                 // CYRILLIC CAPITAL LIGATURE A IE → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER E
+	0x04D5:  "ae",                 // CYRILLIC SMALL LIGATURE A IE → LATIN SMALL LETTER A + LATIN SMALL LETTER E
+	0x04D8:  "Ə",                  // CYRILLIC CAPITAL LETTER SCHWA → LATIN CAPITAL LETTER SCHWA
+	0x04D9:  "ǝ",                  // CYRILLIC SMALL LETTER SCHWA → LATIN SMALL LETTER TURNED E
+	0x04E0:  "3",                  // CYRILLIC CAPITAL LETTER ABKHASIAN DZE → DIGIT THREE
+	0x04E1:  "ȝ",                  // CYRILLIC SMALL LETTER ABKHASIAN DZE → LATIN SMALL LETTER YOGH
+	0x04E8:  "O̵",                 // CYRILLIC CAPITAL LETTER BARRED O → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x04E9:  "o̵",                 // CYRILLIC SMALL LETTER BARRED O → LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x0501:  "d",                  // CYRILLIC SMALL LETTER KOMI DE → LATIN SMALL LETTER D
+	0x050A:  "Ƕ",                  // CYRILLIC CAPITAL LETTER KOMI NJE → LATIN CAPITAL LETTER HWAIR
+	0x050C:  "G",                  // CYRILLIC CAPITAL LETTER KOMI SJE → LATIN CAPITAL LETTER G
+	0x050D:  "ɢ",                  // CYRILLIC SMALL LETTER KOMI SJE → LATIN LETTER SMALL CAPITAL G
+	0x0510:  "Ɛ",                  // CYRILLIC CAPITAL LETTER REVERSED ZE → LATIN CAPITAL LETTER OPEN E
+	0x0511:  "ꞓ",                  // CYRILLIC SMALL LETTER REVERSED ZE → LATIN SMALL LETTER C WITH BAR
+	0x051B:  "q",                  // CYRILLIC SMALL LETTER QA → LATIN SMALL LETTER Q
+	0x051C:  "W",                  // CYRILLIC CAPITAL LETTER WE → LATIN CAPITAL LETTER W
+	0x051D:  "w",                  // CYRILLIC SMALL LETTER WE → LATIN SMALL LETTER W
+	0x053B:  "ኮ",                  // ARMENIAN CAPITAL LETTER INI → ETHIOPIC SYLLABLE KO
+	0x0544:  "ሆ",                  // ARMENIAN CAPITAL LETTER MEN → ETHIOPIC SYLLABLE HO
+	0x054A:  "ጣ",                  // ARMENIAN CAPITAL LETTER PEH → ETHIOPIC SYLLABLE THAA
+	0x054C:  "ቡ",                  // ARMENIAN CAPITAL LETTER RA → ETHIOPIC SYLLABLE BU
+	0x054D:  "U",                  // ARMENIAN CAPITAL LETTER SEH → LATIN CAPITAL LETTER U
+	0x054F:  "S",                  // ARMENIAN CAPITAL LETTER TIWN → LATIN CAPITAL LETTER S
+	0x0553:  "Φ",                  // ARMENIAN CAPITAL LETTER PIWR → GREEK CAPITAL LETTER PHI
+	0x0555:  "O",                  // ARMENIAN CAPITAL LETTER OH → LATIN CAPITAL LETTER O
+	0x055A:  "'",                  // ARMENIAN APOSTROPHE → APOSTROPHE
+	0x055D:  "'",                  // ARMENIAN COMMA → APOSTROPHE
+	0x0561:  "w",                  // ARMENIAN SMALL LETTER AYB → LATIN SMALL LETTER W
+	0x0563:  "q",                  // ARMENIAN SMALL LETTER GIM → LATIN SMALL LETTER Q
+	0x0566:  "q",                  // ARMENIAN SMALL LETTER ZA → LATIN SMALL LETTER Q
+	0x056E:  "ẟ",                  // ARMENIAN SMALL LETTER CA → LATIN SMALL LETTER DELTA
+	0x0570:  "h",                  // ARMENIAN SMALL LETTER HO → LATIN SMALL LETTER H
+	0x0572:  "n̩",                 // ARMENIAN SMALL LETTER GHAD → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x0575:  "ȷ",                  // ARMENIAN SMALL LETTER YI → LATIN SMALL LETTER DOTLESS J
+	0x0578:  "n",                  // ARMENIAN SMALL LETTER VO → LATIN SMALL LETTER N
+	0x057A:  "ɰ",                  // ARMENIAN SMALL LETTER PEH → LATIN SMALL LETTER TURNED M WITH LONG LEG
+	0x057C:  "n",                  // ARMENIAN SMALL LETTER RA → LATIN SMALL LETTER N
+	0x057D:  "u",                  // ARMENIAN SMALL LETTER SEH → LATIN SMALL LETTER U
+	0x0581:  "g",                  // ARMENIAN SMALL LETTER CO → LATIN SMALL LETTER G
+	0x0582:  "i",                  // ARMENIAN SMALL LETTER YIWN → LATIN SMALL LETTER I
+	0x0584:  "f",                  // ARMENIAN SMALL LETTER KEH → LATIN SMALL LETTER F
+	0x0585:  "o",                  // ARMENIAN SMALL LETTER OH → LATIN SMALL LETTER O
+	0x0587:  "եi",                 // ARMENIAN SMALL LIGATURE ECH YIWN → ARMENIAN SMALL LETTER ECH + LATIN SMALL LETTER I
+	0x0589:  ":",                  // ARMENIAN FULL STOP → COLON
+	0x059C:  "́",                  // HEBREW ACCENT GERESH → COMBINING ACUTE ACCENT
+	0x059D:  "́",                  // HEBREW ACCENT GERESH MUQDAM → COMBINING ACUTE ACCENT
+	0x05A4:  "֚",                  // HEBREW ACCENT MAHAPAKH → HEBREW ACCENT YETIV
+	0x05A8:  "֙",                  // HEBREW ACCENT QADMA → HEBREW ACCENT PASHTA
+	0x05AD:  "֖",                  // HEBREW ACCENT DEHI → HEBREW ACCENT TIPEHA
+	0x05AE:  "֘",                  // HEBREW ACCENT ZINOR → HEBREW ACCENT ZARQA
+	0x05AF:  "̊",                  // HEBREW MARK MASORA CIRCLE → COMBINING RING ABOVE
+	0x05B4:  "̣",                  // HEBREW POINT HIRIQ → COMBINING DOT BELOW
+	0x05B9:  "̇",                  // HEBREW POINT HOLAM → COMBINING DOT ABOVE
+	0x05BA:  "̇",                  // HEBREW POINT HOLAM HASER FOR VAV → COMBINING DOT ABOVE
+	0x05C0:  "l",                  // HEBREW PUNCTUATION PASEQ → LATIN SMALL LETTER L
+	0x05C1:  "̇",                  // HEBREW POINT SHIN DOT → COMBINING DOT ABOVE
+	0x05C2:  "̇",                  // HEBREW POINT SIN DOT → COMBINING DOT ABOVE
+	0x05C3:  ":",                  // HEBREW PUNCTUATION SOF PASUQ → COLON
+	0x05C4:  "̇",                  // HEBREW MARK UPPER DOT → COMBINING DOT ABOVE
+	0x05C5:  "̣",                  // HEBREW MARK LOWER DOT → COMBINING DOT BELOW
+	0x05D5:  "l",                  // HEBREW LETTER VAV → LATIN SMALL LETTER L
+	0x05D8:  "v",                  // HEBREW LETTER TET → LATIN SMALL LETTER V
+	0x05D9:  "'",                  // HEBREW LETTER YOD → APOSTROPHE
+	0x05DF:  "l",                  // HEBREW LETTER FINAL NUN → LATIN SMALL LETTER L
+	0x05E1:  "o",                  // HEBREW LETTER SAMEKH → LATIN SMALL LETTER O
+	0x05F0:  "ll",                 // HEBREW LIGATURE YIDDISH DOUBLE VAV → LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x05F1:  "l'",                 // HEBREW LIGATURE YIDDISH VAV YOD → LATIN SMALL LETTER L + APOSTROPHE
+	0x05F2:  "''",                 // HEBREW LIGATURE YIDDISH DOUBLE YOD → APOSTROPHE + APOSTROPHE
+	0x05F3:  "'",                  // HEBREW PUNCTUATION GERESH → APOSTROPHE
+	0x05F4:  "''",                 // HEBREW PUNCTUATION GERSHAYIM → APOSTROPHE + APOSTROPHE
+	0x0609:  "º/₀₀",               // ARABIC-INDIC PER MILLE SIGN → MASCULINE ORDINAL INDICATOR + SOLIDUS + SUBSCRIPT ZERO + SUBSCRIPT ZERO
+	0x060A:  "º/₀₀₀",              // ARABIC-INDIC PER TEN THOUSAND SIGN → MASCULINE ORDINAL INDICATOR + SOLIDUS + SUBSCRIPT ZERO + SUBSCRIPT ZERO + SUBSCRIPT ZERO
+	0x060D:  ",",                  // ARABIC DATE SEPARATOR → COMMA
+	0x060F:  "ع",                  // ARABIC SIGN MISRA → ARABIC LETTER AIN
+	0x0618:  "́",                  // ARABIC SMALL FATHA → COMBINING ACUTE ACCENT
+	0x0619:  "̓",                  // ARABIC SMALL DAMMA → COMBINING COMMA ABOVE
+	0x061A:  "ِ",                  // ARABIC SMALL KASRA → ARABIC KASRA
+	0x0623:  "lٴ",                 // ARABIC LETTER ALEF WITH HAMZA ABOVE → LATIN SMALL LETTER L + ARABIC LETTER HIGH HAMZA
+	0x0624:  "وٴ",                 // ARABIC LETTER WAW WITH HAMZA ABOVE → ARABIC LETTER WAW + ARABIC LETTER HIGH HAMZA
+	0x0625:  "lٕ",                 // ARABIC LETTER ALEF WITH HAMZA BELOW → LATIN SMALL LETTER L + ARABIC HAMZA BELOW
+	0x0626:  "ىٴ",                 // ARABIC LETTER YEH WITH HAMZA ABOVE → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA
+	0x0627:  "l",                  // ARABIC LETTER ALEF → LATIN SMALL LETTER L
+	0x062B:  "ىۛ",                 // ARABIC LETTER THEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x0634:  "سۛ",                 // ARABIC LETTER SHEEN → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0x063D:  "ى̂",                 // ARABIC LETTER FARSI YEH WITH INVERTED V → ARABIC LETTER ALEF MAKSURA + COMBINING CIRCUMFLEX ACCENT
+	0x063F:  "ىۛ",                 // ARABIC LETTER FARSI YEH WITH THREE DOTS ABOVE → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x0647:  "o",                  // ARABIC LETTER HEH → LATIN SMALL LETTER O
+	0x064A:  "ى",                  // ARABIC LETTER YEH → ARABIC LETTER ALEF MAKSURA
+	0x064B:  "̋",                  // ARABIC FATHATAN → COMBINING DOUBLE ACUTE ACCENT
+	0x064E:  "́",                  // ARABIC FATHA → COMBINING ACUTE ACCENT
+	0x064F:  "̓",                  // ARABIC DAMMA → COMBINING COMMA ABOVE
+	0x0652:  "̊",                  // ARABIC SUKUN → COMBINING RING ABOVE
+	0x0653:  "̃",                  // ARABIC MADDAH ABOVE → COMBINING TILDE
+	0x0656:  "̩",                  // ARABIC SUBSCRIPT ALEF → COMBINING VERTICAL LINE BELOW
+	0x0657:  "̒",                  // ARABIC INVERTED DAMMA → COMBINING TURNED COMMA ABOVE
+	0x0658:  "̆",                  // ARABIC MARK NOON GHUNNA → COMBINING BREVE
+	0x0659:  "̄",                  // ARABIC ZWARAKAY → COMBINING MACRON
+	0x065A:  "̆",                  // ARABIC VOWEL SIGN SMALL V ABOVE → COMBINING BREVE
+	0x065B:  "̂",                  // ARABIC VOWEL SIGN INVERTED SMALL V ABOVE → COMBINING CIRCUMFLEX ACCENT
+	0x065C:  "̣",                  // ARABIC VOWEL SIGN DOT BELOW → COMBINING DOT BELOW
+	0x065D:  "̔",                  // ARABIC REVERSED DAMMA → COMBINING REVERSED COMMA ABOVE
+	0x065F:  "ٕ",                  // ARABIC WAVY HAMZA BELOW → ARABIC HAMZA BELOW
+	0x0660:  ".",                  // ARABIC-INDIC DIGIT ZERO → FULL STOP
+	0x0661:  "l",                  // ARABIC-INDIC DIGIT ONE → LATIN SMALL LETTER L
+	0x0665:  "o",                  // ARABIC-INDIC DIGIT FIVE → LATIN SMALL LETTER O
+	0x0667:  "V",                  // ARABIC-INDIC DIGIT SEVEN → LATIN CAPITAL LETTER V
+	0x0668:  "Ʌ",                  // ARABIC-INDIC DIGIT EIGHT → LATIN CAPITAL LETTER TURNED V
+	0x066A:  "º/₀",                // ARABIC PERCENT SIGN → MASCULINE ORDINAL INDICATOR + SOLIDUS + SUBSCRIPT ZERO
+	0x066B:  ",",                  // ARABIC DECIMAL SEPARATOR → COMMA
+	0x066C:  "،",                  // ARABIC THOUSANDS SEPARATOR → ARABIC COMMA
+	0x066D:  "*",                  // ARABIC FIVE POINTED STAR → ASTERISK
+	0x066E:  "ى",                  // ARABIC LETTER DOTLESS BEH → ARABIC LETTER ALEF MAKSURA
+	0x066F:  "ڡ",                  // ARABIC LETTER DOTLESS QAF → ARABIC LETTER DOTLESS FEH
+	0x0672:  "lٴ",                 // ARABIC LETTER ALEF WITH WAVY HAMZA ABOVE → LATIN SMALL LETTER L + ARABIC LETTER HIGH HAMZA
+	0x0673:  "lٕ",                 // ARABIC LETTER ALEF WITH WAVY HAMZA BELOW → LATIN SMALL LETTER L + ARABIC HAMZA BELOW
+	0x0675:  "lٴ",                 // ARABIC LETTER HIGH HAMZA ALEF → LATIN SMALL LETTER L + ARABIC LETTER HIGH HAMZA
+	0x0676:  "وٴ",                 // ARABIC LETTER HIGH HAMZA WAW → ARABIC LETTER WAW + ARABIC LETTER HIGH HAMZA
+	0x0677:  "و̓ٴ",                // ARABIC LETTER U WITH HAMZA ABOVE → ARABIC LETTER WAW + COMBINING COMMA ABOVE + ARABIC LETTER HIGH HAMZA
+	0x0678:  "ىٴ",                 // ARABIC LETTER HIGH HAMZA YEH → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA
+	0x0679:  "ىؕ",                 // ARABIC LETTER TTEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0x067A:  "ت",                  // ARABIC LETTER TTEHEH → ARABIC LETTER TEH
+	0x067E:  "ىۛ",                 // ARABIC LETTER PEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x0681:  "حٔ",                 // ARABIC LETTER HAH WITH HAMZA ABOVE → ARABIC LETTER HAH + ARABIC HAMZA ABOVE
+	0x0685:  "حۛ",                 // ARABIC LETTER HAH WITH THREE DOTS ABOVE → ARABIC LETTER HAH + ARABIC SMALL HIGH THREE DOTS
+	0x0688:  "دؕ",                 // ARABIC LETTER DDAL → ARABIC LETTER DAL + ARABIC SMALL HIGH TAH
+	0x068B:  "ڊؕ",                 // ARABIC LETTER DAL WITH DOT BELOW AND SMALL TAH → ARABIC LETTER DAL WITH DOT BELOW + ARABIC SMALL HIGH TAH
+	0x068E:  "دۛ",                 // ARABIC LETTER DUL → ARABIC LETTER DAL + ARABIC SMALL HIGH THREE DOTS
+	0x068F:  "دۛ",                 // ARABIC LETTER DAL WITH THREE DOTS ABOVE DOWNWARDS → ARABIC LETTER DAL + ARABIC SMALL HIGH THREE DOTS
+	0x0691:  "رؕ",                 // ARABIC LETTER RREH → ARABIC LETTER REH + ARABIC SMALL HIGH TAH
+	0x0692:  "ر̆",                 // ARABIC LETTER REH WITH SMALL V → ARABIC LETTER REH + COMBINING BREVE
+	0x0698:  "رۛ",                 // ARABIC LETTER JEH → ARABIC LETTER REH + ARABIC SMALL HIGH THREE DOTS
+	0x069E:  "صۛ",                 // ARABIC LETTER SAD WITH THREE DOTS ABOVE → ARABIC LETTER SAD + ARABIC SMALL HIGH THREE DOTS
+	0x069F:  "طۛ",                 // ARABIC LETTER TAH WITH THREE DOTS ABOVE → ARABIC LETTER TAH + ARABIC SMALL HIGH THREE DOTS
+	0x06A4:  "ڡۛ",                 // ARABIC LETTER VEH → ARABIC LETTER DOTLESS FEH + ARABIC SMALL HIGH THREE DOTS
+	0x06A7:  "ف",                  // ARABIC LETTER QAF WITH DOT ABOVE → ARABIC LETTER FEH
+	0x06A8:  "ڡۛ",                 // ARABIC LETTER QAF WITH THREE DOTS ABOVE → ARABIC LETTER DOTLESS FEH + ARABIC SMALL HIGH THREE DOTS
+	0x06A9:  "ك",                  // ARABIC LETTER KEHEH → ARABIC LETTER KAF
+	0x06AA:  "ك",                  // ARABIC LETTER SWASH KAF → ARABIC LETTER KAF
+	0x06AD:  "كۛ",                 // ARABIC LETTER NG → ARABIC LETTER KAF + ARABIC SMALL HIGH THREE DOTS
+	0x06B4:  "گۛ",                 // ARABIC LETTER GAF WITH THREE DOTS ABOVE → ARABIC LETTER GAF + ARABIC SMALL HIGH THREE DOTS
+	0x06B5:  "ل̆",                 // ARABIC LETTER LAM WITH SMALL V → ARABIC LETTER LAM + COMBINING BREVE
+	0x06B7:  "لۛ",                 // ARABIC LETTER LAM WITH THREE DOTS ABOVE → ARABIC LETTER LAM + ARABIC SMALL HIGH THREE DOTS
+	0x06BA:  "ى",                  // ARABIC LETTER NOON GHUNNA → ARABIC LETTER ALEF MAKSURA
+	0x06BB:  "ىؕ",                 // ARABIC LETTER RNOON → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0x06BD:  "ىۛ",                 // ARABIC LETTER NOON WITH THREE DOTS ABOVE → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x06BE:  "o",                  // ARABIC LETTER HEH DOACHASHMEE → LATIN SMALL LETTER O
+	0x06C1:  "o",                  // ARABIC LETTER HEH GOAL → LATIN SMALL LETTER O
+	0x06C2:  "ۀ",                  // ARABIC LETTER HEH GOAL WITH HAMZA ABOVE → ARABIC LETTER HEH WITH YEH ABOVE
+	0x06C3:  "ة",                  // ARABIC LETTER TEH MARBUTA GOAL → ARABIC LETTER TEH MARBUTA
+	0x06C6:  "و̆",                 // ARABIC LETTER OE → ARABIC LETTER WAW + COMBINING BREVE
+	0x06C7:  "و̓",                 // ARABIC LETTER U → ARABIC LETTER WAW + COMBINING COMMA ABOVE
+	0x06C8:  "وٰ",                 // ARABIC LETTER YU → ARABIC LETTER WAW + ARABIC LETTER SUPERSCRIPT ALEF
+	0x06C9:  "و̂",                 // ARABIC LETTER KIRGHIZ YU → ARABIC LETTER WAW + COMBINING CIRCUMFLEX ACCENT
+	0x06CB:  "وۛ",                 // ARABIC LETTER VE → ARABIC LETTER WAW + ARABIC SMALL HIGH THREE DOTS
+	0x06CC:  "ى",                  // ARABIC LETTER FARSI YEH → ARABIC LETTER ALEF MAKSURA
+	0x06CE:  "ى̆",                 // ARABIC LETTER YEH WITH SMALL V → ARABIC LETTER ALEF MAKSURA + COMBINING BREVE
+	0x06D0:  "ٻ",                  // ARABIC LETTER E → ARABIC LETTER BEEH
+	0x06D1:  "ىۛ",                 // ARABIC LETTER YEH WITH THREE DOTS BELOW → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x06D2:  "ى",                  // ARABIC LETTER YEH BARREE → ARABIC LETTER ALEF MAKSURA
+	0x06D4:  "-",                  // ARABIC FULL STOP → HYPHEN-MINUS
+	0x06D5:  "o",                  // ARABIC LETTER AE → LATIN SMALL LETTER O
+	0x06DF:  "̊",                  // ARABIC SMALL HIGH ROUNDED ZERO → COMBINING RING ABOVE
+	0x06E8:  "̆̇",                 // ARABIC SMALL HIGH NOON → COMBINING BREVE + COMBINING DOT ABOVE
+	0x06EC:  "̇",                  // ARABIC ROUNDED HIGH STOP WITH FILLED CENTRE → COMBINING DOT ABOVE
+	0x06EE:  "د̂",                 // ARABIC LETTER DAL WITH INVERTED V → ARABIC LETTER DAL + COMBINING CIRCUMFLEX ACCENT
+	0x06EF:  "ر̂",                 // ARABIC LETTER REH WITH INVERTED V → ARABIC LETTER REH + COMBINING CIRCUMFLEX ACCENT
+	0x06F0:  ".",                  // EXTENDED ARABIC-INDIC DIGIT ZERO → FULL STOP
+	0x06F1:  "l",                  // EXTENDED ARABIC-INDIC DIGIT ONE → LATIN SMALL LETTER L
+	0x06F2:  "٢",                  // EXTENDED ARABIC-INDIC DIGIT TWO → ARABIC-INDIC DIGIT TWO
+	0x06F3:  "٣",                  // EXTENDED ARABIC-INDIC DIGIT THREE → ARABIC-INDIC DIGIT THREE
+	0x06F4:  "٤",                  // EXTENDED ARABIC-INDIC DIGIT FOUR → ARABIC-INDIC DIGIT FOUR
+	0x06F5:  "o",                  // EXTENDED ARABIC-INDIC DIGIT FIVE → LATIN SMALL LETTER O
+	0x06F6:  "٦",                  // EXTENDED ARABIC-INDIC DIGIT SIX → ARABIC-INDIC DIGIT SIX
+	0x06F7:  "V",                  // EXTENDED ARABIC-INDIC DIGIT SEVEN → LATIN CAPITAL LETTER V
+	0x06F8:  "Ʌ",                  // EXTENDED ARABIC-INDIC DIGIT EIGHT → LATIN CAPITAL LETTER TURNED V
+	0x06F9:  "٩",                  // EXTENDED ARABIC-INDIC DIGIT NINE → ARABIC-INDIC DIGIT NINE
+	0x06FD:  "ء𐻺",                 // ( ۽ → ء𐻺 )
+	0x06FE:  "م𐻺",                 // ( ۾ → م𐻺 )
+	0x06FF:  "ô",                 // ARABIC LETTER HEH WITH INVERTED V → LATIN SMALL LETTER O + COMBINING CIRCUMFLEX ACCENT
+	0x0701:  ".",                  // SYRIAC SUPRALINEAR FULL STOP → FULL STOP
+	0x0702:  ".",                  // SYRIAC SUBLINEAR FULL STOP → FULL STOP
+	0x0703:  ":",                  // SYRIAC SUPRALINEAR COLON → COLON
+	0x0704:  ":",                  // SYRIAC SUBLINEAR COLON → COLON
+	0x0740:  "̇",                  // SYRIAC FEMININE DOT → COMBINING DOT ABOVE
+	0x0741:  "̇",                  // SYRIAC QUSHSHAYA → COMBINING DOT ABOVE
+	0x0742:  "ܼ",                  // SYRIAC RUKKAKHA → SYRIAC HBASA-ESASA DOTTED
+	0x0747:  "́",                  // SYRIAC OBLIQUE LINE ABOVE → COMBINING ACUTE ACCENT
+	0x0751:  "بۛ",                 // ARABIC LETTER BEH WITH DOT BELOW AND THREE DOTS ABOVE → ARABIC LETTER BEH + ARABIC SMALL HIGH THREE DOTS
+	0x0752:  "ىۛ",                 // ARABIC LETTER BEH WITH THREE DOTS POINTING UPWARDS BELOW → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x0756:  "ى̆",                 // ARABIC LETTER BEH WITH SMALL V → ARABIC LETTER ALEF MAKSURA + COMBINING BREVE
+	0x0762:  "ڬ",                  // ARABIC LETTER KEHEH WITH DOT ABOVE → ARABIC LETTER KAF WITH DOT ABOVE
+	0x0763:  "كۛ",                 // ARABIC LETTER KEHEH WITH THREE DOTS ABOVE → ARABIC LETTER KAF + ARABIC SMALL HIGH THREE DOTS
+	0x0767:  "ݔ",                  // ARABIC LETTER NOON WITH TWO DOTS BELOW → ARABIC LETTER BEH WITH TWO DOTS BELOW AND DOT ABOVE
+	0x0768:  "نؕ",                 // ARABIC LETTER NOON WITH SMALL TAH → ARABIC LETTER NOON + ARABIC SMALL HIGH TAH
+	0x0769:  "ن̆",                 // ARABIC LETTER NOON WITH SMALL V → ARABIC LETTER NOON + COMBINING BREVE
+	0x076C:  "رٔ",                 // ARABIC LETTER REH WITH HAMZA ABOVE → ARABIC LETTER REH + ARABIC HAMZA ABOVE
+	0x0771:  "ڗؕ",                 // ARABIC LETTER REH WITH SMALL ARABIC LETTER TAH AND TWO DOTS → ARABIC LETTER REH WITH TWO DOTS ABOVE + ARABIC SMALL HIGH TAH
+	0x0772:  "حٔ",                 // ARABIC LETTER HAH WITH SMALL ARABIC LETTER TAH ABOVE → ARABIC LETTER HAH + ARABIC HAMZA ABOVE
+	0x077E:  "س̂",                 // ARABIC LETTER SEEN WITH INVERTED V → ARABIC LETTER SEEN + COMBINING CIRCUMFLEX ACCENT
+	0x07C0:  "O",                  // NKO DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x07CA:  "l",                  // NKO LETTER A → LATIN SMALL LETTER L
+	0x07EB:  "̄",                  // NKO COMBINING SHORT HIGH TONE → COMBINING MACRON
+	0x07ED:  "̇",                  // NKO COMBINING SHORT RISING TONE → COMBINING DOT ABOVE
+	0x07EE:  "̂",                  // NKO COMBINING LONG DESCENDING TONE → COMBINING CIRCUMFLEX ACCENT
+	0x07F3:  "̈",                  // NKO COMBINING DOUBLE DOT ABOVE → COMBINING DIAERESIS
+	0x07F4:  "'",                  // NKO HIGH TONE APOSTROPHE → APOSTROPHE
+	0x07F5:  "'",                  // NKO LOW TONE APOSTROPHE → APOSTROPHE
+	0x07FA:  "_",                  // NKO LAJANYALAN → LOW LINE
+	0x088F:  "ى̊",                 // ( ࢏ → ى̊ )
+	0x08A1:  "بٔ",                 // ARABIC LETTER BEH WITH HAMZA ABOVE → ARABIC LETTER BEH + ARABIC HAMZA ABOVE
+	0x08A4:  "ڢۛ",                 // ARABIC LETTER FEH WITH DOT BELOW AND THREE DOTS ABOVE → ARABIC LETTER FEH WITH DOT MOVED BELOW + ARABIC SMALL HIGH THREE DOTS
+	0x08A7:  "مۛ",                 // ARABIC LETTER MEEM WITH THREE DOTS ABOVE → ARABIC LETTER MEEM + ARABIC SMALL HIGH THREE DOTS
+	0x08A8:  "ىٔ",                 // ARABIC LETTER YEH WITH TWO DOTS BELOW AND HAMZA ABOVE → ARABIC LETTER ALEF MAKSURA + ARABIC HAMZA ABOVE
+	0x08A9:  "ݔ",                  // ARABIC LETTER YEH WITH TWO DOTS BELOW AND DOT ABOVE → ARABIC LETTER BEH WITH TWO DOTS BELOW AND DOT ABOVE
+	0x08AE:  "د̤̣",                // ARABIC LETTER DAL WITH THREE DOTS BELOW → ARABIC LETTER DAL + COMBINING DIAERESIS BELOW + COMBINING DOT BELOW
+	0x08AF:  "ص̤̣",                // ARABIC LETTER SAD WITH THREE DOTS BELOW → ARABIC LETTER SAD + COMBINING DIAERESIS BELOW + COMBINING DOT BELOW
+	0x08B0:  "گ",                  // ARABIC LETTER GAF WITH INVERTED STROKE → ARABIC LETTER GAF
+	0x08B1:  "و",                  // ARABIC LETTER STRAIGHT WAW → ARABIC LETTER WAW
+	0x08B2:  "ز̂",                 // ARABIC LETTER ZAIN WITH INVERTED V ABOVE → ARABIC LETTER ZAIN + COMBINING CIRCUMFLEX ACCENT
+	0x08B6:  "بۢ",                 // ARABIC LETTER BEH WITH SMALL MEEM ABOVE → ARABIC LETTER BEH + ARABIC SMALL HIGH MEEM ISOLATED FORM
+	0x08B7:  "ىۛۢ",                // ARABIC LETTER PEH WITH SMALL MEEM ABOVE → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC SMALL HIGH MEEM ISOLATED FORM
+	0x08B9:  "ر̆̇",                // ARABIC LETTER REH WITH SMALL NOON ABOVE → ARABIC LETTER REH + COMBINING BREVE + COMBINING DOT ABOVE
+	0x08BA:  "ى̆̇",                // ARABIC LETTER YEH WITH TWO DOTS BELOW AND SMALL NOON ABOVE → ARABIC LETTER ALEF MAKSURA + COMBINING BREVE + COMBINING DOT ABOVE
+	0x08BB:  "ڡ",                  // ARABIC LETTER AFRICAN FEH → ARABIC LETTER DOTLESS FEH
+	0x08BC:  "ڡ",                  // ARABIC LETTER AFRICAN QAF → ARABIC LETTER DOTLESS FEH
+	0x08BD:  "ى",                  // ARABIC LETTER AFRICAN NOON → ARABIC LETTER ALEF MAKSURA
+	0x08BE:  "ىۛ̆",                // ARABIC LETTER PEH WITH SMALL V → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + COMBINING BREVE
+	0x08BF:  "ت̆",                 // ARABIC LETTER TEH WITH SMALL V → ARABIC LETTER TEH + COMBINING BREVE
+	0x08C0:  "ىؕ̆",                // ARABIC LETTER TTEH WITH SMALL V → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH + COMBINING BREVE
+	0x08C1:  "چ̆",                 // ARABIC LETTER TCHEH WITH SMALL V → ARABIC LETTER TCHEH + COMBINING BREVE
+	0x08C2:  "ك̆",                 // ARABIC LETTER KEHEH WITH SMALL V → ARABIC LETTER KAF + COMBINING BREVE
+	0x08E5:  "ٌ",                  // ARABIC CURLY DAMMA → ARABIC DAMMATAN
+	0x08E8:  "ٌ",                  // ARABIC CURLY DAMMATAN → ARABIC DAMMATAN
+	0x08EA:  "̇",                  // ARABIC TONE ONE DOT ABOVE → COMBINING DOT ABOVE
+	0x08EB:  "̈",                  // ARABIC TONE TWO DOTS ABOVE → COMBINING DIAERESIS
+	0x08ED:  "̣",                  // ARABIC TONE ONE DOT BELOW → COMBINING DOT BELOW
+	0x08EE:  "̤",                  // ARABIC TONE TWO DOTS BELOW → COMBINING DIAERESIS BELOW
+	0x08F0:  "̋",                  // ARABIC OPEN FATHATAN → COMBINING DOUBLE ACUTE ACCENT
+	0x08F1:  "ٌ",                  // ARABIC OPEN DAMMATAN → ARABIC DAMMATAN
+	0x08F2:  "ٍ",                  // ARABIC OPEN KASRATAN → ARABIC KASRATAN
+	0x08F3:  "̓",                  // ARABIC SMALL HIGH WAW → COMBINING COMMA ABOVE
+	0x08F8:  "͐",                  // ARABIC RIGHT ARROWHEAD ABOVE → COMBINING RIGHT ARROWHEAD ABOVE
+	0x08F9:  "͔",                  // ARABIC LEFT ARROWHEAD BELOW → COMBINING LEFT ARROWHEAD BELOW
+	0x08FA:  "͕",                  // ARABIC RIGHT ARROWHEAD BELOW → COMBINING RIGHT ARROWHEAD BELOW
+	0x08FF:  "͐",                  // ARABIC MARK SIDEWAYS NOON GHUNNA → COMBINING RIGHT ARROWHEAD ABOVE
+	0x0900:  "͒",                  // DEVANAGARI SIGN INVERTED CANDRABINDU → COMBINING FERMATA
+	0x0901:  "̆̇",                 // DEVANAGARI SIGN CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0902:  "̇",                  // DEVANAGARI SIGN ANUSVARA → COMBINING DOT ABOVE
+	0x0903:  ":",                  // DEVANAGARI SIGN VISARGA → COLON
+	0x0904:  "अॆ",                 // DEVANAGARI LETTER SHORT A → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN SHORT E
+	0x0906:  "अा",                 // DEVANAGARI LETTER AA → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN AA
+	0x0908:  "र्इ",                // DEVANAGARI LETTER II → DEVANAGARI LETTER RA + DEVANAGARI SIGN VIRAMA + DEVANAGARI LETTER I
+	0x090D:  "ए̆",                 // DEVANAGARI LETTER CANDRA E → DEVANAGARI LETTER E + COMBINING BREVE
+	0x090E:  "एॆ",                 // DEVANAGARI LETTER SHORT E → DEVANAGARI LETTER E + DEVANAGARI VOWEL SIGN SHORT E
+	0x0910:  "ए𑭤",                 // ( ऐ → ए𑭤 )
+	0x0911:  "अा̆",                // DEVANAGARI LETTER CANDRA O → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN AA + COMBINING BREVE
+	0x0912:  "अाॆ",                // DEVANAGARI LETTER SHORT O → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN AA + DEVANAGARI VOWEL SIGN SHORT E
+	0x0913:  "अा𑭤",                // ( ओ → अा𑭤 )
+	0x0914:  "अाै",                // DEVANAGARI LETTER AU → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN AA + DEVANAGARI VOWEL SIGN AI
+	0x093B:  "ाऺ",                 // DEVANAGARI VOWEL SIGN OOE → DEVANAGARI VOWEL SIGN AA + DEVANAGARI VOWEL SIGN OE
+	0x093C:  "̣",                  // DEVANAGARI SIGN NUKTA → COMBINING DOT BELOW
+	0x093F:  "ি",                  // DEVANAGARI VOWEL SIGN I → BENGALI VOWEL SIGN I
+	0x0945:  "̆",                  // DEVANAGARI VOWEL SIGN CANDRA E → COMBINING BREVE
+	0x0947:  "𑭤",                  // ( े → 𑭤 )
+	0x0949:  "ा̆",                 // DEVANAGARI VOWEL SIGN CANDRA O → DEVANAGARI VOWEL SIGN AA + COMBINING BREVE
+	0x0952:  "̱",                  // DEVANAGARI STRESS SIGN ANUDATTA → COMBINING MACRON BELOW
+	0x0953:  "̀",                  // DEVANAGARI GRAVE ACCENT → COMBINING GRAVE ACCENT
+	0x0954:  "́",                  // DEVANAGARI ACUTE ACCENT → COMBINING ACUTE ACCENT
+	0x0956:  "𑭢",                  // ( ॖ → 𑭢 )
+	0x0957:  "𑭣",                  // ( ॗ → 𑭣 )
+	0x0965:  "।।",                 // DEVANAGARI DOUBLE DANDA → DEVANAGARI DANDA + DEVANAGARI DANDA
+	0x0966:  "o",                  // DEVANAGARI DIGIT ZERO → LATIN SMALL LETTER O
+	0x0967:  "٩",                  // DEVANAGARI DIGIT ONE → ARABIC-INDIC DIGIT NINE
+	0x0969:  "3",                  // DEVANAGARI DIGIT THREE → DIGIT THREE
+	0x0972:  "अ̆",                 // DEVANAGARI LETTER CANDRA A → DEVANAGARI LETTER A + COMBINING BREVE
+	0x0973:  "अऺ",                 // DEVANAGARI LETTER OE → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN OE
+	0x0974:  "अाऺ",                // DEVANAGARI LETTER OOE → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN AA + DEVANAGARI VOWEL SIGN OE
+	0x0975:  "अॏ",                 // DEVANAGARI LETTER AW → DEVANAGARI LETTER A + DEVANAGARI VOWEL SIGN AW
+	0x097D:  "?",                  // DEVANAGARI LETTER GLOTTAL STOP → QUESTION MARK
+	0x0981:  "̆̇",                 // BENGALI SIGN CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0986:  "অা",                 // BENGALI LETTER AA → BENGALI LETTER A + BENGALI VOWEL SIGN AA
+	0x09BC:  "̣",                  // BENGALI SIGN NUKTA → COMBINING DOT BELOW
+	0x09E0:  "ঋৃ",                 // BENGALI LETTER VOCALIC RR → BENGALI LETTER VOCALIC R + BENGALI VOWEL SIGN VOCALIC R
+	0x09E1:  "ঋৃ",                 // BENGALI LETTER VOCALIC LL → BENGALI LETTER VOCALIC R + BENGALI VOWEL SIGN VOCALIC R
+	0x09E6:  "o",                  // BENGALI DIGIT ZERO → LATIN SMALL LETTER O
+	0x09EA:  "8",                  // BENGALI DIGIT FOUR → DIGIT EIGHT
+	0x09ED:  "9",                  // BENGALI DIGIT SEVEN → DIGIT NINE
+	0x09F0:  "র",                  // BENGALI LETTER RA WITH MIDDLE DIAGONAL → BENGALI LETTER RA
+	0x0A02:  "̇",                  // GURMUKHI SIGN BINDI → COMBINING DOT ABOVE
+	0x0A03:  "ঃ",                  // GURMUKHI SIGN VISARGA → BENGALI SIGN VISARGA
+	0x0A06:  "ਅਾ",                 // GURMUKHI LETTER AA → GURMUKHI LETTER A + GURMUKHI VOWEL SIGN AA
+	0x0A07:  "प्टি",               // GURMUKHI LETTER I → DEVANAGARI LETTER PA + DEVANAGARI SIGN VIRAMA + DEVANAGARI LETTER TTA + BENGALI VOWEL SIGN I
+	0x0A08:  "प्टੀ",               // GURMUKHI LETTER II → DEVANAGARI LETTER PA + DEVANAGARI SIGN VIRAMA + DEVANAGARI LETTER TTA + GURMUKHI VOWEL SIGN II
+	0x0A09:  "ੳ𑭢",                 // ( ਉ → ੳ𑭢 )
+	0x0A0A:  "ੳ𑭣",                 // ( ਊ → ੳ𑭣 )
+	0x0A0F:  "प्ट𑭤",               // ( ਏ → प्ट𑭤 )
+	0x0A10:  "ਅै",                 // GURMUKHI LETTER AI → GURMUKHI LETTER A + DEVANAGARI VOWEL SIGN AI
+	0x0A14:  "ਅੌ",                 // GURMUKHI LETTER AU → GURMUKHI LETTER A + GURMUKHI VOWEL SIGN AU
+	0x0A15:  "व",                  // GURMUKHI LETTER KA → DEVANAGARI LETTER VA
+	0x0A1C:  "त्त",                // GURMUKHI LETTER JA → DEVANAGARI LETTER TA + DEVANAGARI SIGN VIRAMA + DEVANAGARI LETTER TA
+	0x0A1F:  "ट",                  // GURMUKHI LETTER TTA → DEVANAGARI LETTER TTA
+	0x0A20:  "ठ",                  // GURMUKHI LETTER TTHA → DEVANAGARI LETTER TTHA
+	0x0A24:  "उ",                  // GURMUKHI LETTER TA → DEVANAGARI LETTER U
+	0x0A27:  "प",                  // GURMUKHI LETTER DHA → DEVANAGARI LETTER PA
+	0x0A2B:  "ढ",                  // GURMUKHI LETTER PHA → DEVANAGARI LETTER DDHA
+	0x0A2E:  "भ",                  // GURMUKHI LETTER MA → DEVANAGARI LETTER BHA
+	0x0A35:  "ह",                  // GURMUKHI LETTER VA → DEVANAGARI LETTER HA
+	0x0A38:  "म",                  // GURMUKHI LETTER SA → DEVANAGARI LETTER MA
+	0x0A3C:  "̣",                  // GURMUKHI SIGN NUKTA → COMBINING DOT BELOW
+	0x0A3F:  "ি",                  // GURMUKHI VOWEL SIGN I → BENGALI VOWEL SIGN I
+	0x0A41:  "𑭢",                  // ( ੁ → 𑭢 )
+	0x0A42:  "𑭣",                  // ( ੂ → 𑭣 )
+	0x0A47:  "𑭤",                  // ( ੇ → 𑭤 )
+	0x0A48:  "ै",                  // GURMUKHI VOWEL SIGN AI → DEVANAGARI VOWEL SIGN AI
+	0x0A4B:  "ॆ",                  // GURMUKHI VOWEL SIGN OO → DEVANAGARI VOWEL SIGN SHORT E
+	0x0A4D:  "्",                  // GURMUKHI SIGN VIRAMA → DEVANAGARI SIGN VIRAMA
+	0x0A66:  "o",                  // GURMUKHI DIGIT ZERO → LATIN SMALL LETTER O
+	0x0A67:  "9",                  // GURMUKHI DIGIT ONE → DIGIT NINE
+	0x0A6A:  "8",                  // GURMUKHI DIGIT FOUR → DIGIT EIGHT
+	0x0A72:  "प्ट",                // GURMUKHI IRI → DEVANAGARI LETTER PA + DEVANAGARI SIGN VIRAMA + DEVANAGARI LETTER TTA
+	0x0A81:  "̆̇",                 // GUJARATI SIGN CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0A82:  "̇",                  // GUJARATI SIGN ANUSVARA → COMBINING DOT ABOVE
+	0x0A83:  ":",                  // GUJARATI SIGN VISARGA → COLON
+	0x0A86:  "અા",                 // GUJARATI LETTER AA → GUJARATI LETTER A + GUJARATI VOWEL SIGN AA
+	0x0A8D:  "અૅ",                 // GUJARATI VOWEL CANDRA E → GUJARATI LETTER A + GUJARATI VOWEL SIGN CANDRA E
+	0x0A8F:  "અે",                 // GUJARATI LETTER E → GUJARATI LETTER A + GUJARATI VOWEL SIGN E
+	0x0A90:  "અૈ",                 // GUJARATI LETTER AI → GUJARATI LETTER A + GUJARATI VOWEL SIGN AI
+	0x0A91:  "અાૅ",                // GUJARATI VOWEL CANDRA O → GUJARATI LETTER A + GUJARATI VOWEL SIGN AA + GUJARATI VOWEL SIGN CANDRA E
+	0x0A93:  "અાે",                // GUJARATI LETTER O → GUJARATI LETTER A + GUJARATI VOWEL SIGN AA + GUJARATI VOWEL SIGN E
+	0x0A94:  "અાૈ",                // GUJARATI LETTER AU → GUJARATI LETTER A + GUJARATI VOWEL SIGN AA + GUJARATI VOWEL SIGN AI
+	0x0AAA:  "ч",                  // GUJARATI LETTER PA → CYRILLIC SMALL LETTER CHE
+	0x0AB0:  "२",                  // GUJARATI LETTER RA → DEVANAGARI DIGIT TWO
+	0x0ABC:  "̣",                  // GUJARATI SIGN NUKTA → COMBINING DOT BELOW
+	0x0ABD:  "ऽ",                  // GUJARATI SIGN AVAGRAHA → DEVANAGARI SIGN AVAGRAHA
+	0x0AC1:  "ु",                  // GUJARATI VOWEL SIGN U → DEVANAGARI VOWEL SIGN U
+	0x0AC2:  "ू",                  // GUJARATI VOWEL SIGN UU → DEVANAGARI VOWEL SIGN UU
+	0x0ACD:  "्",                  // GUJARATI SIGN VIRAMA → DEVANAGARI SIGN VIRAMA
+	0x0AE6:  "o",                  // GUJARATI DIGIT ZERO → LATIN SMALL LETTER O
+	0x0AE8:  "२",                  // GUJARATI DIGIT TWO → DEVANAGARI DIGIT TWO
+	0x0AE9:  "3",                  // GUJARATI DIGIT THREE → DIGIT THREE
+	0x0AEA:  "४",                  // GUJARATI DIGIT FOUR → DEVANAGARI DIGIT FOUR
+	0x0AEB:  "ч",                  // GUJARATI DIGIT FIVE → CYRILLIC SMALL LETTER CHE
+	0x0AEE:  "८",                  // GUJARATI DIGIT EIGHT → DEVANAGARI DIGIT EIGHT
+	0x0AF0:  "॰",                  // GUJARATI ABBREVIATION SIGN → DEVANAGARI ABBREVIATION SIGN
+	0x0B01:  "̆̇",                 // ORIYA SIGN CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0B03:  "8",                  // ORIYA SIGN VISARGA → DIGIT EIGHT
+	0x0B06:  "ଅା",                 // ORIYA LETTER AA → ORIYA LETTER A + ORIYA VOWEL SIGN AA
+	0x0B20:  "O",                  // ORIYA LETTER TTHA → LATIN CAPITAL LETTER O
+	0x0B3C:  "̣",                  // ORIYA SIGN NUKTA → COMBINING DOT BELOW
+	0x0B66:  "o",                  // ORIYA DIGIT ZERO → LATIN SMALL LETTER O
+	0x0B68:  "9",                  // ORIYA DIGIT TWO → DIGIT NINE
+	0x0B82:  "̊",                  // TAMIL SIGN ANUSVARA → COMBINING RING ABOVE
+	0x0B8A:  "உள",                 // TAMIL LETTER UU → TAMIL LETTER U + TAMIL LETTER LLA
+	0x0B94:  "ஒள",                 // TAMIL LETTER AU → TAMIL LETTER O + TAMIL LETTER LLA
+	0x0B9C:  "ஐ",                  // TAMIL LETTER JA → TAMIL LETTER AI
+	0x0BB0:  "ஈ",                  // TAMIL LETTER RA → TAMIL LETTER II
+	0x0BB8:  "ஶ",                  // TAMIL LETTER SA → TAMIL LETTER SHA
+	0x0BBE:  "ஈ",                  // TAMIL VOWEL SIGN AA → TAMIL LETTER II
+	0x0BC8:  "ன",                  // TAMIL VOWEL SIGN AI → TAMIL LETTER NNNA
+	0x0BCA:  "ெஈ",                 // TAMIL VOWEL SIGN O → TAMIL VOWEL SIGN E + TAMIL LETTER II
+	0x0BCB:  "ேஈ",                 // TAMIL VOWEL SIGN OO → TAMIL VOWEL SIGN EE + TAMIL LETTER II
+	0x0BCC:  "ெள",                 // TAMIL VOWEL SIGN AU → TAMIL VOWEL SIGN E + TAMIL LETTER LLA
+	0x0BCD:  "̇",                  // TAMIL SIGN VIRAMA → COMBINING DOT ABOVE
+	0x0BD7:  "ள",                  // TAMIL AU LENGTH MARK → TAMIL LETTER LLA
+	0x0BE6:  "o",                  // TAMIL DIGIT ZERO → LATIN SMALL LETTER O
+	0x0BE7:  "க",                  // TAMIL DIGIT ONE → TAMIL LETTER KA
+	0x0BE8:  "உ",                  // TAMIL DIGIT TWO → TAMIL LETTER U
+	0x0BEA:  "ச",                  // TAMIL DIGIT FOUR → TAMIL LETTER CA
+	0x0BEB:  "ஈு",                 // TAMIL DIGIT FIVE → TAMIL LETTER II + TAMIL VOWEL SIGN U
+	0x0BEC:  "சு",                 // TAMIL DIGIT SIX → TAMIL LETTER CA + TAMIL VOWEL SIGN U
+	0x0BED:  "எ",                  // TAMIL DIGIT SEVEN → TAMIL LETTER E
+	0x0BEE:  "அ",                  // TAMIL DIGIT EIGHT → TAMIL LETTER A
+	0x0BF0:  "ய",                  // TAMIL NUMBER TEN → TAMIL LETTER YA
+	0x0BF2:  "சூ",                 // TAMIL NUMBER ONE THOUSAND → TAMIL LETTER CA + TAMIL VOWEL SIGN UU
+	0x0BF4:  "மீ",                 // TAMIL MONTH SIGN → TAMIL LETTER MA + TAMIL VOWEL SIGN II
+	0x0BF5:  "௳",                  // TAMIL YEAR SIGN → TAMIL DAY SIGN
+	0x0BF7:  "எவ",                 // TAMIL CREDIT SIGN → TAMIL LETTER E + TAMIL LETTER VA
+	0x0BF8:  "ஷ",                  // TAMIL AS ABOVE SIGN → TAMIL LETTER SSA
+	0x0BFA:  "நீ",                 // TAMIL NUMBER SIGN → TAMIL LETTER NA + TAMIL VOWEL SIGN II
+	0x0C00:  "̆̇",                 // TELUGU SIGN COMBINING CANDRABINDU ABOVE → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0C02:  "o",                  // TELUGU SIGN ANUSVARA → LATIN SMALL LETTER O
+	0x0C03:  "ঃ",                  // TELUGU SIGN VISARGA → BENGALI SIGN VISARGA
+	0x0C13:  "ఒౕ",                 // TELUGU LETTER OO → TELUGU LETTER O + TELUGU LENGTH MARK
+	0x0C14:  "ఒౌ",                 // TELUGU LETTER AU → TELUGU LETTER O + TELUGU VOWEL SIGN AU
+	0x0C16:  "ಖ̣",                 // TELUGU LETTER KHA → KANNADA LETTER KHA + COMBINING DOT BELOW
+	0x0C20:  "రּ",                 // TELUGU LETTER TTHA → TELUGU LETTER RA + HEBREW POINT DAGESH OR MAPIQ
+	0x0C22:  "డ̣",                 // TELUGU LETTER DDHA → TELUGU LETTER DDA + COMBINING DOT BELOW
+	0x0C25:  "ధּ",                 // TELUGU LETTER THA → TELUGU LETTER DHA + HEBREW POINT DAGESH OR MAPIQ
+	0x0C2D:  "బ̣",                 // TELUGU LETTER BHA → TELUGU LETTER BA + COMBINING DOT BELOW
+	0x0C2E:  "వు",                 // TELUGU LETTER MA → TELUGU LETTER VA + TELUGU VOWEL SIGN U
+	0x0C37:  "వ̣",                 // TELUGU LETTER SSA → TELUGU LETTER VA + COMBINING DOT BELOW
+	0x0C39:  "వా",                 // TELUGU LETTER HA → TELUGU LETTER VA + TELUGU VOWEL SIGN AA
+	0x0C42:  "ుా",                 // TELUGU VOWEL SIGN UU → TELUGU VOWEL SIGN U + TELUGU VOWEL SIGN AA
+	0x0C44:  "ృా",                 // TELUGU VOWEL SIGN VOCALIC RR → TELUGU VOWEL SIGN VOCALIC R + TELUGU VOWEL SIGN AA
+	0x0C60:  "ఋా",                 // TELUGU LETTER VOCALIC RR → TELUGU LETTER VOCALIC R + TELUGU VOWEL SIGN AA
+	0x0C61:  "ఌా",                 // TELUGU LETTER VOCALIC LL → TELUGU LETTER VOCALIC L + TELUGU VOWEL SIGN AA
+	0x0C66:  "o",                  // TELUGU DIGIT ZERO → LATIN SMALL LETTER O
+	0x0C81:  "̆̇",                 // KANNADA SIGN CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0C82:  "o",                  // KANNADA SIGN ANUSVARA → LATIN SMALL LETTER O
+	0x0C83:  "ঃ",                  // KANNADA SIGN VISARGA → BENGALI SIGN VISARGA
+	0x0C85:  "అ",                  // KANNADA LETTER A → TELUGU LETTER A
+	0x0C86:  "ఆ",                  // KANNADA LETTER AA → TELUGU LETTER AA
+	0x0C87:  "ఇ",                  // KANNADA LETTER I → TELUGU LETTER I
+	0x0C90:  "ఐ",                  // KANNADA LETTER AI → TELUGU LETTER AI
+	0x0C92:  "ఒ",                  // KANNADA LETTER O → TELUGU LETTER O
+	0x0C93:  "ఒౕ",                 // KANNADA LETTER OO → TELUGU LETTER O + TELUGU LENGTH MARK
+	0x0C94:  "ఒౌ",                 // KANNADA LETTER AU → TELUGU LETTER O + TELUGU VOWEL SIGN AU
+	0x0C97:  "గ",                  // KANNADA LETTER GA → TELUGU LETTER GA
+	0x0C9C:  "జ",                  // KANNADA LETTER JA → TELUGU LETTER JA
+	0x0C9D:  "ఝ",                  // KANNADA LETTER JHA → TELUGU LETTER JHA
+	0x0C9E:  "ఞ",                  // KANNADA LETTER NYA → TELUGU LETTER NYA
+	0x0C9F:  "ట",                  // KANNADA LETTER TTA → TELUGU LETTER TTA
+	0x0CA3:  "ణ",                  // KANNADA LETTER NNA → TELUGU LETTER NNA
+	0x0CA6:  "ద",                  // KANNADA LETTER DA → TELUGU LETTER DA
+	0x0CA8:  "న",                  // KANNADA LETTER NA → TELUGU LETTER NA
+	0x0CAF:  "య",                  // KANNADA LETTER YA → TELUGU LETTER YA
+	0x0CB0:  "ర",                  // KANNADA LETTER RA → TELUGU LETTER RA
+	0x0CB1:  "ఱ",                  // KANNADA LETTER RRA → TELUGU LETTER RRA
+	0x0CB2:  "ల",                  // KANNADA LETTER LA → TELUGU LETTER LA
+	0x0CB3:  "ళ",                  // KANNADA LETTER LLA → TELUGU LETTER LLA
+	0x0CBF:  "ి",                  // KANNADA VOWEL SIGN I → TELUGU VOWEL SIGN I
+	0x0CC1:  "ు",                  // KANNADA VOWEL SIGN U → TELUGU VOWEL SIGN U
+	0x0CC3:  "ృ",                  // KANNADA VOWEL SIGN VOCALIC R → TELUGU VOWEL SIGN VOCALIC R
+	0x0CDC:  "౜",                  // ( ೜ → ౜ )
+	0x0CE1:  "ಌಾ",                 // KANNADA LETTER VOCALIC LL → KANNADA LETTER VOCALIC L + KANNADA VOWEL SIGN AA
+	0x0CE6:  "O",                  // KANNADA DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x0CE7:  "౧",                  // KANNADA DIGIT ONE → TELUGU DIGIT ONE
+	0x0CE8:  "౨",                  // KANNADA DIGIT TWO → TELUGU DIGIT TWO
+	0x0CEF:  "౯",                  // KANNADA DIGIT NINE → TELUGU DIGIT NINE
+	0x0D01:  "̆̇",                 // MALAYALAM SIGN CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x0D02:  "o",                  // MALAYALAM SIGN ANUSVARA → LATIN SMALL LETTER O
+	0x0D03:  "ঃ",                  // MALAYALAM SIGN VISARGA → BENGALI SIGN VISARGA
+	0x0D08:  "ഇൗ",                 // MALAYALAM LETTER II → MALAYALAM LETTER I + MALAYALAM AU LENGTH MARK
+	0x0D09:  "உ",                  // MALAYALAM LETTER U → TAMIL LETTER U
+	0x0D0A:  "உൗ",                 // MALAYALAM LETTER UU → TAMIL LETTER U + MALAYALAM AU LENGTH MARK
+	0x0D0C:  "നു",                 // MALAYALAM LETTER VOCALIC L → MALAYALAM LETTER NA + MALAYALAM VOWEL SIGN U
+	0x0D10:  "ெഎ",                 // MALAYALAM LETTER AI → TAMIL VOWEL SIGN E + MALAYALAM LETTER E
+	0x0D13:  "ഒാ",                 // MALAYALAM LETTER OO → MALAYALAM LETTER O + MALAYALAM VOWEL SIGN AA
+	0x0D14:  "ഒൗ",                 // MALAYALAM LETTER AU → MALAYALAM LETTER O + MALAYALAM AU LENGTH MARK
+	0x0D16:  "வ",                  // MALAYALAM LETTER KHA → TAMIL LETTER VA
+	0x0D19:  "നു",                 // MALAYALAM LETTER NGA → MALAYALAM LETTER NA + MALAYALAM VOWEL SIGN U
+	0x0D1C:  "ஐ",                  // MALAYALAM LETTER JA → TAMIL LETTER AI
+	0x0D1F:  "s",                  // MALAYALAM LETTER TTA → LATIN SMALL LETTER S
+	0x0D20:  "o",                  // MALAYALAM LETTER TTHA → LATIN SMALL LETTER O
+	0x0D23:  "ண",                  // MALAYALAM LETTER NNA → TAMIL LETTER NNA
+	0x0D25:  "ம",                  // MALAYALAM LETTER THA → TAMIL LETTER MA
+	0x0D31:  "ര",                  // MALAYALAM LETTER RRA → MALAYALAM LETTER RA
+	0x0D34:  "ழ",                  // MALAYALAM LETTER LLLA → TAMIL LETTER LLLA
+	0x0D36:  "ஶ",                  // MALAYALAM LETTER SHA → TAMIL LETTER SHA
+	0x0D3A:  "டி",                 // MALAYALAM LETTER TTTA → TAMIL LETTER TTA + TAMIL VOWEL SIGN I
+	0x0D3F:  "ி",                  // MALAYALAM VOWEL SIGN I → TAMIL VOWEL SIGN I
+	0x0D40:  "ி",                  // MALAYALAM VOWEL SIGN II → TAMIL VOWEL SIGN I
+	0x0D42:  "ു",                  // MALAYALAM VOWEL SIGN UU → MALAYALAM VOWEL SIGN U
+	0x0D43:  "ു",                  // MALAYALAM VOWEL SIGN VOCALIC R → MALAYALAM VOWEL SIGN U
+	0x0D46:  "ெ",                  // MALAYALAM VOWEL SIGN E → TAMIL VOWEL SIGN E
+	0x0D47:  "ே",                  // MALAYALAM VOWEL SIGN EE → TAMIL VOWEL SIGN EE
+	0x0D48:  "ெெ",                 // MALAYALAM VOWEL SIGN AI → TAMIL VOWEL SIGN E + TAMIL VOWEL SIGN E
+	0x0D4E:  "ॱ",                  // MALAYALAM LETTER DOT REPH → DEVANAGARI SIGN HIGH SPACING DOT
+	0x0D5A:  "ന്മ",                // MALAYALAM FRACTION THREE EIGHTIETHS → MALAYALAM LETTER NA + MALAYALAM SIGN VIRAMA + MALAYALAM LETTER MA
+	0x0D5F:  "oരo",                // MALAYALAM LETTER ARCHAIC II → LATIN SMALL LETTER O + MALAYALAM LETTER RA + LATIN SMALL LETTER O
+	0x0D61:  "ഞ",                  // MALAYALAM LETTER VOCALIC LL → MALAYALAM LETTER NYA
+	0x0D66:  "o",                  // MALAYALAM DIGIT ZERO → LATIN SMALL LETTER O
+	0x0D6A:  "ര്",                 // MALAYALAM DIGIT FOUR → MALAYALAM LETTER RA + MALAYALAM SIGN VIRAMA
+	0x0D6B:  "ദ്ര",                // MALAYALAM DIGIT FIVE → MALAYALAM LETTER DA + MALAYALAM SIGN VIRAMA + MALAYALAM LETTER RA
+	0x0D6C:  "ന്ന",                // MALAYALAM DIGIT SIX → MALAYALAM LETTER NA + MALAYALAM SIGN VIRAMA + MALAYALAM LETTER NA
+	0x0D6D:  "9",                  // MALAYALAM DIGIT SEVEN → DIGIT NINE
+	0x0D6E:  "വ്ര",                // MALAYALAM DIGIT EIGHT → MALAYALAM LETTER VA + MALAYALAM SIGN VIRAMA + MALAYALAM LETTER RA
+	0x0D6F:  "ന്",                 // MALAYALAM DIGIT NINE → MALAYALAM LETTER NA + MALAYALAM SIGN VIRAMA
+	0x0D76:  "ഹ്മ",                // MALAYALAM FRACTION ONE SIXTEENTH → MALAYALAM LETTER HA + MALAYALAM SIGN VIRAMA + MALAYALAM LETTER MA
+	0x0D79:  "നു",                 // MALAYALAM DATE MARK → MALAYALAM LETTER NA + MALAYALAM VOWEL SIGN U
+	0x0D7A:  "ண്",                 // MALAYALAM LETTER CHILLU NN → TAMIL LETTER NNA + MALAYALAM SIGN VIRAMA
+	0x0D7B:  "ന്",                 // MALAYALAM LETTER CHILLU N → MALAYALAM LETTER NA + MALAYALAM SIGN VIRAMA
+	0x0D7C:  "ര്",                 // MALAYALAM LETTER CHILLU RR → MALAYALAM LETTER RA + MALAYALAM SIGN VIRAMA
+	0x0D7D:  "ല്",                 // MALAYALAM LETTER CHILLU L → MALAYALAM LETTER LA + MALAYALAM SIGN VIRAMA
+	0x0D7E:  "ള്",                 // MALAYALAM LETTER CHILLU LL → MALAYALAM LETTER LLA + MALAYALAM SIGN VIRAMA
+	0x0D82:  "o",                  // SINHALA SIGN ANUSVARAYA → LATIN SMALL LETTER O
+	0x0D83:  "ঃ",                  // SINHALA SIGN VISARGAYA → BENGALI SIGN VISARGA
+	0x0D8D:  "සෘ",                 // SINHALA LETTER IRUYANNA → SINHALA LETTER DANTAJA SAYANNA + SINHALA VOWEL SIGN GAETTA-PILLA
+	0x0D92:  "එ්",                 // SINHALA LETTER EEYANNA → SINHALA LETTER EYANNA + SINHALA SIGN AL-LAKUNA
+	0x0D93:  "එෙ",                 // SINHALA LETTER AIYANNA → SINHALA LETTER EYANNA + SINHALA VOWEL SIGN KOMBUVA
+	0x0DB5:  "එ",                  // SINHALA LETTER MAHAAPRAANA PAYANNA → SINHALA LETTER EYANNA
+	0x0DB6:  "ඛ",                  // SINHALA LETTER ALPAPRAANA BAYANNA → SINHALA LETTER MAHAAPRAANA KAYANNA
+	0x0DB9:  "ඔ",                  // SINHALA LETTER AMBA BAYANNA → SINHALA LETTER OYANNA
+	0x0DC0:  "ච",                  // SINHALA LETTER VAYANNA → SINHALA LETTER ALPAPRAANA CAYANNA
+	0x0DC4:  "භ",                  // SINHALA LETTER HAYANNA → SINHALA LETTER MAHAAPRAANA BAYANNA
+	0x0DE9:  "෨ා",                 // SINHALA LITH DIGIT THREE → SINHALA LITH DIGIT TWO + SINHALA VOWEL SIGN AELA-PILLA
+	0x0DEA:  "ජ",                  // SINHALA LITH DIGIT FOUR → SINHALA LETTER ALPAPRAANA JAYANNA
+	0x0DEB:  "ද",                  // SINHALA LITH DIGIT FIVE → SINHALA LETTER ALPAPRAANA DAYANNA
+	0x0DEF:  "෨ී",                 // SINHALA LITH DIGIT NINE → SINHALA LITH DIGIT TWO + SINHALA VOWEL SIGN DIGA IS-PILLA
+	0x0E03:  "ข",                  // THAI CHARACTER KHO KHUAT → THAI CHARACTER KHO KHAI
+	0x0E0B:  "ช",                  // THAI CHARACTER SO SO → THAI CHARACTER CHO CHANG
+	0x0E0F:  "ฎ",                  // THAI CHARACTER TO PATAK → THAI CHARACTER DO CHADA
+	0x0E14:  "ค",                  // THAI CHARACTER DO DEK → THAI CHARACTER KHO KHWAI
+	0x0E15:  "ค",                  // THAI CHARACTER TO TAO → THAI CHARACTER KHO KHWAI
+	0x0E17:  "ฑ",                  // THAI CHARACTER THO THAHAN → THAI CHARACTER THO NANGMONTHO
+	0x0E21:  "ฆ",                  // THAI CHARACTER MO MA → THAI CHARACTER KHO RAKHANG
+	0x0E26:  "ภ",                  // THAI CHARACTER LU → THAI CHARACTER PHO SAMPHAO
+	0x0E33:  "̊า",                 // THAI CHARACTER SARA AM → COMBINING RING ABOVE + THAI CHARACTER SARA AA
+	0x0E41:  "เเ",                 // THAI CHARACTER SARA AE → THAI CHARACTER SARA E + THAI CHARACTER SARA E
+	0x0E45:  "า",                  // THAI CHARACTER LAKKHANGYAO → THAI CHARACTER SARA AA
+	0x0E4D:  "̊",                  // THAI CHARACTER NIKHAHIT → COMBINING RING ABOVE
+	0x0E50:  "o",                  // THAI DIGIT ZERO → LATIN SMALL LETTER O
+	0x0E88:  "จ",                  // LAO LETTER CO → THAI CHARACTER CHO CHAN
+	0x0E8D:  "ย",                  // LAO LETTER NYO → THAI CHARACTER YO YAK
+	0x0E9A:  "บ",                  // LAO LETTER BO → THAI CHARACTER BO BAIMAI
+	0x0E9B:  "ป",                  // LAO LETTER PO → THAI CHARACTER PO PLA
+	0x0E9D:  "ฝ",                  // LAO LETTER FO TAM → THAI CHARACTER FO FA
+	0x0E9E:  "พ",                  // LAO LETTER PHO TAM → THAI CHARACTER PHO PHAN
+	0x0E9F:  "ฟ",                  // LAO LETTER FO SUNG → THAI CHARACTER FO FAN
+	0x0EB3:  "̊າ",                 // LAO VOWEL SIGN AM → COMBINING RING ABOVE + LAO VOWEL SIGN AA
+	0x0EB8:  "ุ",                  // LAO VOWEL SIGN U → THAI CHARACTER SARA U
+	0x0EB9:  "ู",                  // LAO VOWEL SIGN UU → THAI CHARACTER SARA UU
+	0x0EC8:  "่",                  // LAO TONE MAI EK → THAI CHARACTER MAI EK
+	0x0EC9:  "้",                  // LAO TONE MAI THO → THAI CHARACTER MAI THO
+	0x0ECA:  "๊",                  // LAO TONE MAI TI → THAI CHARACTER MAI TRI
+	0x0ECB:  "๋",                  // LAO TONE MAI CATAWA → THAI CHARACTER MAI CHATTAWA
+	0x0ECD:  "̊",                  // LAO NIGGAHITA → COMBINING RING ABOVE
+	0x0ED0:  "o",                  // LAO DIGIT ZERO → LATIN SMALL LETTER O
+	0x0EDC:  "ຫນ",                 // LAO HO NO → LAO LETTER HO SUNG + LAO LETTER NO
+	0x0EDD:  "ຫມ",                 // LAO HO MO → LAO LETTER HO SUNG + LAO LETTER MO
+	0x0F00:  "ཨོཾ",                // TIBETAN SYLLABLE OM → TIBETAN LETTER A + TIBETAN VOWEL SIGN O + TIBETAN SIGN RJES SU NGA RO
+	0x0F02:  "འུྂཿ",               // TIBETAN MARK GTER YIG MGO -UM RNAM BCAD MA → TIBETAN LETTER -A + TIBETAN VOWEL SIGN U + TIBETAN SIGN NYI ZLA NAA DA + TIBETAN SIGN RNAM BCAD
+	0x0F03:  "འུྂ༔",               // TIBETAN MARK GTER YIG MGO -UM GTER TSHEG MA → TIBETAN LETTER -A + TIBETAN VOWEL SIGN U + TIBETAN SIGN NYI ZLA NAA DA + TIBETAN MARK GTER TSHEG
+	0x0F0C:  "་",                  // TIBETAN MARK DELIMITER TSHEG BSTAR → TIBETAN MARK INTERSYLLABIC TSHEG
+	0x0F0E:  "།།",                 // TIBETAN MARK NYIS SHAD → TIBETAN MARK SHAD + TIBETAN MARK SHAD
+	0x0F1B:  "༚༚",                 // TIBETAN SIGN RDEL DKAR GNYIS → TIBETAN SIGN RDEL DKAR GCIG + TIBETAN SIGN RDEL DKAR GCIG
+	0x0F1E:  "༝༝",                 // TIBETAN SIGN RDEL NAG GNYIS → TIBETAN SIGN RDEL NAG GCIG + TIBETAN SIGN RDEL NAG GCIG
+	0x0F1F:  "༚༝",                 // TIBETAN SIGN RDEL DKAR RDEL NAG → TIBETAN SIGN RDEL DKAR GCIG + TIBETAN SIGN RDEL NAG GCIG
+	0x0F37:  "̥",                  // TIBETAN MARK NGAS BZUNG SGOR RTAGS → COMBINING RING BELOW
+	0x0F6A:  "ར",                  // TIBETAN LETTER FIXED-FORM RA → TIBETAN LETTER RA
+	0x0F77:  "ྲཱྀ",                // TIBETAN VOWEL SIGN VOCALIC RR → TIBETAN SUBJOINED LETTER RA + TIBETAN VOWEL SIGN AA + TIBETAN VOWEL SIGN REVERSED I
+	0x0F79:  "ླཱྀ",                // TIBETAN VOWEL SIGN VOCALIC LL → TIBETAN SUBJOINED LETTER LA + TIBETAN VOWEL SIGN AA + TIBETAN VOWEL SIGN REVERSED I
+	0x0F7B:  "ེེ",                 // TIBETAN VOWEL SIGN EE → TIBETAN VOWEL SIGN E + TIBETAN VOWEL SIGN E
+	0x0F7D:  "ོོ",                 // TIBETAN VOWEL SIGN OO → TIBETAN VOWEL SIGN O + TIBETAN VOWEL SIGN O
+	0x0FCE:  "༝༚",                 // TIBETAN SIGN RDEL NAG RDEL DKAR → TIBETAN SIGN RDEL NAG GCIG + TIBETAN SIGN RDEL DKAR GCIG
+	0x0FD5:  "卐",                  // RIGHT-FACING SVASTI SIGN → <CJK Ideograph>
+	0x0FD6:  "卍",                  // LEFT-FACING SVASTI SIGN → <CJK Ideograph>
+	0x1000:  "രာ",                 // MYANMAR LETTER KA → MALAYALAM LETTER RA + MYANMAR VOWEL SIGN AA
+	0x1002:  "ര",                  // MYANMAR LETTER GA → MALAYALAM LETTER RA
+	0x1004:  "c",                  // MYANMAR LETTER NGA → LATIN SMALL LETTER C
+	0x1010:  "oာ",                 // MYANMAR LETTER TA → LATIN SMALL LETTER O + MYANMAR VOWEL SIGN AA
+	0x101D:  "o",                  // MYANMAR LETTER WA → LATIN SMALL LETTER O
+	0x101F:  "ပာ",                 // MYANMAR LETTER HA → MYANMAR LETTER PA + MYANMAR VOWEL SIGN AA
+	0x1023:  "രာ္രာ",              // MYANMAR LETTER I → MALAYALAM LETTER RA + MYANMAR VOWEL SIGN AA + MYANMAR SIGN VIRAMA + MALAYALAM LETTER RA + MYANMAR VOWEL SIGN AA
+	0x1029:  "သြ",                 // MYANMAR LETTER O → MYANMAR LETTER SA + MYANMAR CONSONANT SIGN MEDIAL RA
+	0x102A:  "သြେာ်",              // MYANMAR LETTER AU → MYANMAR LETTER SA + MYANMAR CONSONANT SIGN MEDIAL RA + ORIYA VOWEL SIGN E + MYANMAR VOWEL SIGN AA + MYANMAR SIGN ASAT
+	0x1031:  "େ",                  // MYANMAR VOWEL SIGN E → ORIYA VOWEL SIGN E
+	0x1036:  "̊",                  // MYANMAR SIGN ANUSVARA → COMBINING RING ABOVE
+	0x1038:  "ঃ",                  // MYANMAR SIGN VISARGA → BENGALI SIGN VISARGA
+	0x1040:  "o",                  // MYANMAR DIGIT ZERO → LATIN SMALL LETTER O
+	0x104B:  "၊၊",                 // MYANMAR SIGN SECTION → MYANMAR SIGN LITTLE SECTION + MYANMAR SIGN LITTLE SECTION
+	0x105A:  "c",                  // MYANMAR LETTER MON NGA → LATIN SMALL LETTER C
+	0x1061:  "ရှ",                 // MYANMAR LETTER SGAW KAREN SHA → MYANMAR LETTER RA + MYANMAR CONSONANT SIGN MEDIAL HA
+	0x1065:  "၁",                  // MYANMAR LETTER WESTERN PWO KAREN THA → MYANMAR DIGIT ONE
+	0x1066:  "ပှ",                 // MYANMAR LETTER WESTERN PWO KAREN PWA → MYANMAR LETTER PA + MYANMAR CONSONANT SIGN MEDIAL HA
+	0x106F:  "ပာှ",                // MYANMAR LETTER EASTERN PWO KAREN YWA → MYANMAR LETTER PA + MYANMAR VOWEL SIGN AA + MYANMAR CONSONANT SIGN MEDIAL HA
+	0x1070:  "ဃှ",                 // MYANMAR LETTER EASTERN PWO KAREN GHWA → MYANMAR LETTER GHA + MYANMAR CONSONANT SIGN MEDIAL HA
+	0x107E:  "ၽှ",                 // MYANMAR LETTER SHAN FA → MYANMAR LETTER SHAN PHA + MYANMAR CONSONANT SIGN MEDIAL HA
+	0x1081:  "രှ",                 // MYANMAR LETTER SHAN HA → MALAYALAM LETTER RA + MYANMAR CONSONANT SIGN MEDIAL HA
+	0x109E:  "ႃ̊",                 // MYANMAR SYMBOL SHAN ONE → MYANMAR VOWEL SIGN SHAN AA + COMBINING RING ABOVE
+	0x10A0:  "Ꞇ",                  // GEORGIAN CAPITAL LETTER AN → LATIN CAPITAL LETTER INSULAR T
+	0x10D7:  "oာ",                 // GEORGIAN LETTER TAN → LATIN SMALL LETTER O + MYANMAR VOWEL SIGN AA
+	0x10D8:  "ര",                  // GEORGIAN LETTER IN → MALAYALAM LETTER RA
+	0x10E7:  "y",                  // GEORGIAN LETTER QAR → LATIN SMALL LETTER Y
+	0x10F3:  "ȝ",                  // GEORGIAN LETTER WE → LATIN SMALL LETTER YOGH
+	0x10FF:  "o",                  // GEORGIAN LETTER LABIAL SIGN → LATIN SMALL LETTER O
+	0x1101:  "ᄀᄀ",                 // HANGUL CHOSEONG SSANGKIYEOK → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG KIYEOK
+	0x1104:  "ᄃᄃ",                 // HANGUL CHOSEONG SSANGTIKEUT → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG TIKEUT
+	0x1108:  "ᄇᄇ",                 // HANGUL CHOSEONG SSANGPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PIEUP
+	0x110A:  "ᄉᄉ",                 // HANGUL CHOSEONG SSANGSIOS → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS
+	0x110D:  "ᄌᄌ",                 // HANGUL CHOSEONG SSANGCIEUC → HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG CIEUC
+	0x1113:  "ᄂᄀ",                 // HANGUL CHOSEONG NIEUN-KIYEOK → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG KIYEOK
+	0x1114:  "ᄂᄂ",                 // HANGUL CHOSEONG SSANGNIEUN → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG NIEUN
+	0x1115:  "ᄂᄃ",                 // HANGUL CHOSEONG NIEUN-TIKEUT → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG TIKEUT
+	0x1116:  "ᄂᄇ",                 // HANGUL CHOSEONG NIEUN-PIEUP → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG PIEUP
+	0x1117:  "ᄃᄀ",                 // HANGUL CHOSEONG TIKEUT-KIYEOK → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG KIYEOK
+	0x1118:  "ᄅᄂ",                 // HANGUL CHOSEONG RIEUL-NIEUN → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG NIEUN
+	0x1119:  "ᄅᄅ",                 // HANGUL CHOSEONG SSANGRIEUL → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG RIEUL
+	0x111A:  "ᄅᄒ",                 // HANGUL CHOSEONG RIEUL-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG HIEUH
+	0x111B:  "ᄅᄋ",                 // HANGUL CHOSEONG KAPYEOUNRIEUL → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG IEUNG
+	0x111C:  "ᄆᄇ",                 // HANGUL CHOSEONG MIEUM-PIEUP → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG PIEUP
+	0x111D:  "ᄆᄋ",                 // HANGUL CHOSEONG KAPYEOUNMIEUM → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG IEUNG
+	0x111E:  "ᄇᄀ",                 // HANGUL CHOSEONG PIEUP-KIYEOK → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG KIYEOK
+	0x111F:  "ᄇᄂ",                 // HANGUL CHOSEONG PIEUP-NIEUN → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG NIEUN
+	0x1120:  "ᄇᄃ",                 // HANGUL CHOSEONG PIEUP-TIKEUT → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG TIKEUT
+	0x1121:  "ᄇᄉ",                 // HANGUL CHOSEONG PIEUP-SIOS → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS
+	0x1122:  "ᄇᄉᄀ",                // HANGUL CHOSEONG PIEUP-SIOS-KIYEOK → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0x1123:  "ᄇᄉᄃ",                // HANGUL CHOSEONG PIEUP-SIOS-TIKEUT → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG TIKEUT
+	0x1124:  "ᄇᄉᄇ",                // HANGUL CHOSEONG PIEUP-SIOS-PIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PIEUP
+	0x1125:  "ᄇᄉᄉ",                // HANGUL CHOSEONG PIEUP-SSANGSIOS → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS
+	0x1126:  "ᄇᄉᄌ",                // HANGUL CHOSEONG PIEUP-SIOS-CIEUC → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG CIEUC
+	0x1127:  "ᄇᄌ",                 // HANGUL CHOSEONG PIEUP-CIEUC → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG CIEUC
+	0x1128:  "ᄇᄎ",                 // HANGUL CHOSEONG PIEUP-CHIEUCH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG CHIEUCH
+	0x1129:  "ᄇᄐ",                 // HANGUL CHOSEONG PIEUP-THIEUTH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG THIEUTH
+	0x112A:  "ᄇᄑ",                 // HANGUL CHOSEONG PIEUP-PHIEUPH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PHIEUPH
+	0x112B:  "ᄇᄋ",                 // HANGUL CHOSEONG KAPYEOUNPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0x112C:  "ᄇᄇᄋ",                // HANGUL CHOSEONG KAPYEOUNSSANGPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0x112D:  "ᄉᄀ",                 // HANGUL CHOSEONG SIOS-KIYEOK → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0x112E:  "ᄉᄂ",                 // HANGUL CHOSEONG SIOS-NIEUN → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG NIEUN
+	0x112F:  "ᄉᄃ",                 // HANGUL CHOSEONG SIOS-TIKEUT → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG TIKEUT
+	0x1130:  "ᄉᄅ",                 // HANGUL CHOSEONG SIOS-RIEUL → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG RIEUL
+	0x1131:  "ᄉᄆ",                 // HANGUL CHOSEONG SIOS-MIEUM → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG MIEUM
+	0x1132:  "ᄉᄇ",                 // HANGUL CHOSEONG SIOS-PIEUP → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PIEUP
+	0x1133:  "ᄉᄇᄀ",                // HANGUL CHOSEONG SIOS-PIEUP-KIYEOK → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG KIYEOK
+	0x1134:  "ᄉᄉᄉ",                // HANGUL CHOSEONG SIOS-SSANGSIOS → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS
+	0x1135:  "ᄉᄋ",                 // HANGUL CHOSEONG SIOS-IEUNG → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG IEUNG
+	0x1136:  "ᄉᄌ",                 // HANGUL CHOSEONG SIOS-CIEUC → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG CIEUC
+	0x1137:  "ᄉᄎ",                 // HANGUL CHOSEONG SIOS-CHIEUCH → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG CHIEUCH
+	0x1138:  "ᄉᄏ",                 // HANGUL CHOSEONG SIOS-KHIEUKH → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KHIEUKH
+	0x1139:  "ᄉᄐ",                 // HANGUL CHOSEONG SIOS-THIEUTH → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG THIEUTH
+	0x113A:  "ᄉᄑ",                 // HANGUL CHOSEONG SIOS-PHIEUPH → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PHIEUPH
+	0x113B:  "ᄅᄒ",                 // HANGUL CHOSEONG SIOS-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG HIEUH
+	0x113D:  "ᄼᄼ",                 // HANGUL CHOSEONG CHITUEUMSSANGSIOS → HANGUL CHOSEONG CHITUEUMSIOS + HANGUL CHOSEONG CHITUEUMSIOS
+	0x113F:  "ᄾᄾ",                 // HANGUL CHOSEONG CEONGCHIEUMSSANGSIOS → HANGUL CHOSEONG CEONGCHIEUMSIOS + HANGUL CHOSEONG CEONGCHIEUMSIOS
+	0x1141:  "ᄋᄀ",                 // HANGUL CHOSEONG IEUNG-KIYEOK → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG KIYEOK
+	0x1142:  "ᄋᄃ",                 // HANGUL CHOSEONG IEUNG-TIKEUT → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG TIKEUT
+	0x1143:  "ᄋᄆ",                 // HANGUL CHOSEONG IEUNG-MIEUM → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG MIEUM
+	0x1144:  "ᄋᄇ",                 // HANGUL CHOSEONG IEUNG-PIEUP → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG PIEUP
+	0x1145:  "ᄋᄉ",                 // HANGUL CHOSEONG IEUNG-SIOS → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG SIOS
+	0x1146:  "ᄋᅀ",                 // HANGUL CHOSEONG IEUNG-PANSIOS → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG PANSIOS
+	0x1147:  "ᄋᄋ",                 // HANGUL CHOSEONG SSANGIEUNG → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG IEUNG
+	0x1148:  "ᄋᄌ",                 // HANGUL CHOSEONG IEUNG-CIEUC → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG CIEUC
+	0x1149:  "ᄋᄎ",                 // HANGUL CHOSEONG IEUNG-CHIEUCH → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG CHIEUCH
+	0x114A:  "ᄋᄐ",                 // HANGUL CHOSEONG IEUNG-THIEUTH → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG THIEUTH
+	0x114B:  "ᄋᄑ",                 // HANGUL CHOSEONG IEUNG-PHIEUPH → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG PHIEUPH
+	0x114D:  "ᄌᄋ",                 // HANGUL CHOSEONG CIEUC-IEUNG → HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG IEUNG
+	0x114F:  "ᅎᅎ",                 // HANGUL CHOSEONG CHITUEUMSSANGCIEUC → HANGUL CHOSEONG CHITUEUMCIEUC + HANGUL CHOSEONG CHITUEUMCIEUC
+	0x1151:  "ᅐᅐ",                 // HANGUL CHOSEONG CEONGCHIEUMSSANGCIEUC → HANGUL CHOSEONG CEONGCHIEUMCIEUC + HANGUL CHOSEONG CEONGCHIEUMCIEUC
+	0x1152:  "ᄎᄏ",                 // HANGUL CHOSEONG CHIEUCH-KHIEUKH → HANGUL CHOSEONG CHIEUCH + HANGUL CHOSEONG KHIEUKH
+	0x1153:  "ᄎᄒ",                 // HANGUL CHOSEONG CHIEUCH-HIEUH → HANGUL CHOSEONG CHIEUCH + HANGUL CHOSEONG HIEUH
+	0x1156:  "ᄑᄇ",                 // HANGUL CHOSEONG PHIEUPH-PIEUP → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG PIEUP
+	0x1157:  "ᄑᄋ",                 // HANGUL CHOSEONG KAPYEOUNPHIEUPH → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG IEUNG
+	0x1158:  "ᄒᄒ",                 // HANGUL CHOSEONG SSANGHIEUH → HANGUL CHOSEONG HIEUH + HANGUL CHOSEONG HIEUH
+	0x115A:  "ᄀᄃ",                 // HANGUL CHOSEONG KIYEOK-TIKEUT → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG TIKEUT
+	0x115B:  "ᄂᄉ",                 // HANGUL CHOSEONG NIEUN-SIOS → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG SIOS
+	0x115C:  "ᄂᄌ",                 // HANGUL CHOSEONG NIEUN-CIEUC → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG CIEUC
+	0x115D:  "ᄂᄒ",                 // HANGUL CHOSEONG NIEUN-HIEUH → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG HIEUH
+	0x115E:  "ᄃᄅ",                 // HANGUL CHOSEONG TIKEUT-RIEUL → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG RIEUL
+	0x1162:  "ᅡ丨",                 // HANGUL JUNGSEONG AE → HANGUL JUNGSEONG A + <CJK Ideograph>
+	0x1164:  "ᅣ丨",                 // HANGUL JUNGSEONG YAE → HANGUL JUNGSEONG YA + <CJK Ideograph>
+	0x1166:  "ᅥ丨",                 // HANGUL JUNGSEONG E → HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0x1168:  "ᅧ丨",                 // HANGUL JUNGSEONG YE → HANGUL JUNGSEONG YEO + <CJK Ideograph>
+	0x116A:  "ᅩᅡ",                 // HANGUL JUNGSEONG WA → HANGUL JUNGSEONG O + HANGUL JUNGSEONG A
+	0x116B:  "ᅩᅡ丨",                // HANGUL JUNGSEONG WAE → HANGUL JUNGSEONG O + HANGUL JUNGSEONG A + <CJK Ideograph>
+	0x116C:  "ᅩ丨",                 // HANGUL JUNGSEONG OE → HANGUL JUNGSEONG O + <CJK Ideograph>
+	0x116F:  "ᅮᅥ",                 // HANGUL JUNGSEONG WEO → HANGUL JUNGSEONG U + HANGUL JUNGSEONG EO
+	0x1170:  "ᅮᅥ丨",                // HANGUL JUNGSEONG WE → HANGUL JUNGSEONG U + HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0x1171:  "ᅮ丨",                 // HANGUL JUNGSEONG WI → HANGUL JUNGSEONG U + <CJK Ideograph>
+	0x1173:  "ー",                  // HANGUL JUNGSEONG EU → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x1174:  "ー丨",                 // HANGUL JUNGSEONG YI → KATAKANA-HIRAGANA PROLONGED SOUND MARK + <CJK Ideograph>
+	0x1175:  "丨",                  // HANGUL JUNGSEONG I → <CJK Ideograph>
+	0x1176:  "ᅡᅩ",                 // HANGUL JUNGSEONG A-O → HANGUL JUNGSEONG A + HANGUL JUNGSEONG O
+	0x1177:  "ᅡᅮ",                 // HANGUL JUNGSEONG A-U → HANGUL JUNGSEONG A + HANGUL JUNGSEONG U
+	0x1178:  "ᅣᅩ",                 // HANGUL JUNGSEONG YA-O → HANGUL JUNGSEONG YA + HANGUL JUNGSEONG O
+	0x1179:  "ᅣᅭ",                 // HANGUL JUNGSEONG YA-YO → HANGUL JUNGSEONG YA + HANGUL JUNGSEONG YO
+	0x117A:  "ᅥᅩ",                 // HANGUL JUNGSEONG EO-O → HANGUL JUNGSEONG EO + HANGUL JUNGSEONG O
+	0x117B:  "ᅥᅮ",                 // HANGUL JUNGSEONG EO-U → HANGUL JUNGSEONG EO + HANGUL JUNGSEONG U
+	0x117C:  "ᅥー",                 // HANGUL JUNGSEONG EO-EU → HANGUL JUNGSEONG EO + KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x117D:  "ᅧᅩ",                 // HANGUL JUNGSEONG YEO-O → HANGUL JUNGSEONG YEO + HANGUL JUNGSEONG O
+	0x117E:  "ᅧᅮ",                 // HANGUL JUNGSEONG YEO-U → HANGUL JUNGSEONG YEO + HANGUL JUNGSEONG U
+	0x117F:  "ᅩᅥ",                 // HANGUL JUNGSEONG O-EO → HANGUL JUNGSEONG O + HANGUL JUNGSEONG EO
+	0x1180:  "ᅩᅥ丨",                // HANGUL JUNGSEONG O-E → HANGUL JUNGSEONG O + HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0x1181:  "ᅩᅧ丨",                // HANGUL JUNGSEONG O-YE → HANGUL JUNGSEONG O + HANGUL JUNGSEONG YEO + <CJK Ideograph>
+	0x1182:  "ᅩᅩ",                 // HANGUL JUNGSEONG O-O → HANGUL JUNGSEONG O + HANGUL JUNGSEONG O
+	0x1183:  "ᅩᅮ",                 // HANGUL JUNGSEONG O-U → HANGUL JUNGSEONG O + HANGUL JUNGSEONG U
+	0x1184:  "ᅭᅣ",                 // HANGUL JUNGSEONG YO-YA → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG YA
+	0x1185:  "ᅭᅣ丨",                // HANGUL JUNGSEONG YO-YAE → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG YA + <CJK Ideograph>
+	0x1186:  "ᅭᅣ",                 // HANGUL JUNGSEONG YO-YEO → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG YA
+	0x1187:  "ᅭᅩ",                 // HANGUL JUNGSEONG YO-O → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG O
+	0x1188:  "ᅭ丨",                 // HANGUL JUNGSEONG YO-I → HANGUL JUNGSEONG YO + <CJK Ideograph>
+	0x1189:  "ᅮᅡ",                 // HANGUL JUNGSEONG U-A → HANGUL JUNGSEONG U + HANGUL JUNGSEONG A
+	0x118A:  "ᅮᅡ丨",                // HANGUL JUNGSEONG U-AE → HANGUL JUNGSEONG U + HANGUL JUNGSEONG A + <CJK Ideograph>
+	0x118B:  "ᅮᅥー",                // HANGUL JUNGSEONG U-EO-EU → HANGUL JUNGSEONG U + HANGUL JUNGSEONG EO + KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x118C:  "ᅮᅧ丨",                // HANGUL JUNGSEONG U-YE → HANGUL JUNGSEONG U + HANGUL JUNGSEONG YEO + <CJK Ideograph>
+	0x118D:  "ᅮᅮ",                 // HANGUL JUNGSEONG U-U → HANGUL JUNGSEONG U + HANGUL JUNGSEONG U
+	0x118E:  "ᅲᅡ",                 // HANGUL JUNGSEONG YU-A → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG A
+	0x118F:  "ᅲᅥ",                 // HANGUL JUNGSEONG YU-EO → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG EO
+	0x1190:  "ᅲᅥ丨",                // HANGUL JUNGSEONG YU-E → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0x1191:  "ᅲᅧ",                 // HANGUL JUNGSEONG YU-YEO → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG YEO
+	0x1192:  "ᅲᅧ丨",                // HANGUL JUNGSEONG YU-YE → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG YEO + <CJK Ideograph>
+	0x1193:  "ᅲᅮ",                 // HANGUL JUNGSEONG YU-U → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG U
+	0x1194:  "ᅲ丨",                 // HANGUL JUNGSEONG YU-I → HANGUL JUNGSEONG YU + <CJK Ideograph>
+	0x1195:  "ーᅮ",                 // HANGUL JUNGSEONG EU-U → KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL JUNGSEONG U
+	0x1196:  "ーー",                 // HANGUL JUNGSEONG EU-EU → KATAKANA-HIRAGANA PROLONGED SOUND MARK + KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x1197:  "ー丨ᅮ",                // HANGUL JUNGSEONG YI-U → KATAKANA-HIRAGANA PROLONGED SOUND MARK + <CJK Ideograph> + HANGUL JUNGSEONG U
+	0x1198:  "丨ᅡ",                 // HANGUL JUNGSEONG I-A → <CJK Ideograph> + HANGUL JUNGSEONG A
+	0x1199:  "丨ᅣ",                 // HANGUL JUNGSEONG I-YA → <CJK Ideograph> + HANGUL JUNGSEONG YA
+	0x119A:  "丨ᅩ",                 // HANGUL JUNGSEONG I-O → <CJK Ideograph> + HANGUL JUNGSEONG O
+	0x119B:  "丨ᅮ",                 // HANGUL JUNGSEONG I-U → <CJK Ideograph> + HANGUL JUNGSEONG U
+	0x119C:  "丨ー",                 // HANGUL JUNGSEONG I-EU → <CJK Ideograph> + KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x119D:  "丨ᆞ",                 // HANGUL JUNGSEONG I-ARAEA → <CJK Ideograph> + HANGUL JUNGSEONG ARAEA
+	0x119F:  "ᆞᅥ",                 // HANGUL JUNGSEONG ARAEA-EO → HANGUL JUNGSEONG ARAEA + HANGUL JUNGSEONG EO
+	0x11A0:  "ᆞᅮ",                 // HANGUL JUNGSEONG ARAEA-U → HANGUL JUNGSEONG ARAEA + HANGUL JUNGSEONG U
+	0x11A1:  "ᆞ丨",                 // HANGUL JUNGSEONG ARAEA-I → HANGUL JUNGSEONG ARAEA + <CJK Ideograph>
+	0x11A2:  "ᆞᆞ",                 // HANGUL JUNGSEONG SSANGARAEA → HANGUL JUNGSEONG ARAEA + HANGUL JUNGSEONG ARAEA
+	0x11A3:  "ᅡー",                 // HANGUL JUNGSEONG A-EU → HANGUL JUNGSEONG A + KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x11A4:  "ᅣᅮ",                 // HANGUL JUNGSEONG YA-U → HANGUL JUNGSEONG YA + HANGUL JUNGSEONG U
+	0x11A5:  "ᅧᅣ",                 // HANGUL JUNGSEONG YEO-YA → HANGUL JUNGSEONG YEO + HANGUL JUNGSEONG YA
+	0x11A6:  "ᅩᅣ",                 // HANGUL JUNGSEONG O-YA → HANGUL JUNGSEONG O + HANGUL JUNGSEONG YA
+	0x11A7:  "ᅩᅣ丨",                // HANGUL JUNGSEONG O-YAE → HANGUL JUNGSEONG O + HANGUL JUNGSEONG YA + <CJK Ideograph>
+	0x11A8:  "ᄀ",                  // HANGUL JONGSEONG KIYEOK → HANGUL CHOSEONG KIYEOK
+	0x11A9:  "ᄀᄀ",                 // HANGUL JONGSEONG SSANGKIYEOK → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG KIYEOK
+	0x11AA:  "ᄀᄉ",                 // HANGUL JONGSEONG KIYEOK-SIOS → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG SIOS
+	0x11AB:  "ᄂ",                  // HANGUL JONGSEONG NIEUN → HANGUL CHOSEONG NIEUN
+	0x11AC:  "ᄂᄌ",                 // HANGUL JONGSEONG NIEUN-CIEUC → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG CIEUC
+	0x11AD:  "ᄂᄒ",                 // HANGUL JONGSEONG NIEUN-HIEUH → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG HIEUH
+	0x11AE:  "ᄃ",                  // HANGUL JONGSEONG TIKEUT → HANGUL CHOSEONG TIKEUT
+	0x11AF:  "ᄅ",                  // HANGUL JONGSEONG RIEUL → HANGUL CHOSEONG RIEUL
+	0x11B0:  "ᄅᄀ",                 // HANGUL JONGSEONG RIEUL-KIYEOK → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK
+	0x11B1:  "ᄅᄆ",                 // HANGUL JONGSEONG RIEUL-MIEUM → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG MIEUM
+	0x11B2:  "ᄅᄇ",                 // HANGUL JONGSEONG RIEUL-PIEUP → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP
+	0x11B3:  "ᄅᄉ",                 // HANGUL JONGSEONG RIEUL-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG SIOS
+	0x11B4:  "ᄅᄐ",                 // HANGUL JONGSEONG RIEUL-THIEUTH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG THIEUTH
+	0x11B5:  "ᄅᄑ",                 // HANGUL JONGSEONG RIEUL-PHIEUPH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PHIEUPH
+	0x11B6:  "ᄅᄒ",                 // HANGUL JONGSEONG RIEUL-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG HIEUH
+	0x11B7:  "ᄆ",                  // HANGUL JONGSEONG MIEUM → HANGUL CHOSEONG MIEUM
+	0x11B8:  "ᄇ",                  // HANGUL JONGSEONG PIEUP → HANGUL CHOSEONG PIEUP
+	0x11B9:  "ᄇᄉ",                 // HANGUL JONGSEONG PIEUP-SIOS → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS
+	0x11BA:  "ᄉ",                  // HANGUL JONGSEONG SIOS → HANGUL CHOSEONG SIOS
+	0x11BB:  "ᄉᄉ",                 // HANGUL JONGSEONG SSANGSIOS → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS
+	0x11BC:  "ᄋ",                  // HANGUL JONGSEONG IEUNG → HANGUL CHOSEONG IEUNG
+	0x11BD:  "ᄌ",                  // HANGUL JONGSEONG CIEUC → HANGUL CHOSEONG CIEUC
+	0x11BE:  "ᄎ",                  // HANGUL JONGSEONG CHIEUCH → HANGUL CHOSEONG CHIEUCH
+	0x11BF:  "ᄏ",                  // HANGUL JONGSEONG KHIEUKH → HANGUL CHOSEONG KHIEUKH
+	0x11C0:  "ᄐ",                  // HANGUL JONGSEONG THIEUTH → HANGUL CHOSEONG THIEUTH
+	0x11C1:  "ᄑ",                  // HANGUL JONGSEONG PHIEUPH → HANGUL CHOSEONG PHIEUPH
+	0x11C2:  "ᄒ",                  // HANGUL JONGSEONG HIEUH → HANGUL CHOSEONG HIEUH
+	0x11C3:  "ᄀᄅ",                 // HANGUL JONGSEONG KIYEOK-RIEUL → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG RIEUL
+	0x11C4:  "ᄀᄉᄀ",                // HANGUL JONGSEONG KIYEOK-SIOS-KIYEOK → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0x11C5:  "ᄂᄀ",                 // HANGUL JONGSEONG NIEUN-KIYEOK → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG KIYEOK
+	0x11C6:  "ᄂᄃ",                 // HANGUL JONGSEONG NIEUN-TIKEUT → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG TIKEUT
+	0x11C7:  "ᄂᄉ",                 // HANGUL JONGSEONG NIEUN-SIOS → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG SIOS
+	0x11C8:  "ᄂᅀ",                 // HANGUL JONGSEONG NIEUN-PANSIOS → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG PANSIOS
+	0x11C9:  "ᄂᄐ",                 // HANGUL JONGSEONG NIEUN-THIEUTH → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG THIEUTH
+	0x11CA:  "ᄃᄀ",                 // HANGUL JONGSEONG TIKEUT-KIYEOK → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG KIYEOK
+	0x11CB:  "ᄃᄅ",                 // HANGUL JONGSEONG TIKEUT-RIEUL → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG RIEUL
+	0x11CC:  "ᄅᄀᄉ",                // HANGUL JONGSEONG RIEUL-KIYEOK-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG SIOS
+	0x11CD:  "ᄅᄂ",                 // HANGUL JONGSEONG RIEUL-NIEUN → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG NIEUN
+	0x11CE:  "ᄅᄃ",                 // HANGUL JONGSEONG RIEUL-TIKEUT → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG TIKEUT
+	0x11CF:  "ᄅᄃᄒ",                // HANGUL JONGSEONG RIEUL-TIKEUT-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG HIEUH
+	0x11D0:  "ᄅᄅ",                 // HANGUL JONGSEONG SSANGRIEUL → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG RIEUL
+	0x11D1:  "ᄅᄆᄀ",                // HANGUL JONGSEONG RIEUL-MIEUM-KIYEOK → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG KIYEOK
+	0x11D2:  "ᄅᄆᄉ",                // HANGUL JONGSEONG RIEUL-MIEUM-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG SIOS
+	0x11D3:  "ᄅᄇᄉ",                // HANGUL JONGSEONG RIEUL-PIEUP-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS
+	0x11D4:  "ᄅᄇᄒ",                // HANGUL JONGSEONG RIEUL-PIEUP-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG HIEUH
+	0x11D5:  "ᄅᄇᄋ",                // HANGUL JONGSEONG RIEUL-KAPYEOUNPIEUP → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0x11D6:  "ᄅᄉᄉ",                // HANGUL JONGSEONG RIEUL-SSANGSIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS
+	0x11D7:  "ᄅᅀ",                 // HANGUL JONGSEONG RIEUL-PANSIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PANSIOS
+	0x11D8:  "ᄅᄏ",                 // HANGUL JONGSEONG RIEUL-KHIEUKH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KHIEUKH
+	0x11D9:  "ᄅᅙ",                 // HANGUL JONGSEONG RIEUL-YEORINHIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG YEORINHIEUH
+	0x11DA:  "ᄆᄀ",                 // HANGUL JONGSEONG MIEUM-KIYEOK → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG KIYEOK
+	0x11DB:  "ᄆᄅ",                 // HANGUL JONGSEONG MIEUM-RIEUL → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG RIEUL
+	0x11DC:  "ᄆᄇ",                 // HANGUL JONGSEONG MIEUM-PIEUP → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG PIEUP
+	0x11DD:  "ᄆᄉ",                 // HANGUL JONGSEONG MIEUM-SIOS → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG SIOS
+	0x11DE:  "ᄆᄉᄉ",                // HANGUL JONGSEONG MIEUM-SSANGSIOS → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS
+	0x11DF:  "ᄆᅀ",                 // HANGUL JONGSEONG MIEUM-PANSIOS → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG PANSIOS
+	0x11E0:  "ᄆᄎ",                 // HANGUL JONGSEONG MIEUM-CHIEUCH → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG CHIEUCH
+	0x11E1:  "ᄆᄒ",                 // HANGUL JONGSEONG MIEUM-HIEUH → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG HIEUH
+	0x11E2:  "ᄆᄋ",                 // HANGUL JONGSEONG KAPYEOUNMIEUM → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG IEUNG
+	0x11E3:  "ᄇᄅ",                 // HANGUL JONGSEONG PIEUP-RIEUL → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG RIEUL
+	0x11E4:  "ᄇᄑ",                 // HANGUL JONGSEONG PIEUP-PHIEUPH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PHIEUPH
+	0x11E5:  "ᄇᄒ",                 // HANGUL JONGSEONG PIEUP-HIEUH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG HIEUH
+	0x11E6:  "ᄇᄋ",                 // HANGUL JONGSEONG KAPYEOUNPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0x11E7:  "ᄉᄀ",                 // HANGUL JONGSEONG SIOS-KIYEOK → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0x11E8:  "ᄉᄃ",                 // HANGUL JONGSEONG SIOS-TIKEUT → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG TIKEUT
+	0x11E9:  "ᄉᄅ",                 // HANGUL JONGSEONG SIOS-RIEUL → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG RIEUL
+	0x11EA:  "ᄉᄇ",                 // HANGUL JONGSEONG SIOS-PIEUP → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PIEUP
+	0x11EB:  "ᅀ",                  // HANGUL JONGSEONG PANSIOS → HANGUL CHOSEONG PANSIOS
+	0x11EC:  "ᄋᄀ",                 // HANGUL JONGSEONG IEUNG-KIYEOK → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG KIYEOK
+	0x11ED:  "ᄋᄀᄀ",                // HANGUL JONGSEONG IEUNG-SSANGKIYEOK → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG KIYEOK
+	0x11EE:  "ᄋᄋ",                 // HANGUL JONGSEONG SSANGIEUNG → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG IEUNG
+	0x11EF:  "ᄋᄏ",                 // HANGUL JONGSEONG IEUNG-KHIEUKH → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG KHIEUKH
+	0x11F0:  "ᅌ",                  // HANGUL JONGSEONG YESIEUNG → HANGUL CHOSEONG YESIEUNG
+	0x11F1:  "ᄋᄉ",                 // HANGUL JONGSEONG YESIEUNG-SIOS → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG SIOS
+	0x11F2:  "ᄋᅀ",                 // HANGUL JONGSEONG YESIEUNG-PANSIOS → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG PANSIOS
+	0x11F3:  "ᄑᄇ",                 // HANGUL JONGSEONG PHIEUPH-PIEUP → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG PIEUP
+	0x11F4:  "ᄑᄋ",                 // HANGUL JONGSEONG KAPYEOUNPHIEUPH → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG IEUNG
+	0x11F5:  "ᄒᄂ",                 // HANGUL JONGSEONG HIEUH-NIEUN → HANGUL CHOSEONG HIEUH + HANGUL CHOSEONG NIEUN
+	0x11F6:  "ᄒᄅ",                 // HANGUL JONGSEONG HIEUH-RIEUL → HANGUL CHOSEONG HIEUH + HANGUL CHOSEONG RIEUL
+	0x11F7:  "ᄒᄆ",                 // HANGUL JONGSEONG HIEUH-MIEUM → HANGUL CHOSEONG HIEUH + HANGUL CHOSEONG MIEUM
+	0x11F8:  "ᄒᄇ",                 // HANGUL JONGSEONG HIEUH-PIEUP → HANGUL CHOSEONG HIEUH + HANGUL CHOSEONG PIEUP
+	0x11F9:  "ᅙ",                  // HANGUL JONGSEONG YEORINHIEUH → HANGUL CHOSEONG YEORINHIEUH
+	0x11FA:  "ᄀᄂ",                 // HANGUL JONGSEONG KIYEOK-NIEUN → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG NIEUN
+	0x11FB:  "ᄀᄇ",                 // HANGUL JONGSEONG KIYEOK-PIEUP → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG PIEUP
+	0x11FC:  "ᄀᄎ",                 // HANGUL JONGSEONG KIYEOK-CHIEUCH → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG CHIEUCH
+	0x11FD:  "ᄀᄏ",                 // HANGUL JONGSEONG KIYEOK-KHIEUKH → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG KHIEUKH
+	0x11FE:  "ᄀᄒ",                 // HANGUL JONGSEONG KIYEOK-HIEUH → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG HIEUH
+	0x11FF:  "ᄂᄂ",                 // HANGUL JONGSEONG SSANGNIEUN → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG NIEUN
+	0x1200:  "U",                  // ETHIOPIC SYLLABLE HA → LATIN CAPITAL LETTER U
+	0x1223:  "ɰ",                  // ETHIOPIC SYLLABLE SZAA → LATIN SMALL LETTER TURNED M WITH LONG LEG
+	0x1240:  "Φ",                  // ETHIOPIC SYLLABLE QA → GREEK CAPITAL LETTER PHI
+	0x1260:  "Ո",                  // ETHIOPIC SYLLABLE BA → ARMENIAN CAPITAL LETTER VO
+	0x1294:  "ձ",                  // ETHIOPIC SYLLABLE NEE → ARMENIAN SMALL LETTER JA
+	0x12D0:  "O",                  // ETHIOPIC SYLLABLE PHARYNGEAL A → LATIN CAPITAL LETTER O
+	0x13A0:  "D",                  // CHEROKEE LETTER A → LATIN CAPITAL LETTER D
+	0x13A1:  "R",                  // CHEROKEE LETTER E → LATIN CAPITAL LETTER R
+	0x13A2:  "T",                  // CHEROKEE LETTER I → LATIN CAPITAL LETTER T
+	0x13A4:  "O'",                 // CHEROKEE LETTER U → LATIN CAPITAL LETTER O + APOSTROPHE
+	0x13A5:  "i",                  // CHEROKEE LETTER V → LATIN SMALL LETTER I
+	0x13A8:  "Ⱶ",                  // CHEROKEE LETTER GE → LATIN CAPITAL LETTER HALF H
+	0x13A9:  "Y",                  // CHEROKEE LETTER GI → LATIN CAPITAL LETTER Y
+	0x13AA:  "A",                  // CHEROKEE LETTER GO → LATIN CAPITAL LETTER A
+	0x13AB:  "J",                  // CHEROKEE LETTER GU → LATIN CAPITAL LETTER J
+	0x13AC:  "E",                  // CHEROKEE LETTER GV → LATIN CAPITAL LETTER E
+	0x13AE:  "?",                  // CHEROKEE LETTER HE → QUESTION MARK
+	0x13B0:  "Ⱶ",                  // CHEROKEE LETTER HO → LATIN CAPITAL LETTER HALF H
+	0x13B1:  "Γ",                  // CHEROKEE LETTER HU → GREEK CAPITAL LETTER GAMMA
+	0x13B3:  "W",                  // CHEROKEE LETTER LA → LATIN CAPITAL LETTER W
+	0x13B7:  "M",                  // CHEROKEE LETTER LU → LATIN CAPITAL LETTER M
+	0x13BB:  "H",                  // CHEROKEE LETTER MI → LATIN CAPITAL LETTER H
+	0x13BD:  "Y",                  // CHEROKEE LETTER MU → LATIN CAPITAL LETTER Y
+	0x13BE:  "O̵",                 // CHEROKEE LETTER NA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x13BF:  "ƫ",                  // CHEROKEE LETTER HNA → LATIN SMALL LETTER T WITH PALATAL HOOK
+	0x13C0:  "G",                  // CHEROKEE LETTER NAH → LATIN CAPITAL LETTER G
+	0x13C2:  "h",                  // CHEROKEE LETTER NI → LATIN SMALL LETTER H
+	0x13C3:  "Z",                  // CHEROKEE LETTER NO → LATIN CAPITAL LETTER Z
+	0x13C7:  "Ѡ",                  // CHEROKEE LETTER QUE → CYRILLIC CAPITAL LETTER OMEGA
+	0x13CB:  "Ɛ",                  // CHEROKEE LETTER QUV → LATIN CAPITAL LETTER OPEN E
+	0x13CC:  "U̵",                 // CHEROKEE LETTER SA → LATIN CAPITAL LETTER U + COMBINING SHORT STROKE OVERLAY
+	0x13CE:  "4",                  // CHEROKEE LETTER SE → DIGIT FOUR
+	0x13CF:  "b",                  // CHEROKEE LETTER SI → LATIN SMALL LETTER B
+	0x13D2:  "R",                  // CHEROKEE LETTER SV → LATIN CAPITAL LETTER R
+	0x13D4:  "W",                  // CHEROKEE LETTER TA → LATIN CAPITAL LETTER W
+	0x13D5:  "S",                  // CHEROKEE LETTER DE → LATIN CAPITAL LETTER S
+	0x13D9:  "V",                  // CHEROKEE LETTER DO → LATIN CAPITAL LETTER V
+	0x13DA:  "S",                  // CHEROKEE LETTER DU → LATIN CAPITAL LETTER S
+	0x13DE:  "L",                  // CHEROKEE LETTER TLE → LATIN CAPITAL LETTER L
+	0x13DF:  "C",                  // CHEROKEE LETTER TLI → LATIN CAPITAL LETTER C
+	0x13E2:  "P",                  // CHEROKEE LETTER TLV → LATIN CAPITAL LETTER P
+	0x13E6:  "K",                  // CHEROKEE LETTER TSO → LATIN CAPITAL LETTER K
+	0x13E7:  "d",                  // CHEROKEE LETTER TSU → LATIN SMALL LETTER D
+	0x13EB:  "O̵",                 // CHEROKEE LETTER WI → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x13EE:  "6",                  // CHEROKEE LETTER WV → DIGIT SIX
+	0x13F0:  "ß",                  // CHEROKEE LETTER YE → LATIN SMALL LETTER SHARP S
+	0x13F2:  "h̔",                 // CHEROKEE LETTER YO → LATIN SMALL LETTER H + COMBINING REVERSED COMMA ABOVE
+	0x13F3:  "G",                  // CHEROKEE LETTER YU → LATIN CAPITAL LETTER G
+	0x13F4:  "B",                  // CHEROKEE LETTER YV → LATIN CAPITAL LETTER B
+	0x13FB:  "ɢ",                  // CHEROKEE SMALL LETTER YU → LATIN LETTER SMALL CAPITAL G
+	0x13FC:  "ʙ",                  // CHEROKEE SMALL LETTER YV → LATIN LETTER SMALL CAPITAL B
+	0x1400:  "=",                  // CANADIAN SYLLABICS HYPHEN → EQUALS SIGN
+	0x1403:  "Δ",                  // CANADIAN SYLLABICS I → GREEK CAPITAL LETTER DELTA
+	0x140C:  "·ᐁ",                 // CANADIAN SYLLABICS WE → MIDDLE DOT + CANADIAN SYLLABICS E
+	0x140D:  "ᐁ·",                 // CANADIAN SYLLABICS WEST-CREE WE → CANADIAN SYLLABICS E + MIDDLE DOT
+	0x140E:  "·Δ",                 // CANADIAN SYLLABICS WI → MIDDLE DOT + GREEK CAPITAL LETTER DELTA
+	0x140F:  "Δ·",                 // CANADIAN SYLLABICS WEST-CREE WI → GREEK CAPITAL LETTER DELTA + MIDDLE DOT
+	0x1410:  "·ᐄ",                 // CANADIAN SYLLABICS WII → MIDDLE DOT + CANADIAN SYLLABICS II
+	0x1411:  "ᐄ·",                 // CANADIAN SYLLABICS WEST-CREE WII → CANADIAN SYLLABICS II + MIDDLE DOT
+	0x1412:  "·ᐅ",                 // CANADIAN SYLLABICS WO → MIDDLE DOT + CANADIAN SYLLABICS O
+	0x1413:  "ᐅ·",                 // CANADIAN SYLLABICS WEST-CREE WO → CANADIAN SYLLABICS O + MIDDLE DOT
+	0x1414:  "·ᐆ",                 // CANADIAN SYLLABICS WOO → MIDDLE DOT + CANADIAN SYLLABICS OO
+	0x1415:  "ᐆ·",                 // CANADIAN SYLLABICS WEST-CREE WOO → CANADIAN SYLLABICS OO + MIDDLE DOT
+	0x1417:  "·ᐊ",                 // CANADIAN SYLLABICS WA → MIDDLE DOT + CANADIAN SYLLABICS A
+	0x1418:  "ᐊ·",                 // CANADIAN SYLLABICS WEST-CREE WA → CANADIAN SYLLABICS A + MIDDLE DOT
+	0x1419:  "·ᐋ",                 // CANADIAN SYLLABICS WAA → MIDDLE DOT + CANADIAN SYLLABICS AA
+	0x141A:  "ᐋ·",                 // CANADIAN SYLLABICS WEST-CREE WAA → CANADIAN SYLLABICS AA + MIDDLE DOT
+	0x1427:  "·",                  // CANADIAN SYLLABICS FINAL MIDDLE DOT → MIDDLE DOT
+	0x142B:  "ᐁᐠ",                 // CANADIAN SYLLABICS EN → CANADIAN SYLLABICS E + CANADIAN SYLLABICS FINAL GRAVE
+	0x142C:  "Δᐠ",                 // CANADIAN SYLLABICS IN → GREEK CAPITAL LETTER DELTA + CANADIAN SYLLABICS FINAL GRAVE
+	0x142D:  "ᐅᐠ",                 // CANADIAN SYLLABICS ON → CANADIAN SYLLABICS O + CANADIAN SYLLABICS FINAL GRAVE
+	0x142E:  "ᐊᐠ",                 // CANADIAN SYLLABICS AN → CANADIAN SYLLABICS A + CANADIAN SYLLABICS FINAL GRAVE
+	0x142F:  "V",                  // CANADIAN SYLLABICS PE → LATIN CAPITAL LETTER V
+	0x1431:  "Ʌ",                  // CANADIAN SYLLABICS PI → LATIN CAPITAL LETTER TURNED V
+	0x1433:  ">",                  // CANADIAN SYLLABICS PO → GREATER-THAN SIGN
+	0x1437:  "·>",                 // CANADIAN SYLLABICS CARRIER HI → MIDDLE DOT + GREATER-THAN SIGN
+	0x1438:  "<",                  // CANADIAN SYLLABICS PA → LESS-THAN SIGN
+	0x143A:  "·V",                 // CANADIAN SYLLABICS PWE → MIDDLE DOT + LATIN CAPITAL LETTER V
+	0x143B:  "V·",                 // CANADIAN SYLLABICS WEST-CREE PWE → LATIN CAPITAL LETTER V + MIDDLE DOT
+	0x143C:  "·Ʌ",                 // CANADIAN SYLLABICS PWI → MIDDLE DOT + LATIN CAPITAL LETTER TURNED V
+	0x143D:  "Ʌ·",                 // CANADIAN SYLLABICS WEST-CREE PWI → LATIN CAPITAL LETTER TURNED V + MIDDLE DOT
+	0x143E:  "·ᐲ",                 // CANADIAN SYLLABICS PWII → MIDDLE DOT + CANADIAN SYLLABICS PII
+	0x143F:  "ᐲ·",                 // CANADIAN SYLLABICS WEST-CREE PWII → CANADIAN SYLLABICS PII + MIDDLE DOT
+	0x1440:  "·>",                 // CANADIAN SYLLABICS PWO → MIDDLE DOT + GREATER-THAN SIGN
+	0x1441:  ">·",                 // CANADIAN SYLLABICS WEST-CREE PWO → GREATER-THAN SIGN + MIDDLE DOT
+	0x1442:  "·ᐴ",                 // CANADIAN SYLLABICS PWOO → MIDDLE DOT + CANADIAN SYLLABICS POO
+	0x1443:  "ᐴ·",                 // CANADIAN SYLLABICS WEST-CREE PWOO → CANADIAN SYLLABICS POO + MIDDLE DOT
+	0x1444:  "·<",                 // CANADIAN SYLLABICS PWA → MIDDLE DOT + LESS-THAN SIGN
+	0x1445:  "<·",                 // CANADIAN SYLLABICS WEST-CREE PWA → LESS-THAN SIGN + MIDDLE DOT
+	0x1446:  "·ᐹ",                 // CANADIAN SYLLABICS PWAA → MIDDLE DOT + CANADIAN SYLLABICS PAA
+	0x1447:  "ᐹ·",                 // CANADIAN SYLLABICS WEST-CREE PWAA → CANADIAN SYLLABICS PAA + MIDDLE DOT
+	0x144A:  "'",                  // CANADIAN SYLLABICS WEST-CREE P → APOSTROPHE
+	0x144C:  "U",                  // CANADIAN SYLLABICS TE → LATIN CAPITAL LETTER U
+	0x144E:  "Ո",                  // CANADIAN SYLLABICS TI → ARMENIAN CAPITAL LETTER VO
+	0x1454:  "·ᑐ",                 // CANADIAN SYLLABICS CARRIER DI → MIDDLE DOT + CANADIAN SYLLABICS TO
+	0x1457:  "·U",                 // CANADIAN SYLLABICS TWE → MIDDLE DOT + LATIN CAPITAL LETTER U
+	0x1458:  "U·",                 // CANADIAN SYLLABICS WEST-CREE TWE → LATIN CAPITAL LETTER U + MIDDLE DOT
+	0x1459:  "·Ո",                 // CANADIAN SYLLABICS TWI → MIDDLE DOT + ARMENIAN CAPITAL LETTER VO
+	0x145A:  "Ո·",                 // CANADIAN SYLLABICS WEST-CREE TWI → ARMENIAN CAPITAL LETTER VO + MIDDLE DOT
+	0x145B:  "·ᑏ",                 // CANADIAN SYLLABICS TWII → MIDDLE DOT + CANADIAN SYLLABICS TII
+	0x145C:  "ᑏ·",                 // CANADIAN SYLLABICS WEST-CREE TWII → CANADIAN SYLLABICS TII + MIDDLE DOT
+	0x145D:  "·ᑐ",                 // CANADIAN SYLLABICS TWO → MIDDLE DOT + CANADIAN SYLLABICS TO
+	0x145E:  "ᑐ·",                 // CANADIAN SYLLABICS WEST-CREE TWO → CANADIAN SYLLABICS TO + MIDDLE DOT
+	0x145F:  "·ᑑ",                 // CANADIAN SYLLABICS TWOO → MIDDLE DOT + CANADIAN SYLLABICS TOO
+	0x1460:  "ᑑ·",                 // CANADIAN SYLLABICS WEST-CREE TWOO → CANADIAN SYLLABICS TOO + MIDDLE DOT
+	0x1461:  "·ᑕ",                 // CANADIAN SYLLABICS TWA → MIDDLE DOT + CANADIAN SYLLABICS TA
+	0x1462:  "ᑕ·",                 // CANADIAN SYLLABICS WEST-CREE TWA → CANADIAN SYLLABICS TA + MIDDLE DOT
+	0x1463:  "·ᑖ",                 // CANADIAN SYLLABICS TWAA → MIDDLE DOT + CANADIAN SYLLABICS TAA
+	0x1464:  "ᑖ·",                 // CANADIAN SYLLABICS WEST-CREE TWAA → CANADIAN SYLLABICS TAA + MIDDLE DOT
+	0x1467:  "U'",                 // CANADIAN SYLLABICS TTE → LATIN CAPITAL LETTER U + APOSTROPHE
+	0x1468:  "Ո'",                 // CANADIAN SYLLABICS TTI → ARMENIAN CAPITAL LETTER VO + APOSTROPHE
+	0x1469:  "ᑐ'",                 // CANADIAN SYLLABICS TTO → CANADIAN SYLLABICS TO + APOSTROPHE
+	0x146A:  "ᑕ'",                 // CANADIAN SYLLABICS TTA → CANADIAN SYLLABICS TA + APOSTROPHE
+	0x146D:  "P",                  // CANADIAN SYLLABICS KI → LATIN CAPITAL LETTER P
+	0x146F:  "d",                  // CANADIAN SYLLABICS KO → LATIN SMALL LETTER D
+	0x1472:  "b",                  // CANADIAN SYLLABICS KA → LATIN SMALL LETTER B
+	0x1473:  "ḃ",                 // CANADIAN SYLLABICS KAA → LATIN SMALL LETTER B + COMBINING DOT ABOVE
+	0x1474:  "·ᑫ",                 // CANADIAN SYLLABICS KWE → MIDDLE DOT + CANADIAN SYLLABICS KE
+	0x1475:  "ᑫ·",                 // CANADIAN SYLLABICS WEST-CREE KWE → CANADIAN SYLLABICS KE + MIDDLE DOT
+	0x1476:  "·P",                 // CANADIAN SYLLABICS KWI → MIDDLE DOT + LATIN CAPITAL LETTER P
+	0x1477:  "p·",                 // CANADIAN SYLLABICS WEST-CREE KWI → LATIN SMALL LETTER P + MIDDLE DOT
+	0x1478:  "·ᑮ",                 // CANADIAN SYLLABICS KWII → MIDDLE DOT + CANADIAN SYLLABICS KII
+	0x1479:  "ᑮ·",                 // CANADIAN SYLLABICS WEST-CREE KWII → CANADIAN SYLLABICS KII + MIDDLE DOT
+	0x147A:  "·d",                 // CANADIAN SYLLABICS KWO → MIDDLE DOT + LATIN SMALL LETTER D
+	0x147B:  "d·",                 // CANADIAN SYLLABICS WEST-CREE KWO → LATIN SMALL LETTER D + MIDDLE DOT
+	0x147C:  "·ᑰ",                 // CANADIAN SYLLABICS KWOO → MIDDLE DOT + CANADIAN SYLLABICS KOO
+	0x147D:  "ᑰ·",                 // CANADIAN SYLLABICS WEST-CREE KWOO → CANADIAN SYLLABICS KOO + MIDDLE DOT
+	0x147E:  "·b",                 // CANADIAN SYLLABICS KWA → MIDDLE DOT + LATIN SMALL LETTER B
+	0x147F:  "b·",                 // CANADIAN SYLLABICS WEST-CREE KWA → LATIN SMALL LETTER B + MIDDLE DOT
+	0x1480:  "·ḃ",                // CANADIAN SYLLABICS KWAA → MIDDLE DOT + LATIN SMALL LETTER B + COMBINING DOT ABOVE
+	0x1481:  "ḃ·",                // CANADIAN SYLLABICS WEST-CREE KWAA → LATIN SMALL LETTER B + COMBINING DOT ABOVE + MIDDLE DOT
+	0x1485:  "ᑫ'",                 // CANADIAN SYLLABICS SOUTH-SLAVEY KEH → CANADIAN SYLLABICS KE + APOSTROPHE
+	0x1486:  "P'",                 // CANADIAN SYLLABICS SOUTH-SLAVEY KIH → LATIN CAPITAL LETTER P + APOSTROPHE
+	0x1487:  "d'",                 // CANADIAN SYLLABICS SOUTH-SLAVEY KOH → LATIN SMALL LETTER D + APOSTROPHE
+	0x1488:  "b'",                 // CANADIAN SYLLABICS SOUTH-SLAVEY KAH → LATIN SMALL LETTER B + APOSTROPHE
+	0x148D:  "J",                  // CANADIAN SYLLABICS CO → LATIN CAPITAL LETTER J
+	0x1492:  "·ᒉ",                 // CANADIAN SYLLABICS CWE → MIDDLE DOT + CANADIAN SYLLABICS CE
+	0x1493:  "ᒉ·",                 // CANADIAN SYLLABICS WEST-CREE CWE → CANADIAN SYLLABICS CE + MIDDLE DOT
+	0x1494:  "·ᒋ",                 // CANADIAN SYLLABICS CWI → MIDDLE DOT + CANADIAN SYLLABICS CI
+	0x1495:  "ᒋ·",                 // CANADIAN SYLLABICS WEST-CREE CWI → CANADIAN SYLLABICS CI + MIDDLE DOT
+	0x1496:  "·ᒌ",                 // CANADIAN SYLLABICS CWII → MIDDLE DOT + CANADIAN SYLLABICS CII
+	0x1497:  "ᒌ·",                 // CANADIAN SYLLABICS WEST-CREE CWII → CANADIAN SYLLABICS CII + MIDDLE DOT
+	0x1498:  "·J",                 // CANADIAN SYLLABICS CWO → MIDDLE DOT + LATIN CAPITAL LETTER J
+	0x1499:  "J·",                 // CANADIAN SYLLABICS WEST-CREE CWO → LATIN CAPITAL LETTER J + MIDDLE DOT
+	0x149A:  "·ᒎ",                 // CANADIAN SYLLABICS CWOO → MIDDLE DOT + CANADIAN SYLLABICS COO
+	0x149B:  "ᒎ·",                 // CANADIAN SYLLABICS WEST-CREE CWOO → CANADIAN SYLLABICS COO + MIDDLE DOT
+	0x149C:  "·ᒐ",                 // CANADIAN SYLLABICS CWA → MIDDLE DOT + CANADIAN SYLLABICS CA
+	0x149D:  "ᒐ·",                 // CANADIAN SYLLABICS WEST-CREE CWA → CANADIAN SYLLABICS CA + MIDDLE DOT
+	0x149E:  "·ᒑ",                 // CANADIAN SYLLABICS CWAA → MIDDLE DOT + CANADIAN SYLLABICS CAA
+	0x149F:  "ᒑ·",                 // CANADIAN SYLLABICS WEST-CREE CWAA → CANADIAN SYLLABICS CAA + MIDDLE DOT
+	0x14A5:  "Γ",                  // CANADIAN SYLLABICS MI → GREEK CAPITAL LETTER GAMMA
+	0x14AA:  "L",                  // CANADIAN SYLLABICS MA → LATIN CAPITAL LETTER L
+	0x14AC:  "·ᒣ",                 // CANADIAN SYLLABICS MWE → MIDDLE DOT + CANADIAN SYLLABICS ME
+	0x14AD:  "ᒣ·",                 // CANADIAN SYLLABICS WEST-CREE MWE → CANADIAN SYLLABICS ME + MIDDLE DOT
+	0x14AE:  "·Γ",                 // CANADIAN SYLLABICS MWI → MIDDLE DOT + GREEK CAPITAL LETTER GAMMA
+	0x14AF:  "Γ·",                 // CANADIAN SYLLABICS WEST-CREE MWI → GREEK CAPITAL LETTER GAMMA + MIDDLE DOT
+	0x14B0:  "·ᒦ",                 // CANADIAN SYLLABICS MWII → MIDDLE DOT + CANADIAN SYLLABICS MII
+	0x14B1:  "ᒦ·",                 // CANADIAN SYLLABICS WEST-CREE MWII → CANADIAN SYLLABICS MII + MIDDLE DOT
+	0x14B2:  "·ᒧ",                 // CANADIAN SYLLABICS MWO → MIDDLE DOT + CANADIAN SYLLABICS MO
+	0x14B3:  "ᒧ·",                 // CANADIAN SYLLABICS WEST-CREE MWO → CANADIAN SYLLABICS MO + MIDDLE DOT
+	0x14B4:  "·ᒨ",                 // CANADIAN SYLLABICS MWOO → MIDDLE DOT + CANADIAN SYLLABICS MOO
+	0x14B5:  "ᒨ·",                 // CANADIAN SYLLABICS WEST-CREE MWOO → CANADIAN SYLLABICS MOO + MIDDLE DOT
+	0x14B6:  "·L",                 // CANADIAN SYLLABICS MWA → MIDDLE DOT + LATIN CAPITAL LETTER L
+	0x14B7:  "l·",                 // CANADIAN SYLLABICS WEST-CREE MWA → LATIN SMALL LETTER L + MIDDLE DOT
+	0x14B8:  "·ᒫ",                 // CANADIAN SYLLABICS MWAA → MIDDLE DOT + CANADIAN SYLLABICS MAA
+	0x14B9:  "ᒫ·",                 // CANADIAN SYLLABICS WEST-CREE MWAA → CANADIAN SYLLABICS MAA + MIDDLE DOT
+	0x14BF:  "2",                  // CANADIAN SYLLABICS SAYISI M → DIGIT TWO
+	0x14C9:  "·ᓀ",                 // CANADIAN SYLLABICS NWE → MIDDLE DOT + CANADIAN SYLLABICS NE
+	0x14CA:  "ᓀ·",                 // CANADIAN SYLLABICS WEST-CREE NWE → CANADIAN SYLLABICS NE + MIDDLE DOT
+	0x14CB:  "·ᓇ",                 // CANADIAN SYLLABICS NWA → MIDDLE DOT + CANADIAN SYLLABICS NA
+	0x14CC:  "ᓇ·",                 // CANADIAN SYLLABICS WEST-CREE NWA → CANADIAN SYLLABICS NA + MIDDLE DOT
+	0x14CD:  "·ᓈ",                 // CANADIAN SYLLABICS NWAA → MIDDLE DOT + CANADIAN SYLLABICS NAA
+	0x14CE:  "ᓈ·",                 // CANADIAN SYLLABICS WEST-CREE NWAA → CANADIAN SYLLABICS NAA + MIDDLE DOT
+	0x14D1:  "ᐡ",                  // CANADIAN SYLLABICS CARRIER NG → CANADIAN SYLLABICS FINAL BOTTOM HALF RING
+	0x14DC:  "·ᓓ",                 // CANADIAN SYLLABICS LWE → MIDDLE DOT + CANADIAN SYLLABICS LE
+	0x14DD:  "ᓓ·",                 // CANADIAN SYLLABICS WEST-CREE LWE → CANADIAN SYLLABICS LE + MIDDLE DOT
+	0x14DE:  "·ᓕ",                 // CANADIAN SYLLABICS LWI → MIDDLE DOT + CANADIAN SYLLABICS LI
+	0x14DF:  "ᓕ·",                 // CANADIAN SYLLABICS WEST-CREE LWI → CANADIAN SYLLABICS LI + MIDDLE DOT
+	0x14E0:  "·ᓖ",                 // CANADIAN SYLLABICS LWII → MIDDLE DOT + CANADIAN SYLLABICS LII
+	0x14E1:  "ᓖ·",                 // CANADIAN SYLLABICS WEST-CREE LWII → CANADIAN SYLLABICS LII + MIDDLE DOT
+	0x14E2:  "·ᓗ",                 // CANADIAN SYLLABICS LWO → MIDDLE DOT + CANADIAN SYLLABICS LO
+	0x14E3:  "ᓗ·",                 // CANADIAN SYLLABICS WEST-CREE LWO → CANADIAN SYLLABICS LO + MIDDLE DOT
+	0x14E4:  "·ᓘ",                 // CANADIAN SYLLABICS LWOO → MIDDLE DOT + CANADIAN SYLLABICS LOO
+	0x14E5:  "ᓘ·",                 // CANADIAN SYLLABICS WEST-CREE LWOO → CANADIAN SYLLABICS LOO + MIDDLE DOT
+	0x14E6:  "·ᓚ",                 // CANADIAN SYLLABICS LWA → MIDDLE DOT + CANADIAN SYLLABICS LA
+	0x14E7:  "ᓚ·",                 // CANADIAN SYLLABICS WEST-CREE LWA → CANADIAN SYLLABICS LA + MIDDLE DOT
+	0x14E8:  "·ᓛ",                 // CANADIAN SYLLABICS LWAA → MIDDLE DOT + CANADIAN SYLLABICS LAA
+	0x14E9:  "ᓛ·",                 // CANADIAN SYLLABICS WEST-CREE LWAA → CANADIAN SYLLABICS LAA + MIDDLE DOT
+	0x14F6:  "·ᓭ",                 // CANADIAN SYLLABICS SWE → MIDDLE DOT + CANADIAN SYLLABICS SE
+	0x14F7:  "ᓭ·",                 // CANADIAN SYLLABICS WEST-CREE SWE → CANADIAN SYLLABICS SE + MIDDLE DOT
+	0x14F8:  "·ᓯ",                 // CANADIAN SYLLABICS SWI → MIDDLE DOT + CANADIAN SYLLABICS SI
+	0x14F9:  "ᓯ·",                 // CANADIAN SYLLABICS WEST-CREE SWI → CANADIAN SYLLABICS SI + MIDDLE DOT
+	0x14FA:  "·ᓰ",                 // CANADIAN SYLLABICS SWII → MIDDLE DOT + CANADIAN SYLLABICS SII
+	0x14FB:  "ᓰ·",                 // CANADIAN SYLLABICS WEST-CREE SWII → CANADIAN SYLLABICS SII + MIDDLE DOT
+	0x14FC:  "·ᓱ",                 // CANADIAN SYLLABICS SWO → MIDDLE DOT + CANADIAN SYLLABICS SO
+	0x14FD:  "ᓱ·",                 // CANADIAN SYLLABICS WEST-CREE SWO → CANADIAN SYLLABICS SO + MIDDLE DOT
+	0x14FE:  "·ᓲ",                 // CANADIAN SYLLABICS SWOO → MIDDLE DOT + CANADIAN SYLLABICS SOO
+	0x14FF:  "ᓲ·",                 // CANADIAN SYLLABICS WEST-CREE SWOO → CANADIAN SYLLABICS SOO + MIDDLE DOT
+	0x1500:  "·ᓴ",                 // CANADIAN SYLLABICS SWA → MIDDLE DOT + CANADIAN SYLLABICS SA
+	0x1501:  "ᓴ·",                 // CANADIAN SYLLABICS WEST-CREE SWA → CANADIAN SYLLABICS SA + MIDDLE DOT
+	0x1502:  "·ᓵ",                 // CANADIAN SYLLABICS SWAA → MIDDLE DOT + CANADIAN SYLLABICS SAA
+	0x1503:  "ᓵ·",                 // CANADIAN SYLLABICS WEST-CREE SWAA → CANADIAN SYLLABICS SAA + MIDDLE DOT
+	0x150C:  "ᔋ<",                 // CANADIAN SYLLABICS NASKAPI SPWA → CANADIAN SYLLABICS NASKAPI S-W + LESS-THAN SIGN
+	0x150D:  "ᔋᑕ",                 // CANADIAN SYLLABICS NASKAPI STWA → CANADIAN SYLLABICS NASKAPI S-W + CANADIAN SYLLABICS TA
+	0x150E:  "ᔋb",                 // CANADIAN SYLLABICS NASKAPI SKWA → CANADIAN SYLLABICS NASKAPI S-W + LATIN SMALL LETTER B
+	0x150F:  "ᔋᒐ",                 // CANADIAN SYLLABICS NASKAPI SCWA → CANADIAN SYLLABICS NASKAPI S-W + CANADIAN SYLLABICS CA
+	0x1517:  "·ᔐ",                 // CANADIAN SYLLABICS SHWE → MIDDLE DOT + CANADIAN SYLLABICS SHE
+	0x1518:  "ᔐ·",                 // CANADIAN SYLLABICS WEST-CREE SHWE → CANADIAN SYLLABICS SHE + MIDDLE DOT
+	0x1519:  "·ᔑ",                 // CANADIAN SYLLABICS SHWI → MIDDLE DOT + CANADIAN SYLLABICS SHI
+	0x151A:  "ᔑ·",                 // CANADIAN SYLLABICS WEST-CREE SHWI → CANADIAN SYLLABICS SHI + MIDDLE DOT
+	0x151B:  "·ᔒ",                 // CANADIAN SYLLABICS SHWII → MIDDLE DOT + CANADIAN SYLLABICS SHII
+	0x151C:  "ᔒ·",                 // CANADIAN SYLLABICS WEST-CREE SHWII → CANADIAN SYLLABICS SHII + MIDDLE DOT
+	0x151D:  "·ᔓ",                 // CANADIAN SYLLABICS SHWO → MIDDLE DOT + CANADIAN SYLLABICS SHO
+	0x151E:  "ᔓ·",                 // CANADIAN SYLLABICS WEST-CREE SHWO → CANADIAN SYLLABICS SHO + MIDDLE DOT
+	0x151F:  "·ᔔ",                 // CANADIAN SYLLABICS SHWOO → MIDDLE DOT + CANADIAN SYLLABICS SHOO
+	0x1520:  "ᔔ·",                 // CANADIAN SYLLABICS WEST-CREE SHWOO → CANADIAN SYLLABICS SHOO + MIDDLE DOT
+	0x1521:  "·ᔕ",                 // CANADIAN SYLLABICS SHWA → MIDDLE DOT + CANADIAN SYLLABICS SHA
+	0x1522:  "ᔕ·",                 // CANADIAN SYLLABICS WEST-CREE SHWA → CANADIAN SYLLABICS SHA + MIDDLE DOT
+	0x1523:  "·ᔖ",                 // CANADIAN SYLLABICS SHWAA → MIDDLE DOT + CANADIAN SYLLABICS SHAA
+	0x1524:  "ᔖ·",                 // CANADIAN SYLLABICS WEST-CREE SHWAA → CANADIAN SYLLABICS SHAA + MIDDLE DOT
+	0x152F:  "·4",                 // CANADIAN SYLLABICS YWE → MIDDLE DOT + DIGIT FOUR
+	0x1530:  "4·",                 // CANADIAN SYLLABICS WEST-CREE YWE → DIGIT FOUR + MIDDLE DOT
+	0x1531:  "·ᔨ",                 // CANADIAN SYLLABICS YWI → MIDDLE DOT + CANADIAN SYLLABICS YI
+	0x1532:  "ᔨ·",                 // CANADIAN SYLLABICS WEST-CREE YWI → CANADIAN SYLLABICS YI + MIDDLE DOT
+	0x1533:  "·ᔩ",                 // CANADIAN SYLLABICS YWII → MIDDLE DOT + CANADIAN SYLLABICS YII
+	0x1534:  "ᔩ·",                 // CANADIAN SYLLABICS WEST-CREE YWII → CANADIAN SYLLABICS YII + MIDDLE DOT
+	0x1535:  "·ᔪ",                 // CANADIAN SYLLABICS YWO → MIDDLE DOT + CANADIAN SYLLABICS YO
+	0x1536:  "ᔪ·",                 // CANADIAN SYLLABICS WEST-CREE YWO → CANADIAN SYLLABICS YO + MIDDLE DOT
+	0x1537:  "·ᔫ",                 // CANADIAN SYLLABICS YWOO → MIDDLE DOT + CANADIAN SYLLABICS YOO
+	0x1538:  "ᔫ·",                 // CANADIAN SYLLABICS WEST-CREE YWOO → CANADIAN SYLLABICS YOO + MIDDLE DOT
+	0x1539:  "·ᔭ",                 // CANADIAN SYLLABICS YWA → MIDDLE DOT + CANADIAN SYLLABICS YA
+	0x153A:  "ᔭ·",                 // CANADIAN SYLLABICS WEST-CREE YWA → CANADIAN SYLLABICS YA + MIDDLE DOT
+	0x153B:  "·ᔮ",                 // CANADIAN SYLLABICS YWAA → MIDDLE DOT + CANADIAN SYLLABICS YAA
+	0x153C:  "ᔮ·",                 // CANADIAN SYLLABICS WEST-CREE YWAA → CANADIAN SYLLABICS YAA + MIDDLE DOT
+	0x1540:  "ᐩ",                  // CANADIAN SYLLABICS WEST-CREE Y → CANADIAN SYLLABICS FINAL PLUS
+	0x1541:  "x",                  // CANADIAN SYLLABICS SAYISI YI → LATIN SMALL LETTER X
+	0x154E:  "·ᕌ",                 // CANADIAN SYLLABICS RWAA → MIDDLE DOT + CANADIAN SYLLABICS RAA
+	0x154F:  "ᕌ·",                 // CANADIAN SYLLABICS WEST-CREE RWAA → CANADIAN SYLLABICS RAA + MIDDLE DOT
+	0x155B:  "·ᕚ",                 // CANADIAN SYLLABICS FWAA → MIDDLE DOT + CANADIAN SYLLABICS FAA
+	0x155C:  "ᕚ·",                 // CANADIAN SYLLABICS WEST-CREE FWAA → CANADIAN SYLLABICS FAA + MIDDLE DOT
+	0x1568:  "·ᕧ",                 // CANADIAN SYLLABICS THWAA → MIDDLE DOT + CANADIAN SYLLABICS THAA
+	0x1569:  "ᕧ·",                 // CANADIAN SYLLABICS WEST-CREE THWAA → CANADIAN SYLLABICS THAA + MIDDLE DOT
+	0x1577:  "ẟ",                  // CANADIAN SYLLABICS NUNAVIK HO → LATIN SMALL LETTER DELTA
+	0x157C:  "H",                  // CANADIAN SYLLABICS NUNAVUT H → LATIN CAPITAL LETTER H
+	0x157D:  "x",                  // CANADIAN SYLLABICS HK → LATIN SMALL LETTER X
+	0x157E:  "ᕐᑬ",                 // CANADIAN SYLLABICS QAAI → CANADIAN SYLLABICS R + CANADIAN SYLLABICS KAAI
+	0x157F:  "ᕐP",                 // CANADIAN SYLLABICS QI → CANADIAN SYLLABICS R + LATIN CAPITAL LETTER P
+	0x1580:  "ᕐᑮ",                 // CANADIAN SYLLABICS QII → CANADIAN SYLLABICS R + CANADIAN SYLLABICS KII
+	0x1581:  "ᕐd",                 // CANADIAN SYLLABICS QO → CANADIAN SYLLABICS R + LATIN SMALL LETTER D
+	0x1582:  "ᕐᑰ",                 // CANADIAN SYLLABICS QOO → CANADIAN SYLLABICS R + CANADIAN SYLLABICS KOO
+	0x1583:  "ᕐb",                 // CANADIAN SYLLABICS QA → CANADIAN SYLLABICS R + LATIN SMALL LETTER B
+	0x1584:  "ᕐḃ",                // CANADIAN SYLLABICS QAA → CANADIAN SYLLABICS R + LATIN SMALL LETTER B + COMBINING DOT ABOVE
+	0x1585:  "ᕐᒃ",                 // CANADIAN SYLLABICS Q → CANADIAN SYLLABICS R + CANADIAN SYLLABICS K
+	0x1587:  "R",                  // CANADIAN SYLLABICS TLHI → LATIN CAPITAL LETTER R
+	0x158E:  "ᖕᒊ",                 // CANADIAN SYLLABICS NGAAI → CANADIAN SYLLABICS NG + CANADIAN SYLLABICS CAAI
+	0x158F:  "ᖕᒋ",                 // CANADIAN SYLLABICS NGI → CANADIAN SYLLABICS NG + CANADIAN SYLLABICS CI
+	0x1590:  "ᖕᒌ",                 // CANADIAN SYLLABICS NGII → CANADIAN SYLLABICS NG + CANADIAN SYLLABICS CII
+	0x1591:  "ᖕJ",                 // CANADIAN SYLLABICS NGO → CANADIAN SYLLABICS NG + LATIN CAPITAL LETTER J
+	0x1592:  "ᖕᒎ",                 // CANADIAN SYLLABICS NGOO → CANADIAN SYLLABICS NG + CANADIAN SYLLABICS COO
+	0x1593:  "ᖕᒐ",                 // CANADIAN SYLLABICS NGA → CANADIAN SYLLABICS NG + CANADIAN SYLLABICS CA
+	0x1594:  "ᖕᒑ",                 // CANADIAN SYLLABICS NGAA → CANADIAN SYLLABICS NG + CANADIAN SYLLABICS CAA
+	0x15AF:  "b",                  // CANADIAN SYLLABICS AIVILIK B → LATIN SMALL LETTER B
+	0x15B4:  "F",                  // CANADIAN SYLLABICS BLACKFOOT WE → LATIN CAPITAL LETTER F
+	0x15B5:  "Ⅎ",                  // CANADIAN SYLLABICS BLACKFOOT WI → TURNED CAPITAL F
+	0x15B7:  "ꟻ",                  // CANADIAN SYLLABICS BLACKFOOT WA → LATIN EPIGRAPHIC LETTER REVERSED F
+	0x15C4:  "Ɐ",                  // CANADIAN SYLLABICS CARRIER GHU → LATIN CAPITAL LETTER TURNED A
+	0x15C5:  "A",                  // CANADIAN SYLLABICS CARRIER GHO → LATIN CAPITAL LETTER A
+	0x15DE:  "D",                  // CANADIAN SYLLABICS CARRIER THE → LATIN CAPITAL LETTER D
+	0x15EA:  "D",                  // CANADIAN SYLLABICS CARRIER PE → LATIN CAPITAL LETTER D
+	0x15EF:  "Ѡ",                  // CANADIAN SYLLABICS CARRIER GU → CYRILLIC CAPITAL LETTER OMEGA
+	0x15F0:  "M",                  // CANADIAN SYLLABICS CARRIER GO → LATIN CAPITAL LETTER M
+	0x15F7:  "B",                  // CANADIAN SYLLABICS CARRIER KHE → LATIN CAPITAL LETTER B
+	0x1602:  "ᒐ",                  // CANADIAN SYLLABICS CARRIER NU → CANADIAN SYLLABICS CA
+	0x1603:  "ᒉ",                  // CANADIAN SYLLABICS CARRIER NO → CANADIAN SYLLABICS CE
+	0x1604:  "ᓓ",                  // CANADIAN SYLLABICS CARRIER NE → CANADIAN SYLLABICS LE
+	0x1607:  "ᓚ",                  // CANADIAN SYLLABICS CARRIER NA → CANADIAN SYLLABICS LA
+	0x1622:  "ᕃ",                  // CANADIAN SYLLABICS CARRIER LU → CANADIAN SYLLABICS R-CREE RE
+	0x1623:  "ᕆ",                  // CANADIAN SYLLABICS CARRIER LO → CANADIAN SYLLABICS RI
+	0x1624:  "ᕊ",                  // CANADIAN SYLLABICS CARRIER LE → CANADIAN SYLLABICS WEST-CREE LO
+	0x162E:  "Ʊ",                  // CANADIAN SYLLABICS CARRIER LHU → LATIN CAPITAL LETTER UPSILON
+	0x162F:  "Ω",                  // CANADIAN SYLLABICS CARRIER LHO → GREEK CAPITAL LETTER OMEGA
+	0x1634:  "Ʊ",                  // CANADIAN SYLLABICS CARRIER TLHU → LATIN CAPITAL LETTER UPSILON
+	0x1635:  "Ω",                  // CANADIAN SYLLABICS CARRIER TLHO → GREEK CAPITAL LETTER OMEGA
+	0x166D:  "X",                  // CANADIAN SYLLABICS CHI SIGN → LATIN CAPITAL LETTER X
+	0x166E:  "x",                  // CANADIAN SYLLABICS FULL STOP → LATIN SMALL LETTER X
+	0x166F:  "ᕐᑫ",                 // CANADIAN SYLLABICS QAI → CANADIAN SYLLABICS R + CANADIAN SYLLABICS KE
+	0x1670:  "ᖕᒉ",                 // CANADIAN SYLLABICS NGAI → CANADIAN SYLLABICS NG + CANADIAN SYLLABICS CE
+	0x1671:  "ᖖᒋ",                 // CANADIAN SYLLABICS NNGI → CANADIAN SYLLABICS NNG + CANADIAN SYLLABICS CI
+	0x1672:  "ᖖᒌ",                 // CANADIAN SYLLABICS NNGII → CANADIAN SYLLABICS NNG + CANADIAN SYLLABICS CII
+	0x1673:  "ᖖJ",                 // CANADIAN SYLLABICS NNGO → CANADIAN SYLLABICS NNG + LATIN CAPITAL LETTER J
+	0x1674:  "ᖖᒎ",                 // CANADIAN SYLLABICS NNGOO → CANADIAN SYLLABICS NNG + CANADIAN SYLLABICS COO
+	0x1675:  "ᖖᒐ",                 // CANADIAN SYLLABICS NNGA → CANADIAN SYLLABICS NNG + CANADIAN SYLLABICS CA
+	0x1676:  "ᖖᒑ",                 // CANADIAN SYLLABICS NNGAA → CANADIAN SYLLABICS NNG + CANADIAN SYLLABICS CAA
+	0x1677:  "ᖧ·",                 // CANADIAN SYLLABICS WOODS-CREE THWEE → CANADIAN SYLLABICS TH-CREE THE + MIDDLE DOT
+	0x1678:  "ᖨ·",                 // CANADIAN SYLLABICS WOODS-CREE THWI → CANADIAN SYLLABICS TH-CREE THI + MIDDLE DOT
+	0x1679:  "ᖩ·",                 // CANADIAN SYLLABICS WOODS-CREE THWII → CANADIAN SYLLABICS TH-CREE THII + MIDDLE DOT
+	0x167A:  "ᖪ·",                 // CANADIAN SYLLABICS WOODS-CREE THWO → CANADIAN SYLLABICS TH-CREE THO + MIDDLE DOT
+	0x167B:  "ᖫ·",                 // CANADIAN SYLLABICS WOODS-CREE THWOO → CANADIAN SYLLABICS TH-CREE THOO + MIDDLE DOT
+	0x167C:  "ᖬ·",                 // CANADIAN SYLLABICS WOODS-CREE THWA → CANADIAN SYLLABICS TH-CREE THA + MIDDLE DOT
+	0x167D:  "ᖭ·",                 // CANADIAN SYLLABICS WOODS-CREE THWAA → CANADIAN SYLLABICS TH-CREE THAA + MIDDLE DOT
+	0x1680:  " ",                  // OGHAM SPACE MARK → SPACE
+	0x16B2:  "<",                  // RUNIC LETTER KAUNA → LESS-THAN SIGN
+	0x16B7:  "X",                  // RUNIC LETTER GEBO GYFU G → LATIN CAPITAL LETTER X
+	0x16C1:  "l",                  // RUNIC LETTER ISAZ IS ISS I → LATIN SMALL LETTER L
+	0x16C2:  "ᚽ",                  // RUNIC LETTER E → RUNIC LETTER SHORT-TWIG-HAGALL H
+	0x16CC:  "'",                  // RUNIC LETTER SHORT-TWIG-SOL S → APOSTROPHE
+	0x16D5:  "K",                  // RUNIC LETTER OPEN-P → LATIN CAPITAL LETTER K
+	0x16D6:  "M",                  // RUNIC LETTER EHWAZ EH E → LATIN CAPITAL LETTER M
+	0x16D8:  "Ψ",                  // RUNIC LETTER LONG-BRANCH-MADR M → GREEK CAPITAL LETTER PSI
+	0x16E1:  "ᚼ",                  // RUNIC LETTER IOR → RUNIC LETTER LONG-BRANCH-HAGALL H
+	0x16EB:  "·",                  // RUNIC SINGLE PUNCTUATION → MIDDLE DOT
+	0x16EC:  ":",                  // RUNIC MULTIPLE PUNCTUATION → COLON
+	0x16ED:  "+",                  // RUNIC CROSS PUNCTUATION → PLUS SIGN
+	0x16F0:  "Φ",                  // RUNIC BELGTHOR SYMBOL → GREEK CAPITAL LETTER PHI
+	0x1734:  "᜕",                  // HANUNOO SIGN PAMUDPOD → TAGALOG SIGN PAMUDPOD
+	0x1735:  "/",                  // PHILIPPINE SINGLE PUNCTUATION → SOLIDUS
+	0x178F:  "ដ",                  // KHMER LETTER TA → KHMER LETTER DA
+	0x17A3:  "អ",                  // KHMER INDEPENDENT VOWEL QAQ → KHMER LETTER QA
+	0x17B7:  "ิ",                  // KHMER VOWEL SIGN I → THAI CHARACTER SARA I
+	0x17B8:  "ี",                  // KHMER VOWEL SIGN II → THAI CHARACTER SARA II
+	0x17B9:  "ึ",                  // KHMER VOWEL SIGN Y → THAI CHARACTER SARA UE
+	0x17BA:  "ื",                  // KHMER VOWEL SIGN YY → THAI CHARACTER SARA UEE
+	0x17C6:  "̊",                  // KHMER SIGN NIKAHIT → COMBINING RING ABOVE
+	0x17CB:  "่",                  // KHMER SIGN BANTOC → THAI CHARACTER MAI EK
+	0x17D3:  "̊",                  // KHMER SIGN BATHAMASAT → COMBINING RING ABOVE
+	0x17D4:  "ฯ",                  // KHMER SIGN KHAN → THAI CHARACTER PAIYANNOI
+	0x17D5:  "๚",                  // KHMER SIGN BARIYOOSAN → THAI CHARACTER ANGKHANKHU
+	0x17D9:  "๏",                  // KHMER SIGN PHNAEK MUAN → THAI CHARACTER FONGMAN
+	0x17DA:  "๛",                  // KHMER SIGN KOOMUUT → THAI CHARACTER KHOMUT
+	0x17E0:  "o",                  // KHMER DIGIT ZERO → LATIN SMALL LETTER O
+	0x1803:  ":",                  // MONGOLIAN FULL STOP → COLON
+	0x1809:  ":",                  // MONGOLIAN MANCHU FULL STOP → COLON
+	0x1855:  "ᠵ",                  // MONGOLIAN LETTER TODO YA → MONGOLIAN LETTER JA
+	0x1896:  "ᡜ",                  // MONGOLIAN LETTER ALI GALI ZA → MONGOLIAN LETTER TODO DZA
+	0x18B3:  "·ᢱ",                 // CANADIAN SYLLABICS WAY → MIDDLE DOT + CANADIAN SYLLABICS AY
+	0x18B6:  "·ᢴ",                 // CANADIAN SYLLABICS PWOY → MIDDLE DOT + CANADIAN SYLLABICS POY
+	0x18B9:  "·ᢸ",                 // CANADIAN SYLLABICS KWAY → MIDDLE DOT + CANADIAN SYLLABICS KAY
+	0x18C2:  "·ᣀ",                 // CANADIAN SYLLABICS SHWOY → MIDDLE DOT + CANADIAN SYLLABICS SHOY
+	0x18C6:  "·ᓂ",                 // CANADIAN SYLLABICS NWI → MIDDLE DOT + CANADIAN SYLLABICS NI
+	0x18C7:  "ᓂ·",                 // CANADIAN SYLLABICS OJIBWAY NWI → CANADIAN SYLLABICS NI + MIDDLE DOT
+	0x18C8:  "·ᓃ",                 // CANADIAN SYLLABICS NWII → MIDDLE DOT + CANADIAN SYLLABICS NII
+	0x18C9:  "ᓃ·",                 // CANADIAN SYLLABICS OJIBWAY NWII → CANADIAN SYLLABICS NII + MIDDLE DOT
+	0x18CA:  "·ᓄ",                 // CANADIAN SYLLABICS NWO → MIDDLE DOT + CANADIAN SYLLABICS NO
+	0x18CB:  "ᓄ·",                 // CANADIAN SYLLABICS OJIBWAY NWO → CANADIAN SYLLABICS NO + MIDDLE DOT
+	0x18CC:  "·ᓅ",                 // CANADIAN SYLLABICS NWOO → MIDDLE DOT + CANADIAN SYLLABICS NOO
+	0x18CD:  "ᓅ·",                 // CANADIAN SYLLABICS OJIBWAY NWOO → CANADIAN SYLLABICS NOO + MIDDLE DOT
+	0x18CE:  "·ᕃ",                 // CANADIAN SYLLABICS RWEE → MIDDLE DOT + CANADIAN SYLLABICS R-CREE RE
+	0x18CF:  "·ᕆ",                 // CANADIAN SYLLABICS RWI → MIDDLE DOT + CANADIAN SYLLABICS RI
+	0x18D0:  "·ᕇ",                 // CANADIAN SYLLABICS RWII → MIDDLE DOT + CANADIAN SYLLABICS RII
+	0x18D1:  "·ᕈ",                 // CANADIAN SYLLABICS RWO → MIDDLE DOT + CANADIAN SYLLABICS RO
+	0x18D2:  "·ᕉ",                 // CANADIAN SYLLABICS RWOO → MIDDLE DOT + CANADIAN SYLLABICS ROO
+	0x18D3:  "·ᕋ",                 // CANADIAN SYLLABICS RWA → MIDDLE DOT + CANADIAN SYLLABICS RA
+	0x18DB:  "ᣵ",                  // CANADIAN SYLLABICS OJIBWAY SH → CANADIAN SYLLABICS CARRIER DENTAL S
+	0x18DC:  "ᣟᐞ",                 // CANADIAN SYLLABICS EASTERN W → CANADIAN SYLLABICS FINAL RAISED DOT + CANADIAN SYLLABICS GLOTTAL STOP
+	0x18DD:  "ᐞᣟ",                 // CANADIAN SYLLABICS WESTERN W → CANADIAN SYLLABICS GLOTTAL STOP + CANADIAN SYLLABICS FINAL RAISED DOT
+	0x18E0:  "ᕃ·",                 // CANADIAN SYLLABICS R-CREE RWE → CANADIAN SYLLABICS R-CREE RE + MIDDLE DOT
+	0x18E3:  "ᕞ·",                 // CANADIAN SYLLABICS THWE → CANADIAN SYLLABICS THE + MIDDLE DOT
+	0x18E4:  "ᕦ·",                 // CANADIAN SYLLABICS THWA → CANADIAN SYLLABICS THA + MIDDLE DOT
+	0x18E5:  "ᕫ·",                 // CANADIAN SYLLABICS TTHWE → CANADIAN SYLLABICS TTHE + MIDDLE DOT
+	0x18E8:  "ᖆ·",                 // CANADIAN SYLLABICS TLHWE → CANADIAN SYLLABICS TLHE + MIDDLE DOT
+	0x18EA:  "ᖗ·",                 // CANADIAN SYLLABICS SAYISI SHWE → CANADIAN SYLLABICS SAYISI SHE + MIDDLE DOT
+	0x18ED:  "Ѡ·",                 // CANADIAN SYLLABICS CARRIER GWU → CYRILLIC CAPITAL LETTER OMEGA + MIDDLE DOT
+	0x18F0:  "ᗴ·",                 // CANADIAN SYLLABICS CARRIER GWA → CANADIAN SYLLABICS CARRIER GA + MIDDLE DOT
+	0x18F2:  "ᘛ·",                 // CANADIAN SYLLABICS CARRIER JWA → CANADIAN SYLLABICS CARRIER JA + MIDDLE DOT
+	0x19D0:  "ᦞ",                  // NEW TAI LUE DIGIT ZERO → NEW TAI LUE LETTER LOW VA
+	0x19D1:  "ᦱ",                  // NEW TAI LUE DIGIT ONE → NEW TAI LUE VOWEL SIGN AA
+	0x1A80:  "ᩅ",                  // TAI THAM HORA DIGIT ZERO → TAI THAM LETTER WA
+	0x1A90:  "ᩅ",                  // TAI THAM THAM DIGIT ZERO → TAI THAM LETTER WA
+	0x1AA9:  "᪨᪨",                 // TAI THAM SIGN KAANKUU → TAI THAM SIGN KAAN + TAI THAM SIGN KAAN
+	0x1AAB:  "᪪᪨",                 // TAI THAM SIGN SATKAANKUU → TAI THAM SIGN SATKAAN + TAI THAM SIGN KAAN
+	0x1AB4:  "ۛ",                  // COMBINING TRIPLE DOT → ARABIC SMALL HIGH THREE DOTS
+	0x1AB7:  "̨",                  // COMBINING OPEN MARK BELOW → COMBINING OGONEK
+	0x1AD9:  "᫆",                  // ( ᫙ → ᫆ )
+	0x1AE2:  "̄",                  // ( ᫢ → ̄ )
+	0x1AE7:  "᫥",                  // ( ᫧ → ᫥ )
+	0x1AE8:  "̄̄",                 // ( ᫨ → ̄̄ )
+	0x1B52:  "ᬍ",                  // BALINESE DIGIT TWO → BALINESE LETTER LA LENGA
+	0x1B53:  "ᬑ",                  // BALINESE DIGIT THREE → BALINESE LETTER OKARA
+	0x1B58:  "ᬨ",                  // BALINESE DIGIT EIGHT → BALINESE LETTER PA KAPAL
+	0x1B5C:  "᭐",                  // BALINESE WINDU → BALINESE DIGIT ZERO
+	0x1B5F:  "᭞᭞",                 // BALINESE CARIK PAREREN → BALINESE CARIK SIKI + BALINESE CARIK SIKI
+	0x1C3C:  "᰻᰻",                 // LEPCHA PUNCTUATION NYET THYOOM TA-ROL → LEPCHA PUNCTUATION TA-ROL + LEPCHA PUNCTUATION TA-ROL
+	0x1C7F:  "᱾᱾",                 // OL CHIKI PUNCTUATION DOUBLE MUCAAD → OL CHIKI PUNCTUATION MUCAAD + OL CHIKI PUNCTUATION MUCAAD
+	0x1CD0:  "̂",                  // VEDIC TONE KARSHANA → COMBINING CIRCUMFLEX ACCENT
+	0x1CD2:  "̄",                  // VEDIC TONE PRENKHA → COMBINING MACRON
+	0x1CD3:  "''",                 // VEDIC SIGN NIHSHVASA → APOSTROPHE + APOSTROPHE
+	0x1CD5:  "̫",                  // VEDIC TONE YAJURVEDIC AGGRAVATED INDEPENDENT SVARITA → COMBINING INVERTED DOUBLE ARCH BELOW
+	0x1CD8:  "̮",                  // VEDIC TONE CANDRA BELOW → COMBINING BREVE BELOW
+	0x1CD9:  "̭",                  // VEDIC TONE YAJURVEDIC KATHAKA INDEPENDENT SVARITA SCHROEDER → COMBINING CIRCUMFLEX ACCENT BELOW
+	0x1CDA:  "̎",                  // VEDIC TONE DOUBLE SVARITA → COMBINING DOUBLE VERTICAL LINE ABOVE
+	0x1CDC:  "̩",                  // VEDIC TONE KATHAKA ANUDATTA → COMBINING VERTICAL LINE BELOW
+	0x1CDD:  "̣",                  // VEDIC TONE DOT BELOW → COMBINING DOT BELOW
+	0x1CDE:  "̤",                  // VEDIC TONE TWO DOTS BELOW → COMBINING DIAERESIS BELOW
+	0x1CED:  "̖",                  // VEDIC SIGN TIRYAK → COMBINING GRAVE ACCENT BELOW
+	0x1D04:  "c",                  // LATIN LETTER SMALL CAPITAL C → LATIN SMALL LETTER C
+	0x1D08:  "ɜ",                  // LATIN SMALL LETTER TURNED OPEN E → LATIN SMALL LETTER REVERSED OPEN E
+	0x1D0B:  "ĸ",                  // LATIN LETTER SMALL CAPITAL K → LATIN SMALL LETTER KRA
+	0x1D0D:  "ʍ",                  // LATIN LETTER SMALL CAPITAL M → LATIN SMALL LETTER TURNED W
+	0x1D0F:  "o",                  // LATIN LETTER SMALL CAPITAL O → LATIN SMALL LETTER O
+	0x1D10:  "ɔ",                  // LATIN LETTER SMALL CAPITAL OPEN O → LATIN SMALL LETTER OPEN O
+	0x1D11:  "o",                  // LATIN SMALL LETTER SIDEWAYS O → LATIN SMALL LETTER O
+	0x1D14:  "ǝo",                 // LATIN SMALL LETTER TURNED OE → LATIN SMALL LETTER TURNED E + LATIN SMALL LETTER O
+	0x1D1C:  "u",                  // LATIN LETTER SMALL CAPITAL U → LATIN SMALL LETTER U
+	0x1D20:  "v",                  // LATIN LETTER SMALL CAPITAL V → LATIN SMALL LETTER V
+	0x1D21:  "w",                  // LATIN LETTER SMALL CAPITAL W → LATIN SMALL LETTER W
+	0x1D22:  "z",                  // LATIN LETTER SMALL CAPITAL Z → LATIN SMALL LETTER Z
+	0x1D24:  "ƨ",                  // LATIN LETTER VOICED LARYNGEAL SPIRANT → LATIN SMALL LETTER TONE TWO
+	0x1D26:  "r",                  // GREEK LETTER SMALL CAPITAL GAMMA → LATIN SMALL LETTER R
+	0x1D27:  "ʌ",                  // GREEK LETTER SMALL CAPITAL LAMDA → LATIN SMALL LETTER TURNED V
+	0x1D28:  "π",                  // GREEK LETTER SMALL CAPITAL PI → GREEK SMALL LETTER PI
+	0x1D29:  "ᴘ",                  // GREEK LETTER SMALL CAPITAL RHO → LATIN LETTER SMALL CAPITAL P
+	0x1D2B:  "л",                  // CYRILLIC LETTER SMALL CAPITAL EL → CYRILLIC SMALL LETTER EL
+	0x1D3E:  "ᣖ",                  // MODIFIER LETTER CAPITAL P → CANADIAN SYLLABICS OJIBWAY K
+	0x1D52:  "º",                  // MODIFIER LETTER SMALL O → MASCULINE ORDINAL INDICATOR
+	0x1D6B:  "ue",                 // LATIN SMALL LETTER UE → LATIN SMALL LETTER U + LATIN SMALL LETTER E
+	0x1D6E:  "f̴",                 // LATIN SMALL LETTER F WITH MIDDLE TILDE → LATIN SMALL LETTER F + COMBINING TILDE OVERLAY
+	0x1D6F:  "rn̴",                // LATIN SMALL LETTER M WITH MIDDLE TILDE → LATIN SMALL LETTER R + LATIN SMALL LETTER N + COMBINING TILDE OVERLAY
+	0x1D70:  "n̴",                 // LATIN SMALL LETTER N WITH MIDDLE TILDE → LATIN SMALL LETTER N + COMBINING TILDE OVERLAY
+	0x1D72:  "r̴",                 // LATIN SMALL LETTER R WITH MIDDLE TILDE → LATIN SMALL LETTER R + COMBINING TILDE OVERLAY
+	0x1D73:  "ɾ̴",                 // LATIN SMALL LETTER R WITH FISHHOOK AND MIDDLE TILDE → LATIN SMALL LETTER R WITH FISHHOOK + COMBINING TILDE OVERLAY
+	0x1D74:  "s̴",                 // LATIN SMALL LETTER S WITH MIDDLE TILDE → LATIN SMALL LETTER S + COMBINING TILDE OVERLAY
+	0x1D75:  "t̴",                 // LATIN SMALL LETTER T WITH MIDDLE TILDE → LATIN SMALL LETTER T + COMBINING TILDE OVERLAY
+	0x1D76:  "z̴",                 // LATIN SMALL LETTER Z WITH MIDDLE TILDE → LATIN SMALL LETTER Z + COMBINING TILDE OVERLAY
+	0x1D78:  "ᴴ",                  // MODIFIER LETTER CYRILLIC EN → MODIFIER LETTER CAPITAL H
+	0x1D7B:  "i̵",                 // LATIN SMALL CAPITAL LETTER I WITH STROKE → LATIN SMALL LETTER I + COMBINING SHORT STROKE OVERLAY
+	0x1D7C:  "i̵",                 // LATIN SMALL LETTER IOTA WITH STROKE → LATIN SMALL LETTER I + COMBINING SHORT STROKE OVERLAY
+	0x1D7D:  "p̵",                 // LATIN SMALL LETTER P WITH STROKE → LATIN SMALL LETTER P + COMBINING SHORT STROKE OVERLAY
+	0x1D7E:  "u̵",                 // LATIN SMALL CAPITAL LETTER U WITH STROKE → LATIN SMALL LETTER U + COMBINING SHORT STROKE OVERLAY
+	0x1D7F:  "ʊ̵",                 // LATIN SMALL LETTER UPSILON WITH STROKE → LATIN SMALL LETTER UPSILON + COMBINING SHORT STROKE OVERLAY
+	0x1D83:  "g",                  // LATIN SMALL LETTER G WITH PALATAL HOOK → LATIN SMALL LETTER G
+	0x1D8C:  "y",                  // LATIN SMALL LETTER V WITH PALATAL HOOK → LATIN SMALL LETTER Y
+	0x1D90:  "ɋ",                  // LATIN SMALL LETTER ALPHA WITH RETROFLEX HOOK → LATIN SMALL LETTER Q WITH HOOK TAIL
+	0x1D9F:  "ᵋ",                  // MODIFIER LETTER SMALL REVERSED OPEN E → MODIFIER LETTER SMALL OPEN E
+	0x1DA2:  "ᵍ",                  // MODIFIER LETTER SMALL SCRIPT G → MODIFIER LETTER SMALL G
+	0x1DBA:  "ᣔ",                  // MODIFIER LETTER SMALL TURNED V → CANADIAN SYLLABICS OJIBWAY P
+	0x1DBB:  "ᙆ",                  // MODIFIER LETTER SMALL Z → CANADIAN SYLLABICS CARRIER Z
+	0x1DE8:  "᫚",                  // ( ᷨ → ᫚ )
+	0x1DEE:  "ⷬ",                  // COMBINING LATIN SMALL LETTER P → COMBINING CYRILLIC LETTER ER
+	0x1E43:  "ꭑ",                  // LATIN SMALL LETTER M WITH DOT BELOW → LATIN SMALL LETTER TURNED UI
+	0x1E9A:  "ả",                  // LATIN SMALL LETTER A WITH RIGHT HALF RING → LATIN SMALL LETTER A WITH HOOK ABOVE
+	0x1E9D:  "f",                  // LATIN SMALL LETTER LONG S WITH HIGH STROKE → LATIN SMALL LETTER F
+	0x1E9E:  "ß",                  // LATIN CAPITAL LETTER SHARP S → LATIN SMALL LETTER SHARP S
+	0x1EFF:  "y",                  // LATIN SMALL LETTER Y WITH LOOP → LATIN SMALL LETTER Y
+	0x1F7D:  "ῴ",                  // GREEK SMALL LETTER OMEGA WITH OXIA → GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1FBD:  "'",                  // GREEK KORONIS → APOSTROPHE
+	0x1FBE:  "i",                  // GREEK PROSGEGRAMMENI → LATIN SMALL LETTER I
+	0x1FBF:  "'",                  // GREEK PSILI → APOSTROPHE
+	0x1FC0:  "~",                  // GREEK PERISPOMENI → TILDE
+	0x1FEF:  "'",                  // GREEK VARIA → APOSTROPHE
+	0x1FF6:  "Ꮿ",                  // GREEK SMALL LETTER OMEGA WITH PERISPOMENI → CHEROKEE LETTER YA
+	0x1FFD:  "'",                  // GREEK OXIA → APOSTROPHE
+	0x1FFE:  "'",                  // GREEK DASIA → APOSTROPHE
+	0x2000:  " ",                  // EN QUAD → SPACE
+	0x2001:  " ",                  // EM QUAD → SPACE
+	0x2002:  " ",                  // EN SPACE → SPACE
+	0x2003:  " ",                  // EM SPACE → SPACE
+	0x2004:  " ",                  // THREE-PER-EM SPACE → SPACE
+	0x2005:  " ",                  // FOUR-PER-EM SPACE → SPACE
+	0x2006:  " ",                  // SIX-PER-EM SPACE → SPACE
+	0x2007:  " ",                  // FIGURE SPACE → SPACE
+	0x2008:  " ",                  // PUNCTUATION SPACE → SPACE
+	0x2009:  " ",                  // THIN SPACE → SPACE
+	0x200A:  " ",                  // HAIR SPACE → SPACE
+	0x2010:  "-",                  // HYPHEN → HYPHEN-MINUS
+	0x2011:  "-",                  // NON-BREAKING HYPHEN → HYPHEN-MINUS
+	0x2012:  "-",                  // FIGURE DASH → HYPHEN-MINUS
+	0x2013:  "-",                  // EN DASH → HYPHEN-MINUS
+	0x2014:  "ー",                  // EM DASH → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x2015:  "ー",                  // HORIZONTAL BAR → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x2016:  "ll",                 // DOUBLE VERTICAL LINE → LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x2018:  "'",                  // LEFT SINGLE QUOTATION MARK → APOSTROPHE
+	0x2019:  "'",                  // RIGHT SINGLE QUOTATION MARK → APOSTROPHE
+	0x201A:  ",",                  // SINGLE LOW-9 QUOTATION MARK → COMMA
+	0x201B:  "'",                  // SINGLE HIGH-REVERSED-9 QUOTATION MARK → APOSTROPHE
+	0x201C:  "''",                 // LEFT DOUBLE QUOTATION MARK → APOSTROPHE + APOSTROPHE
+	0x201D:  "''",                 // RIGHT DOUBLE QUOTATION MARK → APOSTROPHE + APOSTROPHE
+	0x201F:  "''",                 // DOUBLE HIGH-REVERSED-9 QUOTATION MARK → APOSTROPHE + APOSTROPHE
+	0x2022:  "·",                  // BULLET → MIDDLE DOT
+	0x2024:  ".",                  // ONE DOT LEADER → FULL STOP
+	0x2025:  "..",                 // TWO DOT LEADER → FULL STOP + FULL STOP
+	0x2026:  "...",                // HORIZONTAL ELLIPSIS → FULL STOP + FULL STOP + FULL STOP
+	0x2027:  "·",                  // HYPHENATION POINT → MIDDLE DOT
+	0x2028:  " ",                  // LINE SEPARATOR → SPACE
+	0x2029:  " ",                  // PARAGRAPH SEPARATOR → SPACE
+	0x202F:  " ",                  // NARROW NO-BREAK SPACE → SPACE
+	0x2030:  "º/₀₀",               // PER MILLE SIGN → MASCULINE ORDINAL INDICATOR + SOLIDUS + SUBSCRIPT ZERO + SUBSCRIPT ZERO
+	0x2031:  "º/₀₀₀",              // PER TEN THOUSAND SIGN → MASCULINE ORDINAL INDICATOR + SOLIDUS + SUBSCRIPT ZERO + SUBSCRIPT ZERO + SUBSCRIPT ZERO
+	0x2032:  "'",                  // PRIME → APOSTROPHE
+	0x2033:  "''",                 // DOUBLE PRIME → APOSTROPHE + APOSTROPHE
+	0x2034:  "'''",                // TRIPLE PRIME → APOSTROPHE + APOSTROPHE + APOSTROPHE
+	0x2035:  "'",                  // REVERSED PRIME → APOSTROPHE
+	0x2036:  "''",                 // REVERSED DOUBLE PRIME → APOSTROPHE + APOSTROPHE
+	0x2037:  "'''",                // REVERSED TRIPLE PRIME → APOSTROPHE + APOSTROPHE + APOSTROPHE
+	0x2039:  "<",                  // SINGLE LEFT-POINTING ANGLE QUOTATION MARK → LESS-THAN SIGN
+	0x203A:  ">",                  // SINGLE RIGHT-POINTING ANGLE QUOTATION MARK → GREATER-THAN SIGN
+	0x203C:  "!!",                 // DOUBLE EXCLAMATION MARK → EXCLAMATION MARK + EXCLAMATION MARK
+	0x203E:  "ˉ",                  // OVERLINE → MODIFIER LETTER MACRON
+	0x2041:  "/",                  // CARET INSERTION POINT → SOLIDUS
+	0x2043:  "-",                  // HYPHEN BULLET → HYPHEN-MINUS
+	0x2044:  "/",                  // FRACTION SLASH → SOLIDUS
+	0x2047:  "??",                 // DOUBLE QUESTION MARK → QUESTION MARK + QUESTION MARK
+	0x2048:  "?!",                 // QUESTION EXCLAMATION MARK → QUESTION MARK + EXCLAMATION MARK
+	0x2049:  "!?",                 // EXCLAMATION QUESTION MARK → EXCLAMATION MARK + QUESTION MARK
+	0x204E:  "*",                  // LOW ASTERISK → ASTERISK
+	0x2052:  "º/₀",                // COMMERCIAL MINUS SIGN → MASCULINE ORDINAL INDICATOR + SOLIDUS + SUBSCRIPT ZERO
+	0x2053:  "~",                  // SWUNG DASH → TILDE
+	0x2057:  "''''",               // QUADRUPLE PRIME → APOSTROPHE + APOSTROPHE + APOSTROPHE + APOSTROPHE
+	0x205A:  ":",                  // TWO DOT PUNCTUATION → COLON
+	0x205D:  "ⵗ",                  // TRICOLON → TIFINAGH LETTER TUAREG YAGH
+	0x205E:  "ⵂ",                  // VERTICAL FOUR DOTS → TIFINAGH LETTER TUAREG YAH
+	0x205F:  " ",                  // MEDIUM MATHEMATICAL SPACE → SPACE
+	0x2070:  "º",                  // SUPERSCRIPT ZERO → MASCULINE ORDINAL INDICATOR
+	0x2079:  "ꝰ",                  // SUPERSCRIPT NINE → MODIFIER LETTER US
+	0x20A1:  "C⃫",                 // COLON SIGN → LATIN CAPITAL LETTER C + COMBINING LONG DOUBLE SOLIDUS OVERLAY
+	0x20A4:  "£",                  // LIRA SIGN → POUND SIGN
+	0x20A5:  "rn̸",                // MILL SIGN → LATIN SMALL LETTER R + LATIN SMALL LETTER N + COMBINING LONG SOLIDUS OVERLAY
+	0x20A8:  "Rs",                 // RUPEE SIGN → LATIN CAPITAL LETTER R + LATIN SMALL LETTER S
+	0x20A9:  "W̵",                 // WON SIGN → LATIN CAPITAL LETTER W + COMBINING SHORT STROKE OVERLAY
+	0x20AB:  "ḏ̵",                // DONG SIGN → LATIN SMALL LETTER D + COMBINING SHORT STROKE OVERLAY + COMBINING MACRON BELOW
+	0x20AC:  "Ꞓ",                  // EURO SIGN → LATIN CAPITAL LETTER C WITH BAR
+	0x20AD:  "K̵",                 // KIP SIGN → LATIN CAPITAL LETTER K + COMBINING SHORT STROKE OVERLAY
+	0x20AE:  "T⃫",                 // TUGRIK SIGN → LATIN CAPITAL LETTER T + COMBINING LONG DOUBLE SOLIDUS OVERLAY
+	0x20B6:  "lt",                 // LIVRE TOURNOIS SIGN → LATIN SMALL LETTER L + LATIN SMALL LETTER T
+	0x20BD:  "Ք",                  // RUBLE SIGN → ARMENIAN CAPITAL LETTER KEH
+	0x20C1:  "رىlل",               // ( ⃁ → رىlل )
+	0x20DB:  "ۛ",                  // COMBINING THREE DOTS ABOVE → ARABIC SMALL HIGH THREE DOTS
+	0x2100:  "a/c",                // ACCOUNT OF → LATIN SMALL LETTER A + SOLIDUS + LATIN SMALL LETTER C
+	0x2101:  "a/s",                // ADDRESSED TO THE SUBJECT → LATIN SMALL LETTER A + SOLIDUS + LATIN SMALL LETTER S
+	0x2102:  "C",                  // DOUBLE-STRUCK CAPITAL C → LATIN CAPITAL LETTER C
+	0x2103:  "°C",                 // DEGREE CELSIUS → DEGREE SIGN + LATIN CAPITAL LETTER C
+	0x2105:  "c/o",                // CARE OF → LATIN SMALL LETTER C + SOLIDUS + LATIN SMALL LETTER O
+	0x2106:  "c/u",                // CADA UNA → LATIN SMALL LETTER C + SOLIDUS + LATIN SMALL LETTER U
+	0x2107:  "Ɛ",                  // EULER CONSTANT → LATIN CAPITAL LETTER OPEN E
+	0x2108:  "Э",                  // SCRUPLE → CYRILLIC CAPITAL LETTER E
+	0x2109:  "°F",                 // DEGREE FAHRENHEIT → DEGREE SIGN + LATIN CAPITAL LETTER F
+	0x210A:  "g",                  // SCRIPT SMALL G → LATIN SMALL LETTER G
+	0x210B:  "H",                  // SCRIPT CAPITAL H → LATIN CAPITAL LETTER H
+	0x210C:  "H",                  // BLACK-LETTER CAPITAL H → LATIN CAPITAL LETTER H
+	0x210D:  "H",                  // DOUBLE-STRUCK CAPITAL H → LATIN CAPITAL LETTER H
+	0x210E:  "h",                  // PLANCK CONSTANT → LATIN SMALL LETTER H
+	0x210F:  "h̵",                 // PLANCK CONSTANT OVER TWO PI → LATIN SMALL LETTER H + COMBINING SHORT STROKE OVERLAY
+	0x2110:  "l",                  // SCRIPT CAPITAL I → LATIN SMALL LETTER L
+	0x2111:  "l",                  // BLACK-LETTER CAPITAL I → LATIN SMALL LETTER L
+	0x2112:  "L",                  // SCRIPT CAPITAL L → LATIN CAPITAL LETTER L
+	0x2113:  "l",                  // SCRIPT SMALL L → LATIN SMALL LETTER L
+	0x2115:  "N",                  // DOUBLE-STRUCK CAPITAL N → LATIN CAPITAL LETTER N
+	0x2116:  "No",                 // NUMERO SIGN → LATIN CAPITAL LETTER N + LATIN SMALL LETTER O
+	0x2119:  "P",                  // DOUBLE-STRUCK CAPITAL P → LATIN CAPITAL LETTER P
+	0x211A:  "Q",                  // DOUBLE-STRUCK CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x211B:  "R",                  // SCRIPT CAPITAL R → LATIN CAPITAL LETTER R
+	0x211C:  "R",                  // BLACK-LETTER CAPITAL R → LATIN CAPITAL LETTER R
+	0x211D:  "R",                  // DOUBLE-STRUCK CAPITAL R → LATIN CAPITAL LETTER R
+	0x2121:  "TEL",                // TELEPHONE SIGN → LATIN CAPITAL LETTER T + LATIN CAPITAL LETTER E + LATIN CAPITAL LETTER L
+	0x2124:  "Z",                  // DOUBLE-STRUCK CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x2126:  "Ω",                  // OHM SIGN → GREEK CAPITAL LETTER OMEGA
+	0x2127:  "Ʊ",                  // INVERTED OHM SIGN → LATIN CAPITAL LETTER UPSILON
+	0x2128:  "Z",                  // BLACK-LETTER CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x2129:  "ɿ",                  // TURNED GREEK SMALL LETTER IOTA → LATIN SMALL LETTER REVERSED R WITH FISHHOOK
+	0x212A:  "K",                  // KELVIN SIGN → LATIN CAPITAL LETTER K
+	0x212C:  "B",                  // SCRIPT CAPITAL B → LATIN CAPITAL LETTER B
+	0x212D:  "C",                  // BLACK-LETTER CAPITAL C → LATIN CAPITAL LETTER C
+	0x212E:  "e",                  // ESTIMATED SYMBOL → LATIN SMALL LETTER E
+	0x212F:  "e",                  // SCRIPT SMALL E → LATIN SMALL LETTER E
+	0x2130:  "E",                  // SCRIPT CAPITAL E → LATIN CAPITAL LETTER E
+	0x2131:  "F",                  // SCRIPT CAPITAL F → LATIN CAPITAL LETTER F
+	0x2133:  "M",                  // SCRIPT CAPITAL M → LATIN CAPITAL LETTER M
+	0x2134:  "o",                  // SCRIPT SMALL O → LATIN SMALL LETTER O
+	0x2135:  "א",                  // ALEF SYMBOL → HEBREW LETTER ALEF
+	0x2136:  "ב",                  // BET SYMBOL → HEBREW LETTER BET
+	0x2137:  "ג",                  // GIMEL SYMBOL → HEBREW LETTER GIMEL
+	0x2138:  "ד",                  // DALET SYMBOL → HEBREW LETTER DALET
+	0x2139:  "i",                  // INFORMATION SOURCE → LATIN SMALL LETTER I
+	0x213B:  "FAX",                // FACSIMILE SIGN → LATIN CAPITAL LETTER F + LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER X
+	0x213C:  "π",                  // DOUBLE-STRUCK SMALL PI → GREEK SMALL LETTER PI
+	0x213D:  "y",                  // DOUBLE-STRUCK SMALL GAMMA → LATIN SMALL LETTER Y
+	0x213E:  "Γ",                  // DOUBLE-STRUCK CAPITAL GAMMA → GREEK CAPITAL LETTER GAMMA
+	0x213F:  "Π",                  // DOUBLE-STRUCK CAPITAL PI → GREEK CAPITAL LETTER PI
+	0x2140:  "Ʃ",                  // DOUBLE-STRUCK N-ARY SUMMATION → LATIN CAPITAL LETTER ESH
+	0x2141:  "ꓨ",                  // TURNED SANS-SERIF CAPITAL G → LISU LETTER HHA
+	0x2142:  "ꓶ",                  // TURNED SANS-SERIF CAPITAL L → LISU LETTER UH
+	0x2143:  "𖼀",                  // REVERSED SANS-SERIF CAPITAL L → MIAO LETTER PA
+	0x2145:  "D",                  // DOUBLE-STRUCK ITALIC CAPITAL D → LATIN CAPITAL LETTER D
+	0x2146:  "d",                  // DOUBLE-STRUCK ITALIC SMALL D → LATIN SMALL LETTER D
+	0x2147:  "e",                  // DOUBLE-STRUCK ITALIC SMALL E → LATIN SMALL LETTER E
+	0x2148:  "i",                  // DOUBLE-STRUCK ITALIC SMALL I → LATIN SMALL LETTER I
+	0x2149:  "j",                  // DOUBLE-STRUCK ITALIC SMALL J → LATIN SMALL LETTER J
+	0x2160:  "l",                  // ROMAN NUMERAL ONE → LATIN SMALL LETTER L
+	0x2161:  "ll",                 // ROMAN NUMERAL TWO → LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x2162:  "lll",                // ROMAN NUMERAL THREE → LATIN SMALL LETTER L + LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x2163:  "lV",                 // ROMAN NUMERAL FOUR → LATIN SMALL LETTER L + LATIN CAPITAL LETTER V
+	0x2164:  "V",                  // ROMAN NUMERAL FIVE → LATIN CAPITAL LETTER V
+	0x2165:  "Vl",                 // ROMAN NUMERAL SIX → LATIN CAPITAL LETTER V + LATIN SMALL LETTER L
+	0x2166:  "Vll",                // ROMAN NUMERAL SEVEN → LATIN CAPITAL LETTER V + LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x2167:  "Vlll",               // ROMAN NUMERAL EIGHT → LATIN CAPITAL LETTER V + LATIN SMALL LETTER L + LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x2168:  "lX",                 // ROMAN NUMERAL NINE → LATIN SMALL LETTER L + LATIN CAPITAL LETTER X
+	0x2169:  "X",                  // ROMAN NUMERAL TEN → LATIN CAPITAL LETTER X
+	0x216A:  "Xl",                 // ROMAN NUMERAL ELEVEN → LATIN CAPITAL LETTER X + LATIN SMALL LETTER L
+	0x216B:  "Xll",                // ROMAN NUMERAL TWELVE → LATIN CAPITAL LETTER X + LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x216C:  "L",                  // ROMAN NUMERAL FIFTY → LATIN CAPITAL LETTER L
+	0x216D:  "C",                  // ROMAN NUMERAL ONE HUNDRED → LATIN CAPITAL LETTER C
+	0x216E:  "D",                  // ROMAN NUMERAL FIVE HUNDRED → LATIN CAPITAL LETTER D
+	0x216F:  "M",                  // ROMAN NUMERAL ONE THOUSAND → LATIN CAPITAL LETTER M
+	0x2170:  "i",                  // SMALL ROMAN NUMERAL ONE → LATIN SMALL LETTER I
+	0x2171:  "ii",                 // SMALL ROMAN NUMERAL TWO → LATIN SMALL LETTER I + LATIN SMALL LETTER I
+	0x2172:  "iii",                // SMALL ROMAN NUMERAL THREE → LATIN SMALL LETTER I + LATIN SMALL LETTER I + LATIN SMALL LETTER I
+	0x2173:  "iv",                 // SMALL ROMAN NUMERAL FOUR → LATIN SMALL LETTER I + LATIN SMALL LETTER V
+	0x2174:  "v",                  // SMALL ROMAN NUMERAL FIVE → LATIN SMALL LETTER V
+	0x2175:  "vi",                 // SMALL ROMAN NUMERAL SIX → LATIN SMALL LETTER V + LATIN SMALL LETTER I
+	0x2176:  "vii",                // SMALL ROMAN NUMERAL SEVEN → LATIN SMALL LETTER V + LATIN SMALL LETTER I + LATIN SMALL LETTER I
+	0x2177:  "viii",               // SMALL ROMAN NUMERAL EIGHT → LATIN SMALL LETTER V + LATIN SMALL LETTER I + LATIN SMALL LETTER I + LATIN SMALL LETTER I
+	0x2178:  "ix",                 // SMALL ROMAN NUMERAL NINE → LATIN SMALL LETTER I + LATIN SMALL LETTER X
+	0x2179:  "x",                  // SMALL ROMAN NUMERAL TEN → LATIN SMALL LETTER X
+	0x217A:  "xi",                 // SMALL ROMAN NUMERAL ELEVEN → LATIN SMALL LETTER X + LATIN SMALL LETTER I
+	0x217B:  "xii",                // SMALL ROMAN NUMERAL TWELVE → LATIN SMALL LETTER X + LATIN SMALL LETTER I + LATIN SMALL LETTER I
+	0x217C:  "l",                  // SMALL ROMAN NUMERAL FIFTY → LATIN SMALL LETTER L
+	0x217D:  "c",                  // SMALL ROMAN NUMERAL ONE HUNDRED → LATIN SMALL LETTER C
+	0x217E:  "d",                  // SMALL ROMAN NUMERAL FIVE HUNDRED → LATIN SMALL LETTER D
+	0x217F:  "rn",                 // SMALL ROMAN NUMERAL ONE THOUSAND → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x2183:  "Ɔ",                  // ROMAN NUMERAL REVERSED ONE HUNDRED → LATIN CAPITAL LETTER OPEN O
+	0x2184:  "ɔ",                  // LATIN SMALL LETTER REVERSED C → LATIN SMALL LETTER OPEN O
+	0x2191:  "ᛏ",                  // UPWARDS ARROW → RUNIC LETTER TIWAZ TIR TYR T
+	0x2195:  "ᛨ",                  // UP DOWN ARROW → RUNIC LETTER ICELANDIC-YR
+	0x21B5:  "↲",                  // DOWNWARDS ARROW WITH CORNER LEFTWARDS → DOWNWARDS ARROW WITH TIP LEFTWARDS
+	0x21BA:  "🄎",                  // ANTICLOCKWISE OPEN CIRCLE ARROW → CIRCLED ANTICLOCKWISE ARROW
+	0x21BE:  "ᛚ",                  // UPWARDS HARPOON WITH BARB RIGHTWARDS → RUNIC LETTER LAUKAZ LAGU LOGR L
+	0x21BF:  "ᛐ",                  // UPWARDS HARPOON WITH BARB LEFTWARDS → RUNIC LETTER SHORT-TWIG-TYR T
+	0x21C4:  "🣐",                  // ( ⇄ → 🣐 )
+	0x21CC:  "🣑",                  // ( ⇌ → 🣑 )
+	0x2200:  "Ɐ",                  // FOR ALL → LATIN CAPITAL LETTER TURNED A
+	0x2203:  "Ǝ",                  // THERE EXISTS → LATIN CAPITAL LETTER REVERSED E
+	0x2206:  "Δ",                  // INCREMENT → GREEK CAPITAL LETTER DELTA
+	0x220F:  "Π",                  // N-ARY PRODUCT → GREEK CAPITAL LETTER PI
+	0x2211:  "Ʃ",                  // N-ARY SUMMATION → LATIN CAPITAL LETTER ESH
+	0x2212:  "-",                  // MINUS SIGN → HYPHEN-MINUS
+	0x2214:  "+̇",                 // DOT PLUS → PLUS SIGN + COMBINING DOT ABOVE
+	0x2215:  "/",                  // DIVISION SLASH → SOLIDUS
+	0x2216:  "\\",                 // SET MINUS → REVERSE SOLIDUS
+	0x2217:  "*",                  // ASTERISK OPERATOR → ASTERISK
+	0x2218:  "°",                  // RING OPERATOR → DEGREE SIGN
+	0x2219:  "·",                  // BULLET OPERATOR → MIDDLE DOT
+	0x221E:  "oo",                 // INFINITY → LATIN SMALL LETTER O + LATIN SMALL LETTER O
+	0x2223:  "l",                  // DIVIDES → LATIN SMALL LETTER L
+	0x2225:  "ll",                 // PARALLEL TO → LATIN SMALL LETTER L + LATIN SMALL LETTER L
+	0x2228:  "v",                  // LOGICAL OR → LATIN SMALL LETTER V
+	0x2229:  "Ո",                  // INTERSECTION → ARMENIAN CAPITAL LETTER VO
+	0x222A:  "U",                  // UNION → LATIN CAPITAL LETTER U
+	0x222B:  "ʃ",                  // INTEGRAL → LATIN SMALL LETTER ESH
+	0x222C:  "ʃʃ",                 // DOUBLE INTEGRAL → LATIN SMALL LETTER ESH + LATIN SMALL LETTER ESH
+	0x222D:  "ʃʃʃ",                // TRIPLE INTEGRAL → LATIN SMALL LETTER ESH + LATIN SMALL LETTER ESH + LATIN SMALL LETTER ESH
+	0x222F:  "∮∮",                 // SURFACE INTEGRAL → CONTOUR INTEGRAL + CONTOUR INTEGRAL
+	0x2230:  "∮∮∮",                // VOLUME INTEGRAL → CONTOUR INTEGRAL + CONTOUR INTEGRAL + CONTOUR INTEGRAL
+	0x2236:  ":",                  // RATIO → COLON
+	0x2238:  "-̇",                 // DOT MINUS → HYPHEN-MINUS + COMBINING DOT ABOVE
+	0x223C:  "~",                  // TILDE OPERATOR → TILDE
+	0x2250:  "=̇",                 // APPROACHES THE LIMIT → EQUALS SIGN + COMBINING DOT ABOVE
+	0x2251:  "=̣̇",                // GEOMETRICALLY EQUAL TO → EQUALS SIGN + COMBINING DOT ABOVE + COMBINING DOT BELOW
+	0x2257:  "=̊",                 // RING EQUAL TO → EQUALS SIGN + COMBINING RING ABOVE
+	0x2259:  "=̂",                 // ESTIMATES → EQUALS SIGN + COMBINING CIRCUMFLEX ACCENT
+	0x225A:  "=̆",                 // EQUIANGULAR TO → EQUALS SIGN + COMBINING BREVE
+	0x225E:  "=ͫ",                 // MEASURED BY → EQUALS SIGN + COMBINING LATIN SMALL LETTER M
+	0x2263:  "≡",                  // STRICTLY EQUIVALENT TO → IDENTICAL TO
+	0x226A:  "<<",                 // MUCH LESS-THAN → LESS-THAN SIGN + LESS-THAN SIGN
+	0x226B:  ">>",                 // MUCH GREATER-THAN → GREATER-THAN SIGN + GREATER-THAN SIGN
+	0x2282:  "ᑕ",                  // SUBSET OF → CANADIAN SYLLABICS TA
+	0x2283:  "ᑐ",                  // SUPERSET OF → CANADIAN SYLLABICS TO
+	0x2295:  "𐊨",                  // CIRCLED PLUS → CARIAN LETTER Q
+	0x2296:  "O̵",                 // CIRCLED MINUS → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x2299:  "ʘ",                  // CIRCLED DOT OPERATOR → LATIN LETTER BILABIAL CLICK
+	0x229D:  "O̵",                 // CIRCLED DASH → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x22A4:  "T",                  // DOWN TACK → LATIN CAPITAL LETTER T
+	0x22A5:  "ꓕ",                  // UP TACK → LISU LETTER THA
+	0x22C0:  "∧",                  // N-ARY LOGICAL AND → LOGICAL AND
+	0x22C1:  "v",                  // N-ARY LOGICAL OR → LATIN SMALL LETTER V
+	0x22C2:  "Ո",                  // N-ARY INTERSECTION → ARMENIAN CAPITAL LETTER VO
+	0x22C3:  "U",                  // N-ARY UNION → LATIN CAPITAL LETTER U
+	0x22C4:  "ᛜ",                  // DIAMOND OPERATOR → RUNIC LETTER INGWAZ
+	0x22C5:  "·",                  // DOT OPERATOR → MIDDLE DOT
+	0x22C8:  "ᛞ",                  // BOWTIE → RUNIC LETTER DAGAZ DAEG D
+	0x22D6:  "<·",                 // LESS-THAN WITH DOT → LESS-THAN SIGN + MIDDLE DOT
+	0x22D7:  "·>",                 // GREATER-THAN WITH DOT → MIDDLE DOT + GREATER-THAN SIGN
+	0x22D8:  "<<<",                // VERY MUCH LESS-THAN → LESS-THAN SIGN + LESS-THAN SIGN + LESS-THAN SIGN
+	0x22D9:  ">>>",                // VERY MUCH GREATER-THAN → GREATER-THAN SIGN + GREATER-THAN SIGN + GREATER-THAN SIGN
+	0x22EE:  "ⵗ",                  // VERTICAL ELLIPSIS → TIFINAGH LETTER TUAREG YAGH
+	0x22EF:  "···",                // MIDLINE HORIZONTAL ELLIPSIS → MIDDLE DOT + MIDDLE DOT + MIDDLE DOT
+	0x22F4:  "ꞓ",                  // SMALL ELEMENT OF WITH VERTICAL BAR AT END OF HORIZONTAL STROKE → LATIN SMALL LETTER C WITH BAR
+	0x22FF:  "E",                  // Z NOTATION BAG MEMBERSHIP → LATIN CAPITAL LETTER E
+	0x2300:  "∅",                  // DIAMETER SIGN → EMPTY SET
+	0x2325:  "⌤",                  // OPTION KEY → UP ARROWHEAD BETWEEN TWO HORIZONTAL BARS
+	0x2329:  "❬",                  // LEFT-POINTING ANGLE BRACKET → MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT
+	0x232A:  "❭",                  // RIGHT-POINTING ANGLE BRACKET → MEDIUM RIGHT-POINTING ANGLE BRACKET ORNAMENT
+	0x2341:  "〼",                  // APL FUNCTIONAL SYMBOL QUAD SLASH → MASU MARK
+	0x2359:  "Δ̲",                 // APL FUNCTIONAL SYMBOL DELTA UNDERBAR → GREEK CAPITAL LETTER DELTA + COMBINING LOW LINE
+	0x235A:  "ᛜ̲",                 // APL FUNCTIONAL SYMBOL DIAMOND UNDERBAR → RUNIC LETTER INGWAZ + COMBINING LOW LINE
+	0x235C:  "°̲",                 // APL FUNCTIONAL SYMBOL CIRCLE UNDERBAR → DEGREE SIGN + COMBINING LOW LINE
+	0x235F:  "⊛",                  // APL FUNCTIONAL SYMBOL CIRCLE STAR → CIRCLED ASTERISK OPERATOR
+	0x2361:  "T̈",                 // APL FUNCTIONAL SYMBOL UP TACK DIAERESIS → LATIN CAPITAL LETTER T + COMBINING DIAERESIS
+	0x2362:  "∇̈",                 // APL FUNCTIONAL SYMBOL DEL DIAERESIS → NABLA + COMBINING DIAERESIS
+	0x2363:  "⋆̈",                 // APL FUNCTIONAL SYMBOL STAR DIAERESIS → STAR OPERATOR + COMBINING DIAERESIS
+	0x2364:  "°̈",                 // APL FUNCTIONAL SYMBOL JOT DIAERESIS → DEGREE SIGN + COMBINING DIAERESIS
+	0x2365:  "ة",                  // APL FUNCTIONAL SYMBOL CIRCLE DIAERESIS → ARABIC LETTER TEH MARBUTA
+	0x2368:  "~̈",                 // APL FUNCTIONAL SYMBOL TILDE DIAERESIS → TILDE + COMBINING DIAERESIS
+	0x2369:  "ᐵ",                  // APL FUNCTIONAL SYMBOL GREATER-THAN DIAERESIS → CANADIAN SYLLABICS Y-CREE POO
+	0x236B:  "∇̴",                 // APL FUNCTIONAL SYMBOL DEL TILDE → NABLA + COMBINING TILDE OVERLAY
+	0x236C:  "O̵",                 // APL FUNCTIONAL SYMBOL ZILDE → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x2373:  "i",                  // APL FUNCTIONAL SYMBOL IOTA → LATIN SMALL LETTER I
+	0x2374:  "p",                  // APL FUNCTIONAL SYMBOL RHO → LATIN SMALL LETTER P
+	0x2375:  "ω",                  // APL FUNCTIONAL SYMBOL OMEGA → GREEK SMALL LETTER OMEGA
+	0x2376:  "a̲",                 // APL FUNCTIONAL SYMBOL ALPHA UNDERBAR → LATIN SMALL LETTER A + COMBINING LOW LINE
+	0x2377:  "ꞓ̲",                 // APL FUNCTIONAL SYMBOL EPSILON UNDERBAR → LATIN SMALL LETTER C WITH BAR + COMBINING LOW LINE
+	0x2378:  "i̲",                 // APL FUNCTIONAL SYMBOL IOTA UNDERBAR → LATIN SMALL LETTER I + COMBINING LOW LINE
+	0x2379:  "ω̲",                 // APL FUNCTIONAL SYMBOL OMEGA UNDERBAR → GREEK SMALL LETTER OMEGA + COMBINING LOW LINE
+	0x237A:  "a",                  // APL FUNCTIONAL SYMBOL ALPHA → LATIN SMALL LETTER A
+	0x237F:  "ᚽ",                  // VERTICAL LINE WITH MIDDLE DOT → RUNIC LETTER SHORT-TWIG-HAGALL H
+	0x239C:  "丨",                  // LEFT PARENTHESIS EXTENSION → <CJK Ideograph>
+	0x239F:  "丨",                  // RIGHT PARENTHESIS EXTENSION → <CJK Ideograph>
+	0x23A2:  "丨",                  // LEFT SQUARE BRACKET EXTENSION → <CJK Ideograph>
+	0x23A5:  "丨",                  // RIGHT SQUARE BRACKET EXTENSION → <CJK Ideograph>
+	0x23AA:  "丨",                  // CURLY BRACKET EXTENSION → <CJK Ideograph>
+	0x23AE:  "丨",                  // INTEGRAL EXTENSION → <CJK Ideograph>
+	0x23C1:  "⍕",                  // DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL WITH CIRCLE → APL FUNCTIONAL SYMBOL UP TACK JOT
+	0x23C2:  "⍎",                  // DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL WITH CIRCLE → APL FUNCTIONAL SYMBOL DOWN TACK JOT
+	0x23C3:  "⍋",                  // DENTISTRY SYMBOL LIGHT VERTICAL WITH TRIANGLE → APL FUNCTIONAL SYMBOL DELTA STILE
+	0x23C6:  "⍭",                  // DENTISTRY SYMBOL LIGHT VERTICAL AND WAVE → APL FUNCTIONAL SYMBOL STILE TILDE
+	0x23E8:  "₁₀",                 // DECIMAL EXPONENT SYMBOL → SUBSCRIPT ONE + SUBSCRIPT ZERO
+	0x23FC:  "⏻",                  // POWER ON-OFF SYMBOL → POWER SYMBOL
+	0x23FD:  "l",                  // POWER ON SYMBOL → LATIN SMALL LETTER L
+	0x23FE:  "☾",                  // POWER SLEEP SYMBOL → LAST QUARTER MOON
+	0x244A:  "\\\\",               // OCR DOUBLE BACKSLASH → REVERSE SOLIDUS + REVERSE SOLIDUS
+	0x2460:  "➀",                  // CIRCLED DIGIT ONE → DINGBAT CIRCLED SANS-SERIF DIGIT ONE
+	0x2461:  "➁",                  // CIRCLED DIGIT TWO → DINGBAT CIRCLED SANS-SERIF DIGIT TWO
+	0x2462:  "➂",                  // CIRCLED DIGIT THREE → DINGBAT CIRCLED SANS-SERIF DIGIT THREE
+	0x2463:  "➃",                  // CIRCLED DIGIT FOUR → DINGBAT CIRCLED SANS-SERIF DIGIT FOUR
+	0x2464:  "➄",                  // CIRCLED DIGIT FIVE → DINGBAT CIRCLED SANS-SERIF DIGIT FIVE
+	0x2465:  "➅",                  // CIRCLED DIGIT SIX → DINGBAT CIRCLED SANS-SERIF DIGIT SIX
+	0x2466:  "➆",                  // CIRCLED DIGIT SEVEN → DINGBAT CIRCLED SANS-SERIF DIGIT SEVEN
+	0x2467:  "➇",                  // CIRCLED DIGIT EIGHT → DINGBAT CIRCLED SANS-SERIF DIGIT EIGHT
+	0x2468:  "➈",                  // CIRCLED DIGIT NINE → DINGBAT CIRCLED SANS-SERIF DIGIT NINE
+	0x2469:  "➉",                  // CIRCLED NUMBER TEN → DINGBAT CIRCLED SANS-SERIF NUMBER TEN
+	0x2474:  "(l)",                // ) PARENTHESIZED DIGIT ONE → LEFT PARENTHESIS + LATIN SMALL LETTER L + RIGHT PARENTHESIS
+	0x2475:  "(2)",                // ) PARENTHESIZED DIGIT TWO → LEFT PARENTHESIS + DIGIT TWO + RIGHT PARENTHESIS
+	0x2476:  "(3)",                // ) PARENTHESIZED DIGIT THREE → LEFT PARENTHESIS + DIGIT THREE + RIGHT PARENTHESIS
+	0x2477:  "(4)",                // ) PARENTHESIZED DIGIT FOUR → LEFT PARENTHESIS + DIGIT FOUR + RIGHT PARENTHESIS
+	0x2478:  "(5)",                // ) PARENTHESIZED DIGIT FIVE → LEFT PARENTHESIS + DIGIT FIVE + RIGHT PARENTHESIS
+	0x2479:  "(6)",                // ) PARENTHESIZED DIGIT SIX → LEFT PARENTHESIS + DIGIT SIX + RIGHT PARENTHESIS
+	0x247A:  "(7)",                // ) PARENTHESIZED DIGIT SEVEN → LEFT PARENTHESIS + DIGIT SEVEN + RIGHT PARENTHESIS
+	0x247B:  "(8)",                // ) PARENTHESIZED DIGIT EIGHT → LEFT PARENTHESIS + DIGIT EIGHT + RIGHT PARENTHESIS
+	0x247C:  "(9)",                // ) PARENTHESIZED DIGIT NINE → LEFT PARENTHESIS + DIGIT NINE + RIGHT PARENTHESIS
+	0x247D:  "(lO)",               // ) PARENTHESIZED NUMBER TEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + LATIN CAPITAL LETTER O + RIGHT PARENTHESIS
+	0x247E:  "(ll)",               // ) PARENTHESIZED NUMBER ELEVEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + LATIN SMALL LETTER L + RIGHT PARENTHESIS
+	0x247F:  "(l2)",               // ) PARENTHESIZED NUMBER TWELVE → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT TWO + RIGHT PARENTHESIS
+	0x2480:  "(l3)",               // ) PARENTHESIZED NUMBER THIRTEEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT THREE + RIGHT PARENTHESIS
+	0x2481:  "(l4)",               // ) PARENTHESIZED NUMBER FOURTEEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT FOUR + RIGHT PARENTHESIS
+	0x2482:  "(l5)",               // ) PARENTHESIZED NUMBER FIFTEEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT FIVE + RIGHT PARENTHESIS
+	0x2483:  "(l6)",               // ) PARENTHESIZED NUMBER SIXTEEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT SIX + RIGHT PARENTHESIS
+	0x2484:  "(l7)",               // ) PARENTHESIZED NUMBER SEVENTEEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT SEVEN + RIGHT PARENTHESIS
+	0x2485:  "(l8)",               // ) PARENTHESIZED NUMBER EIGHTEEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT EIGHT + RIGHT PARENTHESIS
+	0x2486:  "(l9)",               // ) PARENTHESIZED NUMBER NINETEEN → LEFT PARENTHESIS + LATIN SMALL LETTER L + DIGIT NINE + RIGHT PARENTHESIS
+	0x2487:  "(2O)",               // ) PARENTHESIZED NUMBER TWENTY → LEFT PARENTHESIS + DIGIT TWO + LATIN CAPITAL LETTER O + RIGHT PARENTHESIS
+	0x2488:  "l.",                 // DIGIT ONE FULL STOP → LATIN SMALL LETTER L + FULL STOP
+	0x2489:  "2.",                 // DIGIT TWO FULL STOP → DIGIT TWO + FULL STOP
+	0x248A:  "3.",                 // DIGIT THREE FULL STOP → DIGIT THREE + FULL STOP
+	0x248B:  "4.",                 // DIGIT FOUR FULL STOP → DIGIT FOUR + FULL STOP
+	0x248C:  "5.",                 // DIGIT FIVE FULL STOP → DIGIT FIVE + FULL STOP
+	0x248D:  "6.",                 // DIGIT SIX FULL STOP → DIGIT SIX + FULL STOP
+	0x248E:  "7.",                 // DIGIT SEVEN FULL STOP → DIGIT SEVEN + FULL STOP
+	0x248F:  "8.",                 // DIGIT EIGHT FULL STOP → DIGIT EIGHT + FULL STOP
+	0x2490:  "9.",                 // DIGIT NINE FULL STOP → DIGIT NINE + FULL STOP
+	0x2491:  "lO.",                // NUMBER TEN FULL STOP → LATIN SMALL LETTER L + LATIN CAPITAL LETTER O + FULL STOP
+	0x2492:  "ll.",                // NUMBER ELEVEN FULL STOP → LATIN SMALL LETTER L + LATIN SMALL LETTER L + FULL STOP
+	0x2493:  "l2.",                // NUMBER TWELVE FULL STOP → LATIN SMALL LETTER L + DIGIT TWO + FULL STOP
+	0x2494:  "l3.",                // NUMBER THIRTEEN FULL STOP → LATIN SMALL LETTER L + DIGIT THREE + FULL STOP
+	0x2495:  "l4.",                // NUMBER FOURTEEN FULL STOP → LATIN SMALL LETTER L + DIGIT FOUR + FULL STOP
+	0x2496:  "l5.",                // NUMBER FIFTEEN FULL STOP → LATIN SMALL LETTER L + DIGIT FIVE + FULL STOP
+	0x2497:  "l6.",                // NUMBER SIXTEEN FULL STOP → LATIN SMALL LETTER L + DIGIT SIX + FULL STOP
+	0x2498:  "l7.",                // NUMBER SEVENTEEN FULL STOP → LATIN SMALL LETTER L + DIGIT SEVEN + FULL STOP
+	0x2499:  "l8.",                // NUMBER EIGHTEEN FULL STOP → LATIN SMALL LETTER L + DIGIT EIGHT + FULL STOP
+	0x249A:  "l9.",                // NUMBER NINETEEN FULL STOP → LATIN SMALL LETTER L + DIGIT NINE + FULL STOP
+	0x249B:  "2O.",                // NUMBER TWENTY FULL STOP → DIGIT TWO + LATIN CAPITAL LETTER O + FULL STOP
+	0x249C:  "(a)",                // ) PARENTHESIZED LATIN SMALL LETTER A → LEFT PARENTHESIS + LATIN SMALL LETTER A + RIGHT PARENTHESIS
+	0x249D:  "(b)",                // ) PARENTHESIZED LATIN SMALL LETTER B → LEFT PARENTHESIS + LATIN SMALL LETTER B + RIGHT PARENTHESIS
+	0x249E:  "(c)",                // ) PARENTHESIZED LATIN SMALL LETTER C → LEFT PARENTHESIS + LATIN SMALL LETTER C + RIGHT PARENTHESIS
+	0x249F:  "(d)",                // ) PARENTHESIZED LATIN SMALL LETTER D → LEFT PARENTHESIS + LATIN SMALL LETTER D + RIGHT PARENTHESIS
+	0x24A0:  "(e)",                // ) PARENTHESIZED LATIN SMALL LETTER E → LEFT PARENTHESIS + LATIN SMALL LETTER E + RIGHT PARENTHESIS
+	0x24A1:  "(f)",                // ) PARENTHESIZED LATIN SMALL LETTER F → LEFT PARENTHESIS + LATIN SMALL LETTER F + RIGHT PARENTHESIS
+	0x24A2:  "(g)",                // ) PARENTHESIZED LATIN SMALL LETTER G → LEFT PARENTHESIS + LATIN SMALL LETTER G + RIGHT PARENTHESIS
+	0x24A3:  "(h)",                // ) PARENTHESIZED LATIN SMALL LETTER H → LEFT PARENTHESIS + LATIN SMALL LETTER H + RIGHT PARENTHESIS
+	0x24A4:  "(i)",                // ) PARENTHESIZED LATIN SMALL LETTER I → LEFT PARENTHESIS + LATIN SMALL LETTER I + RIGHT PARENTHESIS
+	0x24A5:  "(j)",                // ) PARENTHESIZED LATIN SMALL LETTER J → LEFT PARENTHESIS + LATIN SMALL LETTER J + RIGHT PARENTHESIS
+	0x24A6:  "(k)",                // ) PARENTHESIZED LATIN SMALL LETTER K → LEFT PARENTHESIS + LATIN SMALL LETTER K + RIGHT PARENTHESIS
+	0x24A7:  "(l)",                // ) PARENTHESIZED LATIN SMALL LETTER L → LEFT PARENTHESIS + LATIN SMALL LETTER L + RIGHT PARENTHESIS
+	0x24A8:  "(rn)",               // ) PARENTHESIZED LATIN SMALL LETTER M → LEFT PARENTHESIS + LATIN SMALL LETTER R + LATIN SMALL LETTER N + RIGHT PARENTHESIS
+	0x24A9:  "(n)",                // ) PARENTHESIZED LATIN SMALL LETTER N → LEFT PARENTHESIS + LATIN SMALL LETTER N + RIGHT PARENTHESIS
+	0x24AA:  "(o)",                // ) PARENTHESIZED LATIN SMALL LETTER O → LEFT PARENTHESIS + LATIN SMALL LETTER O + RIGHT PARENTHESIS
+	0x24AB:  "(p)",                // ) PARENTHESIZED LATIN SMALL LETTER P → LEFT PARENTHESIS + LATIN SMALL LETTER P + RIGHT PARENTHESIS
+	0x24AC:  "(q)",                // ) PARENTHESIZED LATIN SMALL LETTER Q → LEFT PARENTHESIS + LATIN SMALL LETTER Q + RIGHT PARENTHESIS
+	0x24AD:  "(r)",                // ) PARENTHESIZED LATIN SMALL LETTER R → LEFT PARENTHESIS + LATIN SMALL LETTER R + RIGHT PARENTHESIS
+	0x24AE:  "(s)",                // ) PARENTHESIZED LATIN SMALL LETTER S → LEFT PARENTHESIS + LATIN SMALL LETTER S + RIGHT PARENTHESIS
+	0x24AF:  "(t)",                // ) PARENTHESIZED LATIN SMALL LETTER T → LEFT PARENTHESIS + LATIN SMALL LETTER T + RIGHT PARENTHESIS
+	0x24B0:  "(u)",                // ) PARENTHESIZED LATIN SMALL LETTER U → LEFT PARENTHESIS + LATIN SMALL LETTER U + RIGHT PARENTHESIS
+	0x24B1:  "(v)",                // ) PARENTHESIZED LATIN SMALL LETTER V → LEFT PARENTHESIS + LATIN SMALL LETTER V + RIGHT PARENTHESIS
+	0x24B2:  "(w)",                // ) PARENTHESIZED LATIN SMALL LETTER W → LEFT PARENTHESIS + LATIN SMALL LETTER W + RIGHT PARENTHESIS
+	0x24B3:  "(x)",                // ) PARENTHESIZED LATIN SMALL LETTER X → LEFT PARENTHESIS + LATIN SMALL LETTER X + RIGHT PARENTHESIS
+	0x24B4:  "(y)",                // ) PARENTHESIZED LATIN SMALL LETTER Y → LEFT PARENTHESIS + LATIN SMALL LETTER Y + RIGHT PARENTHESIS
+	0x24B5:  "(z)",                // ) PARENTHESIZED LATIN SMALL LETTER Z → LEFT PARENTHESIS + LATIN SMALL LETTER Z + RIGHT PARENTHESIS
+	0x24B8:  "©",                  // CIRCLED LATIN CAPITAL LETTER C → COPYRIGHT SIGN
+	0x24C5:  "℗",                  // CIRCLED LATIN CAPITAL LETTER P → SOUND RECORDING COPYRIGHT
+	0x24C7:  "®",                  // CIRCLED LATIN CAPITAL LETTER R → REGISTERED SIGN
+	0x24DB:  "Ⓘ",                  // CIRCLED LATIN SMALL LETTER L → CIRCLED LATIN CAPITAL LETTER I
+	0x24EA:  "🄍",                  // CIRCLED DIGIT ZERO → CIRCLED ZERO WITH SLASH
+	0x2500:  "ー",                  // BOX DRAWINGS LIGHT HORIZONTAL → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x2501:  "ー",                  // BOX DRAWINGS HEAVY HORIZONTAL → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x2503:  "│",                  // BOX DRAWINGS HEAVY VERTICAL → BOX DRAWINGS LIGHT VERTICAL
+	0x250F:  "┌",                  // BOX DRAWINGS HEAVY DOWN AND RIGHT → BOX DRAWINGS LIGHT DOWN AND RIGHT
+	0x2523:  "├",                  // BOX DRAWINGS HEAVY VERTICAL AND RIGHT → BOX DRAWINGS LIGHT VERTICAL AND RIGHT
+	0x256A:  "ǂ",                  // BOX DRAWINGS VERTICAL SINGLE AND HORIZONTAL DOUBLE → LATIN LETTER ALVEOLAR CLICK
+	0x2571:  "/",                  // BOX DRAWINGS LIGHT DIAGONAL UPPER RIGHT TO LOWER LEFT → SOLIDUS
+	0x2573:  "X",                  // BOX DRAWINGS LIGHT DIAGONAL CROSS → LATIN CAPITAL LETTER X
+	0x2588:  "∎",                  // FULL BLOCK → END OF PROOF
+	0x2590:  "▌",                  // RIGHT HALF BLOCK → LEFT HALF BLOCK
+	0x2594:  "ˉ",                  // UPPER ONE EIGHTH BLOCK → MODIFIER LETTER MACRON
+	0x2597:  "▖",                  // QUADRANT LOWER RIGHT → QUADRANT LOWER LEFT
+	0x259D:  "▘",                  // QUADRANT UPPER RIGHT → QUADRANT UPPER LEFT
+	0x25A0:  "∎",                  // BLACK SQUARE → END OF PROOF
+	0x25B1:  "⏥",                  // WHITE PARALLELOGRAM → FLATNESS
+	0x25B3:  "Δ",                  // WHITE UP-POINTING TRIANGLE → GREEK CAPITAL LETTER DELTA
+	0x25B7:  "⊳",                  // WHITE RIGHT-POINTING TRIANGLE → CONTAINS AS NORMAL SUBGROUP
+	0x25B8:  "▶",                  // BLACK RIGHT-POINTING SMALL TRIANGLE → BLACK RIGHT-POINTING TRIANGLE
+	0x25BA:  "▶",                  // BLACK RIGHT-POINTING POINTER → BLACK RIGHT-POINTING TRIANGLE
+	0x25BD:  "𐊼",                  // WHITE DOWN-POINTING TRIANGLE → CARIAN LETTER K
+	0x25C1:  "⊲",                  // WHITE LEFT-POINTING TRIANGLE → NORMAL SUBGROUP OF
+	0x25C7:  "ᛜ",                  // WHITE DIAMOND → RUNIC LETTER INGWAZ
+	0x25CA:  "ᛜ",                  // LOZENGE → RUNIC LETTER INGWAZ
+	0x25CB:  "°",                  // WHITE CIRCLE → DEGREE SIGN
+	0x25CE:  "⌾",                  // BULLSEYE → APL FUNCTIONAL SYMBOL CIRCLE JOT
+	0x25E0:  "⌒",                  // UPPER HALF CIRCLE → ARC
+	0x25E6:  "°",                  // WHITE BULLET → DEGREE SIGN
+	0x2609:  "ʘ",                  // SUN → LATIN LETTER BILABIAL CLICK
+	0x2610:  "□",                  // BALLOT BOX → WHITE SQUARE
+	0x2625:  "𐦞",                  // ANKH → MEROITIC HIEROGLYPHIC SYMBOL VIDJ
+	0x2630:  "Ξ",                  // TRIGRAM FOR HEAVEN → GREEK CAPITAL LETTER XI
+	0x2638:  "⎈",                  // WHEEL OF DHARMA → HELM SYMBOL
+	0x264E:  "≏",                  // LIBRA → DIFFERENCE BETWEEN
+	0x2657:  "🩕",                  // ( ♗ → 🩕 )
+	0x265D:  "🩗",                  // ( ♝ → 🩗 )
+	0x2662:  "ᛜ",                  // WHITE DIAMOND SUIT → RUNIC LETTER INGWAZ
+	0x2669:  "𝅘𝅥",                 // QUARTER NOTE → MUSICAL SYMBOL NOTEHEAD BLACK + MUSICAL SYMBOL COMBINING STEM
+	0x266A:  "𝅘𝅥𝅮",                // EIGHTH NOTE → MUSICAL SYMBOL NOTEHEAD BLACK + MUSICAL SYMBOL COMBINING STEM + MUSICAL SYMBOL COMBINING FLAG-1
+	0x26AC:  "॰",                  // MEDIUM SMALL WHITE CIRCLE → DEVANAGARI ABBREVIATION SIGN
+	0x2768:  "(",                  // MEDIUM LEFT PARENTHESIS ORNAMENT → LEFT PARENTHESIS
+	0x2769:  ")",                  // ) MEDIUM RIGHT PARENTHESIS ORNAMENT → RIGHT PARENTHESIS
+	0x276E:  "<",                  // HEAVY LEFT-POINTING ANGLE QUOTATION MARK ORNAMENT → LESS-THAN SIGN
+	0x276F:  ">",                  // HEAVY RIGHT-POINTING ANGLE QUOTATION MARK ORNAMENT → GREATER-THAN SIGN
+	0x2772:  "(",                  // LIGHT LEFT TORTOISE SHELL BRACKET ORNAMENT → LEFT PARENTHESIS
+	0x2773:  ")",                  // ) LIGHT RIGHT TORTOISE SHELL BRACKET ORNAMENT → RIGHT PARENTHESIS
+	0x2774:  "{",                  // MEDIUM LEFT CURLY BRACKET ORNAMENT → LEFT CURLY BRACKET
+	0x2775:  "}",                  // MEDIUM RIGHT CURLY BRACKET ORNAMENT → RIGHT CURLY BRACKET
+	0x2795:  "+",                  // HEAVY PLUS SIGN → PLUS SIGN
+	0x2796:  "-",                  // HEAVY MINUS SIGN → HYPHEN-MINUS
+	0x2797:  "÷",                  // HEAVY DIVISION SIGN → DIVISION SIGN
+	0x27C2:  "ꓕ",                  // PERPENDICULAR → LISU LETTER THA
+	0x27C8:  "\\ᑕ",                // REVERSE SOLIDUS PRECEDING SUBSET → REVERSE SOLIDUS + CANADIAN SYLLABICS TA
+	0x27C9:  "ᑐ/",                 // SUPERSET PRECEDING SOLIDUS → CANADIAN SYLLABICS TO + SOLIDUS
+	0x27CB:  "/",                  // MATHEMATICAL RISING DIAGONAL → SOLIDUS
+	0x27CD:  "\\",                 // MATHEMATICAL FALLING DIAGONAL → REVERSE SOLIDUS
+	0x27D9:  "T",                  // LARGE DOWN TACK → LATIN CAPITAL LETTER T
+	0x27E8:  "❬",                  // MATHEMATICAL LEFT ANGLE BRACKET → MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT
+	0x27E9:  "❭",                  // MATHEMATICAL RIGHT ANGLE BRACKET → MEDIUM RIGHT-POINTING ANGLE BRACKET ORNAMENT
+	0x28FF:  "𜻠",                  // ( ⣿ → 𜻠 )
+	0x292B:  "x",                  // RISING DIAGONAL CROSSING FALLING DIAGONAL → LATIN SMALL LETTER X
+	0x292C:  "x",                  // FALLING DIAGONAL CROSSING RISING DIAGONAL → LATIN SMALL LETTER X
+	0x2963:  "ᛐᛚ",                 // UPWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT → RUNIC LETTER SHORT-TWIG-TYR T + RUNIC LETTER LAUKAZ LAGU LOGR L
+	0x2965:  "⇃⇂",                 // DOWNWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT → DOWNWARDS HARPOON WITH BARB LEFTWARDS + DOWNWARDS HARPOON WITH BARB RIGHTWARDS
+	0x296E:  "ᛐ⇂",                 // UPWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT → RUNIC LETTER SHORT-TWIG-TYR T + DOWNWARDS HARPOON WITH BARB RIGHTWARDS
+	0x296F:  "⇃ᛚ",                 // DOWNWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT → DOWNWARDS HARPOON WITH BARB LEFTWARDS + RUNIC LETTER LAUKAZ LAGU LOGR L
+	0x2999:  "ⵂ",                  // DOTTED FENCE → TIFINAGH LETTER TUAREG YAH
+	0x29B0:  "⍉",                  // REVERSED EMPTY SET → APL FUNCTIONAL SYMBOL CIRCLE BACKSLASH
+	0x29B5:  "𜻰",                  // ( ⦵ → 𜻰 )
+	0x29BE:  "⌾",                  // CIRCLED WHITE BULLET → APL FUNCTIONAL SYMBOL CIRCLE JOT
+	0x29C4:  "〼",                  // SQUARED RISING DIAGONAL SLASH → MASU MARK
+	0x29C5:  "⍂",                  // SQUARED FALLING DIAGONAL SLASH → APL FUNCTIONAL SYMBOL QUAD BACKSLASH
+	0x29C7:  "⌻",                  // SQUARED SMALL CIRCLE → APL FUNCTIONAL SYMBOL QUAD JOT
+	0x29D6:  "𐋀",                  // WHITE HOURGLASS → CARIAN LETTER G
+	0x29D9:  "⦚",                  // RIGHT WIGGLY FENCE → VERTICAL ZIGZAG LINE
+	0x29F4:  ":→",                 // RULE-DELAYED → COLON + RIGHTWARDS ARROW
+	0x29F5:  "\\",                 // REVERSE SOLIDUS OPERATOR → REVERSE SOLIDUS
+	0x29F6:  "/̄",                 // SOLIDUS WITH OVERBAR → SOLIDUS + COMBINING MACRON
+	0x29F8:  "/",                  // BIG SOLIDUS → SOLIDUS
+	0x29F9:  "\\",                 // BIG REVERSE SOLIDUS → REVERSE SOLIDUS
+	0x2A00:  "ʘ",                  // N-ARY CIRCLED DOT OPERATOR → LATIN LETTER BILABIAL CLICK
+	0x2A01:  "𐊨",                  // N-ARY CIRCLED PLUS OPERATOR → CARIAN LETTER Q
+	0x2A02:  "⊗",                  // N-ARY CIRCLED TIMES OPERATOR → CIRCLED TIMES
+	0x2A03:  "⊍",                  // N-ARY UNION OPERATOR WITH DOT → MULTISET MULTIPLICATION
+	0x2A04:  "⊎",                  // N-ARY UNION OPERATOR WITH PLUS → MULTISET UNION
+	0x2A05:  "⊓",                  // N-ARY SQUARE INTERSECTION OPERATOR → SQUARE CAP
+	0x2A06:  "⊔",                  // N-ARY SQUARE UNION OPERATOR → SQUARE CUP
+	0x2A0C:  "ʃʃʃʃ",               // QUADRUPLE INTEGRAL OPERATOR → LATIN SMALL LETTER ESH + LATIN SMALL LETTER ESH + LATIN SMALL LETTER ESH + LATIN SMALL LETTER ESH
+	0x2A1D:  "ᛞ",                  // JOIN → RUNIC LETTER DAGAZ DAEG D
+	0x2A20:  ">>",                 // Z NOTATION SCHEMA PIPING → GREATER-THAN SIGN + GREATER-THAN SIGN
+	0x2A21:  "ᛚ",                  // Z NOTATION SCHEMA PROJECTION → RUNIC LETTER LAUKAZ LAGU LOGR L
+	0x2A22:  "+̊",                 // PLUS SIGN WITH SMALL CIRCLE ABOVE → PLUS SIGN + COMBINING RING ABOVE
+	0x2A23:  "+̂",                 // PLUS SIGN WITH CIRCUMFLEX ACCENT ABOVE → PLUS SIGN + COMBINING CIRCUMFLEX ACCENT
+	0x2A24:  "+̃",                 // PLUS SIGN WITH TILDE ABOVE → PLUS SIGN + COMBINING TILDE
+	0x2A25:  "+̣",                 // PLUS SIGN WITH DOT BELOW → PLUS SIGN + COMBINING DOT BELOW
+	0x2A26:  "+̰",                 // PLUS SIGN WITH TILDE BELOW → PLUS SIGN + COMBINING TILDE BELOW
+	0x2A27:  "+₂",                 // PLUS SIGN WITH SUBSCRIPT TWO → PLUS SIGN + SUBSCRIPT TWO
+	0x2A29:  "-̓",                 // MINUS SIGN WITH COMMA ABOVE → HYPHEN-MINUS + COMBINING COMMA ABOVE
+	0x2A2A:  "-̣",                 // MINUS SIGN WITH DOT BELOW → HYPHEN-MINUS + COMBINING DOT BELOW
+	0x2A2F:  "x",                  // VECTOR OR CROSS PRODUCT → LATIN SMALL LETTER X
+	0x2A30:  "ẋ",                 // MULTIPLICATION SIGN WITH DOT ABOVE → LATIN SMALL LETTER X + COMBINING DOT ABOVE
+	0x2A3D:  "⌙",                  // RIGHTHAND INTERIOR PRODUCT → TURNED NOT SIGN
+	0x2A3E:  "⨟",                  // Z NOTATION RELATIONAL COMPOSITION → Z NOTATION SCHEMA COMPOSITION
+	0x2A3F:  "∐",                  // AMALGAMATION OR COPRODUCT → N-ARY COPRODUCT
+	0x2A6A:  "~̇",                 // TILDE OPERATOR WITH DOT ABOVE → TILDE + COMBINING DOT ABOVE
+	0x2A6E:  "=⃰",                 // EQUALS WITH ASTERISK → EQUALS SIGN + COMBINING ASTERISK ABOVE
+	0x2A74:  "::=",                // DOUBLE COLON EQUAL → COLON + COLON + EQUALS SIGN
+	0x2A75:  "==",                 // TWO CONSECUTIVE EQUALS SIGNS → EQUALS SIGN + EQUALS SIGN
+	0x2A76:  "===",                // THREE CONSECUTIVE EQUALS SIGNS → EQUALS SIGN + EQUALS SIGN + EQUALS SIGN
+	0x2AA5:  "><",                 // GREATER-THAN BESIDE LESS-THAN → GREATER-THAN SIGN + LESS-THAN SIGN
+	0x2AAA:  "ᗕ",                  // SMALLER THAN → CANADIAN SYLLABICS CARRIER WA
+	0x2AAB:  "ᗒ",                  // LARGER THAN → CANADIAN SYLLABICS CARRIER WE
+	0x2AD7:  "ᑐᑕ",                 // SUPERSET BESIDE SUBSET → CANADIAN SYLLABICS TO + CANADIAN SYLLABICS TA
+	0x2AFB:  "///",                // TRIPLE SOLIDUS BINARY RELATION → SOLIDUS + SOLIDUS + SOLIDUS
+	0x2AFD:  "//",                 // DOUBLE SOLIDUS OPERATOR → SOLIDUS + SOLIDUS
+	0x2B96:  "=᪲",                 // ( ⮖ → =᪲ )
+	0x2BEC:  "↞",                  // LEFTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS → LEFTWARDS TWO HEADED ARROW
+	0x2BED:  "↟",                  // UPWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS → UPWARDS TWO HEADED ARROW
+	0x2BEE:  "↠",                  // RIGHTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS → RIGHTWARDS TWO HEADED ARROW
+	0x2BEF:  "↡",                  // DOWNWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS → DOWNWARDS TWO HEADED ARROW
+	0x2C67:  "H̩",                 // LATIN CAPITAL LETTER H WITH DESCENDER → LATIN CAPITAL LETTER H + COMBINING VERTICAL LINE BELOW
+	0x2C69:  "K̩",                 // LATIN CAPITAL LETTER K WITH DESCENDER → LATIN CAPITAL LETTER K + COMBINING VERTICAL LINE BELOW
+	0x2C82:  "B",                  // COPTIC CAPITAL LETTER VIDA → LATIN CAPITAL LETTER B
+	0x2C83:  "ʙ",                  // COPTIC SMALL LETTER VIDA → LATIN LETTER SMALL CAPITAL B
+	0x2C84:  "Γ",                  // COPTIC CAPITAL LETTER GAMMA → GREEK CAPITAL LETTER GAMMA
+	0x2C85:  "r",                  // COPTIC SMALL LETTER GAMMA → LATIN SMALL LETTER R
+	0x2C86:  "Δ",                  // COPTIC CAPITAL LETTER DALDA → GREEK CAPITAL LETTER DELTA
+	0x2C88:  "Ꞓ",                  // COPTIC CAPITAL LETTER EIE → LATIN CAPITAL LETTER C WITH BAR
+	0x2C89:  "ꞓ",                  // COPTIC SMALL LETTER EIE → LATIN SMALL LETTER C WITH BAR
+	0x2C8B:  "ς",                  // COPTIC SMALL LETTER SOU → GREEK SMALL LETTER FINAL SIGMA
+	0x2C8C:  "Ⱬ",                  // COPTIC CAPITAL LETTER ZATA → LATIN CAPITAL LETTER Z WITH DESCENDER
+	0x2C8D:  "ⱬ",                  // COPTIC SMALL LETTER ZATA → LATIN SMALL LETTER Z WITH DESCENDER
+	0x2C8E:  "H",                  // COPTIC CAPITAL LETTER HATE → LATIN CAPITAL LETTER H
+	0x2C8F:  "ʜ",                  // COPTIC SMALL LETTER HATE → LATIN LETTER SMALL CAPITAL H
+	0x2C90:  "O̵",                 // COPTIC CAPITAL LETTER THETHE → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x2C91:  "o̵",                 // COPTIC SMALL LETTER THETHE → LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x2C92:  "l",                  // COPTIC CAPITAL LETTER IAUDA → LATIN SMALL LETTER L
+	0x2C93:  "i",                  // COPTIC SMALL LETTER IAUDA → LATIN SMALL LETTER I
+	0x2C94:  "K",                  // COPTIC CAPITAL LETTER KAPA → LATIN CAPITAL LETTER K
+	0x2C95:  "ĸ",                  // COPTIC SMALL LETTER KAPA → LATIN SMALL LETTER KRA
+	0x2C96:  "λ",                  // COPTIC CAPITAL LETTER LAULA → GREEK SMALL LETTER LAMDA
+	0x2C97:  "ʌ",                  // COPTIC SMALL LETTER LAULA → LATIN SMALL LETTER TURNED V
+	0x2C98:  "M",                  // COPTIC CAPITAL LETTER MI → LATIN CAPITAL LETTER M
+	0x2C99:  "ʍ",                  // COPTIC SMALL LETTER MI → LATIN SMALL LETTER TURNED W
+	0x2C9A:  "N",                  // COPTIC CAPITAL LETTER NI → LATIN CAPITAL LETTER N
+	0x2C9B:  "ɴ",                  // COPTIC SMALL LETTER NI → LATIN LETTER SMALL CAPITAL N
+	0x2C9C:  "3",                  // COPTIC CAPITAL LETTER KSI → DIGIT THREE
+	0x2C9D:  "ʓ",                  // COPTIC SMALL LETTER KSI → LATIN SMALL LETTER EZH WITH CURL
+	0x2C9E:  "O",                  // COPTIC CAPITAL LETTER O → LATIN CAPITAL LETTER O
+	0x2C9F:  "o",                  // COPTIC SMALL LETTER O → LATIN SMALL LETTER O
+	0x2CA0:  "Π",                  // COPTIC CAPITAL LETTER PI → GREEK CAPITAL LETTER PI
+	0x2CA1:  "π",                  // COPTIC SMALL LETTER PI → GREEK SMALL LETTER PI
+	0x2CA2:  "P",                  // COPTIC CAPITAL LETTER RO → LATIN CAPITAL LETTER P
+	0x2CA3:  "p",                  // COPTIC SMALL LETTER RO → LATIN SMALL LETTER P
+	0x2CA4:  "C",                  // COPTIC CAPITAL LETTER SIMA → LATIN CAPITAL LETTER C
+	0x2CA5:  "c",                  // COPTIC SMALL LETTER SIMA → LATIN SMALL LETTER C
+	0x2CA6:  "T",                  // COPTIC CAPITAL LETTER TAU → LATIN CAPITAL LETTER T
+	0x2CA7:  "ᴛ",                  // COPTIC SMALL LETTER TAU → LATIN LETTER SMALL CAPITAL T
+	0x2CA8:  "Y",                  // COPTIC CAPITAL LETTER UA → LATIN CAPITAL LETTER Y
+	0x2CA9:  "y",                  // COPTIC SMALL LETTER UA → LATIN SMALL LETTER Y
+	0x2CAA:  "Φ",                  // COPTIC CAPITAL LETTER FI → GREEK CAPITAL LETTER PHI
+	0x2CAB:  "ɸ",                  // COPTIC SMALL LETTER FI → LATIN SMALL LETTER PHI
+	0x2CAC:  "X",                  // COPTIC CAPITAL LETTER KHI → LATIN CAPITAL LETTER X
+	0x2CAD:  "χ",                  // COPTIC SMALL LETTER KHI → GREEK SMALL LETTER CHI
+	0x2CAE:  "Ψ",                  // COPTIC CAPITAL LETTER PSI → GREEK CAPITAL LETTER PSI
+	0x2CAF:  "ψ",                  // COPTIC SMALL LETTER PSI → GREEK SMALL LETTER PSI
+	0x2CB0:  "Ꙍ",                  // COPTIC CAPITAL LETTER OOU → CYRILLIC CAPITAL LETTER BROAD OMEGA
+	0x2CB1:  "ω",                  // COPTIC SMALL LETTER OOU → GREEK SMALL LETTER OMEGA
+	0x2CB2:  "-̇",                 // COPTIC CAPITAL LETTER DIALECT-P ALEF → HYPHEN-MINUS + COMBINING DOT ABOVE
+	0x2CB3:  "-̇",                 // COPTIC SMALL LETTER DIALECT-P ALEF → HYPHEN-MINUS + COMBINING DOT ABOVE
+	0x2CB4:  "<·",                 // COPTIC CAPITAL LETTER OLD COPTIC AIN → LESS-THAN SIGN + MIDDLE DOT
+	0x2CB5:  "<·",                 // COPTIC SMALL LETTER OLD COPTIC AIN → LESS-THAN SIGN + MIDDLE DOT
+	0x2CB6:  "Ξ",                  // COPTIC CAPITAL LETTER CRYPTOGRAMMIC EIE → GREEK CAPITAL LETTER XI
+	0x2CB7:  "≡",                  // COPTIC SMALL LETTER CRYPTOGRAMMIC EIE → IDENTICAL TO
+	0x2CBA:  "-",                  // COPTIC CAPITAL LETTER DIALECT-P NI → HYPHEN-MINUS
+	0x2CBB:  "-",                  // COPTIC SMALL LETTER DIALECT-P NI → HYPHEN-MINUS
+	0x2CBC:  "Ш",                  // COPTIC CAPITAL LETTER CRYPTOGRAMMIC NI → CYRILLIC CAPITAL LETTER SHA
+	0x2CBD:  "w",                  // COPTIC SMALL LETTER CRYPTOGRAMMIC NI → LATIN SMALL LETTER W
+	0x2CC0:  "Ք",                  // COPTIC CAPITAL LETTER SAMPI → ARMENIAN CAPITAL LETTER KEH
+	0x2CC1:  "ϼ",                  // COPTIC SMALL LETTER SAMPI → GREEK RHO WITH STROKE SYMBOL
+	0x2CC4:  "3",                  // COPTIC CAPITAL LETTER OLD COPTIC SHEI → DIGIT THREE
+	0x2CC5:  "ȝ",                  // COPTIC SMALL LETTER OLD COPTIC SHEI → LATIN SMALL LETTER YOGH
+	0x2CC6:  "/",                  // COPTIC CAPITAL LETTER OLD COPTIC ESH → SOLIDUS
+	0x2CC7:  "/",                  // COPTIC SMALL LETTER OLD COPTIC ESH → SOLIDUS
+	0x2CCA:  "9",                  // COPTIC CAPITAL LETTER DIALECT-P HORI → DIGIT NINE
+	0x2CCB:  "9",                  // COPTIC SMALL LETTER DIALECT-P HORI → DIGIT NINE
+	0x2CCC:  "3",                  // COPTIC CAPITAL LETTER OLD COPTIC HORI → DIGIT THREE
+	0x2CCD:  "ȝ",                  // COPTIC SMALL LETTER OLD COPTIC HORI → LATIN SMALL LETTER YOGH
+	0x2CCE:  "P",                  // COPTIC CAPITAL LETTER OLD COPTIC HA → LATIN CAPITAL LETTER P
+	0x2CCF:  "p",                  // COPTIC SMALL LETTER OLD COPTIC HA → LATIN SMALL LETTER P
+	0x2CD0:  "L",                  // COPTIC CAPITAL LETTER L-SHAPED HA → LATIN CAPITAL LETTER L
+	0x2CD1:  "ʟ",                  // COPTIC SMALL LETTER L-SHAPED HA → LATIN LETTER SMALL CAPITAL L
+	0x2CD2:  "6",                  // COPTIC CAPITAL LETTER OLD COPTIC HEI → DIGIT SIX
+	0x2CD3:  "6",                  // COPTIC SMALL LETTER OLD COPTIC HEI → DIGIT SIX
+	0x2CDC:  "6",                  // COPTIC CAPITAL LETTER OLD NUBIAN SHIMA → DIGIT SIX
+	0x2CDD:  "ẟ",                  // COPTIC SMALL LETTER OLD NUBIAN SHIMA → LATIN SMALL LETTER DELTA
+	0x2CE0:  "ɸ",                  // COPTIC CAPITAL LETTER OLD NUBIAN NYI → LATIN SMALL LETTER PHI
+	0x2CE1:  "ɸ",                  // COPTIC SMALL LETTER OLD NUBIAN NYI → LATIN SMALL LETTER PHI
+	0x2CE4:  "ϗ",                  // COPTIC SYMBOL KAI → GREEK KAI SYMBOL
+	0x2CE8:  "Ք",                  // COPTIC SYMBOL TAU RO → ARMENIAN CAPITAL LETTER KEH
+	0x2CE9:  "☧",                  // COPTIC SYMBOL KHI RO → CHI RHO
+	0x2CF9:  "\\\\",               // COPTIC OLD NUBIAN FULL STOP → REVERSE SOLIDUS + REVERSE SOLIDUS
+	0x2D31:  "O̵",                 // TIFINAGH LETTER YAB → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x2D37:  "Ʌ",                  // TIFINAGH LETTER YAD → LATIN CAPITAL LETTER TURNED V
+	0x2D38:  "V",                  // TIFINAGH LETTER YADH → LATIN CAPITAL LETTER V
+	0x2D39:  "E",                  // TIFINAGH LETTER YADD → LATIN CAPITAL LETTER E
+	0x2D3A:  "Ǝ",                  // TIFINAGH LETTER YADDH → LATIN CAPITAL LETTER REVERSED E
+	0x2D41:  "O̸",                 // TIFINAGH LETTER BERBER ACADEMY YAH → LATIN CAPITAL LETTER O + COMBINING LONG SOLIDUS OVERLAY
+	0x2D48:  "···",                // TIFINAGH LETTER TUAREG YAQ → MIDDLE DOT + MIDDLE DOT + MIDDLE DOT
+	0x2D49:  "Ʃ",                  // TIFINAGH LETTER YI → LATIN CAPITAL LETTER ESH
+	0x2D4F:  "l",                  // TIFINAGH LETTER YAN → LATIN SMALL LETTER L
+	0x2D51:  "!",                  // TIFINAGH LETTER TUAREG YANG → EXCLAMATION MARK
+	0x2D54:  "O",                  // TIFINAGH LETTER YAR → LATIN CAPITAL LETTER O
+	0x2D55:  "Q",                  // TIFINAGH LETTER YARR → LATIN CAPITAL LETTER Q
+	0x2D59:  "ʘ",                  // TIFINAGH LETTER YAS → LATIN LETTER BILABIAL CLICK
+	0x2D5D:  "X",                  // TIFINAGH LETTER YATH → LATIN CAPITAL LETTER X
+	0x2D60:  "Δ",                  // TIFINAGH LETTER YAV → GREEK CAPITAL LETTER DELTA
+	0x2D63:  "ᛯ",                  // TIFINAGH LETTER YAZ → RUNIC TVIMADUR SYMBOL
+	0x2DE8:  "ᷟ",                  // COMBINING CYRILLIC LETTER EM → COMBINING LATIN LETTER SMALL CAPITAL M
+	0x2DEA:  "̊",                  // COMBINING CYRILLIC LETTER O → COMBINING RING ABOVE
+	0x2DED:  "ͨ",                  // COMBINING CYRILLIC LETTER ES → COMBINING LATIN SMALL LETTER C
+	0x2DEE:  "᫛",                  // ( ⷮ → ᫛ )
+	0x2DEF:  "ͯ",                  // COMBINING CYRILLIC LETTER HA → COMBINING LATIN SMALL LETTER X
+	0x2DF6:  "ͣ",                  // COMBINING CYRILLIC LETTER A → COMBINING LATIN SMALL LETTER A
+	0x2DF7:  "ͤ",                  // COMBINING CYRILLIC LETTER IE → COMBINING LATIN SMALL LETTER E
+	0x2E1A:  "-̈",                 // HYPHEN WITH DIAERESIS → HYPHEN-MINUS + COMBINING DIAERESIS
+	0x2E1E:  "~̇",                 // TILDE WITH DOT ABOVE → TILDE + COMBINING DOT ABOVE
+	0x2E1F:  "~̣",                 // TILDE WITH DOT BELOW → TILDE + COMBINING DOT BELOW
+	0x2E26:  "ᑕ",                  // LEFT SIDEWAYS U BRACKET → CANADIAN SYLLABICS TA
+	0x2E27:  "ᑐ",                  // RIGHT SIDEWAYS U BRACKET → CANADIAN SYLLABICS TO
+	0x2E28:  "((",                 // LEFT DOUBLE PARENTHESIS → LEFT PARENTHESIS + LEFT PARENTHESIS
+	0x2E29:  "))",                 // ) RIGHT DOUBLE PARENTHESIS → RIGHT PARENTHESIS + RIGHT PARENTHESIS
+	0x2E2A:  "∵",                  // TWO DOTS OVER ONE DOT PUNCTUATION → BECAUSE
+	0x2E2B:  "∴",                  // ONE DOT OVER TWO DOTS PUNCTUATION → THEREFORE
+	0x2E2C:  "∷",                  // SQUARED FOUR DOT PUNCTUATION → PROPORTION
+	0x2E2E:  "؟",                  // REVERSED QUESTION MARK → ARABIC QUESTION MARK
+	0x2E30:  "°",                  // RING POINT → DEGREE SIGN
+	0x2E31:  "·",                  // WORD SEPARATOR MIDDLE DOT → MIDDLE DOT
+	0x2E32:  "،",                  // TURNED COMMA → ARABIC COMMA
+	0x2E35:  "؛",                  // TURNED SEMICOLON → ARABIC SEMICOLON
+	0x2E39:  "ẟ",                  // TOP HALF SECTION SIGN → LATIN SMALL LETTER DELTA
+	0x2E3D:  "ⵂ",                  // VERTICAL SIX DOTS → TIFINAGH LETTER TUAREG YAH
+	0x2E3F:  "¶",                  // CAPITULUM → PILCROW SIGN
+	0x2E40:  "=",                  // DOUBLE HYPHEN → EQUALS SIGN
+	0x2E82:  "乛",                  // CJK RADICAL SECOND ONE → <CJK Ideograph>
+	0x2E83:  "乚",                  // CJK RADICAL SECOND TWO → <CJK Ideograph>
+	0x2E85:  "亻",                  // CJK RADICAL PERSON → <CJK Ideograph>
+	0x2E89:  "刂",                  // CJK RADICAL KNIFE TWO → <CJK Ideograph>
+	0x2E8B:  "㔾",                  // CJK RADICAL SEAL → <CJK Ideograph Extension A>
+	0x2E8E:  "兀",                  // CJK RADICAL LAME ONE → <CJK Ideograph>
+	0x2E8F:  "尣",                  // CJK RADICAL LAME TWO → <CJK Ideograph>
+	0x2E90:  "尢",                  // CJK RADICAL LAME THREE → <CJK Ideograph>
+	0x2E92:  "巳",                  // CJK RADICAL SNAKE → <CJK Ideograph>
+	0x2E93:  "幺",                  // CJK RADICAL THREAD → <CJK Ideograph>
+	0x2E94:  "彑",                  // CJK RADICAL SNOUT ONE → <CJK Ideograph>
+	0x2E96:  "忄",                  // CJK RADICAL HEART ONE → <CJK Ideograph>
+	0x2E97:  "㣺",                  // CJK RADICAL HEART TWO → <CJK Ideograph Extension A>
+	0x2E98:  "扌",                  // CJK RADICAL HAND → <CJK Ideograph>
+	0x2E99:  "攵",                  // CJK RADICAL RAP → <CJK Ideograph>
+	0x2E9B:  "旡",                  // CJK RADICAL CHOKE → <CJK Ideograph>
+	0x2E9E:  "歺",                  // CJK RADICAL DEATH → <CJK Ideograph>
+	0x2E9F:  "母",                  // CJK RADICAL MOTHER → <CJK Ideograph>
+	0x2EA0:  "民",                  // CJK RADICAL CIVILIAN → <CJK Ideograph>
+	0x2EA1:  "氵",                  // CJK RADICAL WATER ONE → <CJK Ideograph>
+	0x2EA2:  "氺",                  // CJK RADICAL WATER TWO → <CJK Ideograph>
+	0x2EA3:  "灬",                  // CJK RADICAL FIRE → <CJK Ideograph>
+	0x2EA4:  "爫",                  // CJK RADICAL PAW ONE → <CJK Ideograph>
+	0x2EA6:  "丬",                  // CJK RADICAL SIMPLIFIED HALF TREE TRUNK → <CJK Ideograph>
+	0x2EA8:  "犭",                  // CJK RADICAL DOG → <CJK Ideograph>
+	0x2EAB:  "罒",                  // CJK RADICAL EYE → <CJK Ideograph>
+	0x2EAD:  "礻",                  // CJK RADICAL SPIRIT TWO → <CJK Ideograph>
+	0x2EAF:  "糹",                  // CJK RADICAL SILK → <CJK Ideograph>
+	0x2EB1:  "罓",                  // CJK RADICAL NET ONE → <CJK Ideograph>
+	0x2EB2:  "罒",                  // CJK RADICAL NET TWO → <CJK Ideograph>
+	0x2EB9:  "耂",                  // CJK RADICAL OLD → <CJK Ideograph>
+	0x2EBA:  "肀",                  // CJK RADICAL BRUSH ONE → <CJK Ideograph>
+	0x2EBE:  "艹",                  // CJK RADICAL GRASS ONE → <CJK Ideograph>
+	0x2EBF:  "艹",                  // CJK RADICAL GRASS TWO → <CJK Ideograph>
+	0x2EC0:  "艹",                  // CJK RADICAL GRASS THREE → <CJK Ideograph>
+	0x2EC1:  "虎",                  // CJK RADICAL TIGER → <CJK Ideograph>
+	0x2EC2:  "衤",                  // CJK RADICAL CLOTHES → <CJK Ideograph>
+	0x2EC3:  "覀",                  // CJK RADICAL WEST ONE → <CJK Ideograph>
+	0x2EC4:  "西",                  // CJK RADICAL WEST TWO → <CJK Ideograph>
+	0x2EC5:  "见",                  // CJK RADICAL C-SIMPLIFIED SEE → <CJK Ideograph>
+	0x2EC8:  "讠",                  // CJK RADICAL C-SIMPLIFIED SPEECH → <CJK Ideograph>
+	0x2EC9:  "贝",                  // CJK RADICAL C-SIMPLIFIED SHELL → <CJK Ideograph>
+	0x2ECB:  "车",                  // CJK RADICAL C-SIMPLIFIED CART → <CJK Ideograph>
+	0x2ECC:  "辶",                  // CJK RADICAL SIMPLIFIED WALK → <CJK Ideograph>
+	0x2ECD:  "辶",                  // CJK RADICAL WALK ONE → <CJK Ideograph>
+	0x2ECF:  "阝",                  // CJK RADICAL CITY → <CJK Ideograph>
+	0x2ED0:  "钅",                  // CJK RADICAL C-SIMPLIFIED GOLD → <CJK Ideograph>
+	0x2ED1:  "ᄐーᄂᄌ",               // CJK RADICAL LONG ONE → HANGUL CHOSEONG THIEUTH + KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG CIEUC
+	0x2ED2:  "镸",                  // CJK RADICAL LONG TWO → <CJK Ideograph>
+	0x2ED3:  "长",                  // CJK RADICAL C-SIMPLIFIED LONG → <CJK Ideograph>
+	0x2ED4:  "门",                  // CJK RADICAL C-SIMPLIFIED GATE → <CJK Ideograph>
+	0x2ED6:  "阝",                  // CJK RADICAL MOUND TWO → <CJK Ideograph>
+	0x2ED8:  "青",                  // CJK RADICAL BLUE → <CJK Ideograph>
+	0x2ED9:  "韦",                  // CJK RADICAL C-SIMPLIFIED TANNED LEATHER → <CJK Ideograph>
+	0x2EDA:  "页",                  // CJK RADICAL C-SIMPLIFIED LEAF → <CJK Ideograph>
+	0x2EDB:  "风",                  // CJK RADICAL C-SIMPLIFIED WIND → <CJK Ideograph>
+	0x2EDC:  "飞",                  // CJK RADICAL C-SIMPLIFIED FLY → <CJK Ideograph>
+	0x2EDD:  "食",                  // CJK RADICAL EAT ONE → <CJK Ideograph>
+	0x2EDF:  "飠",                  // CJK RADICAL EAT THREE → <CJK Ideograph>
+	0x2EE0:  "饣",                  // CJK RADICAL C-SIMPLIFIED EAT → <CJK Ideograph>
+	0x2EE2:  "马",                  // CJK RADICAL C-SIMPLIFIED HORSE → <CJK Ideograph>
+	0x2EE4:  "鬼",                  // CJK RADICAL GHOST → <CJK Ideograph>
+	0x2EE5:  "鱼",                  // CJK RADICAL C-SIMPLIFIED FISH → <CJK Ideograph>
+	0x2EE8:  "麦",                  // CJK RADICAL SIMPLIFIED WHEAT → <CJK Ideograph>
+	0x2EE9:  "黄",                  // CJK RADICAL SIMPLIFIED YELLOW → <CJK Ideograph>
+	0x2EEB:  "斉",                  // CJK RADICAL J-SIMPLIFIED EVEN → <CJK Ideograph>
+	0x2EEC:  "齐",                  // CJK RADICAL C-SIMPLIFIED EVEN → <CJK Ideograph>
+	0x2EED:  "歯",                  // CJK RADICAL J-SIMPLIFIED TOOTH → <CJK Ideograph>
+	0x2EEE:  "齿",                  // CJK RADICAL C-SIMPLIFIED TOOTH → <CJK Ideograph>
+	0x2EEF:  "竜",                  // CJK RADICAL J-SIMPLIFIED DRAGON → <CJK Ideograph>
+	0x2EF0:  "龙",                  // CJK RADICAL C-SIMPLIFIED DRAGON → <CJK Ideograph>
+	0x2EF2:  "亀",                  // CJK RADICAL J-SIMPLIFIED TURTLE → <CJK Ideograph>
+	0x2EF3:  "龟",                  // CJK RADICAL C-SIMPLIFIED TURTLE → <CJK Ideograph>
+	0x2F00:  "ー",                  // KANGXI RADICAL ONE → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x2F01:  "丨",                  // KANGXI RADICAL LINE → <CJK Ideograph>
+	0x2F02:  "\\",                 // KANGXI RADICAL DOT → REVERSE SOLIDUS
+	0x2F03:  "/",                  // KANGXI RADICAL SLASH → SOLIDUS
+	0x2F04:  "乙",                  // KANGXI RADICAL SECOND → <CJK Ideograph>
+	0x2F05:  "亅",                  // KANGXI RADICAL HOOK → <CJK Ideograph>
+	0x2F06:  "二",                  // KANGXI RADICAL TWO → <CJK Ideograph>
+	0x2F07:  "亠",                  // KANGXI RADICAL LID → <CJK Ideograph>
+	0x2F08:  "人",                  // KANGXI RADICAL MAN → <CJK Ideograph>
+	0x2F09:  "儿",                  // KANGXI RADICAL LEGS → <CJK Ideograph>
+	0x2F0A:  "入",                  // KANGXI RADICAL ENTER → <CJK Ideograph>
+	0x2F0B:  "八",                  // KANGXI RADICAL EIGHT → <CJK Ideograph>
+	0x2F0C:  "冂",                  // KANGXI RADICAL DOWN BOX → <CJK Ideograph>
+	0x2F0D:  "冖",                  // KANGXI RADICAL COVER → <CJK Ideograph>
+	0x2F0E:  "冫",                  // KANGXI RADICAL ICE → <CJK Ideograph>
+	0x2F0F:  "几",                  // KANGXI RADICAL TABLE → <CJK Ideograph>
+	0x2F10:  "凵",                  // KANGXI RADICAL OPEN BOX → <CJK Ideograph>
+	0x2F11:  "刀",                  // KANGXI RADICAL KNIFE → <CJK Ideograph>
+	0x2F12:  "力",                  // KANGXI RADICAL POWER → <CJK Ideograph>
+	0x2F13:  "勹",                  // KANGXI RADICAL WRAP → <CJK Ideograph>
+	0x2F14:  "匕",                  // KANGXI RADICAL SPOON → <CJK Ideograph>
+	0x2F15:  "匚",                  // KANGXI RADICAL RIGHT OPEN BOX → <CJK Ideograph>
+	0x2F16:  "匸",                  // KANGXI RADICAL HIDING ENCLOSURE → <CJK Ideograph>
+	0x2F17:  "十",                  // KANGXI RADICAL TEN → <CJK Ideograph>
+	0x2F18:  "卜",                  // KANGXI RADICAL DIVINATION → <CJK Ideograph>
+	0x2F19:  "卩",                  // KANGXI RADICAL SEAL → <CJK Ideograph>
+	0x2F1A:  "厂",                  // KANGXI RADICAL CLIFF → <CJK Ideograph>
+	0x2F1B:  "厶",                  // KANGXI RADICAL PRIVATE → <CJK Ideograph>
+	0x2F1C:  "又",                  // KANGXI RADICAL AGAIN → <CJK Ideograph>
+	0x2F1D:  "口",                  // KANGXI RADICAL MOUTH → <CJK Ideograph>
+	0x2F1E:  "口",                  // KANGXI RADICAL ENCLOSURE → <CJK Ideograph>
+	0x2F1F:  "土",                  // KANGXI RADICAL EARTH → <CJK Ideograph>
+	0x2F20:  "土",                  // KANGXI RADICAL SCHOLAR → <CJK Ideograph>
+	0x2F21:  "夂",                  // KANGXI RADICAL GO → <CJK Ideograph>
+	0x2F22:  "夊",                  // KANGXI RADICAL GO SLOWLY → <CJK Ideograph>
+	0x2F23:  "夕",                  // KANGXI RADICAL EVENING → <CJK Ideograph>
+	0x2F24:  "大",                  // KANGXI RADICAL BIG → <CJK Ideograph>
+	0x2F25:  "女",                  // KANGXI RADICAL WOMAN → <CJK Ideograph>
+	0x2F26:  "子",                  // KANGXI RADICAL CHILD → <CJK Ideograph>
+	0x2F27:  "宀",                  // KANGXI RADICAL ROOF → <CJK Ideograph>
+	0x2F28:  "寸",                  // KANGXI RADICAL INCH → <CJK Ideograph>
+	0x2F29:  "小",                  // KANGXI RADICAL SMALL → <CJK Ideograph>
+	0x2F2A:  "尢",                  // KANGXI RADICAL LAME → <CJK Ideograph>
+	0x2F2B:  "尸",                  // KANGXI RADICAL CORPSE → <CJK Ideograph>
+	0x2F2C:  "屮",                  // KANGXI RADICAL SPROUT → <CJK Ideograph>
+	0x2F2D:  "山",                  // KANGXI RADICAL MOUNTAIN → <CJK Ideograph>
+	0x2F2E:  "巛",                  // KANGXI RADICAL RIVER → <CJK Ideograph>
+	0x2F2F:  "工",                  // KANGXI RADICAL WORK → <CJK Ideograph>
+	0x2F30:  "己",                  // KANGXI RADICAL ONESELF → <CJK Ideograph>
+	0x2F31:  "巾",                  // KANGXI RADICAL TURBAN → <CJK Ideograph>
+	0x2F32:  "干",                  // KANGXI RADICAL DRY → <CJK Ideograph>
+	0x2F33:  "幺",                  // KANGXI RADICAL SHORT THREAD → <CJK Ideograph>
+	0x2F34:  "广",                  // KANGXI RADICAL DOTTED CLIFF → <CJK Ideograph>
+	0x2F35:  "廴",                  // KANGXI RADICAL LONG STRIDE → <CJK Ideograph>
+	0x2F36:  "廾",                  // KANGXI RADICAL TWO HANDS → <CJK Ideograph>
+	0x2F37:  "弋",                  // KANGXI RADICAL SHOOT → <CJK Ideograph>
+	0x2F38:  "弓",                  // KANGXI RADICAL BOW → <CJK Ideograph>
+	0x2F39:  "彐",                  // KANGXI RADICAL SNOUT → <CJK Ideograph>
+	0x2F3A:  "彡",                  // KANGXI RADICAL BRISTLE → <CJK Ideograph>
+	0x2F3B:  "彳",                  // KANGXI RADICAL STEP → <CJK Ideograph>
+	0x2F3C:  "心",                  // KANGXI RADICAL HEART → <CJK Ideograph>
+	0x2F3D:  "戈",                  // KANGXI RADICAL HALBERD → <CJK Ideograph>
+	0x2F3E:  "戶",                  // KANGXI RADICAL DOOR → <CJK Ideograph>
+	0x2F3F:  "手",                  // KANGXI RADICAL HAND → <CJK Ideograph>
+	0x2F40:  "支",                  // KANGXI RADICAL BRANCH → <CJK Ideograph>
+	0x2F41:  "攴",                  // KANGXI RADICAL RAP → <CJK Ideograph>
+	0x2F42:  "文",                  // KANGXI RADICAL SCRIPT → <CJK Ideograph>
+	0x2F43:  "斗",                  // KANGXI RADICAL DIPPER → <CJK Ideograph>
+	0x2F44:  "斤",                  // KANGXI RADICAL AXE → <CJK Ideograph>
+	0x2F45:  "方",                  // KANGXI RADICAL SQUARE → <CJK Ideograph>
+	0x2F46:  "无",                  // KANGXI RADICAL NOT → <CJK Ideograph>
+	0x2F47:  "日",                  // KANGXI RADICAL SUN → <CJK Ideograph>
+	0x2F48:  "曰",                  // KANGXI RADICAL SAY → <CJK Ideograph>
+	0x2F49:  "月",                  // KANGXI RADICAL MOON → <CJK Ideograph>
+	0x2F4A:  "木",                  // KANGXI RADICAL TREE → <CJK Ideograph>
+	0x2F4B:  "欠",                  // KANGXI RADICAL LACK → <CJK Ideograph>
+	0x2F4C:  "止",                  // KANGXI RADICAL STOP → <CJK Ideograph>
+	0x2F4D:  "歹",                  // KANGXI RADICAL DEATH → <CJK Ideograph>
+	0x2F4E:  "殳",                  // KANGXI RADICAL WEAPON → <CJK Ideograph>
+	0x2F4F:  "毋",                  // KANGXI RADICAL DO NOT → <CJK Ideograph>
+	0x2F50:  "比",                  // KANGXI RADICAL COMPARE → <CJK Ideograph>
+	0x2F51:  "毛",                  // KANGXI RADICAL FUR → <CJK Ideograph>
+	0x2F52:  "氏",                  // KANGXI RADICAL CLAN → <CJK Ideograph>
+	0x2F53:  "气",                  // KANGXI RADICAL STEAM → <CJK Ideograph>
+	0x2F54:  "水",                  // KANGXI RADICAL WATER → <CJK Ideograph>
+	0x2F55:  "火",                  // KANGXI RADICAL FIRE → <CJK Ideograph>
+	0x2F56:  "爪",                  // KANGXI RADICAL CLAW → <CJK Ideograph>
+	0x2F57:  "父",                  // KANGXI RADICAL FATHER → <CJK Ideograph>
+	0x2F58:  "爻",                  // KANGXI RADICAL DOUBLE X → <CJK Ideograph>
+	0x2F59:  "누丨",                // KANGXI RADICAL HALF TREE TRUNK → HANGUL CHOSEONG NIEUN + HANGUL JUNGSEONG U + <CJK Ideograph>
+	0x2F5A:  "片",                  // KANGXI RADICAL SLICE → <CJK Ideograph>
+	0x2F5B:  "牙",                  // KANGXI RADICAL FANG → <CJK Ideograph>
+	0x2F5C:  "牛",                  // KANGXI RADICAL COW → <CJK Ideograph>
+	0x2F5D:  "犬",                  // KANGXI RADICAL DOG → <CJK Ideograph>
+	0x2F5E:  "玄",                  // KANGXI RADICAL PROFOUND → <CJK Ideograph>
+	0x2F5F:  "玉",                  // KANGXI RADICAL JADE → <CJK Ideograph>
+	0x2F60:  "瓜",                  // KANGXI RADICAL MELON → <CJK Ideograph>
+	0x2F61:  "瓦",                  // KANGXI RADICAL TILE → <CJK Ideograph>
+	0x2F62:  "甘",                  // KANGXI RADICAL SWEET → <CJK Ideograph>
+	0x2F63:  "生",                  // KANGXI RADICAL LIFE → <CJK Ideograph>
+	0x2F64:  "用",                  // KANGXI RADICAL USE → <CJK Ideograph>
+	0x2F65:  "田",                  // KANGXI RADICAL FIELD → <CJK Ideograph>
+	0x2F66:  "疋",                  // KANGXI RADICAL BOLT OF CLOTH → <CJK Ideograph>
+	0x2F67:  "疒",                  // KANGXI RADICAL SICKNESS → <CJK Ideograph>
+	0x2F68:  "癶",                  // KANGXI RADICAL DOTTED TENT → <CJK Ideograph>
+	0x2F69:  "白",                  // KANGXI RADICAL WHITE → <CJK Ideograph>
+	0x2F6A:  "皮",                  // KANGXI RADICAL SKIN → <CJK Ideograph>
+	0x2F6B:  "皿",                  // KANGXI RADICAL DISH → <CJK Ideograph>
+	0x2F6C:  "目",                  // KANGXI RADICAL EYE → <CJK Ideograph>
+	0x2F6D:  "矛",                  // KANGXI RADICAL SPEAR → <CJK Ideograph>
+	0x2F6E:  "矢",                  // KANGXI RADICAL ARROW → <CJK Ideograph>
+	0x2F6F:  "石",                  // KANGXI RADICAL STONE → <CJK Ideograph>
+	0x2F70:  "示",                  // KANGXI RADICAL SPIRIT → <CJK Ideograph>
+	0x2F71:  "禸",                  // KANGXI RADICAL TRACK → <CJK Ideograph>
+	0x2F72:  "禾",                  // KANGXI RADICAL GRAIN → <CJK Ideograph>
+	0x2F73:  "穴",                  // KANGXI RADICAL CAVE → <CJK Ideograph>
+	0x2F74:  "立",                  // KANGXI RADICAL STAND → <CJK Ideograph>
+	0x2F75:  "竹",                  // KANGXI RADICAL BAMBOO → <CJK Ideograph>
+	0x2F76:  "米",                  // KANGXI RADICAL RICE → <CJK Ideograph>
+	0x2F77:  "糸",                  // KANGXI RADICAL SILK → <CJK Ideograph>
+	0x2F78:  "缶",                  // KANGXI RADICAL JAR → <CJK Ideograph>
+	0x2F79:  "网",                  // KANGXI RADICAL NET → <CJK Ideograph>
+	0x2F7A:  "羊",                  // KANGXI RADICAL SHEEP → <CJK Ideograph>
+	0x2F7B:  "羽",                  // KANGXI RADICAL FEATHER → <CJK Ideograph>
+	0x2F7C:  "老",                  // KANGXI RADICAL OLD → <CJK Ideograph>
+	0x2F7D:  "而",                  // KANGXI RADICAL AND → <CJK Ideograph>
+	0x2F7E:  "耒",                  // KANGXI RADICAL PLOW → <CJK Ideograph>
+	0x2F7F:  "耳",                  // KANGXI RADICAL EAR → <CJK Ideograph>
+	0x2F80:  "聿",                  // KANGXI RADICAL BRUSH → <CJK Ideograph>
+	0x2F81:  "肉",                  // KANGXI RADICAL MEAT → <CJK Ideograph>
+	0x2F82:  "臣",                  // KANGXI RADICAL MINISTER → <CJK Ideograph>
+	0x2F83:  "自",                  // KANGXI RADICAL SELF → <CJK Ideograph>
+	0x2F84:  "至",                  // KANGXI RADICAL ARRIVE → <CJK Ideograph>
+	0x2F85:  "臼",                  // KANGXI RADICAL MORTAR → <CJK Ideograph>
+	0x2F86:  "舌",                  // KANGXI RADICAL TONGUE → <CJK Ideograph>
+	0x2F87:  "舛",                  // KANGXI RADICAL OPPOSE → <CJK Ideograph>
+	0x2F88:  "舟",                  // KANGXI RADICAL BOAT → <CJK Ideograph>
+	0x2F89:  "艮",                  // KANGXI RADICAL STOPPING → <CJK Ideograph>
+	0x2F8A:  "色",                  // KANGXI RADICAL COLOR → <CJK Ideograph>
+	0x2F8B:  "艸",                  // KANGXI RADICAL GRASS → <CJK Ideograph>
+	0x2F8C:  "虍",                  // KANGXI RADICAL TIGER → <CJK Ideograph>
+	0x2F8D:  "虫",                  // KANGXI RADICAL INSECT → <CJK Ideograph>
+	0x2F8E:  "血",                  // KANGXI RADICAL BLOOD → <CJK Ideograph>
+	0x2F8F:  "行",                  // KANGXI RADICAL WALK ENCLOSURE → <CJK Ideograph>
+	0x2F90:  "衣",                  // KANGXI RADICAL CLOTHES → <CJK Ideograph>
+	0x2F91:  "襾",                  // KANGXI RADICAL WEST → <CJK Ideograph>
+	0x2F92:  "見",                  // KANGXI RADICAL SEE → <CJK Ideograph>
+	0x2F93:  "角",                  // KANGXI RADICAL HORN → <CJK Ideograph>
+	0x2F94:  "言",                  // KANGXI RADICAL SPEECH → <CJK Ideograph>
+	0x2F95:  "谷",                  // KANGXI RADICAL VALLEY → <CJK Ideograph>
+	0x2F96:  "豆",                  // KANGXI RADICAL BEAN → <CJK Ideograph>
+	0x2F97:  "豕",                  // KANGXI RADICAL PIG → <CJK Ideograph>
+	0x2F98:  "豸",                  // KANGXI RADICAL BADGER → <CJK Ideograph>
+	0x2F99:  "貝",                  // KANGXI RADICAL SHELL → <CJK Ideograph>
+	0x2F9A:  "赤",                  // KANGXI RADICAL RED → <CJK Ideograph>
+	0x2F9B:  "走",                  // KANGXI RADICAL RUN → <CJK Ideograph>
+	0x2F9C:  "足",                  // KANGXI RADICAL FOOT → <CJK Ideograph>
+	0x2F9D:  "身",                  // KANGXI RADICAL BODY → <CJK Ideograph>
+	0x2F9E:  "車",                  // KANGXI RADICAL CART → <CJK Ideograph>
+	0x2F9F:  "辛",                  // KANGXI RADICAL BITTER → <CJK Ideograph>
+	0x2FA0:  "辰",                  // KANGXI RADICAL MORNING → <CJK Ideograph>
+	0x2FA1:  "辵",                  // KANGXI RADICAL WALK → <CJK Ideograph>
+	0x2FA2:  "邑",                  // KANGXI RADICAL CITY → <CJK Ideograph>
+	0x2FA3:  "酉",                  // KANGXI RADICAL WINE → <CJK Ideograph>
+	0x2FA4:  "釆",                  // KANGXI RADICAL DISTINGUISH → <CJK Ideograph>
+	0x2FA5:  "里",                  // KANGXI RADICAL VILLAGE → <CJK Ideograph>
+	0x2FA6:  "金",                  // KANGXI RADICAL GOLD → <CJK Ideograph>
+	0x2FA7:  "ᄐーᄂᄌ",               // KANGXI RADICAL LONG → HANGUL CHOSEONG THIEUTH + KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG CIEUC
+	0x2FA8:  "門",                  // KANGXI RADICAL GATE → <CJK Ideograph>
+	0x2FA9:  "阜",                  // KANGXI RADICAL MOUND → <CJK Ideograph>
+	0x2FAA:  "隶",                  // KANGXI RADICAL SLAVE → <CJK Ideograph>
+	0x2FAB:  "隹",                  // KANGXI RADICAL SHORT TAILED BIRD → <CJK Ideograph>
+	0x2FAC:  "雨",                  // KANGXI RADICAL RAIN → <CJK Ideograph>
+	0x2FAD:  "靑",                  // KANGXI RADICAL BLUE → <CJK Ideograph>
+	0x2FAE:  "非",                  // KANGXI RADICAL WRONG → <CJK Ideograph>
+	0x2FAF:  "面",                  // KANGXI RADICAL FACE → <CJK Ideograph>
+	0x2FB0:  "革",                  // KANGXI RADICAL LEATHER → <CJK Ideograph>
+	0x2FB1:  "韋",                  // KANGXI RADICAL TANNED LEATHER → <CJK Ideograph>
+	0x2FB2:  "韭",                  // KANGXI RADICAL LEEK → <CJK Ideograph>
+	0x2FB3:  "音",                  // KANGXI RADICAL SOUND → <CJK Ideograph>
+	0x2FB4:  "頁",                  // KANGXI RADICAL LEAF → <CJK Ideograph>
+	0x2FB5:  "風",                  // KANGXI RADICAL WIND → <CJK Ideograph>
+	0x2FB6:  "飛",                  // KANGXI RADICAL FLY → <CJK Ideograph>
+	0x2FB7:  "食",                  // KANGXI RADICAL EAT → <CJK Ideograph>
+	0x2FB8:  "首",                  // KANGXI RADICAL HEAD → <CJK Ideograph>
+	0x2FB9:  "香",                  // KANGXI RADICAL FRAGRANT → <CJK Ideograph>
+	0x2FBA:  "馬",                  // KANGXI RADICAL HORSE → <CJK Ideograph>
+	0x2FBB:  "骨",                  // KANGXI RADICAL BONE → <CJK Ideograph>
+	0x2FBC:  "高",                  // KANGXI RADICAL TALL → <CJK Ideograph>
+	0x2FBD:  "髟",                  // KANGXI RADICAL HAIR → <CJK Ideograph>
+	0x2FBE:  "鬥",                  // KANGXI RADICAL FIGHT → <CJK Ideograph>
+	0x2FBF:  "鬯",                  // KANGXI RADICAL SACRIFICIAL WINE → <CJK Ideograph>
+	0x2FC0:  "鬲",                  // KANGXI RADICAL CAULDRON → <CJK Ideograph>
+	0x2FC1:  "鬼",                  // KANGXI RADICAL GHOST → <CJK Ideograph>
+	0x2FC2:  "魚",                  // KANGXI RADICAL FISH → <CJK Ideograph>
+	0x2FC3:  "鳥",                  // KANGXI RADICAL BIRD → <CJK Ideograph>
+	0x2FC4:  "鹵",                  // KANGXI RADICAL SALT → <CJK Ideograph>
+	0x2FC5:  "鹿",                  // KANGXI RADICAL DEER → <CJK Ideograph>
+	0x2FC6:  "麥",                  // KANGXI RADICAL WHEAT → <CJK Ideograph>
+	0x2FC7:  "麻",                  // KANGXI RADICAL HEMP → <CJK Ideograph>
+	0x2FC8:  "黃",                  // KANGXI RADICAL YELLOW → <CJK Ideograph>
+	0x2FC9:  "黍",                  // KANGXI RADICAL MILLET → <CJK Ideograph>
+	0x2FCA:  "黑",                  // KANGXI RADICAL BLACK → <CJK Ideograph>
+	0x2FCB:  "黹",                  // KANGXI RADICAL EMBROIDERY → <CJK Ideograph>
+	0x2FCC:  "黽",                  // KANGXI RADICAL FROG → <CJK Ideograph>
+	0x2FCD:  "鼎",                  // KANGXI RADICAL TRIPOD → <CJK Ideograph>
+	0x2FCE:  "鼓",                  // KANGXI RADICAL DRUM → <CJK Ideograph>
+	0x2FCF:  "鼠",                  // KANGXI RADICAL RAT → <CJK Ideograph>
+	0x2FD0:  "鼻",                  // KANGXI RADICAL NOSE → <CJK Ideograph>
+	0x2FD1:  "齊",                  // KANGXI RADICAL EVEN → <CJK Ideograph>
+	0x2FD2:  "齒",                  // KANGXI RADICAL TOOTH → <CJK Ideograph>
+	0x2FD3:  "龍",                  // KANGXI RADICAL DRAGON → <CJK Ideograph>
+	0x2FD4:  "龜",                  // KANGXI RADICAL TURTLE → <CJK Ideograph>
+	0x2FD5:  "龠",                  // KANGXI RADICAL FLUTE → <CJK Ideograph>
+	0x3002:  "˳",                  // IDEOGRAPHIC FULL STOP → MODIFIER LETTER LOW RING
+	0x3003:  "''",                 // DITTO MARK → APOSTROPHE + APOSTROPHE
+	0x3007:  "O",                  // IDEOGRAPHIC NUMBER ZERO → LATIN CAPITAL LETTER O
+	0x3008:  "❬",                  // LEFT ANGLE BRACKET → MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT
+	0x3009:  "❭",                  // RIGHT ANGLE BRACKET → MEDIUM RIGHT-POINTING ANGLE BRACKET ORNAMENT
+	0x3012:  "₸",                  // POSTAL MARK → TENGE SIGN
+	0x3014:  "(",                  // LEFT TORTOISE SHELL BRACKET → LEFT PARENTHESIS
+	0x3015:  ")",                  // ) RIGHT TORTOISE SHELL BRACKET → RIGHT PARENTHESIS
+	0x301A:  "⟦",                  // LEFT WHITE SQUARE BRACKET → MATHEMATICAL LEFT WHITE SQUARE BRACKET
+	0x301B:  "⟧",                  // RIGHT WHITE SQUARE BRACKET → MATHEMATICAL RIGHT WHITE SQUARE BRACKET
+	0x302C:  "̉",                  // IDEOGRAPHIC DEPARTING TONE MARK → COMBINING HOOK ABOVE
+	0x302D:  "̥",                  // IDEOGRAPHIC ENTERING TONE MARK → COMBINING RING BELOW
+	0x3033:  "/",                  // VERTICAL KANA REPEAT MARK UPPER HALF → SOLIDUS
+	0x3036:  "₸",                  // CIRCLED POSTAL MARK → TENGE SIGN
+	0x3038:  "十",                  // HANGZHOU NUMERAL TEN → <CJK Ideograph>
+	0x3039:  "卄",                  // HANGZHOU NUMERAL TWENTY → <CJK Ideograph>
+	0x303A:  "卅",                  // HANGZHOU NUMERAL THIRTY → <CJK Ideograph>
+	0x304F:  "❬",                  // HIRAGANA LETTER KU → MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT
+	0x309A:  "̊",                  // COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK → COMBINING RING ABOVE
+	0x309B:  "ﾞ",                  // KATAKANA-HIRAGANA VOICED SOUND MARK → HALFWIDTH KATAKANA VOICED SOUND MARK
+	0x309C:  "ﾟ",                  // KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK → HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK
+	0x30A0:  "=",                  // KATAKANA-HIRAGANA DOUBLE HYPHEN → EQUALS SIGN
+	0x30A4:  "亻",                  // KATAKANA LETTER I → <CJK Ideograph>
+	0x30A8:  "工",                  // KATAKANA LETTER E → <CJK Ideograph>
+	0x30AB:  "力",                  // KATAKANA LETTER KA → <CJK Ideograph>
+	0x30BF:  "夕",                  // KATAKANA LETTER TA → <CJK Ideograph>
+	0x30C8:  "卜",                  // KATAKANA LETTER TO → <CJK Ideograph>
+	0x30CB:  "二",                  // KATAKANA LETTER NI → <CJK Ideograph>
+	0x30CE:  "/",                  // KATAKANA LETTER NO → SOLIDUS
+	0x30CF:  "八",                  // KATAKANA LETTER HA → <CJK Ideograph>
+	0x30D8:  "へ",                  // KATAKANA LETTER HE → HIRAGANA LETTER HE
+	0x30ED:  "口",                  // KATAKANA LETTER RO → <CJK Ideograph>
+	0x30FB:  "·",                  // KATAKANA MIDDLE DOT → MIDDLE DOT
+	0x3126:  "儿",                  // BOPOMOFO LETTER ER → <CJK Ideograph>
+	0x3131:  "ᄀ",                  // HANGUL LETTER KIYEOK → HANGUL CHOSEONG KIYEOK
+	0x3132:  "ᄀᄀ",                 // HANGUL LETTER SSANGKIYEOK → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG KIYEOK
+	0x3133:  "ᄀᄉ",                 // HANGUL LETTER KIYEOK-SIOS → HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG SIOS
+	0x3134:  "ᄂ",                  // HANGUL LETTER NIEUN → HANGUL CHOSEONG NIEUN
+	0x3135:  "ᄂᄌ",                 // HANGUL LETTER NIEUN-CIEUC → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG CIEUC
+	0x3136:  "ᄂᄒ",                 // HANGUL LETTER NIEUN-HIEUH → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG HIEUH
+	0x3137:  "ᄃ",                  // HANGUL LETTER TIKEUT → HANGUL CHOSEONG TIKEUT
+	0x3138:  "ᄃᄃ",                 // HANGUL LETTER SSANGTIKEUT → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG TIKEUT
+	0x3139:  "ᄅ",                  // HANGUL LETTER RIEUL → HANGUL CHOSEONG RIEUL
+	0x313A:  "ᄅᄀ",                 // HANGUL LETTER RIEUL-KIYEOK → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK
+	0x313B:  "ᄅᄆ",                 // HANGUL LETTER RIEUL-MIEUM → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG MIEUM
+	0x313C:  "ᄅᄇ",                 // HANGUL LETTER RIEUL-PIEUP → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP
+	0x313D:  "ᄅᄉ",                 // HANGUL LETTER RIEUL-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG SIOS
+	0x313E:  "ᄅᄐ",                 // HANGUL LETTER RIEUL-THIEUTH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG THIEUTH
+	0x313F:  "ᄅᄑ",                 // HANGUL LETTER RIEUL-PHIEUPH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PHIEUPH
+	0x3140:  "ᄅᄒ",                 // HANGUL LETTER RIEUL-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG HIEUH
+	0x3141:  "ᄆ",                  // HANGUL LETTER MIEUM → HANGUL CHOSEONG MIEUM
+	0x3142:  "ᄇ",                  // HANGUL LETTER PIEUP → HANGUL CHOSEONG PIEUP
+	0x3143:  "ᄇᄇ",                 // HANGUL LETTER SSANGPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PIEUP
+	0x3144:  "ᄇᄉ",                 // HANGUL LETTER PIEUP-SIOS → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS
+	0x3145:  "ᄉ",                  // HANGUL LETTER SIOS → HANGUL CHOSEONG SIOS
+	0x3146:  "ᄉᄉ",                 // HANGUL LETTER SSANGSIOS → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS
+	0x3147:  "ᄋ",                  // HANGUL LETTER IEUNG → HANGUL CHOSEONG IEUNG
+	0x3148:  "ᄌ",                  // HANGUL LETTER CIEUC → HANGUL CHOSEONG CIEUC
+	0x3149:  "ᄌᄌ",                 // HANGUL LETTER SSANGCIEUC → HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG CIEUC
+	0x314A:  "ᄎ",                  // HANGUL LETTER CHIEUCH → HANGUL CHOSEONG CHIEUCH
+	0x314B:  "ᄏ",                  // HANGUL LETTER KHIEUKH → HANGUL CHOSEONG KHIEUKH
+	0x314C:  "ᄐ",                  // HANGUL LETTER THIEUTH → HANGUL CHOSEONG THIEUTH
+	0x314D:  "ᄑ",                  // HANGUL LETTER PHIEUPH → HANGUL CHOSEONG PHIEUPH
+	0x314E:  "ᄒ",                  // HANGUL LETTER HIEUH → HANGUL CHOSEONG HIEUH
+	0x314F:  "ᅡ",                  // HANGUL LETTER A → HANGUL JUNGSEONG A
+	0x3150:  "ᅡ丨",                 // HANGUL LETTER AE → HANGUL JUNGSEONG A + <CJK Ideograph>
+	0x3151:  "ᅣ",                  // HANGUL LETTER YA → HANGUL JUNGSEONG YA
+	0x3152:  "ᅣ丨",                 // HANGUL LETTER YAE → HANGUL JUNGSEONG YA + <CJK Ideograph>
+	0x3153:  "ᅥ",                  // HANGUL LETTER EO → HANGUL JUNGSEONG EO
+	0x3154:  "ᅥ丨",                 // HANGUL LETTER E → HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0x3155:  "ᅧ",                  // HANGUL LETTER YEO → HANGUL JUNGSEONG YEO
+	0x3156:  "ᅧ丨",                 // HANGUL LETTER YE → HANGUL JUNGSEONG YEO + <CJK Ideograph>
+	0x3157:  "ᅩ",                  // HANGUL LETTER O → HANGUL JUNGSEONG O
+	0x3158:  "ᅩᅡ",                 // HANGUL LETTER WA → HANGUL JUNGSEONG O + HANGUL JUNGSEONG A
+	0x3159:  "ᅩᅡ丨",                // HANGUL LETTER WAE → HANGUL JUNGSEONG O + HANGUL JUNGSEONG A + <CJK Ideograph>
+	0x315A:  "ᅩ丨",                 // HANGUL LETTER OE → HANGUL JUNGSEONG O + <CJK Ideograph>
+	0x315B:  "ᅭ",                  // HANGUL LETTER YO → HANGUL JUNGSEONG YO
+	0x315C:  "ᅮ",                  // HANGUL LETTER U → HANGUL JUNGSEONG U
+	0x315D:  "ᅮᅥ",                 // HANGUL LETTER WEO → HANGUL JUNGSEONG U + HANGUL JUNGSEONG EO
+	0x315E:  "ᅮᅥ丨",                // HANGUL LETTER WE → HANGUL JUNGSEONG U + HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0x315F:  "ᅮ丨",                 // HANGUL LETTER WI → HANGUL JUNGSEONG U + <CJK Ideograph>
+	0x3160:  "ᅲ",                  // HANGUL LETTER YU → HANGUL JUNGSEONG YU
+	0x3161:  "ー",                  // HANGUL LETTER EU → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x3162:  "ー丨",                 // HANGUL LETTER YI → KATAKANA-HIRAGANA PROLONGED SOUND MARK + <CJK Ideograph>
+	0x3163:  "丨",                  // HANGUL LETTER I → <CJK Ideograph>
+	0x3164:  "ᅠ",                  // HANGUL FILLER → HANGUL JUNGSEONG FILLER
+	0x3165:  "ᄂᄂ",                 // HANGUL LETTER SSANGNIEUN → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG NIEUN
+	0x3166:  "ᄂᄃ",                 // HANGUL LETTER NIEUN-TIKEUT → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG TIKEUT
+	0x3167:  "ᄂᄉ",                 // HANGUL LETTER NIEUN-SIOS → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG SIOS
+	0x3168:  "ᄂᅀ",                 // HANGUL LETTER NIEUN-PANSIOS → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG PANSIOS
+	0x3169:  "ᄅᄀᄉ",                // HANGUL LETTER RIEUL-KIYEOK-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG SIOS
+	0x316A:  "ᄅᄃ",                 // HANGUL LETTER RIEUL-TIKEUT → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG TIKEUT
+	0x316B:  "ᄅᄇᄉ",                // HANGUL LETTER RIEUL-PIEUP-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS
+	0x316C:  "ᄅᅀ",                 // HANGUL LETTER RIEUL-PANSIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PANSIOS
+	0x316D:  "ᄅᅙ",                 // HANGUL LETTER RIEUL-YEORINHIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG YEORINHIEUH
+	0x316E:  "ᄆᄇ",                 // HANGUL LETTER MIEUM-PIEUP → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG PIEUP
+	0x316F:  "ᄆᄉ",                 // HANGUL LETTER MIEUM-SIOS → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG SIOS
+	0x3170:  "ᄆᅀ",                 // HANGUL LETTER MIEUM-PANSIOS → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG PANSIOS
+	0x3171:  "ᄆᄋ",                 // HANGUL LETTER KAPYEOUNMIEUM → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG IEUNG
+	0x3172:  "ᄇᄀ",                 // HANGUL LETTER PIEUP-KIYEOK → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG KIYEOK
+	0x3173:  "ᄇᄃ",                 // HANGUL LETTER PIEUP-TIKEUT → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG TIKEUT
+	0x3174:  "ᄇᄉᄀ",                // HANGUL LETTER PIEUP-SIOS-KIYEOK → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0x3175:  "ᄇᄉᄃ",                // HANGUL LETTER PIEUP-SIOS-TIKEUT → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG TIKEUT
+	0x3176:  "ᄇᄌ",                 // HANGUL LETTER PIEUP-CIEUC → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG CIEUC
+	0x3177:  "ᄇᄐ",                 // HANGUL LETTER PIEUP-THIEUTH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG THIEUTH
+	0x3178:  "ᄇᄋ",                 // HANGUL LETTER KAPYEOUNPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0x3179:  "ᄇᄇᄋ",                // HANGUL LETTER KAPYEOUNSSANGPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0x317A:  "ᄉᄀ",                 // HANGUL LETTER SIOS-KIYEOK → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0x317B:  "ᄉᄂ",                 // HANGUL LETTER SIOS-NIEUN → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG NIEUN
+	0x317C:  "ᄉᄃ",                 // HANGUL LETTER SIOS-TIKEUT → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG TIKEUT
+	0x317D:  "ᄉᄇ",                 // HANGUL LETTER SIOS-PIEUP → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PIEUP
+	0x317E:  "ᄉᄌ",                 // HANGUL LETTER SIOS-CIEUC → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG CIEUC
+	0x317F:  "ᅀ",                  // HANGUL LETTER PANSIOS → HANGUL CHOSEONG PANSIOS
+	0x3180:  "ᄋᄋ",                 // HANGUL LETTER SSANGIEUNG → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG IEUNG
+	0x3181:  "ᅌ",                  // HANGUL LETTER YESIEUNG → HANGUL CHOSEONG YESIEUNG
+	0x3182:  "ᄋᄉ",                 // HANGUL LETTER YESIEUNG-SIOS → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG SIOS
+	0x3183:  "ᄋᅀ",                 // HANGUL LETTER YESIEUNG-PANSIOS → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG PANSIOS
+	0x3184:  "ᄑᄋ",                 // HANGUL LETTER KAPYEOUNPHIEUPH → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG IEUNG
+	0x3185:  "ᄒᄒ",                 // HANGUL LETTER SSANGHIEUH → HANGUL CHOSEONG HIEUH + HANGUL CHOSEONG HIEUH
+	0x3186:  "ᅙ",                  // HANGUL LETTER YEORINHIEUH → HANGUL CHOSEONG YEORINHIEUH
+	0x3187:  "ᅭᅣ",                 // HANGUL LETTER YO-YA → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG YA
+	0x3188:  "ᅭᅣ丨",                // HANGUL LETTER YO-YAE → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG YA + <CJK Ideograph>
+	0x3189:  "ᅭ丨",                 // HANGUL LETTER YO-I → HANGUL JUNGSEONG YO + <CJK Ideograph>
+	0x318A:  "ᅲᅧ",                 // HANGUL LETTER YU-YEO → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG YEO
+	0x318B:  "ᅲᅧ丨",                // HANGUL LETTER YU-YE → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG YEO + <CJK Ideograph>
+	0x318C:  "ᅲ丨",                 // HANGUL LETTER YU-I → HANGUL JUNGSEONG YU + <CJK Ideograph>
+	0x318D:  "ᆞ",                  // HANGUL LETTER ARAEA → HANGUL JUNGSEONG ARAEA
+	0x318E:  "ᆞ丨",                 // HANGUL LETTER ARAEAE → HANGUL JUNGSEONG ARAEA + <CJK Ideograph>
+	0x31D0:  "ー",                  // CJK STROKE H → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x31D1:  "丨",                  // CJK STROKE S → <CJK Ideograph>
+	0x31D3:  "/",                  // CJK STROKE SP → SOLIDUS
+	0x31D4:  "\\",                 // CJK STROKE D → REVERSE SOLIDUS
+	0x31D6:  "乛",                  // CJK STROKE HG → <CJK Ideograph>
+	0x31DA:  "亅",                  // CJK STROKE SG → <CJK Ideograph>
+	0x31DB:  "❬",                  // CJK STROKE PD → MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT
+	0x31DF:  "乚",                  // CJK STROKE SWG → <CJK Ideograph>
+	0x31E0:  "乙",                  // CJK STROKE HXWG → <CJK Ideograph>
+	0x3200:  "(ᄀ)",                // ) PARENTHESIZED HANGUL KIYEOK → LEFT PARENTHESIS + HANGUL CHOSEONG KIYEOK + RIGHT PARENTHESIS
+	0x3201:  "(ᄂ)",                // ) PARENTHESIZED HANGUL NIEUN → LEFT PARENTHESIS + HANGUL CHOSEONG NIEUN + RIGHT PARENTHESIS
+	0x3202:  "(ᄃ)",                // ) PARENTHESIZED HANGUL TIKEUT → LEFT PARENTHESIS + HANGUL CHOSEONG TIKEUT + RIGHT PARENTHESIS
+	0x3203:  "(ᄅ)",                // ) PARENTHESIZED HANGUL RIEUL → LEFT PARENTHESIS + HANGUL CHOSEONG RIEUL + RIGHT PARENTHESIS
+	0x3204:  "(ᄆ)",                // ) PARENTHESIZED HANGUL MIEUM → LEFT PARENTHESIS + HANGUL CHOSEONG MIEUM + RIGHT PARENTHESIS
+	0x3205:  "(ᄇ)",                // ) PARENTHESIZED HANGUL PIEUP → LEFT PARENTHESIS + HANGUL CHOSEONG PIEUP + RIGHT PARENTHESIS
+	0x3206:  "(ᄉ)",                // ) PARENTHESIZED HANGUL SIOS → LEFT PARENTHESIS + HANGUL CHOSEONG SIOS + RIGHT PARENTHESIS
+	0x3207:  "(ᄋ)",                // ) PARENTHESIZED HANGUL IEUNG → LEFT PARENTHESIS + HANGUL CHOSEONG IEUNG + RIGHT PARENTHESIS
+	0x3208:  "(ᄌ)",                // ) PARENTHESIZED HANGUL CIEUC → LEFT PARENTHESIS + HANGUL CHOSEONG CIEUC + RIGHT PARENTHESIS
+	0x3209:  "(ᄎ)",                // ) PARENTHESIZED HANGUL CHIEUCH → LEFT PARENTHESIS + HANGUL CHOSEONG CHIEUCH + RIGHT PARENTHESIS
+	0x320A:  "(ᄏ)",                // ) PARENTHESIZED HANGUL KHIEUKH → LEFT PARENTHESIS + HANGUL CHOSEONG KHIEUKH + RIGHT PARENTHESIS
+	0x320B:  "(ᄐ)",                // ) PARENTHESIZED HANGUL THIEUTH → LEFT PARENTHESIS + HANGUL CHOSEONG THIEUTH + RIGHT PARENTHESIS
+	0x320C:  "(ᄑ)",                // ) PARENTHESIZED HANGUL PHIEUPH → LEFT PARENTHESIS + HANGUL CHOSEONG PHIEUPH + RIGHT PARENTHESIS
+	0x320D:  "(ᄒ)",                // ) PARENTHESIZED HANGUL HIEUH → LEFT PARENTHESIS + HANGUL CHOSEONG HIEUH + RIGHT PARENTHESIS
+	0x320E:  "(가)",                // ) PARENTHESIZED HANGUL KIYEOK A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x320F:  "(나)",                // ) PARENTHESIZED HANGUL NIEUN A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3210:  "(다)",                // ) PARENTHESIZED HANGUL TIKEUT A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3211:  "(라)",                // ) PARENTHESIZED HANGUL RIEUL A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3212:  "(마)",                // ) PARENTHESIZED HANGUL MIEUM A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3213:  "(바)",                // ) PARENTHESIZED HANGUL PIEUP A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3214:  "(사)",                // ) PARENTHESIZED HANGUL SIOS A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3215:  "(아)",                // ) PARENTHESIZED HANGUL IEUNG A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3216:  "(자)",                // ) PARENTHESIZED HANGUL CIEUC A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3217:  "(차)",                // ) PARENTHESIZED HANGUL CHIEUCH A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3218:  "(카)",                // ) PARENTHESIZED HANGUL KHIEUKH A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3219:  "(타)",                // ) PARENTHESIZED HANGUL THIEUTH A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x321A:  "(파)",                // ) PARENTHESIZED HANGUL PHIEUPH A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x321B:  "(하)",                // ) PARENTHESIZED HANGUL HIEUH A → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x321C:  "(주)",                // ) PARENTHESIZED HANGUL CIEUC U → LEFT PARENTHESIS + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x321D:  "(오전)",               // ) PARENTHESIZED KOREAN CHARACTER OJEON → LEFT PARENTHESIS + <Hangul Syllable> + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x321E:  "(오후)",               // ) PARENTHESIZED KOREAN CHARACTER O HU → LEFT PARENTHESIS + <Hangul Syllable> + <Hangul Syllable> + RIGHT PARENTHESIS
+	0x3220:  "(ー)",                // ) PARENTHESIZED IDEOGRAPH ONE → LEFT PARENTHESIS + KATAKANA-HIRAGANA PROLONGED SOUND MARK + RIGHT PARENTHESIS
+	0x3221:  "(二)",                // ) PARENTHESIZED IDEOGRAPH TWO → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3222:  "(三)",                // ) PARENTHESIZED IDEOGRAPH THREE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3223:  "(四)",                // ) PARENTHESIZED IDEOGRAPH FOUR → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3224:  "(五)",                // ) PARENTHESIZED IDEOGRAPH FIVE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3225:  "(六)",                // ) PARENTHESIZED IDEOGRAPH SIX → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3226:  "(七)",                // ) PARENTHESIZED IDEOGRAPH SEVEN → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3227:  "(八)",                // ) PARENTHESIZED IDEOGRAPH EIGHT → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3228:  "(九)",                // ) PARENTHESIZED IDEOGRAPH NINE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3229:  "(十)",                // ) PARENTHESIZED IDEOGRAPH TEN → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x322A:  "(月)",                // ) PARENTHESIZED IDEOGRAPH MOON → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x322B:  "(火)",                // ) PARENTHESIZED IDEOGRAPH FIRE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x322C:  "(水)",                // ) PARENTHESIZED IDEOGRAPH WATER → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x322D:  "(木)",                // ) PARENTHESIZED IDEOGRAPH WOOD → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x322E:  "(金)",                // ) PARENTHESIZED IDEOGRAPH METAL → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x322F:  "(土)",                // ) PARENTHESIZED IDEOGRAPH EARTH → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3230:  "(日)",                // ) PARENTHESIZED IDEOGRAPH SUN → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3231:  "(株)",                // ) PARENTHESIZED IDEOGRAPH STOCK → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3232:  "(有)",                // ) PARENTHESIZED IDEOGRAPH HAVE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3233:  "(社)",                // ) PARENTHESIZED IDEOGRAPH SOCIETY → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3234:  "(名)",                // ) PARENTHESIZED IDEOGRAPH NAME → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3235:  "(特)",                // ) PARENTHESIZED IDEOGRAPH SPECIAL → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3236:  "(財)",                // ) PARENTHESIZED IDEOGRAPH FINANCIAL → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3237:  "(祝)",                // ) PARENTHESIZED IDEOGRAPH CONGRATULATION → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3238:  "(労)",                // ) PARENTHESIZED IDEOGRAPH LABOR → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3239:  "(代)",                // ) PARENTHESIZED IDEOGRAPH REPRESENT → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x323A:  "(呼)",                // ) PARENTHESIZED IDEOGRAPH CALL → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x323B:  "(学)",                // ) PARENTHESIZED IDEOGRAPH STUDY → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x323C:  "(監)",                // ) PARENTHESIZED IDEOGRAPH SUPERVISE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x323D:  "(企)",                // ) PARENTHESIZED IDEOGRAPH ENTERPRISE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x323E:  "(資)",                // ) PARENTHESIZED IDEOGRAPH RESOURCE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x323F:  "(協)",                // ) PARENTHESIZED IDEOGRAPH ALLIANCE → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3240:  "(祭)",                // ) PARENTHESIZED IDEOGRAPH FESTIVAL → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3241:  "(休)",                // ) PARENTHESIZED IDEOGRAPH REST → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3242:  "(自)",                // ) PARENTHESIZED IDEOGRAPH SELF → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x3243:  "(至)",                // ) PARENTHESIZED IDEOGRAPH REACH → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x32C0:  "l月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY → LATIN SMALL LETTER L + <CJK Ideograph>
+	0x32C1:  "2月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR FEBRUARY → DIGIT TWO + <CJK Ideograph>
+	0x32C2:  "3月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR MARCH → DIGIT THREE + <CJK Ideograph>
+	0x32C3:  "4月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR APRIL → DIGIT FOUR + <CJK Ideograph>
+	0x32C4:  "5月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR MAY → DIGIT FIVE + <CJK Ideograph>
+	0x32C5:  "6月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JUNE → DIGIT SIX + <CJK Ideograph>
+	0x32C6:  "7月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JULY → DIGIT SEVEN + <CJK Ideograph>
+	0x32C7:  "8月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR AUGUST → DIGIT EIGHT + <CJK Ideograph>
+	0x32C8:  "9月",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR SEPTEMBER → DIGIT NINE + <CJK Ideograph>
+	0x32C9:  "lO月",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR OCTOBER → LATIN SMALL LETTER L + LATIN CAPITAL LETTER O + <CJK Ideograph>
+	0x32CA:  "ll月",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR NOVEMBER → LATIN SMALL LETTER L + LATIN SMALL LETTER L + <CJK Ideograph>
+	0x32CB:  "l2月",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DECEMBER → LATIN SMALL LETTER L + DIGIT TWO + <CJK Ideograph>
+	0x3358:  "O点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ZERO → LATIN CAPITAL LETTER O + <CJK Ideograph>
+	0x3359:  "l点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ONE → LATIN SMALL LETTER L + <CJK Ideograph>
+	0x335A:  "2点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWO → DIGIT TWO + <CJK Ideograph>
+	0x335B:  "3点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THREE → DIGIT THREE + <CJK Ideograph>
+	0x335C:  "4点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOUR → DIGIT FOUR + <CJK Ideograph>
+	0x335D:  "5点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIVE → DIGIT FIVE + <CJK Ideograph>
+	0x335E:  "6点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIX → DIGIT SIX + <CJK Ideograph>
+	0x335F:  "7点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVEN → DIGIT SEVEN + <CJK Ideograph>
+	0x3360:  "8点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHT → DIGIT EIGHT + <CJK Ideograph>
+	0x3361:  "9点",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINE → DIGIT NINE + <CJK Ideograph>
+	0x3362:  "lO点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TEN → LATIN SMALL LETTER L + LATIN CAPITAL LETTER O + <CJK Ideograph>
+	0x3363:  "ll点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ELEVEN → LATIN SMALL LETTER L + LATIN SMALL LETTER L + <CJK Ideograph>
+	0x3364:  "l2点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWELVE → LATIN SMALL LETTER L + DIGIT TWO + <CJK Ideograph>
+	0x3365:  "l3点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THIRTEEN → LATIN SMALL LETTER L + DIGIT THREE + <CJK Ideograph>
+	0x3366:  "l4点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOURTEEN → LATIN SMALL LETTER L + DIGIT FOUR + <CJK Ideograph>
+	0x3367:  "l5点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIFTEEN → LATIN SMALL LETTER L + DIGIT FIVE + <CJK Ideograph>
+	0x3368:  "l6点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIXTEEN → LATIN SMALL LETTER L + DIGIT SIX + <CJK Ideograph>
+	0x3369:  "l7点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVENTEEN → LATIN SMALL LETTER L + DIGIT SEVEN + <CJK Ideograph>
+	0x336A:  "l8点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHTEEN → LATIN SMALL LETTER L + DIGIT EIGHT + <CJK Ideograph>
+	0x336B:  "l9点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINETEEN → LATIN SMALL LETTER L + DIGIT NINE + <CJK Ideograph>
+	0x336C:  "2O点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY → DIGIT TWO + LATIN CAPITAL LETTER O + <CJK Ideograph>
+	0x336D:  "2l点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-ONE → DIGIT TWO + LATIN SMALL LETTER L + <CJK Ideograph>
+	0x336E:  "22点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-TWO → DIGIT TWO + DIGIT TWO + <CJK Ideograph>
+	0x336F:  "23点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-THREE → DIGIT TWO + DIGIT THREE + <CJK Ideograph>
+	0x3370:  "24点",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-FOUR → DIGIT TWO + DIGIT FOUR + <CJK Ideograph>
+	0x33E0:  "l日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ONE → LATIN SMALL LETTER L + <CJK Ideograph>
+	0x33E1:  "2日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWO → DIGIT TWO + <CJK Ideograph>
+	0x33E2:  "3日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THREE → DIGIT THREE + <CJK Ideograph>
+	0x33E3:  "4日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOUR → DIGIT FOUR + <CJK Ideograph>
+	0x33E4:  "5日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIVE → DIGIT FIVE + <CJK Ideograph>
+	0x33E5:  "6日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIX → DIGIT SIX + <CJK Ideograph>
+	0x33E6:  "7日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVEN → DIGIT SEVEN + <CJK Ideograph>
+	0x33E7:  "8日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHT → DIGIT EIGHT + <CJK Ideograph>
+	0x33E8:  "9日",                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINE → DIGIT NINE + <CJK Ideograph>
+	0x33E9:  "lO日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TEN → LATIN SMALL LETTER L + LATIN CAPITAL LETTER O + <CJK Ideograph>
+	0x33EA:  "ll日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ELEVEN → LATIN SMALL LETTER L + LATIN SMALL LETTER L + <CJK Ideograph>
+	0x33EB:  "l2日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWELVE → LATIN SMALL LETTER L + DIGIT TWO + <CJK Ideograph>
+	0x33EC:  "l3日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTEEN → LATIN SMALL LETTER L + DIGIT THREE + <CJK Ideograph>
+	0x33ED:  "l4日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOURTEEN → LATIN SMALL LETTER L + DIGIT FOUR + <CJK Ideograph>
+	0x33EE:  "l5日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIFTEEN → LATIN SMALL LETTER L + DIGIT FIVE + <CJK Ideograph>
+	0x33EF:  "l6日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIXTEEN → LATIN SMALL LETTER L + DIGIT SIX + <CJK Ideograph>
+	0x33F0:  "l7日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVENTEEN → LATIN SMALL LETTER L + DIGIT SEVEN + <CJK Ideograph>
+	0x33F1:  "l8日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHTEEN → LATIN SMALL LETTER L + DIGIT EIGHT + <CJK Ideograph>
+	0x33F2:  "l9日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINETEEN → LATIN SMALL LETTER L + DIGIT NINE + <CJK Ideograph>
+	0x33F3:  "2O日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY → DIGIT TWO + LATIN CAPITAL LETTER O + <CJK Ideograph>
+	0x33F4:  "2l日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-ONE → DIGIT TWO + LATIN SMALL LETTER L + <CJK Ideograph>
+	0x33F5:  "22日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-TWO → DIGIT TWO + DIGIT TWO + <CJK Ideograph>
+	0x33F6:  "23日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-THREE → DIGIT TWO + DIGIT THREE + <CJK Ideograph>
+	0x33F7:  "24日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FOUR → DIGIT TWO + DIGIT FOUR + <CJK Ideograph>
+	0x33F8:  "25日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FIVE → DIGIT TWO + DIGIT FIVE + <CJK Ideograph>
+	0x33F9:  "26日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SIX → DIGIT TWO + DIGIT SIX + <CJK Ideograph>
+	0x33FA:  "27日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SEVEN → DIGIT TWO + DIGIT SEVEN + <CJK Ideograph>
+	0x33FB:  "28日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-EIGHT → DIGIT TWO + DIGIT EIGHT + <CJK Ideograph>
+	0x33FC:  "29日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-NINE → DIGIT TWO + DIGIT NINE + <CJK Ideograph>
+	0x33FD:  "3O日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY → DIGIT THREE + LATIN CAPITAL LETTER O + <CJK Ideograph>
+	0x33FE:  "3l日",                // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY-ONE → DIGIT THREE + LATIN SMALL LETTER L + <CJK Ideograph>
+	0x39B3:  "㘽",                  // <CJK Ideograph Extension A> → <CJK Ideograph Extension A>
+	0x439B:  "㖈",                  // <CJK Ideograph Extension A> → <CJK Ideograph Extension A>
+	0x4420:  "㬻",                  // <CJK Ideograph Extension A> → <CJK Ideograph Extension A>
+	0x4695:  "𧢮",                  // <CJK Ideograph Extension A> → <CJK Ideograph Extension B>
+	0x4E00:  "ー",                  // <CJK Ideograph> → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x4E15:  "조",                 // <CJK Ideograph> → HANGUL CHOSEONG CIEUC + HANGUL JUNGSEONG O
+	0x4E1B:  "ᄉᄉー",                // <CJK Ideograph> → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS + KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0x4E36:  "\\",                 // <CJK Ideograph> → REVERSE SOLIDUS
+	0x4E3F:  "/",                  // <CJK Ideograph> → SOLIDUS
+	0x4E8E:  "𛄢",                  // <CJK Ideograph> → KATAKANA LETTER ARCHAIC WU
+	0x4ECA:  "ᄉーᄀ",                // <CJK Ideograph> → HANGUL CHOSEONG SIOS + KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL CHOSEONG KIYEOK
+	0x5002:  "併",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x503C:  "値",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x5152:  "𖿳",                  // ( 兒 → 𖿳 )
+	0x535F:  "마",                 // <CJK Ideograph> → HANGUL CHOSEONG MIEUM + HANGUL JUNGSEONG A
+	0x5408:  "ᄉーᄆ",                // <CJK Ideograph> → HANGUL CHOSEONG SIOS + KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL CHOSEONG MIEUM
+	0x555F:  "啓",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x56D7:  "口",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x586B:  "塡",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x58EB:  "土",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x58FF:  "墫",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x5B00:  "媯",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x5E32:  "帡",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x5E50:  "㬺",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x6138:  "𫜿",                  // ( 愸 → 𫜿 )
+	0x6238:  "戶",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x6409:  "㩁",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x6663:  "䀿",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x6669:  "晚",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x66F6:  "㫚",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x6726:  "䑃",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x67FF:  "杮",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x69E9:  "㮣",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x6A27:  "榝",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x6F59:  "溈",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x723F:  "누丨",                // <CJK Ideograph> → HANGUL CHOSEONG NIEUN + HANGUL JUNGSEONG U + <CJK Ideograph>
+	0x784F:  "研",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x7D76:  "絕",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x80A6:  "朌",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x80CA:  "朐",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x80D0:  "朏",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x80F6:  "㬵",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x8101:  "朓",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8127:  "朘",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8141:  "胼",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x81A7:  "朣",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x853F:  "蒍",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8641:  "蘷",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8A1E:  "䚶",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0x8A7D:  "訮",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8B8F:  "讆",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8C63:  "豜",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8D86:  "赿",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8DFA:  "跥",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8E9B:  "躗",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x8F27:  "軿",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x90DE:  "郎",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x93AE:  "鎭",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x9577:  "ᄐーᄂᄌ",               // <CJK Ideograph> → HANGUL CHOSEONG THIEUTH + KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG CIEUC
+	0x96B8:  "隷",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x9E43:  "鹂",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x9ED2:  "黑",                  // <CJK Ideograph> → <CJK Ideograph>
+	0x9FC3:  "䀹",                  // <CJK Ideograph> → <CJK Ideograph Extension A>
+	0xA494:  "ꋍ",                  // YI RADICAL CYP → YI SYLLABLE CYP
+	0xA49C:  "ꃀ",                  // YI RADICAL MOP → YI SYLLABLE MOP
+	0xA49E:  "ꁊ",                  // YI RADICAL PUT → YI SYLLABLE PUT
+	0xA4A7:  "ꑘ",                  // YI RADICAL NYOP → YI SYLLABLE NYOP
+	0xA4A8:  "ꄲ",                  // YI RADICAL TU → YI SYLLABLE TU
+	0xA4AC:  "ꁐ",                  // YI RADICAL PYT → YI SYLLABLE PYT
+	0xA4B0:  "ꏂ",                  // YI RADICAL SHY → YI SYLLABLE SHY
+	0xA4BA:  "ꎿ",                  // YI RADICAL SHUR → YI SYLLABLE SHUR
+	0xA4BE:  "ꊱ",                  // YI RADICAL CIP → YI SYLLABLE CIP
+	0xA4BF:  "ꉙ",                  // YI RADICAL HXOP → YI SYLLABLE HXOP
+	0xA4C0:  "ꎫ",                  // YI RADICAL SHAT → YI SYLLABLE SHAT
+	0xA4C2:  "ꎵ",                  // YI RADICAL SHOP → YI SYLLABLE SHOP
+	0xA4D0:  "B",                  // LISU LETTER BA → LATIN CAPITAL LETTER B
+	0xA4D1:  "P",                  // LISU LETTER PA → LATIN CAPITAL LETTER P
+	0xA4D2:  "d",                  // LISU LETTER PHA → LATIN SMALL LETTER D
+	0xA4D3:  "D",                  // LISU LETTER DA → LATIN CAPITAL LETTER D
+	0xA4D4:  "T",                  // LISU LETTER TA → LATIN CAPITAL LETTER T
+	0xA4D6:  "G",                  // LISU LETTER GA → LATIN CAPITAL LETTER G
+	0xA4D7:  "K",                  // LISU LETTER KA → LATIN CAPITAL LETTER K
+	0xA4D9:  "J",                  // LISU LETTER JA → LATIN CAPITAL LETTER J
+	0xA4DA:  "C",                  // LISU LETTER CA → LATIN CAPITAL LETTER C
+	0xA4DB:  "Ɔ",                  // LISU LETTER CHA → LATIN CAPITAL LETTER OPEN O
+	0xA4DC:  "Z",                  // LISU LETTER DZA → LATIN CAPITAL LETTER Z
+	0xA4DD:  "F",                  // LISU LETTER TSA → LATIN CAPITAL LETTER F
+	0xA4DE:  "Ⅎ",                  // LISU LETTER TSHA → TURNED CAPITAL F
+	0xA4DF:  "M",                  // LISU LETTER MA → LATIN CAPITAL LETTER M
+	0xA4E0:  "N",                  // LISU LETTER NA → LATIN CAPITAL LETTER N
+	0xA4E1:  "L",                  // LISU LETTER LA → LATIN CAPITAL LETTER L
+	0xA4E2:  "S",                  // LISU LETTER SA → LATIN CAPITAL LETTER S
+	0xA4E3:  "R",                  // LISU LETTER ZHA → LATIN CAPITAL LETTER R
+	0xA4E5:  "Ʌ",                  // LISU LETTER NGA → LATIN CAPITAL LETTER TURNED V
+	0xA4E6:  "V",                  // LISU LETTER HA → LATIN CAPITAL LETTER V
+	0xA4E7:  "H",                  // LISU LETTER XA → LATIN CAPITAL LETTER H
+	0xA4EA:  "W",                  // LISU LETTER WA → LATIN CAPITAL LETTER W
+	0xA4EB:  "X",                  // LISU LETTER SHA → LATIN CAPITAL LETTER X
+	0xA4EC:  "Y",                  // LISU LETTER YA → LATIN CAPITAL LETTER Y
+	0xA4ED:  "ᙠ",                  // LISU LETTER GHA → CANADIAN SYLLABICS CARRIER TSA
+	0xA4EE:  "A",                  // LISU LETTER A → LATIN CAPITAL LETTER A
+	0xA4EF:  "Ɐ",                  // LISU LETTER AE → LATIN CAPITAL LETTER TURNED A
+	0xA4F0:  "E",                  // LISU LETTER E → LATIN CAPITAL LETTER E
+	0xA4F1:  "Ǝ",                  // LISU LETTER EU → LATIN CAPITAL LETTER REVERSED E
+	0xA4F2:  "l",                  // LISU LETTER I → LATIN SMALL LETTER L
+	0xA4F3:  "O",                  // LISU LETTER O → LATIN CAPITAL LETTER O
+	0xA4F4:  "U",                  // LISU LETTER U → LATIN CAPITAL LETTER U
+	0xA4F5:  "Ո",                  // LISU LETTER UE → ARMENIAN CAPITAL LETTER VO
+	0xA4F7:  "ᗡ",                  // LISU LETTER OE → CANADIAN SYLLABICS CARRIER THA
+	0xA4F8:  ".",                  // LISU LETTER TONE MYA TI → FULL STOP
+	0xA4F9:  ",",                  // LISU LETTER TONE NA PO → COMMA
+	0xA4FA:  "..",                 // LISU LETTER TONE MYA CYA → FULL STOP + FULL STOP
+	0xA4FB:  ".,",                 // LISU LETTER TONE MYA BO → FULL STOP + COMMA
+	0xA4FD:  ":",                  // LISU LETTER TONE MYA JEU → COLON
+	0xA4FE:  "-.",                 // LISU PUNCTUATION COMMA → HYPHEN-MINUS + FULL STOP
+	0xA4FF:  "=",                  // LISU PUNCTUATION FULL STOP → EQUALS SIGN
+	0xA60E:  ".",                  // VAI FULL STOP → FULL STOP
+	0xA644:  "2",                  // CYRILLIC CAPITAL LETTER REVERSED DZE → DIGIT TWO
+	0xA645:  "ƨ",                  // CYRILLIC SMALL LETTER REVERSED DZE → LATIN SMALL LETTER TONE TWO
+	0xA647:  "i",                  // CYRILLIC SMALL LETTER IOTA → LATIN SMALL LETTER I
+	0xA64D:  "ω",                  // CYRILLIC SMALL LETTER BROAD OMEGA → GREEK SMALL LETTER OMEGA
+	0xA650:  "Ъl",                 // CYRILLIC CAPITAL LETTER YERU WITH BACK YER → CYRILLIC CAPITAL LETTER HARD SIGN + LATIN SMALL LETTER L
+	0xA651:  "ˉbi",                // CYRILLIC SMALL LETTER YERU WITH BACK YER → MODIFIER LETTER MACRON + LATIN SMALL LETTER B + LATIN SMALL LETTER I
+	0xA668:  "ʘ",                  // CYRILLIC CAPITAL LETTER MONOCULAR O → LATIN LETTER BILABIAL CLICK
+	0xA66F:  "⃩",                  // COMBINING CYRILLIC VZMET → COMBINING WIDE BRIDGE ABOVE
+	0xA67C:  "̆",                  // COMBINING CYRILLIC KAVYKA → COMBINING BREVE
+	0xA67E:  "ˇ",                  // CYRILLIC KAVYKA → CARON
+	0xA695:  "h̔",                 // CYRILLIC SMALL LETTER HWE → LATIN SMALL LETTER H + COMBINING REVERSED COMMA ABOVE
+	0xA698:  "OO",                 // CYRILLIC CAPITAL LETTER DOUBLE O → LATIN CAPITAL LETTER O + LATIN CAPITAL LETTER O
+	0xA699:  "oo",                 // CYRILLIC SMALL LETTER DOUBLE O → LATIN SMALL LETTER O + LATIN SMALL LETTER O
+	0xA69A:  "𐊨",                  // CYRILLIC CAPITAL LETTER CROSSED O → CARIAN LETTER Q
+	0xA6A1:  "И",                  // BAMUM LETTER KA → CYRILLIC CAPITAL LETTER I
+	0xA6B0:  "ᚹ",                  // BAMUM LETTER TAA → RUNIC LETTER WUNJO WYNN W
+	0xA6B1:  "Ⱶ",                  // BAMUM LETTER NDAA → LATIN CAPITAL LETTER HALF H
+	0xA6CD:  "ʡ",                  // BAMUM LETTER LU → LATIN LETTER GLOTTAL STOP WITH STROKE
+	0xA6CE:  "Ʌ",                  // BAMUM LETTER MI → LATIN CAPITAL LETTER TURNED V
+	0xA6DB:  "Π",                  // BAMUM LETTER NA → GREEK CAPITAL LETTER PI
+	0xA6DF:  "V",                  // BAMUM LETTER KO → LATIN CAPITAL LETTER V
+	0xA6EB:  "?",                  // BAMUM LETTER NTUU → QUESTION MARK
+	0xA6EF:  "2",                  // BAMUM LETTER KOGHOM → DIGIT TWO
+	0xA6F0:  "̂",                  // BAMUM COMBINING MARK KOQNDON → COMBINING CIRCUMFLEX ACCENT
+	0xA6F1:  "̄",                  // BAMUM COMBINING MARK TUKWENTIS → COMBINING MACRON
+	0xA6F4:  "꛳꛳",                 // BAMUM COLON → BAMUM FULL STOP + BAMUM FULL STOP
+	0xA714:  "˫",                  // MODIFIER LETTER MID LEFT-STEM TONE BAR → MODIFIER LETTER YANG DEPARTING TONE MARK
+	0xA716:  "˪",                  // MODIFIER LETTER EXTRA-LOW LEFT-STEM TONE BAR → MODIFIER LETTER YIN DEPARTING TONE MARK
+	0xA728:  "T3",                 // LATIN CAPITAL LETTER TZ → LATIN CAPITAL LETTER T + DIGIT THREE
+	0xA729:  "tȝ",                 // LATIN SMALL LETTER TZ → LATIN SMALL LETTER T + LATIN SMALL LETTER YOGH
+	0xA731:  "s",                  // LATIN LETTER SMALL CAPITAL S → LATIN SMALL LETTER S
+	0xA732:  "AA",                 // LATIN CAPITAL LETTER AA → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER A
+	0xA733:  "aa",                 // LATIN SMALL LETTER AA → LATIN SMALL LETTER A + LATIN SMALL LETTER A
+	0xA734:  "AO",                 // LATIN CAPITAL LETTER AO → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER O
+	0xA735:  "ao",                 // LATIN SMALL LETTER AO → LATIN SMALL LETTER A + LATIN SMALL LETTER O
+	0xA736:  "AU",                 // LATIN CAPITAL LETTER AU → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER U
+	0xA737:  "au",                 // LATIN SMALL LETTER AU → LATIN SMALL LETTER A + LATIN SMALL LETTER U
+	0xA738:  "AV",                 // LATIN CAPITAL LETTER AV → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER V
+	0xA739:  "av",                 // LATIN SMALL LETTER AV → LATIN SMALL LETTER A + LATIN SMALL LETTER V
+	0xA73A:  "AV",                 // LATIN CAPITAL LETTER AV WITH HORIZONTAL BAR → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER V
+	0xA73B:  "av",                 // LATIN SMALL LETTER AV WITH HORIZONTAL BAR → LATIN SMALL LETTER A + LATIN SMALL LETTER V
+	0xA73C:  "AY",                 // LATIN CAPITAL LETTER AY → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER Y
+	0xA73D:  "ay",                 // LATIN SMALL LETTER AY → LATIN SMALL LETTER A + LATIN SMALL LETTER Y
+	0xA740:  "K̵",                 // LATIN CAPITAL LETTER K WITH STROKE → LATIN CAPITAL LETTER K + COMBINING SHORT STROKE OVERLAY
+	0xA74A:  "O̵",                 // LATIN CAPITAL LETTER O WITH LONG STROKE OVERLAY → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0xA74B:  "o̵",                 // LATIN SMALL LETTER O WITH LONG STROKE OVERLAY → LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0xA74E:  "OO",                 // LATIN CAPITAL LETTER OO → LATIN CAPITAL LETTER O + LATIN CAPITAL LETTER O
+	0xA74F:  "oo",                 // LATIN SMALL LETTER OO → LATIN SMALL LETTER O + LATIN SMALL LETTER O
+	0xA75A:  "2",                  // LATIN CAPITAL LETTER R ROTUNDA → DIGIT TWO
+	0xA761:  "w̦",                 // LATIN SMALL LETTER VY → LATIN SMALL LETTER W + COMBINING COMMA BELOW
+	0xA76A:  "3",                  // LATIN CAPITAL LETTER ET → DIGIT THREE
+	0xA76B:  "ȝ",                  // LATIN SMALL LETTER ET → LATIN SMALL LETTER YOGH
+	0xA76E:  "9",                  // LATIN CAPITAL LETTER CON → DIGIT NINE
+	0xA777:  "tf",                 // LATIN SMALL LETTER TUM → LATIN SMALL LETTER T + LATIN SMALL LETTER F
+	0xA778:  "&",                  // LATIN SMALL LETTER UM → AMPERSAND
+	0xA77A:  "Ꝺ",                  // LATIN SMALL LETTER INSULAR D → LATIN CAPITAL LETTER INSULAR D
+	0xA789:  ":",                  // MODIFIER LETTER COLON → COLON
+	0xA78C:  "'",                  // LATIN SMALL LETTER SALTILLO → APOSTROPHE
+	0xA78F:  "·",                  // LATIN LETTER SINOLOGICAL DOT → MIDDLE DOT
+	0xA795:  "ꜧ",                  // LATIN SMALL LETTER H WITH PALATAL HOOK → LATIN SMALL LETTER HENG
+	0xA798:  "F",                  // LATIN CAPITAL LETTER F WITH STROKE → LATIN CAPITAL LETTER F
+	0xA799:  "f",                  // LATIN SMALL LETTER F WITH STROKE → LATIN SMALL LETTER F
+	0xA79A:  "𐐒",                  // LATIN CAPITAL LETTER VOLAPUK AE → DESERET CAPITAL LETTER BEE
+	0xA79B:  "𐐺",                  // LATIN SMALL LETTER VOLAPUK AE → DESERET SMALL LETTER BEE
+	0xA79D:  "ʚ",                  // LATIN SMALL LETTER VOLAPUK OE → LATIN SMALL LETTER CLOSED OPEN E
+	0xA79E:  "ꓤ",                  // LATIN CAPITAL LETTER VOLAPUK UE → LISU LETTER ZA
+	0xA79F:  "u",                  // LATIN SMALL LETTER VOLAPUK UE → LATIN SMALL LETTER U
+	0xA7AB:  "3",                  // LATIN CAPITAL LETTER REVERSED OPEN E → DIGIT THREE
+	0xA7B1:  "ꓕ",                  // LATIN CAPITAL LETTER TURNED T → LISU LETTER THA
+	0xA7B2:  "J",                  // LATIN CAPITAL LETTER J WITH CROSSED-TAIL → LATIN CAPITAL LETTER J
+	0xA7B3:  "X",                  // LATIN CAPITAL LETTER CHI → LATIN CAPITAL LETTER X
+	0xA7B4:  "B",                  // LATIN CAPITAL LETTER BETA → LATIN CAPITAL LETTER B
+	0xA7B5:  "ß",                  // LATIN SMALL LETTER BETA → LATIN SMALL LETTER SHARP S
+	0xA7B6:  "Ꙍ",                  // LATIN CAPITAL LETTER OMEGA → CYRILLIC CAPITAL LETTER BROAD OMEGA
+	0xA7B7:  "ω",                  // LATIN SMALL LETTER OMEGA → GREEK SMALL LETTER OMEGA
+	0xA7CF:  "꟎",                  // ( ꟏ → ꟎ )
+	0xA7D2:  "ꟓ",                  // ( ꟒ → ꟓ )
+	0xA7D4:  "ꟕ",                  // ( ꟔ → ꟕ )
+	0xA7D6:  "ß",                  // LATIN CAPITAL LETTER MIDDLE SCOTS S → LATIN SMALL LETTER SHARP S
+	0xA7DA:  "Ʌ",                  // ( Ꟛ → Ʌ )
+	0xA7DB:  "λ",                  // ( ꟛ → λ )
+	0xA7DC:  "Ʌ̸",                 // ( Ƛ → Ʌ̸ )
+	0xA7F1:  "ᣵ",                  // ( ꟱ → ᣵ )
+	0xA7F7:  "ー",                  // LATIN EPIGRAPHIC LETTER SIDEWAYS I → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0xA830:  "।",                  // NORTH INDIC FRACTION ONE QUARTER → DEVANAGARI DANDA
+	0xA960:  "ᄃᄆ",                 // HANGUL CHOSEONG TIKEUT-MIEUM → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG MIEUM
+	0xA961:  "ᄃᄇ",                 // HANGUL CHOSEONG TIKEUT-PIEUP → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG PIEUP
+	0xA962:  "ᄃᄉ",                 // HANGUL CHOSEONG TIKEUT-SIOS → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG SIOS
+	0xA963:  "ᄃᄌ",                 // HANGUL CHOSEONG TIKEUT-CIEUC → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG CIEUC
+	0xA964:  "ᄅᄀ",                 // HANGUL CHOSEONG RIEUL-KIYEOK → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK
+	0xA965:  "ᄅᄀᄀ",                // HANGUL CHOSEONG RIEUL-SSANGKIYEOK → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG KIYEOK
+	0xA966:  "ᄅᄃ",                 // HANGUL CHOSEONG RIEUL-TIKEUT → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG TIKEUT
+	0xA967:  "ᄅᄃᄃ",                // HANGUL CHOSEONG RIEUL-SSANGTIKEUT → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG TIKEUT
+	0xA968:  "ᄅᄆ",                 // HANGUL CHOSEONG RIEUL-MIEUM → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG MIEUM
+	0xA969:  "ᄅᄇ",                 // HANGUL CHOSEONG RIEUL-PIEUP → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP
+	0xA96A:  "ᄅᄇᄇ",                // HANGUL CHOSEONG RIEUL-SSANGPIEUP → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PIEUP
+	0xA96B:  "ᄅᄇᄋ",                // HANGUL CHOSEONG RIEUL-KAPYEOUNPIEUP → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0xA96C:  "ᄅᄉ",                 // HANGUL CHOSEONG RIEUL-SIOS → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG SIOS
+	0xA96D:  "ᄅᄌ",                 // HANGUL CHOSEONG RIEUL-CIEUC → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG CIEUC
+	0xA96E:  "ᄅᄏ",                 // HANGUL CHOSEONG RIEUL-KHIEUKH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KHIEUKH
+	0xA96F:  "ᄆᄀ",                 // HANGUL CHOSEONG MIEUM-KIYEOK → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG KIYEOK
+	0xA970:  "ᄆᄃ",                 // HANGUL CHOSEONG MIEUM-TIKEUT → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG TIKEUT
+	0xA971:  "ᄆᄉ",                 // HANGUL CHOSEONG MIEUM-SIOS → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG SIOS
+	0xA972:  "ᄇᄉᄐ",                // HANGUL CHOSEONG PIEUP-SIOS-THIEUTH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG THIEUTH
+	0xA973:  "ᄇᄏ",                 // HANGUL CHOSEONG PIEUP-KHIEUKH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG KHIEUKH
+	0xA974:  "ᄇᄒ",                 // HANGUL CHOSEONG PIEUP-HIEUH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG HIEUH
+	0xA975:  "ᄉᄉᄇ",                // HANGUL CHOSEONG SSANGSIOS-PIEUP → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PIEUP
+	0xA976:  "ᄋᄅ",                 // HANGUL CHOSEONG IEUNG-RIEUL → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG RIEUL
+	0xA977:  "ᄋᄒ",                 // HANGUL CHOSEONG IEUNG-HIEUH → HANGUL CHOSEONG IEUNG + HANGUL CHOSEONG HIEUH
+	0xA978:  "ᄌᄌᄒ",                // HANGUL CHOSEONG SSANGCIEUC-HIEUH → HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG HIEUH
+	0xA979:  "ᄐᄐ",                 // HANGUL CHOSEONG SSANGTHIEUTH → HANGUL CHOSEONG THIEUTH + HANGUL CHOSEONG THIEUTH
+	0xA97A:  "ᄑᄒ",                 // HANGUL CHOSEONG PHIEUPH-HIEUH → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG HIEUH
+	0xA97B:  "ᄒᄉ",                 // HANGUL CHOSEONG HIEUH-SIOS → HANGUL CHOSEONG HIEUH + HANGUL CHOSEONG SIOS
+	0xA97C:  "ᅙᅙ",                 // HANGUL CHOSEONG SSANGYEORINHIEUH → HANGUL CHOSEONG YEORINHIEUH + HANGUL CHOSEONG YEORINHIEUH
+	0xA992:  "ⰿ",                  // JAVANESE LETTER GA → GLAGOLITIC SMALL LETTER MYSLITE
+	0xA9A3:  "ꦝ",                  // JAVANESE LETTER DA MAHAPRANA → JAVANESE LETTER DDA
+	0xA9C6:  "꧐",                  // JAVANESE PADA WINDU → JAVANESE DIGIT ZERO
+	0xA9CF:  "٢",                  // JAVANESE PANGRANGKEP → ARABIC-INDIC DIGIT TWO
+	0xAA53:  "ꨁ",                  // CHAM DIGIT THREE → CHAM LETTER I
+	0xAA56:  "ꨣ",                  // CHAM DIGIT SIX → CHAM LETTER RA
+	0xAB32:  "e",                  // LATIN SMALL LETTER BLACKLETTER E → LATIN SMALL LETTER E
+	0xAB35:  "f",                  // LATIN SMALL LETTER LENIS F → LATIN SMALL LETTER F
+	0xAB3D:  "o",                  // LATIN SMALL LETTER BLACKLETTER O → LATIN SMALL LETTER O
+	0xAB3E:  "o̸",                 // LATIN SMALL LETTER BLACKLETTER O WITH STROKE → LATIN SMALL LETTER O + COMBINING LONG SOLIDUS OVERLAY
+	0xAB3F:  "ɔ̸",                 // LATIN SMALL LETTER OPEN O WITH STROKE → LATIN SMALL LETTER OPEN O + COMBINING LONG SOLIDUS OVERLAY
+	0xAB41:  "ǝo̸",                // LATIN SMALL LETTER TURNED OE WITH STROKE → LATIN SMALL LETTER TURNED E + LATIN SMALL LETTER O + COMBINING LONG SOLIDUS OVERLAY
+	0xAB42:  "ǝo̵",                // LATIN SMALL LETTER TURNED OE WITH HORIZONTAL STROKE → LATIN SMALL LETTER TURNED E + LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0xAB47:  "r",                  // LATIN SMALL LETTER R WITHOUT HANDLE → LATIN SMALL LETTER R
+	0xAB48:  "r",                  // LATIN SMALL LETTER DOUBLE R → LATIN SMALL LETTER R
+	0xAB4D:  "ʃ",                  // LATIN SMALL LETTER BASELINE ESH → LATIN SMALL LETTER ESH
+	0xAB4E:  "u",                  // LATIN SMALL LETTER U WITH SHORT RIGHT LEG → LATIN SMALL LETTER U
+	0xAB52:  "u",                  // LATIN SMALL LETTER U WITH LEFT HOOK → LATIN SMALL LETTER U
+	0xAB53:  "χ",                  // LATIN SMALL LETTER CHI → GREEK SMALL LETTER CHI
+	0xAB55:  "χ",                  // LATIN SMALL LETTER CHI WITH LOW LEFT SERIF → GREEK SMALL LETTER CHI
+	0xAB5A:  "y",                  // LATIN SMALL LETTER Y WITH SHORT RIGHT LEG → LATIN SMALL LETTER Y
+	0xAB60:  "љ",                  // LATIN SMALL LETTER SAKHA YAT → CYRILLIC SMALL LETTER LJE
+	0xAB62:  "ɔe",                 // LATIN SMALL LETTER OPEN OE → LATIN SMALL LETTER OPEN O + LATIN SMALL LETTER E
+	0xAB63:  "uo",                 // LATIN SMALL LETTER UO → LATIN SMALL LETTER U + LATIN SMALL LETTER O
+	0xAB70:  "ᴅ",                  // CHEROKEE SMALL LETTER A → LATIN LETTER SMALL CAPITAL D
+	0xAB71:  "ʀ",                  // CHEROKEE SMALL LETTER E → LATIN LETTER SMALL CAPITAL R
+	0xAB72:  "ᴛ",                  // CHEROKEE SMALL LETTER I → LATIN LETTER SMALL CAPITAL T
+	0xAB74:  "ơ",                 // CHEROKEE SMALL LETTER U → LATIN SMALL LETTER O + COMBINING HORN
+	0xAB75:  "i",                  // CHEROKEE SMALL LETTER V → LATIN SMALL LETTER I
+	0xAB7A:  "ᴀ",                  // CHEROKEE SMALL LETTER GO → LATIN LETTER SMALL CAPITAL A
+	0xAB7B:  "ᴊ",                  // CHEROKEE SMALL LETTER GU → LATIN LETTER SMALL CAPITAL J
+	0xAB7C:  "ᴇ",                  // CHEROKEE SMALL LETTER GV → LATIN LETTER SMALL CAPITAL E
+	0xAB7E:  "ɂ",                  // CHEROKEE SMALL LETTER HE → LATIN SMALL LETTER GLOTTAL STOP
+	0xAB80:  "ⱶ",                  // CHEROKEE SMALL LETTER HO → LATIN SMALL LETTER HALF H
+	0xAB81:  "r",                  // CHEROKEE SMALL LETTER HU → LATIN SMALL LETTER R
+	0xAB83:  "w",                  // CHEROKEE SMALL LETTER LA → LATIN SMALL LETTER W
+	0xAB87:  "ʍ",                  // CHEROKEE SMALL LETTER LU → LATIN SMALL LETTER TURNED W
+	0xAB8B:  "ʜ",                  // CHEROKEE SMALL LETTER MI → LATIN LETTER SMALL CAPITAL H
+	0xAB8E:  "o̵",                 // CHEROKEE SMALL LETTER NA → LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0xAB90:  "ɢ",                  // CHEROKEE SMALL LETTER NAH → LATIN LETTER SMALL CAPITAL G
+	0xAB93:  "z",                  // CHEROKEE SMALL LETTER NO → LATIN SMALL LETTER Z
+	0xAB9B:  "ꞓ",                  // CHEROKEE SMALL LETTER QUV → LATIN SMALL LETTER C WITH BAR
+	0xAB9C:  "u̵",                 // CHEROKEE SMALL LETTER SA → LATIN SMALL LETTER U + COMBINING SHORT STROKE OVERLAY
+	0xAB9F:  "ƅ",                  // CHEROKEE SMALL LETTER SI → LATIN SMALL LETTER TONE SIX
+	0xABA2:  "ʀ",                  // CHEROKEE SMALL LETTER SV → LATIN LETTER SMALL CAPITAL R
+	0xABA9:  "v",                  // CHEROKEE SMALL LETTER DO → LATIN SMALL LETTER V
+	0xABAA:  "s",                  // CHEROKEE SMALL LETTER DU → LATIN SMALL LETTER S
+	0xABAE:  "ʟ",                  // CHEROKEE SMALL LETTER TLE → LATIN LETTER SMALL CAPITAL L
+	0xABAF:  "c",                  // CHEROKEE SMALL LETTER TLI → LATIN SMALL LETTER C
+	0xABB2:  "ᴘ",                  // CHEROKEE SMALL LETTER TLV → LATIN LETTER SMALL CAPITAL P
+	0xABB6:  "ĸ",                  // CHEROKEE SMALL LETTER TSO → LATIN SMALL LETTER KRA
+	0xABBB:  "o̵",                 // CHEROKEE SMALL LETTER WI → LATIN SMALL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0xD7B0:  "ᅩᅧ",                 // HANGUL JUNGSEONG O-YEO → HANGUL JUNGSEONG O + HANGUL JUNGSEONG YEO
+	0xD7B1:  "ᅩᅩ丨",                // HANGUL JUNGSEONG O-O-I → HANGUL JUNGSEONG O + HANGUL JUNGSEONG O + <CJK Ideograph>
+	0xD7B2:  "ᅭᅡ",                 // HANGUL JUNGSEONG YO-A → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG A
+	0xD7B3:  "ᅭᅡ丨",                // HANGUL JUNGSEONG YO-AE → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG A + <CJK Ideograph>
+	0xD7B4:  "ᅭᅥ",                 // HANGUL JUNGSEONG YO-EO → HANGUL JUNGSEONG YO + HANGUL JUNGSEONG EO
+	0xD7B5:  "ᅮᅧ",                 // HANGUL JUNGSEONG U-YEO → HANGUL JUNGSEONG U + HANGUL JUNGSEONG YEO
+	0xD7B6:  "ᅮ丨丨",                // HANGUL JUNGSEONG U-I-I → HANGUL JUNGSEONG U + <CJK Ideograph> + <CJK Ideograph>
+	0xD7B7:  "ᅲᅡ丨",                // HANGUL JUNGSEONG YU-AE → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG A + <CJK Ideograph>
+	0xD7B8:  "ᅲᅩ",                 // HANGUL JUNGSEONG YU-O → HANGUL JUNGSEONG YU + HANGUL JUNGSEONG O
+	0xD7B9:  "ーᅡ",                 // HANGUL JUNGSEONG EU-A → KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL JUNGSEONG A
+	0xD7BA:  "ーᅥ",                 // HANGUL JUNGSEONG EU-EO → KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL JUNGSEONG EO
+	0xD7BB:  "ーᅥ丨",                // HANGUL JUNGSEONG EU-E → KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0xD7BC:  "ーᅩ",                 // HANGUL JUNGSEONG EU-O → KATAKANA-HIRAGANA PROLONGED SOUND MARK + HANGUL JUNGSEONG O
+	0xD7BD:  "丨ᅣᅩ",                // HANGUL JUNGSEONG I-YA-O → <CJK Ideograph> + HANGUL JUNGSEONG YA + HANGUL JUNGSEONG O
+	0xD7BE:  "丨ᅣ丨",                // HANGUL JUNGSEONG I-YAE → <CJK Ideograph> + HANGUL JUNGSEONG YA + <CJK Ideograph>
+	0xD7BF:  "丨ᅧ",                 // HANGUL JUNGSEONG I-YEO → <CJK Ideograph> + HANGUL JUNGSEONG YEO
+	0xD7C0:  "丨ᅧ丨",                // HANGUL JUNGSEONG I-YE → <CJK Ideograph> + HANGUL JUNGSEONG YEO + <CJK Ideograph>
+	0xD7C1:  "丨ᅩ丨",                // HANGUL JUNGSEONG I-O-I → <CJK Ideograph> + HANGUL JUNGSEONG O + <CJK Ideograph>
+	0xD7C2:  "丨ᅭ",                 // HANGUL JUNGSEONG I-YO → <CJK Ideograph> + HANGUL JUNGSEONG YO
+	0xD7C3:  "丨ᅲ",                 // HANGUL JUNGSEONG I-YU → <CJK Ideograph> + HANGUL JUNGSEONG YU
+	0xD7C4:  "丨丨",                 // HANGUL JUNGSEONG I-I → <CJK Ideograph> + <CJK Ideograph>
+	0xD7C5:  "ᆞᅡ",                 // HANGUL JUNGSEONG ARAEA-A → HANGUL JUNGSEONG ARAEA + HANGUL JUNGSEONG A
+	0xD7C6:  "ᆞᅥ丨",                // HANGUL JUNGSEONG ARAEA-E → HANGUL JUNGSEONG ARAEA + HANGUL JUNGSEONG EO + <CJK Ideograph>
+	0xD7CB:  "ᄂᄅ",                 // HANGUL JONGSEONG NIEUN-RIEUL → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG RIEUL
+	0xD7CC:  "ᄂᄎ",                 // HANGUL JONGSEONG NIEUN-CHIEUCH → HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG CHIEUCH
+	0xD7CD:  "ᄃᄃ",                 // HANGUL JONGSEONG SSANGTIKEUT → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG TIKEUT
+	0xD7CE:  "ᄃᄃᄇ",                // HANGUL JONGSEONG SSANGTIKEUT-PIEUP → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG PIEUP
+	0xD7CF:  "ᄃᄇ",                 // HANGUL JONGSEONG TIKEUT-PIEUP → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG PIEUP
+	0xD7D0:  "ᄃᄉ",                 // HANGUL JONGSEONG TIKEUT-SIOS → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG SIOS
+	0xD7D1:  "ᄃᄉᄀ",                // HANGUL JONGSEONG TIKEUT-SIOS-KIYEOK → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0xD7D2:  "ᄃᄌ",                 // HANGUL JONGSEONG TIKEUT-CIEUC → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG CIEUC
+	0xD7D3:  "ᄃᄎ",                 // HANGUL JONGSEONG TIKEUT-CHIEUCH → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG CHIEUCH
+	0xD7D4:  "ᄃᄐ",                 // HANGUL JONGSEONG TIKEUT-THIEUTH → HANGUL CHOSEONG TIKEUT + HANGUL CHOSEONG THIEUTH
+	0xD7D5:  "ᄅᄀᄀ",                // HANGUL JONGSEONG RIEUL-SSANGKIYEOK → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG KIYEOK
+	0xD7D6:  "ᄅᄀᄒ",                // HANGUL JONGSEONG RIEUL-KIYEOK-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KIYEOK + HANGUL CHOSEONG HIEUH
+	0xD7D7:  "ᄅᄅᄏ",                // HANGUL JONGSEONG SSANGRIEUL-KHIEUKH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG KHIEUKH
+	0xD7D8:  "ᄅᄆᄒ",                // HANGUL JONGSEONG RIEUL-MIEUM-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG HIEUH
+	0xD7D9:  "ᄅᄇᄃ",                // HANGUL JONGSEONG RIEUL-PIEUP-TIKEUT → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG TIKEUT
+	0xD7DA:  "ᄅᄇᄑ",                // HANGUL JONGSEONG RIEUL-PIEUP-PHIEUPH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PHIEUPH
+	0xD7DB:  "ᄅᅌ",                 // HANGUL JONGSEONG RIEUL-YESIEUNG → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG YESIEUNG
+	0xD7DC:  "ᄅᅙᄒ",                // HANGUL JONGSEONG RIEUL-YEORINHIEUH-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG YEORINHIEUH + HANGUL CHOSEONG HIEUH
+	0xD7DD:  "ᄅᄋ",                 // HANGUL JONGSEONG KAPYEOUNRIEUL → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG IEUNG
+	0xD7DE:  "ᄆᄂ",                 // HANGUL JONGSEONG MIEUM-NIEUN → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG NIEUN
+	0xD7DF:  "ᄆᄂᄂ",                // HANGUL JONGSEONG MIEUM-SSANGNIEUN → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG NIEUN + HANGUL CHOSEONG NIEUN
+	0xD7E0:  "ᄆᄆ",                 // HANGUL JONGSEONG SSANGMIEUM → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG MIEUM
+	0xD7E1:  "ᄆᄇᄉ",                // HANGUL JONGSEONG MIEUM-PIEUP-SIOS → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS
+	0xD7E2:  "ᄆᄌ",                 // HANGUL JONGSEONG MIEUM-CIEUC → HANGUL CHOSEONG MIEUM + HANGUL CHOSEONG CIEUC
+	0xD7E3:  "ᄇᄃ",                 // HANGUL JONGSEONG PIEUP-TIKEUT → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG TIKEUT
+	0xD7E4:  "ᄇᄅᄑ",                // HANGUL JONGSEONG PIEUP-RIEUL-PHIEUPH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG PHIEUPH
+	0xD7E5:  "ᄇᄆ",                 // HANGUL JONGSEONG PIEUP-MIEUM → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG MIEUM
+	0xD7E6:  "ᄇᄇ",                 // HANGUL JONGSEONG SSANGPIEUP → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PIEUP
+	0xD7E7:  "ᄇᄉᄃ",                // HANGUL JONGSEONG PIEUP-SIOS-TIKEUT → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG TIKEUT
+	0xD7E8:  "ᄇᄌ",                 // HANGUL JONGSEONG PIEUP-CIEUC → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG CIEUC
+	0xD7E9:  "ᄇᄎ",                 // HANGUL JONGSEONG PIEUP-CHIEUCH → HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG CHIEUCH
+	0xD7EA:  "ᄉᄆ",                 // HANGUL JONGSEONG SIOS-MIEUM → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG MIEUM
+	0xD7EB:  "ᄉᄇᄋ",                // HANGUL JONGSEONG SIOS-KAPYEOUNPIEUP → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0xD7EC:  "ᄉᄉᄀ",                // HANGUL JONGSEONG SSANGSIOS-KIYEOK → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG KIYEOK
+	0xD7ED:  "ᄉᄉᄃ",                // HANGUL JONGSEONG SSANGSIOS-TIKEUT → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG SIOS + HANGUL CHOSEONG TIKEUT
+	0xD7EE:  "ᄉᅀ",                 // HANGUL JONGSEONG SIOS-PANSIOS → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG PANSIOS
+	0xD7EF:  "ᄉᄌ",                 // HANGUL JONGSEONG SIOS-CIEUC → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG CIEUC
+	0xD7F0:  "ᄉᄎ",                 // HANGUL JONGSEONG SIOS-CHIEUCH → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG CHIEUCH
+	0xD7F1:  "ᄉᄐ",                 // HANGUL JONGSEONG SIOS-THIEUTH → HANGUL CHOSEONG SIOS + HANGUL CHOSEONG THIEUTH
+	0xD7F2:  "ᄅᄒ",                 // HANGUL JONGSEONG SIOS-HIEUH → HANGUL CHOSEONG RIEUL + HANGUL CHOSEONG HIEUH
+	0xD7F3:  "ᅀᄇ",                 // HANGUL JONGSEONG PANSIOS-PIEUP → HANGUL CHOSEONG PANSIOS + HANGUL CHOSEONG PIEUP
+	0xD7F4:  "ᅀᄇᄋ",                // HANGUL JONGSEONG PANSIOS-KAPYEOUNPIEUP → HANGUL CHOSEONG PANSIOS + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG IEUNG
+	0xD7F5:  "ᅌᄆ",                 // HANGUL JONGSEONG YESIEUNG-MIEUM → HANGUL CHOSEONG YESIEUNG + HANGUL CHOSEONG MIEUM
+	0xD7F6:  "ᅌᄒ",                 // HANGUL JONGSEONG YESIEUNG-HIEUH → HANGUL CHOSEONG YESIEUNG + HANGUL CHOSEONG HIEUH
+	0xD7F7:  "ᄌᄇ",                 // HANGUL JONGSEONG CIEUC-PIEUP → HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG PIEUP
+	0xD7F8:  "ᄌᄇᄇ",                // HANGUL JONGSEONG CIEUC-SSANGPIEUP → HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG PIEUP + HANGUL CHOSEONG PIEUP
+	0xD7F9:  "ᄌᄌ",                 // HANGUL JONGSEONG SSANGCIEUC → HANGUL CHOSEONG CIEUC + HANGUL CHOSEONG CIEUC
+	0xD7FA:  "ᄑᄉ",                 // HANGUL JONGSEONG PHIEUPH-SIOS → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG SIOS
+	0xD7FB:  "ᄑᄐ",                 // HANGUL JONGSEONG PHIEUPH-THIEUTH → HANGUL CHOSEONG PHIEUPH + HANGUL CHOSEONG THIEUTH
+	0xF900:  "豈",                  // CJK COMPATIBILITY IDEOGRAPH-F900 → <CJK Ideograph>
+	0xF901:  "更",                  // CJK COMPATIBILITY IDEOGRAPH-F901 → <CJK Ideograph>
+	0xF902:  "車",                  // CJK COMPATIBILITY IDEOGRAPH-F902 → <CJK Ideograph>
+	0xF903:  "賈",                  // CJK COMPATIBILITY IDEOGRAPH-F903 → <CJK Ideograph>
+	0xF904:  "滑",                  // CJK COMPATIBILITY IDEOGRAPH-F904 → <CJK Ideograph>
+	0xF905:  "串",                  // CJK COMPATIBILITY IDEOGRAPH-F905 → <CJK Ideograph>
+	0xF906:  "句",                  // CJK COMPATIBILITY IDEOGRAPH-F906 → <CJK Ideograph>
+	0xF907:  "龜",                  // CJK COMPATIBILITY IDEOGRAPH-F907 → <CJK Ideograph>
+	0xF908:  "龜",                  // CJK COMPATIBILITY IDEOGRAPH-F908 → <CJK Ideograph>
+	0xF909:  "契",                  // CJK COMPATIBILITY IDEOGRAPH-F909 → <CJK Ideograph>
+	0xF90A:  "金",                  // CJK COMPATIBILITY IDEOGRAPH-F90A → <CJK Ideograph>
+	0xF90B:  "喇",                  // CJK COMPATIBILITY IDEOGRAPH-F90B → <CJK Ideograph>
+	0xF90C:  "奈",                  // CJK COMPATIBILITY IDEOGRAPH-F90C → <CJK Ideograph>
+	0xF90D:  "懶",                  // CJK COMPATIBILITY IDEOGRAPH-F90D → <CJK Ideograph>
+	0xF90E:  "癩",                  // CJK COMPATIBILITY IDEOGRAPH-F90E → <CJK Ideograph>
+	0xF90F:  "羅",                  // CJK COMPATIBILITY IDEOGRAPH-F90F → <CJK Ideograph>
+	0xF910:  "蘿",                  // CJK COMPATIBILITY IDEOGRAPH-F910 → <CJK Ideograph>
+	0xF911:  "螺",                  // CJK COMPATIBILITY IDEOGRAPH-F911 → <CJK Ideograph>
+	0xF912:  "裸",                  // CJK COMPATIBILITY IDEOGRAPH-F912 → <CJK Ideograph>
+	0xF913:  "邏",                  // CJK COMPATIBILITY IDEOGRAPH-F913 → <CJK Ideograph>
+	0xF914:  "樂",                  // CJK COMPATIBILITY IDEOGRAPH-F914 → <CJK Ideograph>
+	0xF915:  "洛",                  // CJK COMPATIBILITY IDEOGRAPH-F915 → <CJK Ideograph>
+	0xF916:  "烙",                  // CJK COMPATIBILITY IDEOGRAPH-F916 → <CJK Ideograph>
+	0xF917:  "珞",                  // CJK COMPATIBILITY IDEOGRAPH-F917 → <CJK Ideograph>
+	0xF918:  "落",                  // CJK COMPATIBILITY IDEOGRAPH-F918 → <CJK Ideograph>
+	0xF919:  "酪",                  // CJK COMPATIBILITY IDEOGRAPH-F919 → <CJK Ideograph>
+	0xF91A:  "駱",                  // CJK COMPATIBILITY IDEOGRAPH-F91A → <CJK Ideograph>
+	0xF91B:  "亂",                  // CJK COMPATIBILITY IDEOGRAPH-F91B → <CJK Ideograph>
+	0xF91C:  "卵",                  // CJK COMPATIBILITY IDEOGRAPH-F91C → <CJK Ideograph>
+	0xF91D:  "欄",                  // CJK COMPATIBILITY IDEOGRAPH-F91D → <CJK Ideograph>
+	0xF91E:  "爛",                  // CJK COMPATIBILITY IDEOGRAPH-F91E → <CJK Ideograph>
+	0xF91F:  "蘭",                  // CJK COMPATIBILITY IDEOGRAPH-F91F → <CJK Ideograph>
+	0xF920:  "鸞",                  // CJK COMPATIBILITY IDEOGRAPH-F920 → <CJK Ideograph>
+	0xF921:  "嵐",                  // CJK COMPATIBILITY IDEOGRAPH-F921 → <CJK Ideograph>
+	0xF922:  "濫",                  // CJK COMPATIBILITY IDEOGRAPH-F922 → <CJK Ideograph>
+	0xF923:  "藍",                  // CJK COMPATIBILITY IDEOGRAPH-F923 → <CJK Ideograph>
+	0xF924:  "襤",                  // CJK COMPATIBILITY IDEOGRAPH-F924 → <CJK Ideograph>
+	0xF925:  "拉",                  // CJK COMPATIBILITY IDEOGRAPH-F925 → <CJK Ideograph>
+	0xF926:  "臘",                  // CJK COMPATIBILITY IDEOGRAPH-F926 → <CJK Ideograph>
+	0xF927:  "蠟",                  // CJK COMPATIBILITY IDEOGRAPH-F927 → <CJK Ideograph>
+	0xF928:  "廊",                  // CJK COMPATIBILITY IDEOGRAPH-F928 → <CJK Ideograph>
+	0xF929:  "朗",                  // CJK COMPATIBILITY IDEOGRAPH-F929 → <CJK Ideograph>
+	0xF92A:  "浪",                  // CJK COMPATIBILITY IDEOGRAPH-F92A → <CJK Ideograph>
+	0xF92B:  "狼",                  // CJK COMPATIBILITY IDEOGRAPH-F92B → <CJK Ideograph>
+	0xF92C:  "郎",                  // CJK COMPATIBILITY IDEOGRAPH-F92C → <CJK Ideograph>
+	0xF92D:  "來",                  // CJK COMPATIBILITY IDEOGRAPH-F92D → <CJK Ideograph>
+	0xF92E:  "冷",                  // CJK COMPATIBILITY IDEOGRAPH-F92E → <CJK Ideograph>
+	0xF92F:  "勞",                  // CJK COMPATIBILITY IDEOGRAPH-F92F → <CJK Ideograph>
+	0xF930:  "擄",                  // CJK COMPATIBILITY IDEOGRAPH-F930 → <CJK Ideograph>
+	0xF931:  "櫓",                  // CJK COMPATIBILITY IDEOGRAPH-F931 → <CJK Ideograph>
+	0xF932:  "爐",                  // CJK COMPATIBILITY IDEOGRAPH-F932 → <CJK Ideograph>
+	0xF933:  "盧",                  // CJK COMPATIBILITY IDEOGRAPH-F933 → <CJK Ideograph>
+	0xF934:  "老",                  // CJK COMPATIBILITY IDEOGRAPH-F934 → <CJK Ideograph>
+	0xF935:  "蘆",                  // CJK COMPATIBILITY IDEOGRAPH-F935 → <CJK Ideograph>
+	0xF936:  "虜",                  // CJK COMPATIBILITY IDEOGRAPH-F936 → <CJK Ideograph>
+	0xF937:  "路",                  // CJK COMPATIBILITY IDEOGRAPH-F937 → <CJK Ideograph>
+	0xF938:  "露",                  // CJK COMPATIBILITY IDEOGRAPH-F938 → <CJK Ideograph>
+	0xF939:  "魯",                  // CJK COMPATIBILITY IDEOGRAPH-F939 → <CJK Ideograph>
+	0xF93A:  "鷺",                  // CJK COMPATIBILITY IDEOGRAPH-F93A → <CJK Ideograph>
+	0xF93B:  "碌",                  // CJK COMPATIBILITY IDEOGRAPH-F93B → <CJK Ideograph>
+	0xF93C:  "祿",                  // CJK COMPATIBILITY IDEOGRAPH-F93C → <CJK Ideograph>
+	0xF93D:  "綠",                  // CJK COMPATIBILITY IDEOGRAPH-F93D → <CJK Ideograph>
+	0xF93E:  "菉",                  // CJK COMPATIBILITY IDEOGRAPH-F93E → <CJK Ideograph>
+	0xF93F:  "錄",                  // CJK COMPATIBILITY IDEOGRAPH-F93F → <CJK Ideograph>
+	0xF940:  "鹿",                  // CJK COMPATIBILITY IDEOGRAPH-F940 → <CJK Ideograph>
+	0xF941:  "論",                  // CJK COMPATIBILITY IDEOGRAPH-F941 → <CJK Ideograph>
+	0xF942:  "壟",                  // CJK COMPATIBILITY IDEOGRAPH-F942 → <CJK Ideograph>
+	0xF943:  "弄",                  // CJK COMPATIBILITY IDEOGRAPH-F943 → <CJK Ideograph>
+	0xF944:  "籠",                  // CJK COMPATIBILITY IDEOGRAPH-F944 → <CJK Ideograph>
+	0xF945:  "聾",                  // CJK COMPATIBILITY IDEOGRAPH-F945 → <CJK Ideograph>
+	0xF946:  "牢",                  // CJK COMPATIBILITY IDEOGRAPH-F946 → <CJK Ideograph>
+	0xF947:  "磊",                  // CJK COMPATIBILITY IDEOGRAPH-F947 → <CJK Ideograph>
+	0xF948:  "賂",                  // CJK COMPATIBILITY IDEOGRAPH-F948 → <CJK Ideograph>
+	0xF949:  "雷",                  // CJK COMPATIBILITY IDEOGRAPH-F949 → <CJK Ideograph>
+	0xF94A:  "壘",                  // CJK COMPATIBILITY IDEOGRAPH-F94A → <CJK Ideograph>
+	0xF94B:  "屢",                  // CJK COMPATIBILITY IDEOGRAPH-F94B → <CJK Ideograph>
+	0xF94C:  "樓",                  // CJK COMPATIBILITY IDEOGRAPH-F94C → <CJK Ideograph>
+	0xF94D:  "淚",                  // CJK COMPATIBILITY IDEOGRAPH-F94D → <CJK Ideograph>
+	0xF94E:  "漏",                  // CJK COMPATIBILITY IDEOGRAPH-F94E → <CJK Ideograph>
+	0xF94F:  "累",                  // CJK COMPATIBILITY IDEOGRAPH-F94F → <CJK Ideograph>
+	0xF950:  "縷",                  // CJK COMPATIBILITY IDEOGRAPH-F950 → <CJK Ideograph>
+	0xF951:  "陋",                  // CJK COMPATIBILITY IDEOGRAPH-F951 → <CJK Ideograph>
+	0xF952:  "勒",                  // CJK COMPATIBILITY IDEOGRAPH-F952 → <CJK Ideograph>
+	0xF953:  "肋",                  // CJK COMPATIBILITY IDEOGRAPH-F953 → <CJK Ideograph>
+	0xF954:  "凜",                  // CJK COMPATIBILITY IDEOGRAPH-F954 → <CJK Ideograph>
+	0xF955:  "凌",                  // CJK COMPATIBILITY IDEOGRAPH-F955 → <CJK Ideograph>
+	0xF956:  "稜",                  // CJK COMPATIBILITY IDEOGRAPH-F956 → <CJK Ideograph>
+	0xF957:  "綾",                  // CJK COMPATIBILITY IDEOGRAPH-F957 → <CJK Ideograph>
+	0xF958:  "菱",                  // CJK COMPATIBILITY IDEOGRAPH-F958 → <CJK Ideograph>
+	0xF959:  "陵",                  // CJK COMPATIBILITY IDEOGRAPH-F959 → <CJK Ideograph>
+	0xF95A:  "讀",                  // CJK COMPATIBILITY IDEOGRAPH-F95A → <CJK Ideograph>
+	0xF95B:  "拏",                  // CJK COMPATIBILITY IDEOGRAPH-F95B → <CJK Ideograph>
+	0xF95C:  "樂",                  // CJK COMPATIBILITY IDEOGRAPH-F95C → <CJK Ideograph>
+	0xF95D:  "諾",                  // CJK COMPATIBILITY IDEOGRAPH-F95D → <CJK Ideograph>
+	0xF95E:  "丹",                  // CJK COMPATIBILITY IDEOGRAPH-F95E → <CJK Ideograph>
+	0xF95F:  "寧",                  // CJK COMPATIBILITY IDEOGRAPH-F95F → <CJK Ideograph>
+	0xF960:  "怒",                  // CJK COMPATIBILITY IDEOGRAPH-F960 → <CJK Ideograph>
+	0xF961:  "率",                  // CJK COMPATIBILITY IDEOGRAPH-F961 → <CJK Ideograph>
+	0xF962:  "異",                  // CJK COMPATIBILITY IDEOGRAPH-F962 → <CJK Ideograph>
+	0xF963:  "北",                  // CJK COMPATIBILITY IDEOGRAPH-F963 → <CJK Ideograph>
+	0xF964:  "磻",                  // CJK COMPATIBILITY IDEOGRAPH-F964 → <CJK Ideograph>
+	0xF965:  "便",                  // CJK COMPATIBILITY IDEOGRAPH-F965 → <CJK Ideograph>
+	0xF966:  "復",                  // CJK COMPATIBILITY IDEOGRAPH-F966 → <CJK Ideograph>
+	0xF967:  "不",                  // CJK COMPATIBILITY IDEOGRAPH-F967 → <CJK Ideograph>
+	0xF968:  "泌",                  // CJK COMPATIBILITY IDEOGRAPH-F968 → <CJK Ideograph>
+	0xF969:  "數",                  // CJK COMPATIBILITY IDEOGRAPH-F969 → <CJK Ideograph>
+	0xF96A:  "索",                  // CJK COMPATIBILITY IDEOGRAPH-F96A → <CJK Ideograph>
+	0xF96B:  "參",                  // CJK COMPATIBILITY IDEOGRAPH-F96B → <CJK Ideograph>
+	0xF96C:  "塞",                  // CJK COMPATIBILITY IDEOGRAPH-F96C → <CJK Ideograph>
+	0xF96D:  "省",                  // CJK COMPATIBILITY IDEOGRAPH-F96D → <CJK Ideograph>
+	0xF96E:  "葉",                  // CJK COMPATIBILITY IDEOGRAPH-F96E → <CJK Ideograph>
+	0xF96F:  "說",                  // CJK COMPATIBILITY IDEOGRAPH-F96F → <CJK Ideograph>
+	0xF970:  "殺",                  // CJK COMPATIBILITY IDEOGRAPH-F970 → <CJK Ideograph>
+	0xF971:  "辰",                  // CJK COMPATIBILITY IDEOGRAPH-F971 → <CJK Ideograph>
+	0xF972:  "沈",                  // CJK COMPATIBILITY IDEOGRAPH-F972 → <CJK Ideograph>
+	0xF973:  "拾",                  // CJK COMPATIBILITY IDEOGRAPH-F973 → <CJK Ideograph>
+	0xF974:  "若",                  // CJK COMPATIBILITY IDEOGRAPH-F974 → <CJK Ideograph>
+	0xF975:  "掠",                  // CJK COMPATIBILITY IDEOGRAPH-F975 → <CJK Ideograph>
+	0xF976:  "略",                  // CJK COMPATIBILITY IDEOGRAPH-F976 → <CJK Ideograph>
+	0xF977:  "亮",                  // CJK COMPATIBILITY IDEOGRAPH-F977 → <CJK Ideograph>
+	0xF978:  "兩",                  // CJK COMPATIBILITY IDEOGRAPH-F978 → <CJK Ideograph>
+	0xF979:  "凉",                  // CJK COMPATIBILITY IDEOGRAPH-F979 → <CJK Ideograph>
+	0xF97A:  "梁",                  // CJK COMPATIBILITY IDEOGRAPH-F97A → <CJK Ideograph>
+	0xF97B:  "糧",                  // CJK COMPATIBILITY IDEOGRAPH-F97B → <CJK Ideograph>
+	0xF97C:  "良",                  // CJK COMPATIBILITY IDEOGRAPH-F97C → <CJK Ideograph>
+	0xF97D:  "諒",                  // CJK COMPATIBILITY IDEOGRAPH-F97D → <CJK Ideograph>
+	0xF97E:  "量",                  // CJK COMPATIBILITY IDEOGRAPH-F97E → <CJK Ideograph>
+	0xF97F:  "勵",                  // CJK COMPATIBILITY IDEOGRAPH-F97F → <CJK Ideograph>
+	0xF980:  "呂",                  // CJK COMPATIBILITY IDEOGRAPH-F980 → <CJK Ideograph>
+	0xF981:  "女",                  // CJK COMPATIBILITY IDEOGRAPH-F981 → <CJK Ideograph>
+	0xF982:  "廬",                  // CJK COMPATIBILITY IDEOGRAPH-F982 → <CJK Ideograph>
+	0xF983:  "旅",                  // CJK COMPATIBILITY IDEOGRAPH-F983 → <CJK Ideograph>
+	0xF984:  "濾",                  // CJK COMPATIBILITY IDEOGRAPH-F984 → <CJK Ideograph>
+	0xF985:  "礪",                  // CJK COMPATIBILITY IDEOGRAPH-F985 → <CJK Ideograph>
+	0xF986:  "閭",                  // CJK COMPATIBILITY IDEOGRAPH-F986 → <CJK Ideograph>
+	0xF987:  "驪",                  // CJK COMPATIBILITY IDEOGRAPH-F987 → <CJK Ideograph>
+	0xF988:  "麗",                  // CJK COMPATIBILITY IDEOGRAPH-F988 → <CJK Ideograph>
+	0xF989:  "黎",                  // CJK COMPATIBILITY IDEOGRAPH-F989 → <CJK Ideograph>
+	0xF98A:  "力",                  // CJK COMPATIBILITY IDEOGRAPH-F98A → <CJK Ideograph>
+	0xF98B:  "曆",                  // CJK COMPATIBILITY IDEOGRAPH-F98B → <CJK Ideograph>
+	0xF98C:  "歷",                  // CJK COMPATIBILITY IDEOGRAPH-F98C → <CJK Ideograph>
+	0xF98D:  "轢",                  // CJK COMPATIBILITY IDEOGRAPH-F98D → <CJK Ideograph>
+	0xF98E:  "年",                  // CJK COMPATIBILITY IDEOGRAPH-F98E → <CJK Ideograph>
+	0xF98F:  "憐",                  // CJK COMPATIBILITY IDEOGRAPH-F98F → <CJK Ideograph>
+	0xF990:  "戀",                  // CJK COMPATIBILITY IDEOGRAPH-F990 → <CJK Ideograph>
+	0xF991:  "撚",                  // CJK COMPATIBILITY IDEOGRAPH-F991 → <CJK Ideograph>
+	0xF992:  "漣",                  // CJK COMPATIBILITY IDEOGRAPH-F992 → <CJK Ideograph>
+	0xF993:  "煉",                  // CJK COMPATIBILITY IDEOGRAPH-F993 → <CJK Ideograph>
+	0xF994:  "璉",                  // CJK COMPATIBILITY IDEOGRAPH-F994 → <CJK Ideograph>
+	0xF995:  "秊",                  // CJK COMPATIBILITY IDEOGRAPH-F995 → <CJK Ideograph>
+	0xF996:  "練",                  // CJK COMPATIBILITY IDEOGRAPH-F996 → <CJK Ideograph>
+	0xF997:  "聯",                  // CJK COMPATIBILITY IDEOGRAPH-F997 → <CJK Ideograph>
+	0xF998:  "輦",                  // CJK COMPATIBILITY IDEOGRAPH-F998 → <CJK Ideograph>
+	0xF999:  "蓮",                  // CJK COMPATIBILITY IDEOGRAPH-F999 → <CJK Ideograph>
+	0xF99A:  "連",                  // CJK COMPATIBILITY IDEOGRAPH-F99A → <CJK Ideograph>
+	0xF99B:  "鍊",                  // CJK COMPATIBILITY IDEOGRAPH-F99B → <CJK Ideograph>
+	0xF99C:  "列",                  // CJK COMPATIBILITY IDEOGRAPH-F99C → <CJK Ideograph>
+	0xF99D:  "劣",                  // CJK COMPATIBILITY IDEOGRAPH-F99D → <CJK Ideograph>
+	0xF99E:  "咽",                  // CJK COMPATIBILITY IDEOGRAPH-F99E → <CJK Ideograph>
+	0xF99F:  "烈",                  // CJK COMPATIBILITY IDEOGRAPH-F99F → <CJK Ideograph>
+	0xF9A0:  "裂",                  // CJK COMPATIBILITY IDEOGRAPH-F9A0 → <CJK Ideograph>
+	0xF9A1:  "說",                  // CJK COMPATIBILITY IDEOGRAPH-F9A1 → <CJK Ideograph>
+	0xF9A2:  "廉",                  // CJK COMPATIBILITY IDEOGRAPH-F9A2 → <CJK Ideograph>
+	0xF9A3:  "念",                  // CJK COMPATIBILITY IDEOGRAPH-F9A3 → <CJK Ideograph>
+	0xF9A4:  "捻",                  // CJK COMPATIBILITY IDEOGRAPH-F9A4 → <CJK Ideograph>
+	0xF9A5:  "殮",                  // CJK COMPATIBILITY IDEOGRAPH-F9A5 → <CJK Ideograph>
+	0xF9A6:  "簾",                  // CJK COMPATIBILITY IDEOGRAPH-F9A6 → <CJK Ideograph>
+	0xF9A7:  "獵",                  // CJK COMPATIBILITY IDEOGRAPH-F9A7 → <CJK Ideograph>
+	0xF9A8:  "令",                  // CJK COMPATIBILITY IDEOGRAPH-F9A8 → <CJK Ideograph>
+	0xF9A9:  "囹",                  // CJK COMPATIBILITY IDEOGRAPH-F9A9 → <CJK Ideograph>
+	0xF9AA:  "寧",                  // CJK COMPATIBILITY IDEOGRAPH-F9AA → <CJK Ideograph>
+	0xF9AB:  "嶺",                  // CJK COMPATIBILITY IDEOGRAPH-F9AB → <CJK Ideograph>
+	0xF9AC:  "怜",                  // CJK COMPATIBILITY IDEOGRAPH-F9AC → <CJK Ideograph>
+	0xF9AD:  "玲",                  // CJK COMPATIBILITY IDEOGRAPH-F9AD → <CJK Ideograph>
+	0xF9AE:  "瑩",                  // CJK COMPATIBILITY IDEOGRAPH-F9AE → <CJK Ideograph>
+	0xF9AF:  "羚",                  // CJK COMPATIBILITY IDEOGRAPH-F9AF → <CJK Ideograph>
+	0xF9B0:  "聆",                  // CJK COMPATIBILITY IDEOGRAPH-F9B0 → <CJK Ideograph>
+	0xF9B1:  "鈴",                  // CJK COMPATIBILITY IDEOGRAPH-F9B1 → <CJK Ideograph>
+	0xF9B2:  "零",                  // CJK COMPATIBILITY IDEOGRAPH-F9B2 → <CJK Ideograph>
+	0xF9B3:  "靈",                  // CJK COMPATIBILITY IDEOGRAPH-F9B3 → <CJK Ideograph>
+	0xF9B4:  "領",                  // CJK COMPATIBILITY IDEOGRAPH-F9B4 → <CJK Ideograph>
+	0xF9B5:  "例",                  // CJK COMPATIBILITY IDEOGRAPH-F9B5 → <CJK Ideograph>
+	0xF9B6:  "禮",                  // CJK COMPATIBILITY IDEOGRAPH-F9B6 → <CJK Ideograph>
+	0xF9B7:  "醴",                  // CJK COMPATIBILITY IDEOGRAPH-F9B7 → <CJK Ideograph>
+	0xF9B8:  "隷",                  // CJK COMPATIBILITY IDEOGRAPH-F9B8 → <CJK Ideograph>
+	0xF9B9:  "惡",                  // CJK COMPATIBILITY IDEOGRAPH-F9B9 → <CJK Ideograph>
+	0xF9BA:  "了",                  // CJK COMPATIBILITY IDEOGRAPH-F9BA → <CJK Ideograph>
+	0xF9BB:  "僚",                  // CJK COMPATIBILITY IDEOGRAPH-F9BB → <CJK Ideograph>
+	0xF9BC:  "寮",                  // CJK COMPATIBILITY IDEOGRAPH-F9BC → <CJK Ideograph>
+	0xF9BD:  "尿",                  // CJK COMPATIBILITY IDEOGRAPH-F9BD → <CJK Ideograph>
+	0xF9BE:  "料",                  // CJK COMPATIBILITY IDEOGRAPH-F9BE → <CJK Ideograph>
+	0xF9BF:  "樂",                  // CJK COMPATIBILITY IDEOGRAPH-F9BF → <CJK Ideograph>
+	0xF9C0:  "燎",                  // CJK COMPATIBILITY IDEOGRAPH-F9C0 → <CJK Ideograph>
+	0xF9C1:  "療",                  // CJK COMPATIBILITY IDEOGRAPH-F9C1 → <CJK Ideograph>
+	0xF9C2:  "蓼",                  // CJK COMPATIBILITY IDEOGRAPH-F9C2 → <CJK Ideograph>
+	0xF9C3:  "遼",                  // CJK COMPATIBILITY IDEOGRAPH-F9C3 → <CJK Ideograph>
+	0xF9C4:  "龍",                  // CJK COMPATIBILITY IDEOGRAPH-F9C4 → <CJK Ideograph>
+	0xF9C5:  "暈",                  // CJK COMPATIBILITY IDEOGRAPH-F9C5 → <CJK Ideograph>
+	0xF9C6:  "阮",                  // CJK COMPATIBILITY IDEOGRAPH-F9C6 → <CJK Ideograph>
+	0xF9C7:  "劉",                  // CJK COMPATIBILITY IDEOGRAPH-F9C7 → <CJK Ideograph>
+	0xF9C8:  "杻",                  // CJK COMPATIBILITY IDEOGRAPH-F9C8 → <CJK Ideograph>
+	0xF9C9:  "柳",                  // CJK COMPATIBILITY IDEOGRAPH-F9C9 → <CJK Ideograph>
+	0xF9CA:  "流",                  // CJK COMPATIBILITY IDEOGRAPH-F9CA → <CJK Ideograph>
+	0xF9CB:  "溜",                  // CJK COMPATIBILITY IDEOGRAPH-F9CB → <CJK Ideograph>
+	0xF9CC:  "琉",                  // CJK COMPATIBILITY IDEOGRAPH-F9CC → <CJK Ideograph>
+	0xF9CD:  "留",                  // CJK COMPATIBILITY IDEOGRAPH-F9CD → <CJK Ideograph>
+	0xF9CE:  "硫",                  // CJK COMPATIBILITY IDEOGRAPH-F9CE → <CJK Ideograph>
+	0xF9CF:  "紐",                  // CJK COMPATIBILITY IDEOGRAPH-F9CF → <CJK Ideograph>
+	0xF9D0:  "類",                  // CJK COMPATIBILITY IDEOGRAPH-F9D0 → <CJK Ideograph>
+	0xF9D1:  "六",                  // CJK COMPATIBILITY IDEOGRAPH-F9D1 → <CJK Ideograph>
+	0xF9D2:  "戮",                  // CJK COMPATIBILITY IDEOGRAPH-F9D2 → <CJK Ideograph>
+	0xF9D3:  "陸",                  // CJK COMPATIBILITY IDEOGRAPH-F9D3 → <CJK Ideograph>
+	0xF9D4:  "倫",                  // CJK COMPATIBILITY IDEOGRAPH-F9D4 → <CJK Ideograph>
+	0xF9D5:  "崙",                  // CJK COMPATIBILITY IDEOGRAPH-F9D5 → <CJK Ideograph>
+	0xF9D6:  "淪",                  // CJK COMPATIBILITY IDEOGRAPH-F9D6 → <CJK Ideograph>
+	0xF9D7:  "輪",                  // CJK COMPATIBILITY IDEOGRAPH-F9D7 → <CJK Ideograph>
+	0xF9D8:  "律",                  // CJK COMPATIBILITY IDEOGRAPH-F9D8 → <CJK Ideograph>
+	0xF9D9:  "慄",                  // CJK COMPATIBILITY IDEOGRAPH-F9D9 → <CJK Ideograph>
+	0xF9DA:  "栗",                  // CJK COMPATIBILITY IDEOGRAPH-F9DA → <CJK Ideograph>
+	0xF9DB:  "率",                  // CJK COMPATIBILITY IDEOGRAPH-F9DB → <CJK Ideograph>
+	0xF9DC:  "隆",                  // CJK COMPATIBILITY IDEOGRAPH-F9DC → <CJK Ideograph>
+	0xF9DD:  "利",                  // CJK COMPATIBILITY IDEOGRAPH-F9DD → <CJK Ideograph>
+	0xF9DE:  "吏",                  // CJK COMPATIBILITY IDEOGRAPH-F9DE → <CJK Ideograph>
+	0xF9DF:  "履",                  // CJK COMPATIBILITY IDEOGRAPH-F9DF → <CJK Ideograph>
+	0xF9E0:  "易",                  // CJK COMPATIBILITY IDEOGRAPH-F9E0 → <CJK Ideograph>
+	0xF9E1:  "李",                  // CJK COMPATIBILITY IDEOGRAPH-F9E1 → <CJK Ideograph>
+	0xF9E2:  "梨",                  // CJK COMPATIBILITY IDEOGRAPH-F9E2 → <CJK Ideograph>
+	0xF9E3:  "泥",                  // CJK COMPATIBILITY IDEOGRAPH-F9E3 → <CJK Ideograph>
+	0xF9E4:  "理",                  // CJK COMPATIBILITY IDEOGRAPH-F9E4 → <CJK Ideograph>
+	0xF9E5:  "痢",                  // CJK COMPATIBILITY IDEOGRAPH-F9E5 → <CJK Ideograph>
+	0xF9E6:  "罹",                  // CJK COMPATIBILITY IDEOGRAPH-F9E6 → <CJK Ideograph>
+	0xF9E7:  "裏",                  // CJK COMPATIBILITY IDEOGRAPH-F9E7 → <CJK Ideograph>
+	0xF9E8:  "裡",                  // CJK COMPATIBILITY IDEOGRAPH-F9E8 → <CJK Ideograph>
+	0xF9E9:  "里",                  // CJK COMPATIBILITY IDEOGRAPH-F9E9 → <CJK Ideograph>
+	0xF9EA:  "離",                  // CJK COMPATIBILITY IDEOGRAPH-F9EA → <CJK Ideograph>
+	0xF9EB:  "匿",                  // CJK COMPATIBILITY IDEOGRAPH-F9EB → <CJK Ideograph>
+	0xF9EC:  "溺",                  // CJK COMPATIBILITY IDEOGRAPH-F9EC → <CJK Ideograph>
+	0xF9ED:  "吝",                  // CJK COMPATIBILITY IDEOGRAPH-F9ED → <CJK Ideograph>
+	0xF9EE:  "燐",                  // CJK COMPATIBILITY IDEOGRAPH-F9EE → <CJK Ideograph>
+	0xF9EF:  "璘",                  // CJK COMPATIBILITY IDEOGRAPH-F9EF → <CJK Ideograph>
+	0xF9F0:  "藺",                  // CJK COMPATIBILITY IDEOGRAPH-F9F0 → <CJK Ideograph>
+	0xF9F1:  "隣",                  // CJK COMPATIBILITY IDEOGRAPH-F9F1 → <CJK Ideograph>
+	0xF9F2:  "鱗",                  // CJK COMPATIBILITY IDEOGRAPH-F9F2 → <CJK Ideograph>
+	0xF9F3:  "麟",                  // CJK COMPATIBILITY IDEOGRAPH-F9F3 → <CJK Ideograph>
+	0xF9F4:  "林",                  // CJK COMPATIBILITY IDEOGRAPH-F9F4 → <CJK Ideograph>
+	0xF9F5:  "淋",                  // CJK COMPATIBILITY IDEOGRAPH-F9F5 → <CJK Ideograph>
+	0xF9F6:  "臨",                  // CJK COMPATIBILITY IDEOGRAPH-F9F6 → <CJK Ideograph>
+	0xF9F7:  "立",                  // CJK COMPATIBILITY IDEOGRAPH-F9F7 → <CJK Ideograph>
+	0xF9F8:  "笠",                  // CJK COMPATIBILITY IDEOGRAPH-F9F8 → <CJK Ideograph>
+	0xF9F9:  "粒",                  // CJK COMPATIBILITY IDEOGRAPH-F9F9 → <CJK Ideograph>
+	0xF9FA:  "狀",                  // CJK COMPATIBILITY IDEOGRAPH-F9FA → <CJK Ideograph>
+	0xF9FB:  "炙",                  // CJK COMPATIBILITY IDEOGRAPH-F9FB → <CJK Ideograph>
+	0xF9FC:  "識",                  // CJK COMPATIBILITY IDEOGRAPH-F9FC → <CJK Ideograph>
+	0xF9FD:  "什",                  // CJK COMPATIBILITY IDEOGRAPH-F9FD → <CJK Ideograph>
+	0xF9FE:  "茶",                  // CJK COMPATIBILITY IDEOGRAPH-F9FE → <CJK Ideograph>
+	0xF9FF:  "刺",                  // CJK COMPATIBILITY IDEOGRAPH-F9FF → <CJK Ideograph>
+	0xFA00:  "切",                  // CJK COMPATIBILITY IDEOGRAPH-FA00 → <CJK Ideograph>
+	0xFA01:  "度",                  // CJK COMPATIBILITY IDEOGRAPH-FA01 → <CJK Ideograph>
+	0xFA02:  "拓",                  // CJK COMPATIBILITY IDEOGRAPH-FA02 → <CJK Ideograph>
+	0xFA03:  "糖",                  // CJK COMPATIBILITY IDEOGRAPH-FA03 → <CJK Ideograph>
+	0xFA04:  "宅",                  // CJK COMPATIBILITY IDEOGRAPH-FA04 → <CJK Ideograph>
+	0xFA05:  "洞",                  // CJK COMPATIBILITY IDEOGRAPH-FA05 → <CJK Ideograph>
+	0xFA06:  "暴",                  // CJK COMPATIBILITY IDEOGRAPH-FA06 → <CJK Ideograph>
+	0xFA07:  "輻",                  // CJK COMPATIBILITY IDEOGRAPH-FA07 → <CJK Ideograph>
+	0xFA08:  "行",                  // CJK COMPATIBILITY IDEOGRAPH-FA08 → <CJK Ideograph>
+	0xFA09:  "降",                  // CJK COMPATIBILITY IDEOGRAPH-FA09 → <CJK Ideograph>
+	0xFA0A:  "見",                  // CJK COMPATIBILITY IDEOGRAPH-FA0A → <CJK Ideograph>
+	0xFA0B:  "廓",                  // CJK COMPATIBILITY IDEOGRAPH-FA0B → <CJK Ideograph>
+	0xFA0C:  "兀",                  // CJK COMPATIBILITY IDEOGRAPH-FA0C → <CJK Ideograph>
+	0xFA0D:  "嗀",                  // CJK COMPATIBILITY IDEOGRAPH-FA0D → <CJK Ideograph>
+	0xFA10:  "塚",                  // CJK COMPATIBILITY IDEOGRAPH-FA10 → <CJK Ideograph>
+	0xFA12:  "晴",                  // CJK COMPATIBILITY IDEOGRAPH-FA12 → <CJK Ideograph>
+	0xFA15:  "凞",                  // CJK COMPATIBILITY IDEOGRAPH-FA15 → <CJK Ideograph>
+	0xFA16:  "猪",                  // CJK COMPATIBILITY IDEOGRAPH-FA16 → <CJK Ideograph>
+	0xFA17:  "益",                  // CJK COMPATIBILITY IDEOGRAPH-FA17 → <CJK Ideograph>
+	0xFA18:  "礼",                  // CJK COMPATIBILITY IDEOGRAPH-FA18 → <CJK Ideograph>
+	0xFA19:  "神",                  // CJK COMPATIBILITY IDEOGRAPH-FA19 → <CJK Ideograph>
+	0xFA1A:  "祥",                  // CJK COMPATIBILITY IDEOGRAPH-FA1A → <CJK Ideograph>
+	0xFA1B:  "福",                  // CJK COMPATIBILITY IDEOGRAPH-FA1B → <CJK Ideograph>
+	0xFA1C:  "靖",                  // CJK COMPATIBILITY IDEOGRAPH-FA1C → <CJK Ideograph>
+	0xFA1D:  "精",                  // CJK COMPATIBILITY IDEOGRAPH-FA1D → <CJK Ideograph>
+	0xFA1E:  "羽",                  // CJK COMPATIBILITY IDEOGRAPH-FA1E → <CJK Ideograph>
+	0xFA20:  "蘒",                  // CJK COMPATIBILITY IDEOGRAPH-FA20 → <CJK Ideograph>
+	0xFA22:  "諸",                  // CJK COMPATIBILITY IDEOGRAPH-FA22 → <CJK Ideograph>
+	0xFA25:  "逸",                  // CJK COMPATIBILITY IDEOGRAPH-FA25 → <CJK Ideograph>
+	0xFA26:  "都",                  // CJK COMPATIBILITY IDEOGRAPH-FA26 → <CJK Ideograph>
+	0xFA2A:  "飯",                  // CJK COMPATIBILITY IDEOGRAPH-FA2A → <CJK Ideograph>
+	0xFA2B:  "飼",                  // CJK COMPATIBILITY IDEOGRAPH-FA2B → <CJK Ideograph>
+	0xFA2C:  "館",                  // CJK COMPATIBILITY IDEOGRAPH-FA2C → <CJK Ideograph>
+	0xFA2D:  "鶴",                  // CJK COMPATIBILITY IDEOGRAPH-FA2D → <CJK Ideograph>
+	0xFA2E:  "郎",                  // CJK COMPATIBILITY IDEOGRAPH-FA2E → <CJK Ideograph>
+	0xFA2F:  "隷",                  // CJK COMPATIBILITY IDEOGRAPH-FA2F → <CJK Ideograph>
+	0xFA30:  "侮",                  // CJK COMPATIBILITY IDEOGRAPH-FA30 → <CJK Ideograph>
+	0xFA31:  "僧",                  // CJK COMPATIBILITY IDEOGRAPH-FA31 → <CJK Ideograph>
+	0xFA32:  "免",                  // CJK COMPATIBILITY IDEOGRAPH-FA32 → <CJK Ideograph>
+	0xFA33:  "勉",                  // CJK COMPATIBILITY IDEOGRAPH-FA33 → <CJK Ideograph>
+	0xFA34:  "勤",                  // CJK COMPATIBILITY IDEOGRAPH-FA34 → <CJK Ideograph>
+	0xFA35:  "卑",                  // CJK COMPATIBILITY IDEOGRAPH-FA35 → <CJK Ideograph>
+	0xFA36:  "喝",                  // CJK COMPATIBILITY IDEOGRAPH-FA36 → <CJK Ideograph>
+	0xFA37:  "嘆",                  // CJK COMPATIBILITY IDEOGRAPH-FA37 → <CJK Ideograph>
+	0xFA38:  "器",                  // CJK COMPATIBILITY IDEOGRAPH-FA38 → <CJK Ideograph>
+	0xFA39:  "塀",                  // CJK COMPATIBILITY IDEOGRAPH-FA39 → <CJK Ideograph>
+	0xFA3A:  "墨",                  // CJK COMPATIBILITY IDEOGRAPH-FA3A → <CJK Ideograph>
+	0xFA3B:  "層",                  // CJK COMPATIBILITY IDEOGRAPH-FA3B → <CJK Ideograph>
+	0xFA3C:  "屮",                  // CJK COMPATIBILITY IDEOGRAPH-FA3C → <CJK Ideograph>
+	0xFA3D:  "悔",                  // CJK COMPATIBILITY IDEOGRAPH-FA3D → <CJK Ideograph>
+	0xFA3E:  "慨",                  // CJK COMPATIBILITY IDEOGRAPH-FA3E → <CJK Ideograph>
+	0xFA3F:  "憎",                  // CJK COMPATIBILITY IDEOGRAPH-FA3F → <CJK Ideograph>
+	0xFA40:  "懲",                  // CJK COMPATIBILITY IDEOGRAPH-FA40 → <CJK Ideograph>
+	0xFA41:  "敏",                  // CJK COMPATIBILITY IDEOGRAPH-FA41 → <CJK Ideograph>
+	0xFA42:  "既",                  // CJK COMPATIBILITY IDEOGRAPH-FA42 → <CJK Ideograph>
+	0xFA43:  "暑",                  // CJK COMPATIBILITY IDEOGRAPH-FA43 → <CJK Ideograph>
+	0xFA44:  "梅",                  // CJK COMPATIBILITY IDEOGRAPH-FA44 → <CJK Ideograph>
+	0xFA45:  "海",                  // CJK COMPATIBILITY IDEOGRAPH-FA45 → <CJK Ideograph>
+	0xFA46:  "渚",                  // CJK COMPATIBILITY IDEOGRAPH-FA46 → <CJK Ideograph>
+	0xFA47:  "漢",                  // CJK COMPATIBILITY IDEOGRAPH-FA47 → <CJK Ideograph>
+	0xFA48:  "煮",                  // CJK COMPATIBILITY IDEOGRAPH-FA48 → <CJK Ideograph>
+	0xFA49:  "爫",                  // CJK COMPATIBILITY IDEOGRAPH-FA49 → <CJK Ideograph>
+	0xFA4A:  "琢",                  // CJK COMPATIBILITY IDEOGRAPH-FA4A → <CJK Ideograph>
+	0xFA4B:  "碑",                  // CJK COMPATIBILITY IDEOGRAPH-FA4B → <CJK Ideograph>
+	0xFA4C:  "社",                  // CJK COMPATIBILITY IDEOGRAPH-FA4C → <CJK Ideograph>
+	0xFA4D:  "祉",                  // CJK COMPATIBILITY IDEOGRAPH-FA4D → <CJK Ideograph>
+	0xFA4E:  "祈",                  // CJK COMPATIBILITY IDEOGRAPH-FA4E → <CJK Ideograph>
+	0xFA4F:  "祐",                  // CJK COMPATIBILITY IDEOGRAPH-FA4F → <CJK Ideograph>
+	0xFA50:  "祖",                  // CJK COMPATIBILITY IDEOGRAPH-FA50 → <CJK Ideograph>
+	0xFA51:  "祝",                  // CJK COMPATIBILITY IDEOGRAPH-FA51 → <CJK Ideograph>
+	0xFA52:  "禍",                  // CJK COMPATIBILITY IDEOGRAPH-FA52 → <CJK Ideograph>
+	0xFA53:  "禎",                  // CJK COMPATIBILITY IDEOGRAPH-FA53 → <CJK Ideograph>
+	0xFA54:  "穀",                  // CJK COMPATIBILITY IDEOGRAPH-FA54 → <CJK Ideograph>
+	0xFA55:  "突",                  // CJK COMPATIBILITY IDEOGRAPH-FA55 → <CJK Ideograph>
+	0xFA56:  "節",                  // CJK COMPATIBILITY IDEOGRAPH-FA56 → <CJK Ideograph>
+	0xFA57:  "練",                  // CJK COMPATIBILITY IDEOGRAPH-FA57 → <CJK Ideograph>
+	0xFA58:  "縉",                  // CJK COMPATIBILITY IDEOGRAPH-FA58 → <CJK Ideograph>
+	0xFA59:  "繁",                  // CJK COMPATIBILITY IDEOGRAPH-FA59 → <CJK Ideograph>
+	0xFA5A:  "署",                  // CJK COMPATIBILITY IDEOGRAPH-FA5A → <CJK Ideograph>
+	0xFA5B:  "者",                  // CJK COMPATIBILITY IDEOGRAPH-FA5B → <CJK Ideograph>
+	0xFA5C:  "臭",                  // CJK COMPATIBILITY IDEOGRAPH-FA5C → <CJK Ideograph>
+	0xFA5D:  "艹",                  // CJK COMPATIBILITY IDEOGRAPH-FA5D → <CJK Ideograph>
+	0xFA5E:  "艹",                  // CJK COMPATIBILITY IDEOGRAPH-FA5E → <CJK Ideograph>
+	0xFA5F:  "著",                  // CJK COMPATIBILITY IDEOGRAPH-FA5F → <CJK Ideograph>
+	0xFA60:  "褐",                  // CJK COMPATIBILITY IDEOGRAPH-FA60 → <CJK Ideograph>
+	0xFA61:  "視",                  // CJK COMPATIBILITY IDEOGRAPH-FA61 → <CJK Ideograph>
+	0xFA62:  "謁",                  // CJK COMPATIBILITY IDEOGRAPH-FA62 → <CJK Ideograph>
+	0xFA63:  "謹",                  // CJK COMPATIBILITY IDEOGRAPH-FA63 → <CJK Ideograph>
+	0xFA64:  "賓",                  // CJK COMPATIBILITY IDEOGRAPH-FA64 → <CJK Ideograph>
+	0xFA65:  "贈",                  // CJK COMPATIBILITY IDEOGRAPH-FA65 → <CJK Ideograph>
+	0xFA66:  "辶",                  // CJK COMPATIBILITY IDEOGRAPH-FA66 → <CJK Ideograph>
+	0xFA67:  "逸",                  // CJK COMPATIBILITY IDEOGRAPH-FA67 → <CJK Ideograph>
+	0xFA68:  "難",                  // CJK COMPATIBILITY IDEOGRAPH-FA68 → <CJK Ideograph>
+	0xFA69:  "響",                  // CJK COMPATIBILITY IDEOGRAPH-FA69 → <CJK Ideograph>
+	0xFA6A:  "頻",                  // CJK COMPATIBILITY IDEOGRAPH-FA6A → <CJK Ideograph>
+	0xFA6B:  "恵",                  // CJK COMPATIBILITY IDEOGRAPH-FA6B → <CJK Ideograph>
+	0xFA6C:  "𤋮",                  // CJK COMPATIBILITY IDEOGRAPH-FA6C → <CJK Ideograph Extension B>
+	0xFA6D:  "舘",                  // CJK COMPATIBILITY IDEOGRAPH-FA6D → <CJK Ideograph>
+	0xFA70:  "並",                  // CJK COMPATIBILITY IDEOGRAPH-FA70 → <CJK Ideograph>
+	0xFA71:  "况",                  // CJK COMPATIBILITY IDEOGRAPH-FA71 → <CJK Ideograph>
+	0xFA72:  "全",                  // CJK COMPATIBILITY IDEOGRAPH-FA72 → <CJK Ideograph>
+	0xFA73:  "侀",                  // CJK COMPATIBILITY IDEOGRAPH-FA73 → <CJK Ideograph>
+	0xFA74:  "充",                  // CJK COMPATIBILITY IDEOGRAPH-FA74 → <CJK Ideograph>
+	0xFA75:  "冀",                  // CJK COMPATIBILITY IDEOGRAPH-FA75 → <CJK Ideograph>
+	0xFA76:  "勇",                  // CJK COMPATIBILITY IDEOGRAPH-FA76 → <CJK Ideograph>
+	0xFA77:  "勺",                  // CJK COMPATIBILITY IDEOGRAPH-FA77 → <CJK Ideograph>
+	0xFA78:  "喝",                  // CJK COMPATIBILITY IDEOGRAPH-FA78 → <CJK Ideograph>
+	0xFA79:  "啕",                  // CJK COMPATIBILITY IDEOGRAPH-FA79 → <CJK Ideograph>
+	0xFA7A:  "喙",                  // CJK COMPATIBILITY IDEOGRAPH-FA7A → <CJK Ideograph>
+	0xFA7B:  "嗢",                  // CJK COMPATIBILITY IDEOGRAPH-FA7B → <CJK Ideograph>
+	0xFA7C:  "塚",                  // CJK COMPATIBILITY IDEOGRAPH-FA7C → <CJK Ideograph>
+	0xFA7D:  "墳",                  // CJK COMPATIBILITY IDEOGRAPH-FA7D → <CJK Ideograph>
+	0xFA7E:  "奄",                  // CJK COMPATIBILITY IDEOGRAPH-FA7E → <CJK Ideograph>
+	0xFA7F:  "奔",                  // CJK COMPATIBILITY IDEOGRAPH-FA7F → <CJK Ideograph>
+	0xFA80:  "婢",                  // CJK COMPATIBILITY IDEOGRAPH-FA80 → <CJK Ideograph>
+	0xFA81:  "嬨",                  // CJK COMPATIBILITY IDEOGRAPH-FA81 → <CJK Ideograph>
+	0xFA82:  "廒",                  // CJK COMPATIBILITY IDEOGRAPH-FA82 → <CJK Ideograph>
+	0xFA83:  "廙",                  // CJK COMPATIBILITY IDEOGRAPH-FA83 → <CJK Ideograph>
+	0xFA84:  "彩",                  // CJK COMPATIBILITY IDEOGRAPH-FA84 → <CJK Ideograph>
+	0xFA85:  "徭",                  // CJK COMPATIBILITY IDEOGRAPH-FA85 → <CJK Ideograph>
+	0xFA86:  "惘",                  // CJK COMPATIBILITY IDEOGRAPH-FA86 → <CJK Ideograph>
+	0xFA87:  "慎",                  // CJK COMPATIBILITY IDEOGRAPH-FA87 → <CJK Ideograph>
+	0xFA88:  "愈",                  // CJK COMPATIBILITY IDEOGRAPH-FA88 → <CJK Ideograph>
+	0xFA89:  "憎",                  // CJK COMPATIBILITY IDEOGRAPH-FA89 → <CJK Ideograph>
+	0xFA8A:  "慠",                  // CJK COMPATIBILITY IDEOGRAPH-FA8A → <CJK Ideograph>
+	0xFA8B:  "懲",                  // CJK COMPATIBILITY IDEOGRAPH-FA8B → <CJK Ideograph>
+	0xFA8C:  "戴",                  // CJK COMPATIBILITY IDEOGRAPH-FA8C → <CJK Ideograph>
+	0xFA8D:  "揄",                  // CJK COMPATIBILITY IDEOGRAPH-FA8D → <CJK Ideograph>
+	0xFA8E:  "搜",                  // CJK COMPATIBILITY IDEOGRAPH-FA8E → <CJK Ideograph>
+	0xFA8F:  "摒",                  // CJK COMPATIBILITY IDEOGRAPH-FA8F → <CJK Ideograph>
+	0xFA90:  "敖",                  // CJK COMPATIBILITY IDEOGRAPH-FA90 → <CJK Ideograph>
+	0xFA91:  "晴",                  // CJK COMPATIBILITY IDEOGRAPH-FA91 → <CJK Ideograph>
+	0xFA92:  "朗",                  // CJK COMPATIBILITY IDEOGRAPH-FA92 → <CJK Ideograph>
+	0xFA93:  "望",                  // CJK COMPATIBILITY IDEOGRAPH-FA93 → <CJK Ideograph>
+	0xFA94:  "杖",                  // CJK COMPATIBILITY IDEOGRAPH-FA94 → <CJK Ideograph>
+	0xFA95:  "歹",                  // CJK COMPATIBILITY IDEOGRAPH-FA95 → <CJK Ideograph>
+	0xFA96:  "殺",                  // CJK COMPATIBILITY IDEOGRAPH-FA96 → <CJK Ideograph>
+	0xFA97:  "流",                  // CJK COMPATIBILITY IDEOGRAPH-FA97 → <CJK Ideograph>
+	0xFA98:  "滛",                  // CJK COMPATIBILITY IDEOGRAPH-FA98 → <CJK Ideograph>
+	0xFA99:  "滋",                  // CJK COMPATIBILITY IDEOGRAPH-FA99 → <CJK Ideograph>
+	0xFA9A:  "漢",                  // CJK COMPATIBILITY IDEOGRAPH-FA9A → <CJK Ideograph>
+	0xFA9B:  "瀞",                  // CJK COMPATIBILITY IDEOGRAPH-FA9B → <CJK Ideograph>
+	0xFA9C:  "煮",                  // CJK COMPATIBILITY IDEOGRAPH-FA9C → <CJK Ideograph>
+	0xFA9D:  "瞧",                  // CJK COMPATIBILITY IDEOGRAPH-FA9D → <CJK Ideograph>
+	0xFA9E:  "爵",                  // CJK COMPATIBILITY IDEOGRAPH-FA9E → <CJK Ideograph>
+	0xFA9F:  "犯",                  // CJK COMPATIBILITY IDEOGRAPH-FA9F → <CJK Ideograph>
+	0xFAA0:  "猪",                  // CJK COMPATIBILITY IDEOGRAPH-FAA0 → <CJK Ideograph>
+	0xFAA1:  "瑱",                  // CJK COMPATIBILITY IDEOGRAPH-FAA1 → <CJK Ideograph>
+	0xFAA2:  "甆",                  // CJK COMPATIBILITY IDEOGRAPH-FAA2 → <CJK Ideograph>
+	0xFAA3:  "画",                  // CJK COMPATIBILITY IDEOGRAPH-FAA3 → <CJK Ideograph>
+	0xFAA4:  "瘝",                  // CJK COMPATIBILITY IDEOGRAPH-FAA4 → <CJK Ideograph>
+	0xFAA5:  "瘟",                  // CJK COMPATIBILITY IDEOGRAPH-FAA5 → <CJK Ideograph>
+	0xFAA6:  "益",                  // CJK COMPATIBILITY IDEOGRAPH-FAA6 → <CJK Ideograph>
+	0xFAA7:  "盛",                  // CJK COMPATIBILITY IDEOGRAPH-FAA7 → <CJK Ideograph>
+	0xFAA8:  "直",                  // CJK COMPATIBILITY IDEOGRAPH-FAA8 → <CJK Ideograph>
+	0xFAA9:  "睊",                  // CJK COMPATIBILITY IDEOGRAPH-FAA9 → <CJK Ideograph>
+	0xFAAA:  "着",                  // CJK COMPATIBILITY IDEOGRAPH-FAAA → <CJK Ideograph>
+	0xFAAB:  "磌",                  // CJK COMPATIBILITY IDEOGRAPH-FAAB → <CJK Ideograph>
+	0xFAAC:  "窱",                  // CJK COMPATIBILITY IDEOGRAPH-FAAC → <CJK Ideograph>
+	0xFAAD:  "節",                  // CJK COMPATIBILITY IDEOGRAPH-FAAD → <CJK Ideograph>
+	0xFAAE:  "类",                  // CJK COMPATIBILITY IDEOGRAPH-FAAE → <CJK Ideograph>
+	0xFAAF:  "絛",                  // CJK COMPATIBILITY IDEOGRAPH-FAAF → <CJK Ideograph>
+	0xFAB0:  "練",                  // CJK COMPATIBILITY IDEOGRAPH-FAB0 → <CJK Ideograph>
+	0xFAB1:  "缾",                  // CJK COMPATIBILITY IDEOGRAPH-FAB1 → <CJK Ideograph>
+	0xFAB2:  "者",                  // CJK COMPATIBILITY IDEOGRAPH-FAB2 → <CJK Ideograph>
+	0xFAB3:  "荒",                  // CJK COMPATIBILITY IDEOGRAPH-FAB3 → <CJK Ideograph>
+	0xFAB4:  "華",                  // CJK COMPATIBILITY IDEOGRAPH-FAB4 → <CJK Ideograph>
+	0xFAB5:  "蝹",                  // CJK COMPATIBILITY IDEOGRAPH-FAB5 → <CJK Ideograph>
+	0xFAB6:  "襁",                  // CJK COMPATIBILITY IDEOGRAPH-FAB6 → <CJK Ideograph>
+	0xFAB7:  "覆",                  // CJK COMPATIBILITY IDEOGRAPH-FAB7 → <CJK Ideograph>
+	0xFAB8:  "視",                  // CJK COMPATIBILITY IDEOGRAPH-FAB8 → <CJK Ideograph>
+	0xFAB9:  "調",                  // CJK COMPATIBILITY IDEOGRAPH-FAB9 → <CJK Ideograph>
+	0xFABA:  "諸",                  // CJK COMPATIBILITY IDEOGRAPH-FABA → <CJK Ideograph>
+	0xFABB:  "請",                  // CJK COMPATIBILITY IDEOGRAPH-FABB → <CJK Ideograph>
+	0xFABC:  "謁",                  // CJK COMPATIBILITY IDEOGRAPH-FABC → <CJK Ideograph>
+	0xFABD:  "諾",                  // CJK COMPATIBILITY IDEOGRAPH-FABD → <CJK Ideograph>
+	0xFABE:  "諭",                  // CJK COMPATIBILITY IDEOGRAPH-FABE → <CJK Ideograph>
+	0xFABF:  "謹",                  // CJK COMPATIBILITY IDEOGRAPH-FABF → <CJK Ideograph>
+	0xFAC0:  "變",                  // CJK COMPATIBILITY IDEOGRAPH-FAC0 → <CJK Ideograph>
+	0xFAC1:  "贈",                  // CJK COMPATIBILITY IDEOGRAPH-FAC1 → <CJK Ideograph>
+	0xFAC2:  "輸",                  // CJK COMPATIBILITY IDEOGRAPH-FAC2 → <CJK Ideograph>
+	0xFAC3:  "遲",                  // CJK COMPATIBILITY IDEOGRAPH-FAC3 → <CJK Ideograph>
+	0xFAC4:  "醙",                  // CJK COMPATIBILITY IDEOGRAPH-FAC4 → <CJK Ideograph>
+	0xFAC5:  "鉶",                  // CJK COMPATIBILITY IDEOGRAPH-FAC5 → <CJK Ideograph>
+	0xFAC6:  "陼",                  // CJK COMPATIBILITY IDEOGRAPH-FAC6 → <CJK Ideograph>
+	0xFAC7:  "難",                  // CJK COMPATIBILITY IDEOGRAPH-FAC7 → <CJK Ideograph>
+	0xFAC8:  "靖",                  // CJK COMPATIBILITY IDEOGRAPH-FAC8 → <CJK Ideograph>
+	0xFAC9:  "韛",                  // CJK COMPATIBILITY IDEOGRAPH-FAC9 → <CJK Ideograph>
+	0xFACA:  "響",                  // CJK COMPATIBILITY IDEOGRAPH-FACA → <CJK Ideograph>
+	0xFACB:  "頋",                  // CJK COMPATIBILITY IDEOGRAPH-FACB → <CJK Ideograph>
+	0xFACC:  "頻",                  // CJK COMPATIBILITY IDEOGRAPH-FACC → <CJK Ideograph>
+	0xFACD:  "鬒",                  // CJK COMPATIBILITY IDEOGRAPH-FACD → <CJK Ideograph>
+	0xFACE:  "龜",                  // CJK COMPATIBILITY IDEOGRAPH-FACE → <CJK Ideograph>
+	0xFACF:  "𢡊",                  // CJK COMPATIBILITY IDEOGRAPH-FACF → <CJK Ideograph Extension B>
+	0xFAD0:  "𢡄",                  // CJK COMPATIBILITY IDEOGRAPH-FAD0 → <CJK Ideograph Extension B>
+	0xFAD1:  "𣏕",                  // CJK COMPATIBILITY IDEOGRAPH-FAD1 → <CJK Ideograph Extension B>
+	0xFAD2:  "㮝",                  // CJK COMPATIBILITY IDEOGRAPH-FAD2 → <CJK Ideograph Extension A>
+	0xFAD3:  "䀘",                  // CJK COMPATIBILITY IDEOGRAPH-FAD3 → <CJK Ideograph Extension A>
+	0xFAD4:  "䀹",                  // CJK COMPATIBILITY IDEOGRAPH-FAD4 → <CJK Ideograph Extension A>
+	0xFAD5:  "𥉉",                  // CJK COMPATIBILITY IDEOGRAPH-FAD5 → <CJK Ideograph Extension B>
+	0xFAD6:  "𥳐",                  // CJK COMPATIBILITY IDEOGRAPH-FAD6 → <CJK Ideograph Extension B>
+	0xFAD7:  "𧻓",                  // CJK COMPATIBILITY IDEOGRAPH-FAD7 → <CJK Ideograph Extension B>
+	0xFAD8:  "齃",                  // CJK COMPATIBILITY IDEOGRAPH-FAD8 → <CJK Ideograph>
+	0xFAD9:  "龎",                  // CJK COMPATIBILITY IDEOGRAPH-FAD9 → <CJK Ideograph>
+	0xFB00:  "ff",                 // LATIN SMALL LIGATURE FF → LATIN SMALL LETTER F + LATIN SMALL LETTER F
+	0xFB01:  "fi",                 // LATIN SMALL LIGATURE FI → LATIN SMALL LETTER F + LATIN SMALL LETTER I
+	0xFB02:  "fl",                 // LATIN SMALL LIGATURE FL → LATIN SMALL LETTER F + LATIN SMALL LETTER L
+	0xFB03:  "ffi",                // LATIN SMALL LIGATURE FFI → LATIN SMALL LETTER F + LATIN SMALL LETTER F + LATIN SMALL LETTER I
+	0xFB04:  "ffl",                // LATIN SMALL LIGATURE FFL → LATIN SMALL LETTER F + LATIN SMALL LETTER F + LATIN SMALL LETTER L
+	0xFB06:  "st",                 // LATIN SMALL LIGATURE ST → LATIN SMALL LETTER S + LATIN SMALL LETTER T
+	0xFB13:  "մն",                 // ARMENIAN SMALL LIGATURE MEN NOW → ARMENIAN SMALL LETTER MEN + ARMENIAN SMALL LETTER NOW
+	0xFB14:  "մե",                 // ARMENIAN SMALL LIGATURE MEN ECH → ARMENIAN SMALL LETTER MEN + ARMENIAN SMALL LETTER ECH
+	0xFB15:  "մի",                 // ARMENIAN SMALL LIGATURE MEN INI → ARMENIAN SMALL LETTER MEN + ARMENIAN SMALL LETTER INI
+	0xFB16:  "վն",                 // ARMENIAN SMALL LIGATURE VEW NOW → ARMENIAN SMALL LETTER VEW + ARMENIAN SMALL LETTER NOW
+	0xFB17:  "մխ",                 // ARMENIAN SMALL LIGATURE MEN XEH → ARMENIAN SMALL LETTER MEN + ARMENIAN SMALL LETTER XEH
+	0xFB20:  "ע",                  // HEBREW LETTER ALTERNATIVE AYIN → HEBREW LETTER AYIN
+	0xFB21:  "א",                  // HEBREW LETTER WIDE ALEF → HEBREW LETTER ALEF
+	0xFB22:  "ד",                  // HEBREW LETTER WIDE DALET → HEBREW LETTER DALET
+	0xFB23:  "ה",                  // HEBREW LETTER WIDE HE → HEBREW LETTER HE
+	0xFB24:  "כ",                  // HEBREW LETTER WIDE KAF → HEBREW LETTER KAF
+	0xFB25:  "ל",                  // HEBREW LETTER WIDE LAMED → HEBREW LETTER LAMED
+	0xFB26:  "ם",                  // HEBREW LETTER WIDE FINAL MEM → HEBREW LETTER FINAL MEM
+	0xFB27:  "ר",                  // HEBREW LETTER WIDE RESH → HEBREW LETTER RESH
+	0xFB28:  "ת",                  // HEBREW LETTER WIDE TAV → HEBREW LETTER TAV
+	0xFB29:  "-̇",                 // HEBREW LETTER ALTERNATIVE PLUS SIGN → HYPHEN-MINUS + COMBINING DOT ABOVE
+	0xFB2B:  "שׁ",                  // HEBREW LETTER SHIN WITH SIN DOT → HEBREW LETTER SHIN WITH SHIN DOT
+	0xFB2D:  "שּׁ",                  // HEBREW LETTER SHIN WITH DAGESH AND SIN DOT → HEBREW LETTER SHIN WITH DAGESH AND SHIN DOT
+	0xFB2F:  "אַ",                  // HEBREW LETTER ALEF WITH QAMATS → HEBREW LETTER ALEF WITH PATAH
+	0xFB30:  "אַ",                  // HEBREW LETTER ALEF WITH MAPIQ → HEBREW LETTER ALEF WITH PATAH
+	0xFB39:  "יִ",                  // HEBREW LETTER YOD WITH DAGESH → HEBREW LETTER YOD WITH HIRIQ
+	0xFB49:  "שׁ",                  // HEBREW LETTER SHIN WITH DAGESH → HEBREW LETTER SHIN WITH SHIN DOT
+	0xFB4F:  "אל",                 // HEBREW LIGATURE ALEF LAMED → HEBREW LETTER ALEF + HEBREW LETTER LAMED
+	0xFB50:  "ٱ",                  // ARABIC LETTER ALEF WASLA ISOLATED FORM → ARABIC LETTER ALEF WASLA
+	0xFB51:  "ٱ",                  // ARABIC LETTER ALEF WASLA FINAL FORM → ARABIC LETTER ALEF WASLA
+	0xFB52:  "ٻ",                  // ARABIC LETTER BEEH ISOLATED FORM → ARABIC LETTER BEEH
+	0xFB53:  "ٻ",                  // ARABIC LETTER BEEH FINAL FORM → ARABIC LETTER BEEH
+	0xFB54:  "ٻ",                  // ARABIC LETTER BEEH INITIAL FORM → ARABIC LETTER BEEH
+	0xFB55:  "ٻ",                  // ARABIC LETTER BEEH MEDIAL FORM → ARABIC LETTER BEEH
+	0xFB56:  "ىۛ",                 // ARABIC LETTER PEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFB57:  "ىۛ",                 // ARABIC LETTER PEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFB58:  "ىۛ",                 // ARABIC LETTER PEH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFB59:  "ىۛ",                 // ARABIC LETTER PEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFB5A:  "ڀ",                  // ARABIC LETTER BEHEH ISOLATED FORM → ARABIC LETTER BEHEH
+	0xFB5B:  "ڀ",                  // ARABIC LETTER BEHEH FINAL FORM → ARABIC LETTER BEHEH
+	0xFB5C:  "ڀ",                  // ARABIC LETTER BEHEH INITIAL FORM → ARABIC LETTER BEHEH
+	0xFB5D:  "ڀ",                  // ARABIC LETTER BEHEH MEDIAL FORM → ARABIC LETTER BEHEH
+	0xFB5E:  "ت",                  // ARABIC LETTER TTEHEH ISOLATED FORM → ARABIC LETTER TEH
+	0xFB5F:  "ت",                  // ARABIC LETTER TTEHEH FINAL FORM → ARABIC LETTER TEH
+	0xFB60:  "ت",                  // ARABIC LETTER TTEHEH INITIAL FORM → ARABIC LETTER TEH
+	0xFB61:  "ت",                  // ARABIC LETTER TTEHEH MEDIAL FORM → ARABIC LETTER TEH
+	0xFB62:  "ٿ",                  // ARABIC LETTER TEHEH ISOLATED FORM → ARABIC LETTER TEHEH
+	0xFB63:  "ٿ",                  // ARABIC LETTER TEHEH FINAL FORM → ARABIC LETTER TEHEH
+	0xFB64:  "ٿ",                  // ARABIC LETTER TEHEH INITIAL FORM → ARABIC LETTER TEHEH
+	0xFB65:  "ٿ",                  // ARABIC LETTER TEHEH MEDIAL FORM → ARABIC LETTER TEHEH
+	0xFB66:  "ىؕ",                 // ARABIC LETTER TTEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFB67:  "ىؕ",                 // ARABIC LETTER TTEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFB68:  "ىؕ",                 // ARABIC LETTER TTEH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFB69:  "ىؕ",                 // ARABIC LETTER TTEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFB6A:  "ڡۛ",                 // ARABIC LETTER VEH ISOLATED FORM → ARABIC LETTER DOTLESS FEH + ARABIC SMALL HIGH THREE DOTS
+	0xFB6B:  "ڡۛ",                 // ARABIC LETTER VEH FINAL FORM → ARABIC LETTER DOTLESS FEH + ARABIC SMALL HIGH THREE DOTS
+	0xFB6C:  "ڡۛ",                 // ARABIC LETTER VEH INITIAL FORM → ARABIC LETTER DOTLESS FEH + ARABIC SMALL HIGH THREE DOTS
+	0xFB6D:  "ڡۛ",                 // ARABIC LETTER VEH MEDIAL FORM → ARABIC LETTER DOTLESS FEH + ARABIC SMALL HIGH THREE DOTS
+	0xFB6E:  "ڦ",                  // ARABIC LETTER PEHEH ISOLATED FORM → ARABIC LETTER PEHEH
+	0xFB6F:  "ڦ",                  // ARABIC LETTER PEHEH FINAL FORM → ARABIC LETTER PEHEH
+	0xFB70:  "ڦ",                  // ARABIC LETTER PEHEH INITIAL FORM → ARABIC LETTER PEHEH
+	0xFB71:  "ڦ",                  // ARABIC LETTER PEHEH MEDIAL FORM → ARABIC LETTER PEHEH
+	0xFB72:  "ڄ",                  // ARABIC LETTER DYEH ISOLATED FORM → ARABIC LETTER DYEH
+	0xFB73:  "ڄ",                  // ARABIC LETTER DYEH FINAL FORM → ARABIC LETTER DYEH
+	0xFB74:  "ڄ",                  // ARABIC LETTER DYEH INITIAL FORM → ARABIC LETTER DYEH
+	0xFB75:  "ڄ",                  // ARABIC LETTER DYEH MEDIAL FORM → ARABIC LETTER DYEH
+	0xFB76:  "ڃ",                  // ARABIC LETTER NYEH ISOLATED FORM → ARABIC LETTER NYEH
+	0xFB77:  "ڃ",                  // ARABIC LETTER NYEH FINAL FORM → ARABIC LETTER NYEH
+	0xFB78:  "ڃ",                  // ARABIC LETTER NYEH INITIAL FORM → ARABIC LETTER NYEH
+	0xFB79:  "ڃ",                  // ARABIC LETTER NYEH MEDIAL FORM → ARABIC LETTER NYEH
+	0xFB7A:  "چ",                  // ARABIC LETTER TCHEH ISOLATED FORM → ARABIC LETTER TCHEH
+	0xFB7B:  "چ",                  // ARABIC LETTER TCHEH FINAL FORM → ARABIC LETTER TCHEH
+	0xFB7C:  "چ",                  // ARABIC LETTER TCHEH INITIAL FORM → ARABIC LETTER TCHEH
+	0xFB7D:  "چ",                  // ARABIC LETTER TCHEH MEDIAL FORM → ARABIC LETTER TCHEH
+	0xFB7E:  "ڇ",                  // ARABIC LETTER TCHEHEH ISOLATED FORM → ARABIC LETTER TCHEHEH
+	0xFB7F:  "ڇ",                  // ARABIC LETTER TCHEHEH FINAL FORM → ARABIC LETTER TCHEHEH
+	0xFB80:  "ڇ",                  // ARABIC LETTER TCHEHEH INITIAL FORM → ARABIC LETTER TCHEHEH
+	0xFB81:  "ڇ",                  // ARABIC LETTER TCHEHEH MEDIAL FORM → ARABIC LETTER TCHEHEH
+	0xFB82:  "ڍ",                  // ARABIC LETTER DDAHAL ISOLATED FORM → ARABIC LETTER DDAHAL
+	0xFB83:  "ڍ",                  // ARABIC LETTER DDAHAL FINAL FORM → ARABIC LETTER DDAHAL
+	0xFB84:  "ڌ",                  // ARABIC LETTER DAHAL ISOLATED FORM → ARABIC LETTER DAHAL
+	0xFB85:  "ڌ",                  // ARABIC LETTER DAHAL FINAL FORM → ARABIC LETTER DAHAL
+	0xFB86:  "دۛ",                 // ARABIC LETTER DUL ISOLATED FORM → ARABIC LETTER DAL + ARABIC SMALL HIGH THREE DOTS
+	0xFB87:  "دۛ",                 // ARABIC LETTER DUL FINAL FORM → ARABIC LETTER DAL + ARABIC SMALL HIGH THREE DOTS
+	0xFB88:  "دؕ",                 // ARABIC LETTER DDAL ISOLATED FORM → ARABIC LETTER DAL + ARABIC SMALL HIGH TAH
+	0xFB89:  "دؕ",                 // ARABIC LETTER DDAL FINAL FORM → ARABIC LETTER DAL + ARABIC SMALL HIGH TAH
+	0xFB8A:  "رۛ",                 // ARABIC LETTER JEH ISOLATED FORM → ARABIC LETTER REH + ARABIC SMALL HIGH THREE DOTS
+	0xFB8B:  "رۛ",                 // ARABIC LETTER JEH FINAL FORM → ARABIC LETTER REH + ARABIC SMALL HIGH THREE DOTS
+	0xFB8C:  "رؕ",                 // ARABIC LETTER RREH ISOLATED FORM → ARABIC LETTER REH + ARABIC SMALL HIGH TAH
+	0xFB8D:  "رؕ",                 // ARABIC LETTER RREH FINAL FORM → ARABIC LETTER REH + ARABIC SMALL HIGH TAH
+	0xFB8E:  "ك",                  // ARABIC LETTER KEHEH ISOLATED FORM → ARABIC LETTER KAF
+	0xFB8F:  "ك",                  // ARABIC LETTER KEHEH FINAL FORM → ARABIC LETTER KAF
+	0xFB90:  "ك",                  // ARABIC LETTER KEHEH INITIAL FORM → ARABIC LETTER KAF
+	0xFB91:  "ك",                  // ARABIC LETTER KEHEH MEDIAL FORM → ARABIC LETTER KAF
+	0xFB92:  "گ",                  // ARABIC LETTER GAF ISOLATED FORM → ARABIC LETTER GAF
+	0xFB93:  "گ",                  // ARABIC LETTER GAF FINAL FORM → ARABIC LETTER GAF
+	0xFB94:  "گ",                  // ARABIC LETTER GAF INITIAL FORM → ARABIC LETTER GAF
+	0xFB95:  "گ",                  // ARABIC LETTER GAF MEDIAL FORM → ARABIC LETTER GAF
+	0xFB96:  "ڳ",                  // ARABIC LETTER GUEH ISOLATED FORM → ARABIC LETTER GUEH
+	0xFB97:  "ڳ",                  // ARABIC LETTER GUEH FINAL FORM → ARABIC LETTER GUEH
+	0xFB98:  "ڳ",                  // ARABIC LETTER GUEH INITIAL FORM → ARABIC LETTER GUEH
+	0xFB99:  "ڳ",                  // ARABIC LETTER GUEH MEDIAL FORM → ARABIC LETTER GUEH
+	0xFB9A:  "ڱ",                  // ARABIC LETTER NGOEH ISOLATED FORM → ARABIC LETTER NGOEH
+	0xFB9B:  "ڱ",                  // ARABIC LETTER NGOEH FINAL FORM → ARABIC LETTER NGOEH
+	0xFB9C:  "ڱ",                  // ARABIC LETTER NGOEH INITIAL FORM → ARABIC LETTER NGOEH
+	0xFB9D:  "ڱ",                  // ARABIC LETTER NGOEH MEDIAL FORM → ARABIC LETTER NGOEH
+	0xFB9E:  "ى",                  // ARABIC LETTER NOON GHUNNA ISOLATED FORM → ARABIC LETTER ALEF MAKSURA
+	0xFB9F:  "ى",                  // ARABIC LETTER NOON GHUNNA FINAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBA0:  "ىؕ",                 // ARABIC LETTER RNOON ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFBA1:  "ىؕ",                 // ARABIC LETTER RNOON FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFBA2:  "ىؕ",                 // ARABIC LETTER RNOON INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFBA3:  "ىؕ",                 // ARABIC LETTER RNOON MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH TAH
+	0xFBA4:  "ۀ",                  // ARABIC LETTER HEH WITH YEH ABOVE ISOLATED FORM → ARABIC LETTER HEH WITH YEH ABOVE
+	0xFBA5:  "ۀ",                  // ARABIC LETTER HEH WITH YEH ABOVE FINAL FORM → ARABIC LETTER HEH WITH YEH ABOVE
+	0xFBA6:  "o",                  // ARABIC LETTER HEH GOAL ISOLATED FORM → LATIN SMALL LETTER O
+	0xFBA7:  "o",                  // ARABIC LETTER HEH GOAL FINAL FORM → LATIN SMALL LETTER O
+	0xFBA8:  "o",                  // ARABIC LETTER HEH GOAL INITIAL FORM → LATIN SMALL LETTER O
+	0xFBA9:  "o",                  // ARABIC LETTER HEH GOAL MEDIAL FORM → LATIN SMALL LETTER O
+	0xFBAA:  "o",                  // ARABIC LETTER HEH DOACHASHMEE ISOLATED FORM → LATIN SMALL LETTER O
+	0xFBAB:  "o",                  // ARABIC LETTER HEH DOACHASHMEE FINAL FORM → LATIN SMALL LETTER O
+	0xFBAC:  "o",                  // ARABIC LETTER HEH DOACHASHMEE INITIAL FORM → LATIN SMALL LETTER O
+	0xFBAD:  "o",                  // ARABIC LETTER HEH DOACHASHMEE MEDIAL FORM → LATIN SMALL LETTER O
+	0xFBAE:  "ى",                  // ARABIC LETTER YEH BARREE ISOLATED FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBAF:  "ى",                  // ARABIC LETTER YEH BARREE FINAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBB0:  "ۓ",                  // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE ISOLATED FORM → ARABIC LETTER YEH BARREE WITH HAMZA ABOVE
+	0xFBB1:  "ۓ",                  // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM → ARABIC LETTER YEH BARREE WITH HAMZA ABOVE
+	0xFBD3:  "كۛ",                 // ARABIC LETTER NG ISOLATED FORM → ARABIC LETTER KAF + ARABIC SMALL HIGH THREE DOTS
+	0xFBD4:  "كۛ",                 // ARABIC LETTER NG FINAL FORM → ARABIC LETTER KAF + ARABIC SMALL HIGH THREE DOTS
+	0xFBD5:  "كۛ",                 // ARABIC LETTER NG INITIAL FORM → ARABIC LETTER KAF + ARABIC SMALL HIGH THREE DOTS
+	0xFBD6:  "كۛ",                 // ARABIC LETTER NG MEDIAL FORM → ARABIC LETTER KAF + ARABIC SMALL HIGH THREE DOTS
+	0xFBD7:  "و̓",                 // ARABIC LETTER U ISOLATED FORM → ARABIC LETTER WAW + COMBINING COMMA ABOVE
+	0xFBD8:  "و̓",                 // ARABIC LETTER U FINAL FORM → ARABIC LETTER WAW + COMBINING COMMA ABOVE
+	0xFBD9:  "و̆",                 // ARABIC LETTER OE ISOLATED FORM → ARABIC LETTER WAW + COMBINING BREVE
+	0xFBDA:  "و̆",                 // ARABIC LETTER OE FINAL FORM → ARABIC LETTER WAW + COMBINING BREVE
+	0xFBDB:  "وٰ",                 // ARABIC LETTER YU ISOLATED FORM → ARABIC LETTER WAW + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFBDC:  "وٰ",                 // ARABIC LETTER YU FINAL FORM → ARABIC LETTER WAW + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFBDD:  "و̓ٴ",                // ARABIC LETTER U WITH HAMZA ABOVE ISOLATED FORM → ARABIC LETTER WAW + COMBINING COMMA ABOVE + ARABIC LETTER HIGH HAMZA
+	0xFBDE:  "وۛ",                 // ARABIC LETTER VE ISOLATED FORM → ARABIC LETTER WAW + ARABIC SMALL HIGH THREE DOTS
+	0xFBDF:  "وۛ",                 // ARABIC LETTER VE FINAL FORM → ARABIC LETTER WAW + ARABIC SMALL HIGH THREE DOTS
+	0xFBE0:  "ۅ",                  // ARABIC LETTER KIRGHIZ OE ISOLATED FORM → ARABIC LETTER KIRGHIZ OE
+	0xFBE1:  "ۅ",                  // ARABIC LETTER KIRGHIZ OE FINAL FORM → ARABIC LETTER KIRGHIZ OE
+	0xFBE2:  "و̂",                 // ARABIC LETTER KIRGHIZ YU ISOLATED FORM → ARABIC LETTER WAW + COMBINING CIRCUMFLEX ACCENT
+	0xFBE3:  "و̂",                 // ARABIC LETTER KIRGHIZ YU FINAL FORM → ARABIC LETTER WAW + COMBINING CIRCUMFLEX ACCENT
+	0xFBE4:  "ٻ",                  // ARABIC LETTER E ISOLATED FORM → ARABIC LETTER BEEH
+	0xFBE5:  "ٻ",                  // ARABIC LETTER E FINAL FORM → ARABIC LETTER BEEH
+	0xFBE6:  "ٻ",                  // ARABIC LETTER E INITIAL FORM → ARABIC LETTER BEEH
+	0xFBE7:  "ٻ",                  // ARABIC LETTER E MEDIAL FORM → ARABIC LETTER BEEH
+	0xFBE8:  "ى",                  // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA INITIAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBE9:  "ى",                  // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA MEDIAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBEA:  "ىٴl",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + LATIN SMALL LETTER L
+	0xFBEB:  "ىٴl",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + LATIN SMALL LETTER L
+	0xFBEC:  "ىٴo",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + LATIN SMALL LETTER O
+	0xFBED:  "ىٴo",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + LATIN SMALL LETTER O
+	0xFBEE:  "ىٴو",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW
+	0xFBEF:  "ىٴو",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW
+	0xFBF0:  "ىٴو̓",               // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW + COMBINING COMMA ABOVE
+	0xFBF1:  "ىٴو̓",               // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW + COMBINING COMMA ABOVE
+	0xFBF2:  "ىٴو̆",               // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW + COMBINING BREVE
+	0xFBF3:  "ىٴو̆",               // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW + COMBINING BREVE
+	0xFBF4:  "ىٴوٰ",               // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFBF5:  "ىٴوٰ",               // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER WAW + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFBF6:  "ىٴٻ",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER BEEH
+	0xFBF7:  "ىٴٻ",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER BEEH
+	0xFBF8:  "ىٴٻ",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER BEEH
+	0xFBF9:  "ىٴى",                // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ALEF MAKSURA
+	0xFBFA:  "ىٴى",                // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ALEF MAKSURA
+	0xFBFB:  "ىٴى",                // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ALEF MAKSURA
+	0xFBFC:  "ى",                  // ARABIC LETTER FARSI YEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBFD:  "ى",                  // ARABIC LETTER FARSI YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBFE:  "ى",                  // ARABIC LETTER FARSI YEH INITIAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFBFF:  "ى",                  // ARABIC LETTER FARSI YEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFC00:  "ىٴج",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER JEEM
+	0xFC01:  "ىٴح",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER HAH
+	0xFC02:  "ىٴم",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER MEEM
+	0xFC03:  "ىٴى",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ALEF MAKSURA
+	0xFC04:  "ىٴى",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ALEF MAKSURA
+	0xFC05:  "بج",                 // ARABIC LIGATURE BEH WITH JEEM ISOLATED FORM → ARABIC LETTER BEH + ARABIC LETTER JEEM
+	0xFC06:  "بح",                 // ARABIC LIGATURE BEH WITH HAH ISOLATED FORM → ARABIC LETTER BEH + ARABIC LETTER HAH
+	0xFC07:  "بخ",                 // ARABIC LIGATURE BEH WITH KHAH ISOLATED FORM → ARABIC LETTER BEH + ARABIC LETTER KHAH
+	0xFC08:  "بم",                 // ARABIC LIGATURE BEH WITH MEEM ISOLATED FORM → ARABIC LETTER BEH + ARABIC LETTER MEEM
+	0xFC09:  "بى",                 // ARABIC LIGATURE BEH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER BEH + ARABIC LETTER ALEF MAKSURA
+	0xFC0A:  "بى",                 // ARABIC LIGATURE BEH WITH YEH ISOLATED FORM → ARABIC LETTER BEH + ARABIC LETTER ALEF MAKSURA
+	0xFC0B:  "تج",                 // ARABIC LIGATURE TEH WITH JEEM ISOLATED FORM → ARABIC LETTER TEH + ARABIC LETTER JEEM
+	0xFC0C:  "تح",                 // ARABIC LIGATURE TEH WITH HAH ISOLATED FORM → ARABIC LETTER TEH + ARABIC LETTER HAH
+	0xFC0D:  "تخ",                 // ARABIC LIGATURE TEH WITH KHAH ISOLATED FORM → ARABIC LETTER TEH + ARABIC LETTER KHAH
+	0xFC0E:  "تم",                 // ARABIC LIGATURE TEH WITH MEEM ISOLATED FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM
+	0xFC0F:  "تى",                 // ARABIC LIGATURE TEH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER TEH + ARABIC LETTER ALEF MAKSURA
+	0xFC10:  "تى",                 // ARABIC LIGATURE TEH WITH YEH ISOLATED FORM → ARABIC LETTER TEH + ARABIC LETTER ALEF MAKSURA
+	0xFC11:  "ىۛج",                // ARABIC LIGATURE THEH WITH JEEM ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER JEEM
+	0xFC12:  "ىۛم",                // ARABIC LIGATURE THEH WITH MEEM ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFC13:  "ىۛى",                // ARABIC LIGATURE THEH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFC14:  "ىۛى",                // ARABIC LIGATURE THEH WITH YEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFC15:  "جح",                 // ARABIC LIGATURE JEEM WITH HAH ISOLATED FORM → ARABIC LETTER JEEM + ARABIC LETTER HAH
+	0xFC16:  "جم",                 // ARABIC LIGATURE JEEM WITH MEEM ISOLATED FORM → ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFC17:  "حج",                 // ARABIC LIGATURE HAH WITH JEEM ISOLATED FORM → ARABIC LETTER HAH + ARABIC LETTER JEEM
+	0xFC18:  "حم",                 // ARABIC LIGATURE HAH WITH MEEM ISOLATED FORM → ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFC19:  "خج",                 // ARABIC LIGATURE KHAH WITH JEEM ISOLATED FORM → ARABIC LETTER KHAH + ARABIC LETTER JEEM
+	0xFC1A:  "خح",                 // ARABIC LIGATURE KHAH WITH HAH ISOLATED FORM → ARABIC LETTER KHAH + ARABIC LETTER HAH
+	0xFC1B:  "خم",                 // ARABIC LIGATURE KHAH WITH MEEM ISOLATED FORM → ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFC1C:  "سج",                 // ARABIC LIGATURE SEEN WITH JEEM ISOLATED FORM → ARABIC LETTER SEEN + ARABIC LETTER JEEM
+	0xFC1D:  "سح",                 // ARABIC LIGATURE SEEN WITH HAH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC LETTER HAH
+	0xFC1E:  "سخ",                 // ARABIC LIGATURE SEEN WITH KHAH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC LETTER KHAH
+	0xFC1F:  "سم",                 // ARABIC LIGATURE SEEN WITH MEEM ISOLATED FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM
+	0xFC20:  "صح",                 // ARABIC LIGATURE SAD WITH HAH ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER HAH
+	0xFC21:  "صم",                 // ARABIC LIGATURE SAD WITH MEEM ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER MEEM
+	0xFC22:  "ضج",                 // ARABIC LIGATURE DAD WITH JEEM ISOLATED FORM → ARABIC LETTER DAD + ARABIC LETTER JEEM
+	0xFC23:  "ضح",                 // ARABIC LIGATURE DAD WITH HAH ISOLATED FORM → ARABIC LETTER DAD + ARABIC LETTER HAH
+	0xFC24:  "ضخ",                 // ARABIC LIGATURE DAD WITH KHAH ISOLATED FORM → ARABIC LETTER DAD + ARABIC LETTER KHAH
+	0xFC25:  "ضم",                 // ARABIC LIGATURE DAD WITH MEEM ISOLATED FORM → ARABIC LETTER DAD + ARABIC LETTER MEEM
+	0xFC26:  "طح",                 // ARABIC LIGATURE TAH WITH HAH ISOLATED FORM → ARABIC LETTER TAH + ARABIC LETTER HAH
+	0xFC27:  "طم",                 // ARABIC LIGATURE TAH WITH MEEM ISOLATED FORM → ARABIC LETTER TAH + ARABIC LETTER MEEM
+	0xFC28:  "ظم",                 // ARABIC LIGATURE ZAH WITH MEEM ISOLATED FORM → ARABIC LETTER ZAH + ARABIC LETTER MEEM
+	0xFC29:  "عج",                 // ARABIC LIGATURE AIN WITH JEEM ISOLATED FORM → ARABIC LETTER AIN + ARABIC LETTER JEEM
+	0xFC2A:  "عم",                 // ARABIC LIGATURE AIN WITH MEEM ISOLATED FORM → ARABIC LETTER AIN + ARABIC LETTER MEEM
+	0xFC2B:  "غج",                 // ARABIC LIGATURE GHAIN WITH JEEM ISOLATED FORM → ARABIC LETTER GHAIN + ARABIC LETTER JEEM
+	0xFC2C:  "غم",                 // ARABIC LIGATURE GHAIN WITH MEEM ISOLATED FORM → ARABIC LETTER GHAIN + ARABIC LETTER MEEM
+	0xFC2D:  "فج",                 // ARABIC LIGATURE FEH WITH JEEM ISOLATED FORM → ARABIC LETTER FEH + ARABIC LETTER JEEM
+	0xFC2E:  "فح",                 // ARABIC LIGATURE FEH WITH HAH ISOLATED FORM → ARABIC LETTER FEH + ARABIC LETTER HAH
+	0xFC2F:  "فخ",                 // ARABIC LIGATURE FEH WITH KHAH ISOLATED FORM → ARABIC LETTER FEH + ARABIC LETTER KHAH
+	0xFC30:  "فم",                 // ARABIC LIGATURE FEH WITH MEEM ISOLATED FORM → ARABIC LETTER FEH + ARABIC LETTER MEEM
+	0xFC31:  "فى",                 // ARABIC LIGATURE FEH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER FEH + ARABIC LETTER ALEF MAKSURA
+	0xFC32:  "فى",                 // ARABIC LIGATURE FEH WITH YEH ISOLATED FORM → ARABIC LETTER FEH + ARABIC LETTER ALEF MAKSURA
+	0xFC33:  "قح",                 // ARABIC LIGATURE QAF WITH HAH ISOLATED FORM → ARABIC LETTER QAF + ARABIC LETTER HAH
+	0xFC34:  "قم",                 // ARABIC LIGATURE QAF WITH MEEM ISOLATED FORM → ARABIC LETTER QAF + ARABIC LETTER MEEM
+	0xFC35:  "قى",                 // ARABIC LIGATURE QAF WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER QAF + ARABIC LETTER ALEF MAKSURA
+	0xFC36:  "قى",                 // ARABIC LIGATURE QAF WITH YEH ISOLATED FORM → ARABIC LETTER QAF + ARABIC LETTER ALEF MAKSURA
+	0xFC37:  "كl",                 // ARABIC LIGATURE KAF WITH ALEF ISOLATED FORM → ARABIC LETTER KAF + LATIN SMALL LETTER L
+	0xFC38:  "كج",                 // ARABIC LIGATURE KAF WITH JEEM ISOLATED FORM → ARABIC LETTER KAF + ARABIC LETTER JEEM
+	0xFC39:  "كح",                 // ARABIC LIGATURE KAF WITH HAH ISOLATED FORM → ARABIC LETTER KAF + ARABIC LETTER HAH
+	0xFC3A:  "كخ",                 // ARABIC LIGATURE KAF WITH KHAH ISOLATED FORM → ARABIC LETTER KAF + ARABIC LETTER KHAH
+	0xFC3B:  "كل",                 // ARABIC LIGATURE KAF WITH LAM ISOLATED FORM → ARABIC LETTER KAF + ARABIC LETTER LAM
+	0xFC3C:  "كم",                 // ARABIC LIGATURE KAF WITH MEEM ISOLATED FORM → ARABIC LETTER KAF + ARABIC LETTER MEEM
+	0xFC3D:  "كى",                 // ARABIC LIGATURE KAF WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER KAF + ARABIC LETTER ALEF MAKSURA
+	0xFC3E:  "كى",                 // ARABIC LIGATURE KAF WITH YEH ISOLATED FORM → ARABIC LETTER KAF + ARABIC LETTER ALEF MAKSURA
+	0xFC3F:  "لج",                 // ARABIC LIGATURE LAM WITH JEEM ISOLATED FORM → ARABIC LETTER LAM + ARABIC LETTER JEEM
+	0xFC40:  "لح",                 // ARABIC LIGATURE LAM WITH HAH ISOLATED FORM → ARABIC LETTER LAM + ARABIC LETTER HAH
+	0xFC41:  "لخ",                 // ARABIC LIGATURE LAM WITH KHAH ISOLATED FORM → ARABIC LETTER LAM + ARABIC LETTER KHAH
+	0xFC42:  "لم",                 // ARABIC LIGATURE LAM WITH MEEM ISOLATED FORM → ARABIC LETTER LAM + ARABIC LETTER MEEM
+	0xFC43:  "لى",                 // ARABIC LIGATURE LAM WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA
+	0xFC44:  "لى",                 // ARABIC LIGATURE LAM WITH YEH ISOLATED FORM → ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA
+	0xFC45:  "مج",                 // ARABIC LIGATURE MEEM WITH JEEM ISOLATED FORM → ARABIC LETTER MEEM + ARABIC LETTER JEEM
+	0xFC46:  "مح",                 // ARABIC LIGATURE MEEM WITH HAH ISOLATED FORM → ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFC47:  "مخ",                 // ARABIC LIGATURE MEEM WITH KHAH ISOLATED FORM → ARABIC LETTER MEEM + ARABIC LETTER KHAH
+	0xFC48:  "مم",                 // ARABIC LIGATURE MEEM WITH MEEM ISOLATED FORM → ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFC49:  "مى",                 // ARABIC LIGATURE MEEM WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFC4A:  "مى",                 // ARABIC LIGATURE MEEM WITH YEH ISOLATED FORM → ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFC4B:  "بخ",                 // ARABIC LIGATURE NOON WITH JEEM ISOLATED FORM → ARABIC LETTER BEH + ARABIC LETTER KHAH
+	0xFC4C:  "نح",                 // ARABIC LIGATURE NOON WITH HAH ISOLATED FORM → ARABIC LETTER NOON + ARABIC LETTER HAH
+	0xFC4D:  "نخ",                 // ARABIC LIGATURE NOON WITH KHAH ISOLATED FORM → ARABIC LETTER NOON + ARABIC LETTER KHAH
+	0xFC4E:  "نم",                 // ARABIC LIGATURE NOON WITH MEEM ISOLATED FORM → ARABIC LETTER NOON + ARABIC LETTER MEEM
+	0xFC4F:  "نى",                 // ARABIC LIGATURE NOON WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER NOON + ARABIC LETTER ALEF MAKSURA
+	0xFC50:  "نى",                 // ARABIC LIGATURE NOON WITH YEH ISOLATED FORM → ARABIC LETTER NOON + ARABIC LETTER ALEF MAKSURA
+	0xFC51:  "oج",                 // ARABIC LIGATURE HEH WITH JEEM ISOLATED FORM → LATIN SMALL LETTER O + ARABIC LETTER JEEM
+	0xFC52:  "oم",                 // ARABIC LIGATURE HEH WITH MEEM ISOLATED FORM → LATIN SMALL LETTER O + ARABIC LETTER MEEM
+	0xFC53:  "oى",                 // ARABIC LIGATURE HEH WITH ALEF MAKSURA ISOLATED FORM → LATIN SMALL LETTER O + ARABIC LETTER ALEF MAKSURA
+	0xFC54:  "oى",                 // ARABIC LIGATURE HEH WITH YEH ISOLATED FORM → LATIN SMALL LETTER O + ARABIC LETTER ALEF MAKSURA
+	0xFC55:  "ىج",                 // ARABIC LIGATURE YEH WITH JEEM ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER JEEM
+	0xFC56:  "ىح",                 // ARABIC LIGATURE YEH WITH HAH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HAH
+	0xFC57:  "ىخ",                 // ARABIC LIGATURE YEH WITH KHAH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER KHAH
+	0xFC58:  "ىم",                 // ARABIC LIGATURE YEH WITH MEEM ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER MEEM
+	0xFC59:  "ىى",                 // ARABIC LIGATURE YEH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER ALEF MAKSURA
+	0xFC5A:  "ىى",                 // ARABIC LIGATURE YEH WITH YEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER ALEF MAKSURA
+	0xFC5B:  "ذٰ",                 // ARABIC LIGATURE THAL WITH SUPERSCRIPT ALEF ISOLATED FORM → ARABIC LETTER THAL + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFC5C:  "رٰ",                 // ARABIC LIGATURE REH WITH SUPERSCRIPT ALEF ISOLATED FORM → ARABIC LETTER REH + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFC5D:  "ىٰ",                 // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFC5E:  "ﹲّ",                 // ARABIC LIGATURE SHADDA WITH DAMMATAN ISOLATED FORM → ARABIC DAMMATAN ISOLATED FORM + ARABIC SHADDA
+	0xFC5F:  "ﹴّ",                 // ARABIC LIGATURE SHADDA WITH KASRATAN ISOLATED FORM → ARABIC KASRATAN ISOLATED FORM + ARABIC SHADDA
+	0xFC60:  "ﹶّ",                 // ARABIC LIGATURE SHADDA WITH FATHA ISOLATED FORM → ARABIC FATHA ISOLATED FORM + ARABIC SHADDA
+	0xFC61:  "ﹸّ",                 // ARABIC LIGATURE SHADDA WITH DAMMA ISOLATED FORM → ARABIC DAMMA ISOLATED FORM + ARABIC SHADDA
+	0xFC62:  "ﹺّ",                 // ARABIC LIGATURE SHADDA WITH KASRA ISOLATED FORM → ARABIC KASRA ISOLATED FORM + ARABIC SHADDA
+	0xFC63:  "ﹼٰ",                 // ARABIC LIGATURE SHADDA WITH SUPERSCRIPT ALEF ISOLATED FORM → ARABIC SHADDA ISOLATED FORM + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFC64:  "ىٴر",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH REH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER REH
+	0xFC65:  "ىٴز",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ZAIN FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ZAIN
+	0xFC66:  "ىٴم",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER MEEM
+	0xFC67:  "ىٴن",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH NOON FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER NOON
+	0xFC68:  "ىٴى",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ALEF MAKSURA
+	0xFC69:  "ىٴى",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER ALEF MAKSURA
+	0xFC6A:  "بر",                 // ARABIC LIGATURE BEH WITH REH FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER REH
+	0xFC6B:  "بز",                 // ARABIC LIGATURE BEH WITH ZAIN FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER ZAIN
+	0xFC6C:  "بم",                 // ARABIC LIGATURE BEH WITH MEEM FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER MEEM
+	0xFC6D:  "بن",                 // ARABIC LIGATURE BEH WITH NOON FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER NOON
+	0xFC6E:  "بى",                 // ARABIC LIGATURE BEH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER ALEF MAKSURA
+	0xFC6F:  "بى",                 // ARABIC LIGATURE BEH WITH YEH FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER ALEF MAKSURA
+	0xFC70:  "تر",                 // ARABIC LIGATURE TEH WITH REH FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER REH
+	0xFC71:  "تز",                 // ARABIC LIGATURE TEH WITH ZAIN FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER ZAIN
+	0xFC72:  "تم",                 // ARABIC LIGATURE TEH WITH MEEM FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM
+	0xFC73:  "تن",                 // ARABIC LIGATURE TEH WITH NOON FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER NOON
+	0xFC74:  "تى",                 // ARABIC LIGATURE TEH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER ALEF MAKSURA
+	0xFC75:  "تى",                 // ARABIC LIGATURE TEH WITH YEH FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER ALEF MAKSURA
+	0xFC76:  "ىۛر",                // ARABIC LIGATURE THEH WITH REH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER REH
+	0xFC77:  "ىۛز",                // ARABIC LIGATURE THEH WITH ZAIN FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ZAIN
+	0xFC78:  "ىۛم",                // ARABIC LIGATURE THEH WITH MEEM FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFC79:  "ىۛن",                // ARABIC LIGATURE THEH WITH NOON FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER NOON
+	0xFC7A:  "ىۛى",                // ARABIC LIGATURE THEH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFC7B:  "ىۛى",                // ARABIC LIGATURE THEH WITH YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFC7C:  "فى",                 // ARABIC LIGATURE FEH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER FEH + ARABIC LETTER ALEF MAKSURA
+	0xFC7D:  "فى",                 // ARABIC LIGATURE FEH WITH YEH FINAL FORM → ARABIC LETTER FEH + ARABIC LETTER ALEF MAKSURA
+	0xFC7E:  "قى",                 // ARABIC LIGATURE QAF WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER QAF + ARABIC LETTER ALEF MAKSURA
+	0xFC7F:  "قى",                 // ARABIC LIGATURE QAF WITH YEH FINAL FORM → ARABIC LETTER QAF + ARABIC LETTER ALEF MAKSURA
+	0xFC80:  "كl",                 // ARABIC LIGATURE KAF WITH ALEF FINAL FORM → ARABIC LETTER KAF + LATIN SMALL LETTER L
+	0xFC81:  "كل",                 // ARABIC LIGATURE KAF WITH LAM FINAL FORM → ARABIC LETTER KAF + ARABIC LETTER LAM
+	0xFC82:  "كم",                 // ARABIC LIGATURE KAF WITH MEEM FINAL FORM → ARABIC LETTER KAF + ARABIC LETTER MEEM
+	0xFC83:  "كى",                 // ARABIC LIGATURE KAF WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER KAF + ARABIC LETTER ALEF MAKSURA
+	0xFC84:  "كى",                 // ARABIC LIGATURE KAF WITH YEH FINAL FORM → ARABIC LETTER KAF + ARABIC LETTER ALEF MAKSURA
+	0xFC85:  "لم",                 // ARABIC LIGATURE LAM WITH MEEM FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER MEEM
+	0xFC86:  "لى",                 // ARABIC LIGATURE LAM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA
+	0xFC87:  "لى",                 // ARABIC LIGATURE LAM WITH YEH FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA
+	0xFC88:  "مl",                 // ARABIC LIGATURE MEEM WITH ALEF FINAL FORM → ARABIC LETTER MEEM + LATIN SMALL LETTER L
+	0xFC89:  "مم",                 // ARABIC LIGATURE MEEM WITH MEEM FINAL FORM → ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFC8A:  "نر",                 // ARABIC LIGATURE NOON WITH REH FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER REH
+	0xFC8B:  "نز",                 // ARABIC LIGATURE NOON WITH ZAIN FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER ZAIN
+	0xFC8C:  "نم",                 // ARABIC LIGATURE NOON WITH MEEM FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER MEEM
+	0xFC8D:  "نن",                 // ARABIC LIGATURE NOON WITH NOON FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER NOON
+	0xFC8E:  "نى",                 // ARABIC LIGATURE NOON WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER ALEF MAKSURA
+	0xFC8F:  "نى",                 // ARABIC LIGATURE NOON WITH YEH FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER ALEF MAKSURA
+	0xFC90:  "ىٰ",                 // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFC91:  "ىر",                 // ARABIC LIGATURE YEH WITH REH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER REH
+	0xFC92:  "ىز",                 // ARABIC LIGATURE YEH WITH ZAIN FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER ZAIN
+	0xFC93:  "ىم",                 // ARABIC LIGATURE YEH WITH MEEM FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER MEEM
+	0xFC94:  "ىن",                 // ARABIC LIGATURE YEH WITH NOON FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER NOON
+	0xFC95:  "ىى",                 // ARABIC LIGATURE YEH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER ALEF MAKSURA
+	0xFC96:  "ىى",                 // ARABIC LIGATURE YEH WITH YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER ALEF MAKSURA
+	0xFC97:  "ىٴج",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER JEEM
+	0xFC98:  "ىٴح",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER HAH
+	0xFC99:  "ىٴخ",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH KHAH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER KHAH
+	0xFC9A:  "ىٴم",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER MEEM
+	0xFC9B:  "ىٴo",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + LATIN SMALL LETTER O
+	0xFC9C:  "بج",                 // ARABIC LIGATURE BEH WITH JEEM INITIAL FORM → ARABIC LETTER BEH + ARABIC LETTER JEEM
+	0xFC9D:  "بح",                 // ARABIC LIGATURE BEH WITH HAH INITIAL FORM → ARABIC LETTER BEH + ARABIC LETTER HAH
+	0xFC9E:  "بخ",                 // ARABIC LIGATURE BEH WITH KHAH INITIAL FORM → ARABIC LETTER BEH + ARABIC LETTER KHAH
+	0xFC9F:  "بم",                 // ARABIC LIGATURE BEH WITH MEEM INITIAL FORM → ARABIC LETTER BEH + ARABIC LETTER MEEM
+	0xFCA0:  "بo",                 // ARABIC LIGATURE BEH WITH HEH INITIAL FORM → ARABIC LETTER BEH + LATIN SMALL LETTER O
+	0xFCA1:  "تج",                 // ARABIC LIGATURE TEH WITH JEEM INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER JEEM
+	0xFCA2:  "تح",                 // ARABIC LIGATURE TEH WITH HAH INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER HAH
+	0xFCA3:  "تخ",                 // ARABIC LIGATURE TEH WITH KHAH INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER KHAH
+	0xFCA4:  "تم",                 // ARABIC LIGATURE TEH WITH MEEM INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM
+	0xFCA5:  "تo",                 // ARABIC LIGATURE TEH WITH HEH INITIAL FORM → ARABIC LETTER TEH + LATIN SMALL LETTER O
+	0xFCA6:  "ىۛم",                // ARABIC LIGATURE THEH WITH MEEM INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFCA7:  "جح",                 // ARABIC LIGATURE JEEM WITH HAH INITIAL FORM → ARABIC LETTER JEEM + ARABIC LETTER HAH
+	0xFCA8:  "جم",                 // ARABIC LIGATURE JEEM WITH MEEM INITIAL FORM → ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFCA9:  "حج",                 // ARABIC LIGATURE HAH WITH JEEM INITIAL FORM → ARABIC LETTER HAH + ARABIC LETTER JEEM
+	0xFCAA:  "حم",                 // ARABIC LIGATURE HAH WITH MEEM INITIAL FORM → ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFCAB:  "خج",                 // ARABIC LIGATURE KHAH WITH JEEM INITIAL FORM → ARABIC LETTER KHAH + ARABIC LETTER JEEM
+	0xFCAC:  "خم",                 // ARABIC LIGATURE KHAH WITH MEEM INITIAL FORM → ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFCAD:  "سج",                 // ARABIC LIGATURE SEEN WITH JEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER JEEM
+	0xFCAE:  "سح",                 // ARABIC LIGATURE SEEN WITH HAH INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER HAH
+	0xFCAF:  "سخ",                 // ARABIC LIGATURE SEEN WITH KHAH INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER KHAH
+	0xFCB0:  "سم",                 // ARABIC LIGATURE SEEN WITH MEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM
+	0xFCB1:  "صح",                 // ARABIC LIGATURE SAD WITH HAH INITIAL FORM → ARABIC LETTER SAD + ARABIC LETTER HAH
+	0xFCB2:  "صخ",                 // ARABIC LIGATURE SAD WITH KHAH INITIAL FORM → ARABIC LETTER SAD + ARABIC LETTER KHAH
+	0xFCB3:  "صم",                 // ARABIC LIGATURE SAD WITH MEEM INITIAL FORM → ARABIC LETTER SAD + ARABIC LETTER MEEM
+	0xFCB4:  "ضج",                 // ARABIC LIGATURE DAD WITH JEEM INITIAL FORM → ARABIC LETTER DAD + ARABIC LETTER JEEM
+	0xFCB5:  "ضح",                 // ARABIC LIGATURE DAD WITH HAH INITIAL FORM → ARABIC LETTER DAD + ARABIC LETTER HAH
+	0xFCB6:  "ضخ",                 // ARABIC LIGATURE DAD WITH KHAH INITIAL FORM → ARABIC LETTER DAD + ARABIC LETTER KHAH
+	0xFCB7:  "ضم",                 // ARABIC LIGATURE DAD WITH MEEM INITIAL FORM → ARABIC LETTER DAD + ARABIC LETTER MEEM
+	0xFCB8:  "طح",                 // ARABIC LIGATURE TAH WITH HAH INITIAL FORM → ARABIC LETTER TAH + ARABIC LETTER HAH
+	0xFCB9:  "ظم",                 // ARABIC LIGATURE ZAH WITH MEEM INITIAL FORM → ARABIC LETTER ZAH + ARABIC LETTER MEEM
+	0xFCBA:  "عج",                 // ARABIC LIGATURE AIN WITH JEEM INITIAL FORM → ARABIC LETTER AIN + ARABIC LETTER JEEM
+	0xFCBB:  "عم",                 // ARABIC LIGATURE AIN WITH MEEM INITIAL FORM → ARABIC LETTER AIN + ARABIC LETTER MEEM
+	0xFCBC:  "غج",                 // ARABIC LIGATURE GHAIN WITH JEEM INITIAL FORM → ARABIC LETTER GHAIN + ARABIC LETTER JEEM
+	0xFCBD:  "غم",                 // ARABIC LIGATURE GHAIN WITH MEEM INITIAL FORM → ARABIC LETTER GHAIN + ARABIC LETTER MEEM
+	0xFCBE:  "فج",                 // ARABIC LIGATURE FEH WITH JEEM INITIAL FORM → ARABIC LETTER FEH + ARABIC LETTER JEEM
+	0xFCBF:  "فح",                 // ARABIC LIGATURE FEH WITH HAH INITIAL FORM → ARABIC LETTER FEH + ARABIC LETTER HAH
+	0xFCC0:  "فخ",                 // ARABIC LIGATURE FEH WITH KHAH INITIAL FORM → ARABIC LETTER FEH + ARABIC LETTER KHAH
+	0xFCC1:  "فم",                 // ARABIC LIGATURE FEH WITH MEEM INITIAL FORM → ARABIC LETTER FEH + ARABIC LETTER MEEM
+	0xFCC2:  "قح",                 // ARABIC LIGATURE QAF WITH HAH INITIAL FORM → ARABIC LETTER QAF + ARABIC LETTER HAH
+	0xFCC3:  "قم",                 // ARABIC LIGATURE QAF WITH MEEM INITIAL FORM → ARABIC LETTER QAF + ARABIC LETTER MEEM
+	0xFCC4:  "كج",                 // ARABIC LIGATURE KAF WITH JEEM INITIAL FORM → ARABIC LETTER KAF + ARABIC LETTER JEEM
+	0xFCC5:  "كح",                 // ARABIC LIGATURE KAF WITH HAH INITIAL FORM → ARABIC LETTER KAF + ARABIC LETTER HAH
+	0xFCC6:  "كخ",                 // ARABIC LIGATURE KAF WITH KHAH INITIAL FORM → ARABIC LETTER KAF + ARABIC LETTER KHAH
+	0xFCC7:  "كل",                 // ARABIC LIGATURE KAF WITH LAM INITIAL FORM → ARABIC LETTER KAF + ARABIC LETTER LAM
+	0xFCC8:  "كم",                 // ARABIC LIGATURE KAF WITH MEEM INITIAL FORM → ARABIC LETTER KAF + ARABIC LETTER MEEM
+	0xFCC9:  "لج",                 // ARABIC LIGATURE LAM WITH JEEM INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER JEEM
+	0xFCCA:  "لح",                 // ARABIC LIGATURE LAM WITH HAH INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER HAH
+	0xFCCB:  "لخ",                 // ARABIC LIGATURE LAM WITH KHAH INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER KHAH
+	0xFCCC:  "لم",                 // ARABIC LIGATURE LAM WITH MEEM INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER MEEM
+	0xFCCD:  "لo",                 // ARABIC LIGATURE LAM WITH HEH INITIAL FORM → ARABIC LETTER LAM + LATIN SMALL LETTER O
+	0xFCCE:  "مج",                 // ARABIC LIGATURE MEEM WITH JEEM INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER JEEM
+	0xFCCF:  "مح",                 // ARABIC LIGATURE MEEM WITH HAH INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFCD0:  "مخ",                 // ARABIC LIGATURE MEEM WITH KHAH INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER KHAH
+	0xFCD1:  "مم",                 // ARABIC LIGATURE MEEM WITH MEEM INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFCD2:  "بخ",                 // ARABIC LIGATURE NOON WITH JEEM INITIAL FORM → ARABIC LETTER BEH + ARABIC LETTER KHAH
+	0xFCD3:  "نح",                 // ARABIC LIGATURE NOON WITH HAH INITIAL FORM → ARABIC LETTER NOON + ARABIC LETTER HAH
+	0xFCD4:  "نخ",                 // ARABIC LIGATURE NOON WITH KHAH INITIAL FORM → ARABIC LETTER NOON + ARABIC LETTER KHAH
+	0xFCD5:  "نم",                 // ARABIC LIGATURE NOON WITH MEEM INITIAL FORM → ARABIC LETTER NOON + ARABIC LETTER MEEM
+	0xFCD6:  "نo",                 // ARABIC LIGATURE NOON WITH HEH INITIAL FORM → ARABIC LETTER NOON + LATIN SMALL LETTER O
+	0xFCD7:  "oج",                 // ARABIC LIGATURE HEH WITH JEEM INITIAL FORM → LATIN SMALL LETTER O + ARABIC LETTER JEEM
+	0xFCD8:  "oم",                 // ARABIC LIGATURE HEH WITH MEEM INITIAL FORM → LATIN SMALL LETTER O + ARABIC LETTER MEEM
+	0xFCD9:  "oٰ",                 // ARABIC LIGATURE HEH WITH SUPERSCRIPT ALEF INITIAL FORM → LATIN SMALL LETTER O + ARABIC LETTER SUPERSCRIPT ALEF
+	0xFCDA:  "ىج",                 // ARABIC LIGATURE YEH WITH JEEM INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER JEEM
+	0xFCDB:  "ىح",                 // ARABIC LIGATURE YEH WITH HAH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HAH
+	0xFCDC:  "ىخ",                 // ARABIC LIGATURE YEH WITH KHAH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER KHAH
+	0xFCDD:  "ىم",                 // ARABIC LIGATURE YEH WITH MEEM INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER MEEM
+	0xFCDE:  "ىo",                 // ARABIC LIGATURE YEH WITH HEH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + LATIN SMALL LETTER O
+	0xFCDF:  "ىٴم",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + ARABIC LETTER MEEM
+	0xFCE0:  "ىٴo",                // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA + LATIN SMALL LETTER O
+	0xFCE1:  "بم",                 // ARABIC LIGATURE BEH WITH MEEM MEDIAL FORM → ARABIC LETTER BEH + ARABIC LETTER MEEM
+	0xFCE2:  "بo",                 // ARABIC LIGATURE BEH WITH HEH MEDIAL FORM → ARABIC LETTER BEH + LATIN SMALL LETTER O
+	0xFCE3:  "تم",                 // ARABIC LIGATURE TEH WITH MEEM MEDIAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM
+	0xFCE4:  "تo",                 // ARABIC LIGATURE TEH WITH HEH MEDIAL FORM → ARABIC LETTER TEH + LATIN SMALL LETTER O
+	0xFCE5:  "ىۛم",                // ARABIC LIGATURE THEH WITH MEEM MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFCE6:  "ىۛo",                // ARABIC LIGATURE THEH WITH HEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS + LATIN SMALL LETTER O
+	0xFCE7:  "سم",                 // ARABIC LIGATURE SEEN WITH MEEM MEDIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM
+	0xFCE8:  "سo",                 // ARABIC LIGATURE SEEN WITH HEH MEDIAL FORM → ARABIC LETTER SEEN + LATIN SMALL LETTER O
+	0xFCE9:  "سۛم",                // ARABIC LIGATURE SHEEN WITH MEEM MEDIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFCEA:  "سۛo",                // ARABIC LIGATURE SHEEN WITH HEH MEDIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + LATIN SMALL LETTER O
+	0xFCEB:  "كل",                 // ARABIC LIGATURE KAF WITH LAM MEDIAL FORM → ARABIC LETTER KAF + ARABIC LETTER LAM
+	0xFCEC:  "كم",                 // ARABIC LIGATURE KAF WITH MEEM MEDIAL FORM → ARABIC LETTER KAF + ARABIC LETTER MEEM
+	0xFCED:  "لم",                 // ARABIC LIGATURE LAM WITH MEEM MEDIAL FORM → ARABIC LETTER LAM + ARABIC LETTER MEEM
+	0xFCEE:  "نم",                 // ARABIC LIGATURE NOON WITH MEEM MEDIAL FORM → ARABIC LETTER NOON + ARABIC LETTER MEEM
+	0xFCEF:  "نo",                 // ARABIC LIGATURE NOON WITH HEH MEDIAL FORM → ARABIC LETTER NOON + LATIN SMALL LETTER O
+	0xFCF0:  "ىم",                 // ARABIC LIGATURE YEH WITH MEEM MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER MEEM
+	0xFCF1:  "ىo",                 // ARABIC LIGATURE YEH WITH HEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + LATIN SMALL LETTER O
+	0xFCF2:  "ﹷّ",                 // ARABIC LIGATURE SHADDA WITH FATHA MEDIAL FORM → ARABIC FATHA MEDIAL FORM + ARABIC SHADDA
+	0xFCF3:  "ﹹّ",                 // ARABIC LIGATURE SHADDA WITH DAMMA MEDIAL FORM → ARABIC DAMMA MEDIAL FORM + ARABIC SHADDA
+	0xFCF4:  "ﹻّ",                 // ARABIC LIGATURE SHADDA WITH KASRA MEDIAL FORM → ARABIC KASRA MEDIAL FORM + ARABIC SHADDA
+	0xFCF5:  "طى",                 // ARABIC LIGATURE TAH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER TAH + ARABIC LETTER ALEF MAKSURA
+	0xFCF6:  "طى",                 // ARABIC LIGATURE TAH WITH YEH ISOLATED FORM → ARABIC LETTER TAH + ARABIC LETTER ALEF MAKSURA
+	0xFCF7:  "عى",                 // ARABIC LIGATURE AIN WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER AIN + ARABIC LETTER ALEF MAKSURA
+	0xFCF8:  "عى",                 // ARABIC LIGATURE AIN WITH YEH ISOLATED FORM → ARABIC LETTER AIN + ARABIC LETTER ALEF MAKSURA
+	0xFCF9:  "غى",                 // ARABIC LIGATURE GHAIN WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER GHAIN + ARABIC LETTER ALEF MAKSURA
+	0xFCFA:  "غى",                 // ARABIC LIGATURE GHAIN WITH YEH ISOLATED FORM → ARABIC LETTER GHAIN + ARABIC LETTER ALEF MAKSURA
+	0xFCFB:  "سى",                 // ARABIC LIGATURE SEEN WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER SEEN + ARABIC LETTER ALEF MAKSURA
+	0xFCFC:  "سى",                 // ARABIC LIGATURE SEEN WITH YEH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC LETTER ALEF MAKSURA
+	0xFCFD:  "سۛى",                // ARABIC LIGATURE SHEEN WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFCFE:  "سۛى",                // ARABIC LIGATURE SHEEN WITH YEH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFCFF:  "حى",                 // ARABIC LIGATURE HAH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD00:  "حى",                 // ARABIC LIGATURE HAH WITH YEH ISOLATED FORM → ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD01:  "جى",                 // ARABIC LIGATURE JEEM WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD02:  "جى",                 // ARABIC LIGATURE JEEM WITH YEH ISOLATED FORM → ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD03:  "خى",                 // ARABIC LIGATURE KHAH WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFD04:  "خى",                 // ARABIC LIGATURE KHAH WITH YEH ISOLATED FORM → ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFD05:  "صى",                 // ARABIC LIGATURE SAD WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER ALEF MAKSURA
+	0xFD06:  "صى",                 // ARABIC LIGATURE SAD WITH YEH ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER ALEF MAKSURA
+	0xFD07:  "ضى",                 // ARABIC LIGATURE DAD WITH ALEF MAKSURA ISOLATED FORM → ARABIC LETTER DAD + ARABIC LETTER ALEF MAKSURA
+	0xFD08:  "ضى",                 // ARABIC LIGATURE DAD WITH YEH ISOLATED FORM → ARABIC LETTER DAD + ARABIC LETTER ALEF MAKSURA
+	0xFD09:  "سۛج",                // ARABIC LIGATURE SHEEN WITH JEEM ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER JEEM
+	0xFD0A:  "سۛح",                // ARABIC LIGATURE SHEEN WITH HAH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER HAH
+	0xFD0B:  "سۛخ",                // ARABIC LIGATURE SHEEN WITH KHAH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER KHAH
+	0xFD0C:  "سۛم",                // ARABIC LIGATURE SHEEN WITH MEEM ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFD0D:  "سۛر",                // ARABIC LIGATURE SHEEN WITH REH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER REH
+	0xFD0E:  "سر",                 // ARABIC LIGATURE SEEN WITH REH ISOLATED FORM → ARABIC LETTER SEEN + ARABIC LETTER REH
+	0xFD0F:  "صر",                 // ARABIC LIGATURE SAD WITH REH ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER REH
+	0xFD10:  "ضر",                 // ARABIC LIGATURE DAD WITH REH ISOLATED FORM → ARABIC LETTER DAD + ARABIC LETTER REH
+	0xFD11:  "طى",                 // ARABIC LIGATURE TAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER TAH + ARABIC LETTER ALEF MAKSURA
+	0xFD12:  "طى",                 // ARABIC LIGATURE TAH WITH YEH FINAL FORM → ARABIC LETTER TAH + ARABIC LETTER ALEF MAKSURA
+	0xFD13:  "عى",                 // ARABIC LIGATURE AIN WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER AIN + ARABIC LETTER ALEF MAKSURA
+	0xFD14:  "عى",                 // ARABIC LIGATURE AIN WITH YEH FINAL FORM → ARABIC LETTER AIN + ARABIC LETTER ALEF MAKSURA
+	0xFD15:  "غى",                 // ARABIC LIGATURE GHAIN WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER GHAIN + ARABIC LETTER ALEF MAKSURA
+	0xFD16:  "غى",                 // ARABIC LIGATURE GHAIN WITH YEH FINAL FORM → ARABIC LETTER GHAIN + ARABIC LETTER ALEF MAKSURA
+	0xFD17:  "سى",                 // ARABIC LIGATURE SEEN WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER ALEF MAKSURA
+	0xFD18:  "سى",                 // ARABIC LIGATURE SEEN WITH YEH FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER ALEF MAKSURA
+	0xFD19:  "سۛى",                // ARABIC LIGATURE SHEEN WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFD1A:  "سۛى",                // ARABIC LIGATURE SHEEN WITH YEH FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER ALEF MAKSURA
+	0xFD1B:  "حى",                 // ARABIC LIGATURE HAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD1C:  "حى",                 // ARABIC LIGATURE HAH WITH YEH FINAL FORM → ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD1D:  "جى",                 // ARABIC LIGATURE JEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD1E:  "جى",                 // ARABIC LIGATURE JEEM WITH YEH FINAL FORM → ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD1F:  "خى",                 // ARABIC LIGATURE KHAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFD20:  "خى",                 // ARABIC LIGATURE KHAH WITH YEH FINAL FORM → ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFD21:  "صى",                 // ARABIC LIGATURE SAD WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER SAD + ARABIC LETTER ALEF MAKSURA
+	0xFD22:  "صى",                 // ARABIC LIGATURE SAD WITH YEH FINAL FORM → ARABIC LETTER SAD + ARABIC LETTER ALEF MAKSURA
+	0xFD23:  "ضى",                 // ARABIC LIGATURE DAD WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER DAD + ARABIC LETTER ALEF MAKSURA
+	0xFD24:  "ضى",                 // ARABIC LIGATURE DAD WITH YEH FINAL FORM → ARABIC LETTER DAD + ARABIC LETTER ALEF MAKSURA
+	0xFD25:  "سۛج",                // ARABIC LIGATURE SHEEN WITH JEEM FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER JEEM
+	0xFD26:  "سۛح",                // ARABIC LIGATURE SHEEN WITH HAH FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER HAH
+	0xFD27:  "سۛخ",                // ARABIC LIGATURE SHEEN WITH KHAH FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER KHAH
+	0xFD28:  "سۛم",                // ARABIC LIGATURE SHEEN WITH MEEM FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFD29:  "سۛر",                // ARABIC LIGATURE SHEEN WITH REH FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER REH
+	0xFD2A:  "سر",                 // ARABIC LIGATURE SEEN WITH REH FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER REH
+	0xFD2B:  "صر",                 // ARABIC LIGATURE SAD WITH REH FINAL FORM → ARABIC LETTER SAD + ARABIC LETTER REH
+	0xFD2C:  "ضر",                 // ARABIC LIGATURE DAD WITH REH FINAL FORM → ARABIC LETTER DAD + ARABIC LETTER REH
+	0xFD2D:  "سۛج",                // ARABIC LIGATURE SHEEN WITH JEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER JEEM
+	0xFD2E:  "سۛح",                // ARABIC LIGATURE SHEEN WITH HAH INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER HAH
+	0xFD2F:  "سۛخ",                // ARABIC LIGATURE SHEEN WITH KHAH INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER KHAH
+	0xFD30:  "سۛم",                // ARABIC LIGATURE SHEEN WITH MEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM
+	0xFD31:  "سo",                 // ARABIC LIGATURE SEEN WITH HEH INITIAL FORM → ARABIC LETTER SEEN + LATIN SMALL LETTER O
+	0xFD32:  "سۛo",                // ARABIC LIGATURE SHEEN WITH HEH INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + LATIN SMALL LETTER O
+	0xFD33:  "طم",                 // ARABIC LIGATURE TAH WITH MEEM INITIAL FORM → ARABIC LETTER TAH + ARABIC LETTER MEEM
+	0xFD34:  "سج",                 // ARABIC LIGATURE SEEN WITH JEEM MEDIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER JEEM
+	0xFD35:  "سح",                 // ARABIC LIGATURE SEEN WITH HAH MEDIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER HAH
+	0xFD36:  "سخ",                 // ARABIC LIGATURE SEEN WITH KHAH MEDIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER KHAH
+	0xFD37:  "سۛج",                // ARABIC LIGATURE SHEEN WITH JEEM MEDIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER JEEM
+	0xFD38:  "سۛح",                // ARABIC LIGATURE SHEEN WITH HAH MEDIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER HAH
+	0xFD39:  "سۛخ",                // ARABIC LIGATURE SHEEN WITH KHAH MEDIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER KHAH
+	0xFD3A:  "طم",                 // ARABIC LIGATURE TAH WITH MEEM MEDIAL FORM → ARABIC LETTER TAH + ARABIC LETTER MEEM
+	0xFD3B:  "ظم",                 // ARABIC LIGATURE ZAH WITH MEEM MEDIAL FORM → ARABIC LETTER ZAH + ARABIC LETTER MEEM
+	0xFD3C:  "l̋",                 // ARABIC LIGATURE ALEF WITH FATHATAN FINAL FORM → LATIN SMALL LETTER L + COMBINING DOUBLE ACUTE ACCENT
+	0xFD3D:  "l̋",                 // ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM → LATIN SMALL LETTER L + COMBINING DOUBLE ACUTE ACCENT
+	0xFD3E:  "(",                  // ORNATE LEFT PARENTHESIS → LEFT PARENTHESIS
+	0xFD3F:  ")",                  // ) ORNATE RIGHT PARENTHESIS → RIGHT PARENTHESIS
+	0xFD50:  "تجم",                // ARABIC LIGATURE TEH WITH JEEM WITH MEEM INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFD51:  "تحج",                // ARABIC LIGATURE TEH WITH HAH WITH JEEM FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER HAH + ARABIC LETTER JEEM
+	0xFD52:  "تحج",                // ARABIC LIGATURE TEH WITH HAH WITH JEEM INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER HAH + ARABIC LETTER JEEM
+	0xFD53:  "تحم",                // ARABIC LIGATURE TEH WITH HAH WITH MEEM INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFD54:  "تخم",                // ARABIC LIGATURE TEH WITH KHAH WITH MEEM INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD55:  "تمج",                // ARABIC LIGATURE TEH WITH MEEM WITH JEEM INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM + ARABIC LETTER JEEM
+	0xFD56:  "تمح",                // ARABIC LIGATURE TEH WITH MEEM WITH HAH INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD57:  "تمخ",                // ARABIC LIGATURE TEH WITH MEEM WITH KHAH INITIAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM + ARABIC LETTER KHAH
+	0xFD58:  "جمح",                // ARABIC LIGATURE JEEM WITH MEEM WITH HAH FINAL FORM → ARABIC LETTER JEEM + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD59:  "جمح",                // ARABIC LIGATURE JEEM WITH MEEM WITH HAH INITIAL FORM → ARABIC LETTER JEEM + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD5A:  "حمى",                // ARABIC LIGATURE HAH WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER HAH + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD5B:  "حمى",                // ARABIC LIGATURE HAH WITH MEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER HAH + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD5C:  "سحج",                // ARABIC LIGATURE SEEN WITH HAH WITH JEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER HAH + ARABIC LETTER JEEM
+	0xFD5D:  "سجح",                // ARABIC LIGATURE SEEN WITH JEEM WITH HAH INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER JEEM + ARABIC LETTER HAH
+	0xFD5E:  "سجى",                // ARABIC LIGATURE SEEN WITH JEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD5F:  "سمح",                // ARABIC LIGATURE SEEN WITH MEEM WITH HAH FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD60:  "سمح",                // ARABIC LIGATURE SEEN WITH MEEM WITH HAH INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD61:  "سمج",                // ARABIC LIGATURE SEEN WITH MEEM WITH JEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM + ARABIC LETTER JEEM
+	0xFD62:  "سمم",                // ARABIC LIGATURE SEEN WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD63:  "سمم",                // ARABIC LIGATURE SEEN WITH MEEM WITH MEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD64:  "صحح",                // ARABIC LIGATURE SAD WITH HAH WITH HAH FINAL FORM → ARABIC LETTER SAD + ARABIC LETTER HAH + ARABIC LETTER HAH
+	0xFD65:  "صحح",                // ARABIC LIGATURE SAD WITH HAH WITH HAH INITIAL FORM → ARABIC LETTER SAD + ARABIC LETTER HAH + ARABIC LETTER HAH
+	0xFD66:  "صمم",                // ARABIC LIGATURE SAD WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER SAD + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD67:  "سۛحم",               // ARABIC LIGATURE SHEEN WITH HAH WITH MEEM FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFD68:  "سۛحم",               // ARABIC LIGATURE SHEEN WITH HAH WITH MEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFD69:  "سۛجى",               // ARABIC LIGATURE SHEEN WITH JEEM WITH YEH FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD6A:  "سۛمخ",               // ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM + ARABIC LETTER KHAH
+	0xFD6B:  "سۛمخ",               // ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM + ARABIC LETTER KHAH
+	0xFD6C:  "سۛمم",               // ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD6D:  "سۛمم",               // ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD6E:  "ضحى",                // ARABIC LIGATURE DAD WITH HAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER DAD + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD6F:  "ضخم",                // ARABIC LIGATURE DAD WITH KHAH WITH MEEM FINAL FORM → ARABIC LETTER DAD + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD70:  "ضخم",                // ARABIC LIGATURE DAD WITH KHAH WITH MEEM INITIAL FORM → ARABIC LETTER DAD + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD71:  "طمح",                // ARABIC LIGATURE TAH WITH MEEM WITH HAH FINAL FORM → ARABIC LETTER TAH + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD72:  "طمح",                // ARABIC LIGATURE TAH WITH MEEM WITH HAH INITIAL FORM → ARABIC LETTER TAH + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD73:  "طمم",                // ARABIC LIGATURE TAH WITH MEEM WITH MEEM INITIAL FORM → ARABIC LETTER TAH + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD74:  "طمى",                // ARABIC LIGATURE TAH WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER TAH + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD75:  "عجم",                // ARABIC LIGATURE AIN WITH JEEM WITH MEEM FINAL FORM → ARABIC LETTER AIN + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFD76:  "عمم",                // ARABIC LIGATURE AIN WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER AIN + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD77:  "عمم",                // ARABIC LIGATURE AIN WITH MEEM WITH MEEM INITIAL FORM → ARABIC LETTER AIN + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD78:  "عمى",                // ARABIC LIGATURE AIN WITH MEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER AIN + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD79:  "غمم",                // ARABIC LIGATURE GHAIN WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER GHAIN + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD7A:  "غمى",                // ARABIC LIGATURE GHAIN WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER GHAIN + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD7B:  "غمى",                // ARABIC LIGATURE GHAIN WITH MEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER GHAIN + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD7C:  "فخم",                // ARABIC LIGATURE FEH WITH KHAH WITH MEEM FINAL FORM → ARABIC LETTER FEH + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD7D:  "فخم",                // ARABIC LIGATURE FEH WITH KHAH WITH MEEM INITIAL FORM → ARABIC LETTER FEH + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD7E:  "قمح",                // ARABIC LIGATURE QAF WITH MEEM WITH HAH FINAL FORM → ARABIC LETTER QAF + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD7F:  "قمم",                // ARABIC LIGATURE QAF WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER QAF + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD80:  "لحم",                // ARABIC LIGATURE LAM WITH HAH WITH MEEM FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFD81:  "لحى",                // ARABIC LIGATURE LAM WITH HAH WITH YEH FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD82:  "لحى",                // ARABIC LIGATURE LAM WITH HAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD83:  "لجج",                // ARABIC LIGATURE LAM WITH JEEM WITH JEEM INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER JEEM + ARABIC LETTER JEEM
+	0xFD84:  "لجج",                // ARABIC LIGATURE LAM WITH JEEM WITH JEEM FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER JEEM + ARABIC LETTER JEEM
+	0xFD85:  "لخم",                // ARABIC LIGATURE LAM WITH KHAH WITH MEEM FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD86:  "لخم",                // ARABIC LIGATURE LAM WITH KHAH WITH MEEM INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD87:  "لمح",                // ARABIC LIGATURE LAM WITH MEEM WITH HAH FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD88:  "لمح",                // ARABIC LIGATURE LAM WITH MEEM WITH HAH INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFD89:  "محج",                // ARABIC LIGATURE MEEM WITH HAH WITH JEEM INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER HAH + ARABIC LETTER JEEM
+	0xFD8A:  "محم",                // ARABIC LIGATURE MEEM WITH HAH WITH MEEM INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFD8B:  "محى",                // ARABIC LIGATURE MEEM WITH HAH WITH YEH FINAL FORM → ARABIC LETTER MEEM + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD8C:  "مجح",                // ARABIC LIGATURE MEEM WITH JEEM WITH HAH INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER JEEM + ARABIC LETTER HAH
+	0xFD8D:  "مجم",                // ARABIC LIGATURE MEEM WITH JEEM WITH MEEM INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFD8E:  "مخج",                // ARABIC LIGATURE MEEM WITH KHAH WITH JEEM INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER KHAH + ARABIC LETTER JEEM
+	0xFD8F:  "مخم",                // ARABIC LIGATURE MEEM WITH KHAH WITH MEEM INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER KHAH + ARABIC LETTER MEEM
+	0xFD92:  "مجخ",                // ARABIC LIGATURE MEEM WITH JEEM WITH KHAH INITIAL FORM → ARABIC LETTER MEEM + ARABIC LETTER JEEM + ARABIC LETTER KHAH
+	0xFD93:  "oمج",                // ARABIC LIGATURE HEH WITH MEEM WITH JEEM INITIAL FORM → LATIN SMALL LETTER O + ARABIC LETTER MEEM + ARABIC LETTER JEEM
+	0xFD94:  "oمم",                // ARABIC LIGATURE HEH WITH MEEM WITH MEEM INITIAL FORM → LATIN SMALL LETTER O + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD95:  "نحم",                // ARABIC LIGATURE NOON WITH HAH WITH MEEM INITIAL FORM → ARABIC LETTER NOON + ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFD96:  "نحى",                // ARABIC LIGATURE NOON WITH HAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFD97:  "نجم",                // ARABIC LIGATURE NOON WITH JEEM WITH MEEM FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFD98:  "نجم",                // ARABIC LIGATURE NOON WITH JEEM WITH MEEM INITIAL FORM → ARABIC LETTER NOON + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFD99:  "نجى",                // ARABIC LIGATURE NOON WITH JEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD9A:  "نمى",                // ARABIC LIGATURE NOON WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD9B:  "نمى",                // ARABIC LIGATURE NOON WITH MEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFD9C:  "ىمم",                // ARABIC LIGATURE YEH WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD9D:  "ىمم",                // ARABIC LIGATURE YEH WITH MEEM WITH MEEM INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFD9E:  "بخى",                // ARABIC LIGATURE BEH WITH KHAH WITH YEH FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFD9F:  "تجى",                // ARABIC LIGATURE TEH WITH JEEM WITH YEH FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDA0:  "تجى",                // ARABIC LIGATURE TEH WITH JEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDA1:  "تخى",                // ARABIC LIGATURE TEH WITH KHAH WITH YEH FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFDA2:  "تخى",                // ARABIC LIGATURE TEH WITH KHAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFDA3:  "تمى",                // ARABIC LIGATURE TEH WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDA4:  "تمى",                // ARABIC LIGATURE TEH WITH MEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER TEH + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDA5:  "جمى",                // ARABIC LIGATURE JEEM WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER JEEM + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDA6:  "جحى",                // ARABIC LIGATURE JEEM WITH HAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER JEEM + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDA7:  "جمى",                // ARABIC LIGATURE JEEM WITH MEEM WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER JEEM + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDA8:  "سخى",                // ARABIC LIGATURE SEEN WITH KHAH WITH ALEF MAKSURA FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFDA9:  "صحى",                // ARABIC LIGATURE SAD WITH HAH WITH YEH FINAL FORM → ARABIC LETTER SAD + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDAA:  "سۛحى",               // ARABIC LIGATURE SHEEN WITH HAH WITH YEH FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDAB:  "ضحى",                // ARABIC LIGATURE DAD WITH HAH WITH YEH FINAL FORM → ARABIC LETTER DAD + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDAC:  "لجى",                // ARABIC LIGATURE LAM WITH JEEM WITH YEH FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDAD:  "لمى",                // ARABIC LIGATURE LAM WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDAE:  "ىحى",                // ARABIC LIGATURE YEH WITH HAH WITH YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDAF:  "ىجى",                // ARABIC LIGATURE YEH WITH JEEM WITH YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDB0:  "ىمى",                // ARABIC LIGATURE YEH WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDB1:  "ممى",                // ARABIC LIGATURE MEEM WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER MEEM + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDB2:  "قمى",                // ARABIC LIGATURE QAF WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER QAF + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDB3:  "نحى",                // ARABIC LIGATURE NOON WITH HAH WITH YEH FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDB4:  "قمح",                // ARABIC LIGATURE QAF WITH MEEM WITH HAH INITIAL FORM → ARABIC LETTER QAF + ARABIC LETTER MEEM + ARABIC LETTER HAH
+	0xFDB5:  "لحم",                // ARABIC LIGATURE LAM WITH HAH WITH MEEM INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER HAH + ARABIC LETTER MEEM
+	0xFDB6:  "عمى",                // ARABIC LIGATURE AIN WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER AIN + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDB7:  "كمى",                // ARABIC LIGATURE KAF WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER KAF + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDB8:  "نجح",                // ARABIC LIGATURE NOON WITH JEEM WITH HAH INITIAL FORM → ARABIC LETTER NOON + ARABIC LETTER JEEM + ARABIC LETTER HAH
+	0xFDB9:  "مخى",                // ARABIC LIGATURE MEEM WITH KHAH WITH YEH FINAL FORM → ARABIC LETTER MEEM + ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFDBA:  "لجم",                // ARABIC LIGATURE LAM WITH JEEM WITH MEEM INITIAL FORM → ARABIC LETTER LAM + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFDBB:  "كمم",                // ARABIC LIGATURE KAF WITH MEEM WITH MEEM FINAL FORM → ARABIC LETTER KAF + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFDBC:  "لجم",                // ARABIC LIGATURE LAM WITH JEEM WITH MEEM FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFDBD:  "نجح",                // ARABIC LIGATURE NOON WITH JEEM WITH HAH FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER JEEM + ARABIC LETTER HAH
+	0xFDBE:  "جحى",                // ARABIC LIGATURE JEEM WITH HAH WITH YEH FINAL FORM → ARABIC LETTER JEEM + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDBF:  "حجى",                // ARABIC LIGATURE HAH WITH JEEM WITH YEH FINAL FORM → ARABIC LETTER HAH + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDC0:  "مجى",                // ARABIC LIGATURE MEEM WITH JEEM WITH YEH FINAL FORM → ARABIC LETTER MEEM + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDC1:  "فمى",                // ARABIC LIGATURE FEH WITH MEEM WITH YEH FINAL FORM → ARABIC LETTER FEH + ARABIC LETTER MEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDC2:  "بحى",                // ARABIC LIGATURE BEH WITH HAH WITH YEH FINAL FORM → ARABIC LETTER BEH + ARABIC LETTER HAH + ARABIC LETTER ALEF MAKSURA
+	0xFDC3:  "كمم",                // ARABIC LIGATURE KAF WITH MEEM WITH MEEM INITIAL FORM → ARABIC LETTER KAF + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFDC4:  "عجم",                // ARABIC LIGATURE AIN WITH JEEM WITH MEEM INITIAL FORM → ARABIC LETTER AIN + ARABIC LETTER JEEM + ARABIC LETTER MEEM
+	0xFDC5:  "صمم",                // ARABIC LIGATURE SAD WITH MEEM WITH MEEM INITIAL FORM → ARABIC LETTER SAD + ARABIC LETTER MEEM + ARABIC LETTER MEEM
+	0xFDC6:  "سخى",                // ARABIC LIGATURE SEEN WITH KHAH WITH YEH FINAL FORM → ARABIC LETTER SEEN + ARABIC LETTER KHAH + ARABIC LETTER ALEF MAKSURA
+	0xFDC7:  "نجى",                // ARABIC LIGATURE NOON WITH JEEM WITH YEH FINAL FORM → ARABIC LETTER NOON + ARABIC LETTER JEEM + ARABIC LETTER ALEF MAKSURA
+	0xFDF0:  "صلى",                // ARABIC LIGATURE SALLA USED AS KORANIC STOP SIGN ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA
+	0xFDF1:  "قلى",                // ARABIC LIGATURE QALA USED AS KORANIC STOP SIGN ISOLATED FORM → ARABIC LETTER QAF + ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA
+	0xFDF2:  "lللّٰo",             // ARABIC LIGATURE ALLAH ISOLATED FORM → LATIN SMALL LETTER L + ARABIC LETTER LAM + ARABIC LETTER LAM + ARABIC SHADDA + ARABIC LETTER SUPERSCRIPT ALEF + LATIN SMALL LETTER O
+	0xFDF3:  "lكبر",               // ARABIC LIGATURE AKBAR ISOLATED FORM → LATIN SMALL LETTER L + ARABIC LETTER KAF + ARABIC LETTER BEH + ARABIC LETTER REH
+	0xFDF4:  "محمد",               // ARABIC LIGATURE MOHAMMAD ISOLATED FORM → ARABIC LETTER MEEM + ARABIC LETTER HAH + ARABIC LETTER MEEM + ARABIC LETTER DAL
+	0xFDF5:  "صلعم",               // ARABIC LIGATURE SALAM ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER LAM + ARABIC LETTER AIN + ARABIC LETTER MEEM
+	0xFDF6:  "رسول",               // ARABIC LIGATURE RASOUL ISOLATED FORM → ARABIC LETTER REH + ARABIC LETTER SEEN + ARABIC LETTER WAW + ARABIC LETTER LAM
+	0xFDF7:  "علىo",               // ARABIC LIGATURE ALAYHE ISOLATED FORM → ARABIC LETTER AIN + ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA + LATIN SMALL LETTER O
+	0xFDF8:  "وسلم",               // ARABIC LIGATURE WASALLAM ISOLATED FORM → ARABIC LETTER WAW + ARABIC LETTER SEEN + ARABIC LETTER LAM + ARABIC LETTER MEEM
+	0xFDF9:  "صلى",                // ARABIC LIGATURE SALLA ISOLATED FORM → ARABIC LETTER SAD + ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA
+	0xFDFA:  "صلى lللo علىo وسلم", // ARABIC LIGATURE SALLALLAHOU ALAYHE WASALLAM → ARABIC LETTER SAD + ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA + SPACE + LATIN SMALL LETTER L + ARABIC LETTER LAM + ARABIC LETTER LAM + LATIN SMALL LETTER O + SPACE + ARABIC LETTER AIN + ARABIC LETTER LAM + ARABIC LETTER ALEF MAKSURA + LATIN SMALL LETTER O + SPACE + ARABIC LETTER WAW + ARABIC LETTER SEEN + ARABIC LETTER LAM + ARABIC LETTER MEEM
+	0xFDFB:  "جل جلlلo",           // ARABIC LIGATURE JALLAJALALOUHOU → ARABIC LETTER JEEM + ARABIC LETTER LAM + SPACE + ARABIC LETTER JEEM + ARABIC LETTER LAM + LATIN SMALL LETTER L + ARABIC LETTER LAM + LATIN SMALL LETTER O
+	0xFDFC:  "رىlل",               // RIAL SIGN → ARABIC LETTER REH + ARABIC LETTER ALEF MAKSURA + LATIN SMALL LETTER L + ARABIC LETTER LAM
+	0xFE19:  "ⵗ",                  // PRESENTATION FORM FOR VERTICAL HORIZONTAL ELLIPSIS → TIFINAGH LETTER TUAREG YAGH
+	0xFE30:  ":",                  // PRESENTATION FORM FOR VERTICAL TWO DOT LEADER → COLON
+	0xFE31:  "│",                  // PRESENTATION FORM FOR VERTICAL EM DASH → BOX DRAWINGS LIGHT VERTICAL
+	0xFE34:  "⌇",                  // PRESENTATION FORM FOR VERTICAL WAVY LOW LINE → WAVY LINE
+	0xFE35:  "⏜",                  // PRESENTATION FORM FOR VERTICAL LEFT PARENTHESIS → TOP PARENTHESIS
+	0xFE36:  "⏝",                  // PRESENTATION FORM FOR VERTICAL RIGHT PARENTHESIS → BOTTOM PARENTHESIS
+	0xFE37:  "⏞",                  // PRESENTATION FORM FOR VERTICAL LEFT CURLY BRACKET → TOP CURLY BRACKET
+	0xFE38:  "⏟",                  // PRESENTATION FORM FOR VERTICAL RIGHT CURLY BRACKET → BOTTOM CURLY BRACKET
+	0xFE39:  "⏠",                  // PRESENTATION FORM FOR VERTICAL LEFT TORTOISE SHELL BRACKET → TOP TORTOISE SHELL BRACKET
+	0xFE3A:  "⏡",                  // PRESENTATION FORM FOR VERTICAL RIGHT TORTOISE SHELL BRACKET → BOTTOM TORTOISE SHELL BRACKET
+	0xFE49:  "ˉ",                  // DASHED OVERLINE → MODIFIER LETTER MACRON
+	0xFE4A:  "ˉ",                  // CENTRELINE OVERLINE → MODIFIER LETTER MACRON
+	0xFE4B:  "ˉ",                  // WAVY OVERLINE → MODIFIER LETTER MACRON
+	0xFE4C:  "ˉ",                  // DOUBLE WAVY OVERLINE → MODIFIER LETTER MACRON
+	0xFE4D:  "_",                  // DASHED LOW LINE → LOW LINE
+	0xFE4E:  "_",                  // CENTRELINE LOW LINE → LOW LINE
+	0xFE4F:  "_",                  // WAVY LOW LINE → LOW LINE
+	0xFE58:  "-",                  // SMALL EM DASH → HYPHEN-MINUS
+	0xFE68:  "\\",                 // SMALL REVERSE SOLIDUS → REVERSE SOLIDUS
+	0xFE80:  "ء",                  // ARABIC LETTER HAMZA ISOLATED FORM → ARABIC LETTER HAMZA
+	0xFE81:  "آ",                  // ARABIC LETTER ALEF WITH MADDA ABOVE ISOLATED FORM → ARABIC LETTER ALEF WITH MADDA ABOVE
+	0xFE82:  "آ",                  // ARABIC LETTER ALEF WITH MADDA ABOVE FINAL FORM → ARABIC LETTER ALEF WITH MADDA ABOVE
+	0xFE83:  "lٴ",                 // ARABIC LETTER ALEF WITH HAMZA ABOVE ISOLATED FORM → LATIN SMALL LETTER L + ARABIC LETTER HIGH HAMZA
+	0xFE84:  "lٴ",                 // ARABIC LETTER ALEF WITH HAMZA ABOVE FINAL FORM → LATIN SMALL LETTER L + ARABIC LETTER HIGH HAMZA
+	0xFE85:  "وٴ",                 // ARABIC LETTER WAW WITH HAMZA ABOVE ISOLATED FORM → ARABIC LETTER WAW + ARABIC LETTER HIGH HAMZA
+	0xFE86:  "وٴ",                 // ARABIC LETTER WAW WITH HAMZA ABOVE FINAL FORM → ARABIC LETTER WAW + ARABIC LETTER HIGH HAMZA
+	0xFE87:  "lٕ",                 // ARABIC LETTER ALEF WITH HAMZA BELOW ISOLATED FORM → LATIN SMALL LETTER L + ARABIC HAMZA BELOW
+	0xFE88:  "lٕ",                 // ARABIC LETTER ALEF WITH HAMZA BELOW FINAL FORM → LATIN SMALL LETTER L + ARABIC HAMZA BELOW
+	0xFE89:  "ىٴ",                 // ARABIC LETTER YEH WITH HAMZA ABOVE ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA
+	0xFE8A:  "ىٴ",                 // ARABIC LETTER YEH WITH HAMZA ABOVE FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA
+	0xFE8B:  "ىٴ",                 // ARABIC LETTER YEH WITH HAMZA ABOVE INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA
+	0xFE8C:  "ىٴ",                 // ARABIC LETTER YEH WITH HAMZA ABOVE MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC LETTER HIGH HAMZA
+	0xFE8D:  "l",                  // ARABIC LETTER ALEF ISOLATED FORM → LATIN SMALL LETTER L
+	0xFE8E:  "l",                  // ARABIC LETTER ALEF FINAL FORM → LATIN SMALL LETTER L
+	0xFE8F:  "ب",                  // ARABIC LETTER BEH ISOLATED FORM → ARABIC LETTER BEH
+	0xFE90:  "ب",                  // ARABIC LETTER BEH FINAL FORM → ARABIC LETTER BEH
+	0xFE91:  "ب",                  // ARABIC LETTER BEH INITIAL FORM → ARABIC LETTER BEH
+	0xFE92:  "ب",                  // ARABIC LETTER BEH MEDIAL FORM → ARABIC LETTER BEH
+	0xFE93:  "ة",                  // ARABIC LETTER TEH MARBUTA ISOLATED FORM → ARABIC LETTER TEH MARBUTA
+	0xFE94:  "ة",                  // ARABIC LETTER TEH MARBUTA FINAL FORM → ARABIC LETTER TEH MARBUTA
+	0xFE95:  "ت",                  // ARABIC LETTER TEH ISOLATED FORM → ARABIC LETTER TEH
+	0xFE96:  "ت",                  // ARABIC LETTER TEH FINAL FORM → ARABIC LETTER TEH
+	0xFE97:  "ت",                  // ARABIC LETTER TEH INITIAL FORM → ARABIC LETTER TEH
+	0xFE98:  "ت",                  // ARABIC LETTER TEH MEDIAL FORM → ARABIC LETTER TEH
+	0xFE99:  "ىۛ",                 // ARABIC LETTER THEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFE9A:  "ىۛ",                 // ARABIC LETTER THEH FINAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFE9B:  "ىۛ",                 // ARABIC LETTER THEH INITIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFE9C:  "ىۛ",                 // ARABIC LETTER THEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0xFE9D:  "ج",                  // ARABIC LETTER JEEM ISOLATED FORM → ARABIC LETTER JEEM
+	0xFE9E:  "ج",                  // ARABIC LETTER JEEM FINAL FORM → ARABIC LETTER JEEM
+	0xFE9F:  "ج",                  // ARABIC LETTER JEEM INITIAL FORM → ARABIC LETTER JEEM
+	0xFEA0:  "ج",                  // ARABIC LETTER JEEM MEDIAL FORM → ARABIC LETTER JEEM
+	0xFEA1:  "ح",                  // ARABIC LETTER HAH ISOLATED FORM → ARABIC LETTER HAH
+	0xFEA2:  "ح",                  // ARABIC LETTER HAH FINAL FORM → ARABIC LETTER HAH
+	0xFEA3:  "ح",                  // ARABIC LETTER HAH INITIAL FORM → ARABIC LETTER HAH
+	0xFEA4:  "ح",                  // ARABIC LETTER HAH MEDIAL FORM → ARABIC LETTER HAH
+	0xFEA5:  "خ",                  // ARABIC LETTER KHAH ISOLATED FORM → ARABIC LETTER KHAH
+	0xFEA6:  "خ",                  // ARABIC LETTER KHAH FINAL FORM → ARABIC LETTER KHAH
+	0xFEA7:  "خ",                  // ARABIC LETTER KHAH INITIAL FORM → ARABIC LETTER KHAH
+	0xFEA8:  "خ",                  // ARABIC LETTER KHAH MEDIAL FORM → ARABIC LETTER KHAH
+	0xFEA9:  "د",                  // ARABIC LETTER DAL ISOLATED FORM → ARABIC LETTER DAL
+	0xFEAA:  "د",                  // ARABIC LETTER DAL FINAL FORM → ARABIC LETTER DAL
+	0xFEAB:  "ذ",                  // ARABIC LETTER THAL ISOLATED FORM → ARABIC LETTER THAL
+	0xFEAC:  "ذ",                  // ARABIC LETTER THAL FINAL FORM → ARABIC LETTER THAL
+	0xFEAD:  "ر",                  // ARABIC LETTER REH ISOLATED FORM → ARABIC LETTER REH
+	0xFEAE:  "ر",                  // ARABIC LETTER REH FINAL FORM → ARABIC LETTER REH
+	0xFEAF:  "ز",                  // ARABIC LETTER ZAIN ISOLATED FORM → ARABIC LETTER ZAIN
+	0xFEB0:  "ز",                  // ARABIC LETTER ZAIN FINAL FORM → ARABIC LETTER ZAIN
+	0xFEB1:  "س",                  // ARABIC LETTER SEEN ISOLATED FORM → ARABIC LETTER SEEN
+	0xFEB2:  "س",                  // ARABIC LETTER SEEN FINAL FORM → ARABIC LETTER SEEN
+	0xFEB3:  "س",                  // ARABIC LETTER SEEN INITIAL FORM → ARABIC LETTER SEEN
+	0xFEB4:  "س",                  // ARABIC LETTER SEEN MEDIAL FORM → ARABIC LETTER SEEN
+	0xFEB5:  "سۛ",                 // ARABIC LETTER SHEEN ISOLATED FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0xFEB6:  "سۛ",                 // ARABIC LETTER SHEEN FINAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0xFEB7:  "سۛ",                 // ARABIC LETTER SHEEN INITIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0xFEB8:  "سۛ",                 // ARABIC LETTER SHEEN MEDIAL FORM → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0xFEB9:  "ص",                  // ARABIC LETTER SAD ISOLATED FORM → ARABIC LETTER SAD
+	0xFEBA:  "ص",                  // ARABIC LETTER SAD FINAL FORM → ARABIC LETTER SAD
+	0xFEBB:  "ص",                  // ARABIC LETTER SAD INITIAL FORM → ARABIC LETTER SAD
+	0xFEBC:  "ص",                  // ARABIC LETTER SAD MEDIAL FORM → ARABIC LETTER SAD
+	0xFEBD:  "ض",                  // ARABIC LETTER DAD ISOLATED FORM → ARABIC LETTER DAD
+	0xFEBE:  "ض",                  // ARABIC LETTER DAD FINAL FORM → ARABIC LETTER DAD
+	0xFEBF:  "ض",                  // ARABIC LETTER DAD INITIAL FORM → ARABIC LETTER DAD
+	0xFEC0:  "ض",                  // ARABIC LETTER DAD MEDIAL FORM → ARABIC LETTER DAD
+	0xFEC1:  "ط",                  // ARABIC LETTER TAH ISOLATED FORM → ARABIC LETTER TAH
+	0xFEC2:  "ط",                  // ARABIC LETTER TAH FINAL FORM → ARABIC LETTER TAH
+	0xFEC3:  "ط",                  // ARABIC LETTER TAH INITIAL FORM → ARABIC LETTER TAH
+	0xFEC4:  "ط",                  // ARABIC LETTER TAH MEDIAL FORM → ARABIC LETTER TAH
+	0xFEC5:  "ظ",                  // ARABIC LETTER ZAH ISOLATED FORM → ARABIC LETTER ZAH
+	0xFEC6:  "ظ",                  // ARABIC LETTER ZAH FINAL FORM → ARABIC LETTER ZAH
+	0xFEC7:  "ظ",                  // ARABIC LETTER ZAH INITIAL FORM → ARABIC LETTER ZAH
+	0xFEC8:  "ظ",                  // ARABIC LETTER ZAH MEDIAL FORM → ARABIC LETTER ZAH
+	0xFEC9:  "ع",                  // ARABIC LETTER AIN ISOLATED FORM → ARABIC LETTER AIN
+	0xFECA:  "ع",                  // ARABIC LETTER AIN FINAL FORM → ARABIC LETTER AIN
+	0xFECB:  "ع",                  // ARABIC LETTER AIN INITIAL FORM → ARABIC LETTER AIN
+	0xFECC:  "ع",                  // ARABIC LETTER AIN MEDIAL FORM → ARABIC LETTER AIN
+	0xFECD:  "غ",                  // ARABIC LETTER GHAIN ISOLATED FORM → ARABIC LETTER GHAIN
+	0xFECE:  "غ",                  // ARABIC LETTER GHAIN FINAL FORM → ARABIC LETTER GHAIN
+	0xFECF:  "غ",                  // ARABIC LETTER GHAIN INITIAL FORM → ARABIC LETTER GHAIN
+	0xFED0:  "غ",                  // ARABIC LETTER GHAIN MEDIAL FORM → ARABIC LETTER GHAIN
+	0xFED1:  "ف",                  // ARABIC LETTER FEH ISOLATED FORM → ARABIC LETTER FEH
+	0xFED2:  "ف",                  // ARABIC LETTER FEH FINAL FORM → ARABIC LETTER FEH
+	0xFED3:  "ف",                  // ARABIC LETTER FEH INITIAL FORM → ARABIC LETTER FEH
+	0xFED4:  "ف",                  // ARABIC LETTER FEH MEDIAL FORM → ARABIC LETTER FEH
+	0xFED5:  "ق",                  // ARABIC LETTER QAF ISOLATED FORM → ARABIC LETTER QAF
+	0xFED6:  "ق",                  // ARABIC LETTER QAF FINAL FORM → ARABIC LETTER QAF
+	0xFED7:  "ق",                  // ARABIC LETTER QAF INITIAL FORM → ARABIC LETTER QAF
+	0xFED8:  "ق",                  // ARABIC LETTER QAF MEDIAL FORM → ARABIC LETTER QAF
+	0xFED9:  "ك",                  // ARABIC LETTER KAF ISOLATED FORM → ARABIC LETTER KAF
+	0xFEDA:  "ك",                  // ARABIC LETTER KAF FINAL FORM → ARABIC LETTER KAF
+	0xFEDB:  "ك",                  // ARABIC LETTER KAF INITIAL FORM → ARABIC LETTER KAF
+	0xFEDC:  "ك",                  // ARABIC LETTER KAF MEDIAL FORM → ARABIC LETTER KAF
+	0xFEDD:  "ل",                  // ARABIC LETTER LAM ISOLATED FORM → ARABIC LETTER LAM
+	0xFEDE:  "ل",                  // ARABIC LETTER LAM FINAL FORM → ARABIC LETTER LAM
+	0xFEDF:  "ل",                  // ARABIC LETTER LAM INITIAL FORM → ARABIC LETTER LAM
+	0xFEE0:  "ل",                  // ARABIC LETTER LAM MEDIAL FORM → ARABIC LETTER LAM
+	0xFEE1:  "م",                  // ARABIC LETTER MEEM ISOLATED FORM → ARABIC LETTER MEEM
+	0xFEE2:  "م",                  // ARABIC LETTER MEEM FINAL FORM → ARABIC LETTER MEEM
+	0xFEE3:  "م",                  // ARABIC LETTER MEEM INITIAL FORM → ARABIC LETTER MEEM
+	0xFEE4:  "م",                  // ARABIC LETTER MEEM MEDIAL FORM → ARABIC LETTER MEEM
+	0xFEE5:  "ن",                  // ARABIC LETTER NOON ISOLATED FORM → ARABIC LETTER NOON
+	0xFEE6:  "ن",                  // ARABIC LETTER NOON FINAL FORM → ARABIC LETTER NOON
+	0xFEE7:  "ن",                  // ARABIC LETTER NOON INITIAL FORM → ARABIC LETTER NOON
+	0xFEE8:  "ن",                  // ARABIC LETTER NOON MEDIAL FORM → ARABIC LETTER NOON
+	0xFEE9:  "o",                  // ARABIC LETTER HEH ISOLATED FORM → LATIN SMALL LETTER O
+	0xFEEA:  "o",                  // ARABIC LETTER HEH FINAL FORM → LATIN SMALL LETTER O
+	0xFEEB:  "o",                  // ARABIC LETTER HEH INITIAL FORM → LATIN SMALL LETTER O
+	0xFEEC:  "o",                  // ARABIC LETTER HEH MEDIAL FORM → LATIN SMALL LETTER O
+	0xFEED:  "و",                  // ARABIC LETTER WAW ISOLATED FORM → ARABIC LETTER WAW
+	0xFEEE:  "و",                  // ARABIC LETTER WAW FINAL FORM → ARABIC LETTER WAW
+	0xFEEF:  "ى",                  // ARABIC LETTER ALEF MAKSURA ISOLATED FORM → ARABIC LETTER ALEF MAKSURA
+	0xFEF0:  "ى",                  // ARABIC LETTER ALEF MAKSURA FINAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFEF1:  "ى",                  // ARABIC LETTER YEH ISOLATED FORM → ARABIC LETTER ALEF MAKSURA
+	0xFEF2:  "ى",                  // ARABIC LETTER YEH FINAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFEF3:  "ى",                  // ARABIC LETTER YEH INITIAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFEF4:  "ى",                  // ARABIC LETTER YEH MEDIAL FORM → ARABIC LETTER ALEF MAKSURA
+	0xFEF5:  "لآ",                 // ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE ISOLATED FORM → ARABIC LETTER LAM + ARABIC LETTER ALEF WITH MADDA ABOVE
+	0xFEF6:  "لآ",                 // ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE FINAL FORM → ARABIC LETTER LAM + ARABIC LETTER ALEF WITH MADDA ABOVE
+	0xFEF7:  "لlٴ",                // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE ISOLATED FORM → ARABIC LETTER LAM + LATIN SMALL LETTER L + ARABIC LETTER HIGH HAMZA
+	0xFEF8:  "لlٴ",                // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE FINAL FORM → ARABIC LETTER LAM + LATIN SMALL LETTER L + ARABIC LETTER HIGH HAMZA
+	0xFEF9:  "لlٕ",                // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW ISOLATED FORM → ARABIC LETTER LAM + LATIN SMALL LETTER L + ARABIC HAMZA BELOW
+	0xFEFA:  "لlٕ",                // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW FINAL FORM → ARABIC LETTER LAM + LATIN SMALL LETTER L + ARABIC HAMZA BELOW
+	0xFEFB:  "لl",                 // ARABIC LIGATURE LAM WITH ALEF ISOLATED FORM → ARABIC LETTER LAM + LATIN SMALL LETTER L
+	0xFEFC:  "لl",                 // ARABIC LIGATURE LAM WITH ALEF FINAL FORM → ARABIC LETTER LAM + LATIN SMALL LETTER L
+	0xFF01:  "!",                  // FULLWIDTH EXCLAMATION MARK → EXCLAMATION MARK
+	0xFF02:  "''",                 // FULLWIDTH QUOTATION MARK → APOSTROPHE + APOSTROPHE
+	0xFF07:  "'",                  // FULLWIDTH APOSTROPHE → APOSTROPHE
+	0xFF0D:  "ー",                  // FULLWIDTH HYPHEN-MINUS → KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0xFF1A:  ":",                  // FULLWIDTH COLON → COLON
+	0xFF21:  "A",                  // FULLWIDTH LATIN CAPITAL LETTER A → LATIN CAPITAL LETTER A
+	0xFF22:  "B",                  // FULLWIDTH LATIN CAPITAL LETTER B → LATIN CAPITAL LETTER B
+	0xFF23:  "C",                  // FULLWIDTH LATIN CAPITAL LETTER C → LATIN CAPITAL LETTER C
+	0xFF25:  "E",                  // FULLWIDTH LATIN CAPITAL LETTER E → LATIN CAPITAL LETTER E
+	0xFF28:  "H",                  // FULLWIDTH LATIN CAPITAL LETTER H → LATIN CAPITAL LETTER H
+	0xFF29:  "l",                  // FULLWIDTH LATIN CAPITAL LETTER I → LATIN SMALL LETTER L
+	0xFF2A:  "J",                  // FULLWIDTH LATIN CAPITAL LETTER J → LATIN CAPITAL LETTER J
+	0xFF2B:  "K",                  // FULLWIDTH LATIN CAPITAL LETTER K → LATIN CAPITAL LETTER K
+	0xFF2D:  "M",                  // FULLWIDTH LATIN CAPITAL LETTER M → LATIN CAPITAL LETTER M
+	0xFF2E:  "N",                  // FULLWIDTH LATIN CAPITAL LETTER N → LATIN CAPITAL LETTER N
+	0xFF2F:  "O",                  // FULLWIDTH LATIN CAPITAL LETTER O → LATIN CAPITAL LETTER O
+	0xFF30:  "P",                  // FULLWIDTH LATIN CAPITAL LETTER P → LATIN CAPITAL LETTER P
+	0xFF33:  "S",                  // FULLWIDTH LATIN CAPITAL LETTER S → LATIN CAPITAL LETTER S
+	0xFF34:  "T",                  // FULLWIDTH LATIN CAPITAL LETTER T → LATIN CAPITAL LETTER T
+	0xFF38:  "X",                  // FULLWIDTH LATIN CAPITAL LETTER X → LATIN CAPITAL LETTER X
+	0xFF39:  "Y",                  // FULLWIDTH LATIN CAPITAL LETTER Y → LATIN CAPITAL LETTER Y
+	0xFF3A:  "Z",                  // FULLWIDTH LATIN CAPITAL LETTER Z → LATIN CAPITAL LETTER Z
+	0xFF3B:  "(",                  // FULLWIDTH LEFT SQUARE BRACKET → LEFT PARENTHESIS
+	0xFF3C:  "\\",                 // FULLWIDTH REVERSE SOLIDUS → REVERSE SOLIDUS
+	0xFF3D:  ")",                  // ) FULLWIDTH RIGHT SQUARE BRACKET → RIGHT PARENTHESIS
+	0xFF3E:  "︿",                  // FULLWIDTH CIRCUMFLEX ACCENT → PRESENTATION FORM FOR VERTICAL LEFT ANGLE BRACKET
+	0xFF40:  "'",                  // FULLWIDTH GRAVE ACCENT → APOSTROPHE
+	0xFF41:  "a",                  // FULLWIDTH LATIN SMALL LETTER A → LATIN SMALL LETTER A
+	0xFF43:  "c",                  // FULLWIDTH LATIN SMALL LETTER C → LATIN SMALL LETTER C
+	0xFF45:  "e",                  // FULLWIDTH LATIN SMALL LETTER E → LATIN SMALL LETTER E
+	0xFF47:  "g",                  // FULLWIDTH LATIN SMALL LETTER G → LATIN SMALL LETTER G
+	0xFF48:  "h",                  // FULLWIDTH LATIN SMALL LETTER H → LATIN SMALL LETTER H
+	0xFF49:  "i",                  // FULLWIDTH LATIN SMALL LETTER I → LATIN SMALL LETTER I
+	0xFF4A:  "j",                  // FULLWIDTH LATIN SMALL LETTER J → LATIN SMALL LETTER J
+	0xFF4C:  "l",                  // FULLWIDTH LATIN SMALL LETTER L → LATIN SMALL LETTER L
+	0xFF4F:  "o",                  // FULLWIDTH LATIN SMALL LETTER O → LATIN SMALL LETTER O
+	0xFF50:  "p",                  // FULLWIDTH LATIN SMALL LETTER P → LATIN SMALL LETTER P
+	0xFF53:  "s",                  // FULLWIDTH LATIN SMALL LETTER S → LATIN SMALL LETTER S
+	0xFF56:  "v",                  // FULLWIDTH LATIN SMALL LETTER V → LATIN SMALL LETTER V
+	0xFF58:  "x",                  // FULLWIDTH LATIN SMALL LETTER X → LATIN SMALL LETTER X
+	0xFF59:  "y",                  // FULLWIDTH LATIN SMALL LETTER Y → LATIN SMALL LETTER Y
+	0xFF5C:  "│",                  // FULLWIDTH VERTICAL LINE → BOX DRAWINGS LIGHT VERTICAL
+	0xFF5E:  "〜",                  // FULLWIDTH TILDE → WAVE DASH
+	0xFF65:  "·",                  // HALFWIDTH KATAKANA MIDDLE DOT → MIDDLE DOT
+	0xFFE3:  "ˉ",                  // FULLWIDTH MACRON → MODIFIER LETTER MACRON
+	0xFFE8:  "l",                  // HALFWIDTH FORMS LIGHT VERTICAL → LATIN SMALL LETTER L
+	0xFFED:  "▪",                  // HALFWIDTH BLACK SQUARE → BLACK SMALL SQUARE
+	0x10101: "·",                  // AEGEAN WORD SEPARATOR DOT → MIDDLE DOT
+	0x1018E: "N̊",                 // NOMISMA SIGN → LATIN CAPITAL LETTER N + COMBINING RING ABOVE
+	0x10196: "X̵",                 // ROMAN DENARIUS SIGN → LATIN CAPITAL LETTER X + COMBINING SHORT STROKE OVERLAY
+	0x10197: "V̵",                 // ROMAN QUINARIUS SIGN → LATIN CAPITAL LETTER V + COMBINING SHORT STROKE OVERLAY
+	0x10198: "l̵l̵S̵",             // ROMAN SESTERTIUS SIGN → LATIN SMALL LETTER L + COMBINING SHORT STROKE OVERLAY + LATIN SMALL LETTER L + COMBINING SHORT STROKE OVERLAY + LATIN CAPITAL LETTER S + COMBINING SHORT STROKE OVERLAY
+	0x10199: "l̵l̵",               // ROMAN DUPONDIUS SIGN → LATIN SMALL LETTER L + COMBINING SHORT STROKE OVERLAY + LATIN SMALL LETTER L + COMBINING SHORT STROKE OVERLAY
+	0x101A0: "Ք",                  // GREEK SYMBOL TAU RHO → ARMENIAN CAPITAL LETTER KEH
+	0x10282: "B",                  // LYCIAN LETTER B → LATIN CAPITAL LETTER B
+	0x10285: "Δ",                  // LYCIAN LETTER D → GREEK CAPITAL LETTER DELTA
+	0x10286: "E",                  // LYCIAN LETTER I → LATIN CAPITAL LETTER E
+	0x10287: "F",                  // LYCIAN LETTER W → LATIN CAPITAL LETTER F
+	0x1028A: "l",                  // LYCIAN LETTER J → LATIN SMALL LETTER L
+	0x1028D: "Ʌ",                  // LYCIAN LETTER L → LATIN CAPITAL LETTER TURNED V
+	0x10290: "X",                  // LYCIAN LETTER MM → LATIN CAPITAL LETTER X
+	0x10292: "O",                  // LYCIAN LETTER U → LATIN CAPITAL LETTER O
+	0x10294: "ᛜ",                  // LYCIAN LETTER KK → RUNIC LETTER INGWAZ
+	0x10295: "P",                  // LYCIAN LETTER R → LATIN CAPITAL LETTER P
+	0x10296: "S",                  // LYCIAN LETTER S → LATIN CAPITAL LETTER S
+	0x10297: "T",                  // LYCIAN LETTER T → LATIN CAPITAL LETTER T
+	0x1029B: "+",                  // LYCIAN LETTER H → PLUS SIGN
+	0x102A0: "A",                  // CARIAN LETTER A → LATIN CAPITAL LETTER A
+	0x102A1: "B",                  // CARIAN LETTER P2 → LATIN CAPITAL LETTER B
+	0x102A2: "C",                  // CARIAN LETTER D → LATIN CAPITAL LETTER C
+	0x102A3: "Δ",                  // CARIAN LETTER L → GREEK CAPITAL LETTER DELTA
+	0x102A5: "F",                  // CARIAN LETTER R → LATIN CAPITAL LETTER F
+	0x102AB: "O",                  // CARIAN LETTER O → LATIN CAPITAL LETTER O
+	0x102AD: "Ϙ",                  // CARIAN LETTER T → GREEK LETTER ARCHAIC KOPPA
+	0x102B0: "M",                  // CARIAN LETTER S → LATIN CAPITAL LETTER M
+	0x102B1: "T",                  // CARIAN LETTER C-18 → LATIN CAPITAL LETTER T
+	0x102B2: "Y",                  // CARIAN LETTER U → LATIN CAPITAL LETTER Y
+	0x102B3: "Φ",                  // CARIAN LETTER NN → GREEK CAPITAL LETTER PHI
+	0x102B4: "X",                  // CARIAN LETTER X → LATIN CAPITAL LETTER X
+	0x102B5: "Ψ",                  // CARIAN LETTER N → GREEK CAPITAL LETTER PSI
+	0x102B6: "Ω",                  // CARIAN LETTER TT2 → GREEK CAPITAL LETTER OMEGA
+	0x102B8: "ⵀ",                  // CARIAN LETTER SS → TIFINAGH LETTER YAH
+	0x102CF: "H",                  // CARIAN LETTER E2 → LATIN CAPITAL LETTER H
+	0x102E1: "د",                  // COPTIC EPACT DIGIT ONE → ARABIC LETTER DAL
+	0x102E4: "و",                  // COPTIC EPACT DIGIT FOUR → ARABIC LETTER WAW
+	0x102E8: "ط",                  // COPTIC EPACT DIGIT EIGHT → ARABIC LETTER TAH
+	0x102F2: "ص",                  // COPTIC EPACT NUMBER NINETY → ARABIC LETTER SAD
+	0x102F5: "Z",                  // COPTIC EPACT NUMBER THREE HUNDRED → LATIN CAPITAL LETTER Z
+	0x10301: "B",                  // OLD ITALIC LETTER BE → LATIN CAPITAL LETTER B
+	0x10302: "C",                  // OLD ITALIC LETTER KE → LATIN CAPITAL LETTER C
+	0x10309: "l",                  // OLD ITALIC LETTER I → LATIN SMALL LETTER L
+	0x10311: "M",                  // OLD ITALIC LETTER SHE → LATIN CAPITAL LETTER M
+	0x10312: "Ϙ",                  // OLD ITALIC LETTER KU → GREEK LETTER ARCHAIC KOPPA
+	0x10315: "T",                  // OLD ITALIC LETTER TE → LATIN CAPITAL LETTER T
+	0x10317: "X",                  // OLD ITALIC LETTER EKS → LATIN CAPITAL LETTER X
+	0x1031A: "8",                  // OLD ITALIC LETTER EF → DIGIT EIGHT
+	0x1031F: "*",                  // OLD ITALIC LETTER ESS → ASTERISK
+	0x10320: "l",                  // OLD ITALIC NUMERAL ONE → LATIN SMALL LETTER L
+	0x10322: "X",                  // OLD ITALIC NUMERAL TEN → LATIN CAPITAL LETTER X
+	0x103D1: "𐎂",                  // OLD PERSIAN NUMBER ONE → UGARITIC LETTER GAMLA
+	0x103D3: "𐎓",                  // OLD PERSIAN NUMBER TEN → UGARITIC LETTER AIN
+	0x10401: "Ɛ",                  // DESERET CAPITAL LETTER LONG E → LATIN CAPITAL LETTER OPEN E
+	0x10404: "O",                  // DESERET CAPITAL LETTER LONG O → LATIN CAPITAL LETTER O
+	0x10411: "ꓶ",                  // DESERET CAPITAL LETTER PEE → LISU LETTER UH
+	0x10415: "C",                  // DESERET CAPITAL LETTER CHEE → LATIN CAPITAL LETTER C
+	0x1041B: "L",                  // DESERET CAPITAL LETTER ETH → LATIN CAPITAL LETTER L
+	0x1041F: "Ɒ",                  // DESERET CAPITAL LETTER ESH → LATIN CAPITAL LETTER TURNED ALPHA
+	0x10420: "S",                  // DESERET CAPITAL LETTER ZHEE → LATIN CAPITAL LETTER S
+	0x10423: "Ɔ",                  // DESERET CAPITAL LETTER EM → LATIN CAPITAL LETTER OPEN O
+	0x10425: "И",                  // DESERET CAPITAL LETTER ENG → CYRILLIC CAPITAL LETTER I
+	0x10429: "ꞓ",                  // DESERET SMALL LETTER LONG E → LATIN SMALL LETTER C WITH BAR
+	0x1042A: "ʚ",                  // DESERET SMALL LETTER LONG A → LATIN SMALL LETTER CLOSED OPEN E
+	0x1042C: "o",                  // DESERET SMALL LETTER LONG O → LATIN SMALL LETTER O
+	0x1043D: "c",                  // DESERET SMALL LETTER CHEE → LATIN SMALL LETTER C
+	0x1043F: "ɷ",                  // DESERET SMALL LETTER KAY → LATIN SMALL LETTER CLOSED OMEGA
+	0x10442: "ɞ",                  // DESERET SMALL LETTER VEE → LATIN SMALL LETTER CLOSED REVERSED OPEN E
+	0x10443: "ʟ",                  // DESERET SMALL LETTER ETH → LATIN LETTER SMALL CAPITAL L
+	0x10448: "s",                  // DESERET SMALL LETTER ZHEE → LATIN SMALL LETTER S
+	0x1044B: "ɔ",                  // DESERET SMALL LETTER EM → LATIN SMALL LETTER OPEN O
+	0x1044D: "ᴎ",                  // DESERET SMALL LETTER ENG → LATIN LETTER SMALL CAPITAL REVERSED N
+	0x104A0: "𐒆",                  // OSMANYA DIGIT ZERO → OSMANYA LETTER DEEL
+	0x104B0: "Ʌ",                  // OSAGE CAPITAL LETTER A → LATIN CAPITAL LETTER TURNED V
+	0x104B4: "R",                  // OSAGE CAPITAL LETTER BRA → LATIN CAPITAL LETTER R
+	0x104BC: "Ӄ",                  // OSAGE CAPITAL LETTER KA → CYRILLIC CAPITAL LETTER KA WITH HOOK
+	0x104C2: "O",                  // OSAGE CAPITAL LETTER O → LATIN CAPITAL LETTER O
+	0x104C3: "ʘ",                  // OSAGE CAPITAL LETTER OIN → LATIN LETTER BILABIAL CLICK
+	0x104C4: "Þ",                  // OSAGE CAPITAL LETTER PA → LATIN CAPITAL LETTER THORN
+	0x104CD: "Ћ",                  // OSAGE CAPITAL LETTER DHA → CYRILLIC CAPITAL LETTER TSHE
+	0x104CE: "U",                  // OSAGE CAPITAL LETTER U → LATIN CAPITAL LETTER U
+	0x104D0: "ᛦ",                  // OSAGE CAPITAL LETTER KHA → RUNIC LETTER LONG-BRANCH-YR
+	0x104D1: "Ψ",                  // OSAGE CAPITAL LETTER GHA → GREEK CAPITAL LETTER PSI
+	0x104D2: "7",                  // OSAGE CAPITAL LETTER ZA → DIGIT SEVEN
+	0x104D8: "ʌ",                  // OSAGE SMALL LETTER A → LATIN SMALL LETTER TURNED V
+	0x104DB: "λ",                  // OSAGE SMALL LETTER AH → GREEK SMALL LETTER LAMDA
+	0x104EA: "o",                  // OSAGE SMALL LETTER O → LATIN SMALL LETTER O
+	0x104EB: "ꙩ",                  // OSAGE SMALL LETTER OIN → CYRILLIC SMALL LETTER MONOCULAR O
+	0x104F6: "u",                  // OSAGE SMALL LETTER U → LATIN SMALL LETTER U
+	0x104F9: "ψ",                  // OSAGE SMALL LETTER GHA → GREEK SMALL LETTER PSI
+	0x10513: "N",                  // ELBASAN LETTER NE → LATIN CAPITAL LETTER N
+	0x10516: "O",                  // ELBASAN LETTER O → LATIN CAPITAL LETTER O
+	0x10518: "K",                  // ELBASAN LETTER QE → LATIN CAPITAL LETTER K
+	0x1051C: "C",                  // ELBASAN LETTER SHE → LATIN CAPITAL LETTER C
+	0x1051D: "V",                  // ELBASAN LETTER TE → LATIN CAPITAL LETTER V
+	0x10525: "F",                  // ELBASAN LETTER GHE → LATIN CAPITAL LETTER F
+	0x10526: "L",                  // ELBASAN LETTER GHAMMA → LATIN CAPITAL LETTER L
+	0x10527: "X",                  // ELBASAN LETTER KHE → LATIN CAPITAL LETTER X
+	0x10A3A: "̣",                  // KHAROSHTHI SIGN DOT BELOW → COMBINING DOT BELOW
+	0x10A50: ".",                  // KHAROSHTHI PUNCTUATION DOT → FULL STOP
+	0x10A57: "𐩖𐩖",                 // KHAROSHTHI PUNCTUATION DOUBLE DANDA → KHAROSHTHI PUNCTUATION DANDA + KHAROSHTHI PUNCTUATION DANDA
+	0x10CFA: "𐲥",                  // OLD HUNGARIAN NUMBER ONE → OLD HUNGARIAN CAPITAL LETTER ESZ
+	0x10CFC: "𐲂",                  // OLD HUNGARIAN NUMBER TEN → OLD HUNGARIAN CAPITAL LETTER EB
+	0x10EC6: "ن",                  // ( 𐻆 → ن )
+	0x10EC7: "ڀ",                  // ( 𐻇 → ڀ )
+	0x10ED0: "°̲",                 // ( 𐻐 → °̲ )
+	0x110BB: "॰",                  // KAITHI ABBREVIATION SIGN → DEVANAGARI ABBREVIATION SIGN
+	0x111C7: "॰",                  // SHARADA ABBREVIATION SIGN → DEVANAGARI ABBREVIATION SIGN
+	0x111CA: "̣",                  // SHARADA SIGN NUKTA → COMBINING DOT BELOW
+	0x111CB: "ऺ",                  // SHARADA VOWEL MODIFIER MARK → DEVANAGARI VOWEL SIGN OE
+	0x111DB: "꣼",                  // SHARADA SIGN SIDDHAM → DEVANAGARI SIGN SIDDHAM
+	0x111DC: "ꣻ",                  // SHARADA HEADSTROKE → DEVANAGARI HEADSTROKE
+	0x111DE: "≈",                  // SHARADA SECTION MARK-1 → ALMOST EQUAL TO
+	0x11300: "̊",                  // GRANTHA SIGN COMBINING ANUSVARA ABOVE → COMBINING RING ABOVE
+	0x11413: "𑐴𑑂𑐒",                // NEWA LETTER NGHA → NEWA LETTER HA + NEWA SIGN VIRAMA + NEWA LETTER NGA
+	0x11419: "𑐴𑑂𑐘",                // NEWA LETTER NYHA → NEWA LETTER HA + NEWA SIGN VIRAMA + NEWA LETTER NYA
+	0x11424: "𑐴𑑂𑐣",                // NEWA LETTER NHA → NEWA LETTER HA + NEWA SIGN VIRAMA + NEWA LETTER NA
+	0x1142A: "𑐴𑑂𑐩",                // NEWA LETTER MHA → NEWA LETTER HA + NEWA SIGN VIRAMA + NEWA LETTER MA
+	0x1142D: "𑐴𑑂𑐬",                // NEWA LETTER RHA → NEWA LETTER HA + NEWA SIGN VIRAMA + NEWA LETTER RA
+	0x1142F: "𑐴𑑂𑐮",                // NEWA LETTER LHA → NEWA LETTER HA + NEWA SIGN VIRAMA + NEWA LETTER LA
+	0x1144C: "𑑋𑑋",                 // NEWA DOUBLE DANDA → NEWA DANDA + NEWA DANDA
+	0x11492: "ঘ",                  // TIRHUTA LETTER GHA → BENGALI LETTER GHA
+	0x11494: "চ",                  // TIRHUTA LETTER CA → BENGALI LETTER CA
+	0x11496: "জ",                  // TIRHUTA LETTER JA → BENGALI LETTER JA
+	0x11498: "ঞ",                  // TIRHUTA LETTER NYA → BENGALI LETTER NYA
+	0x11499: "ট",                  // TIRHUTA LETTER TTA → BENGALI LETTER TTA
+	0x1149B: "ড",                  // TIRHUTA LETTER DDA → BENGALI LETTER DDA
+	0x1149D: "ল",                  // TIRHUTA LETTER NNA → BENGALI LETTER LA
+	0x1149E: "ত",                  // TIRHUTA LETTER TA → BENGALI LETTER TA
+	0x1149F: "থ",                  // TIRHUTA LETTER THA → BENGALI LETTER THA
+	0x114A0: "দ",                  // TIRHUTA LETTER DA → BENGALI LETTER DA
+	0x114A1: "ধ",                  // TIRHUTA LETTER DHA → BENGALI LETTER DHA
+	0x114A2: "ন",                  // TIRHUTA LETTER NA → BENGALI LETTER NA
+	0x114A3: "প",                  // TIRHUTA LETTER PA → BENGALI LETTER PA
+	0x114A7: "ম",                  // TIRHUTA LETTER MA → BENGALI LETTER MA
+	0x114A8: "য",                  // TIRHUTA LETTER YA → BENGALI LETTER YA
+	0x114A9: "ব",                  // TIRHUTA LETTER RA → BENGALI LETTER BA
+	0x114AA: "ণ",                  // TIRHUTA LETTER LA → BENGALI LETTER NNA
+	0x114AB: "র",                  // TIRHUTA LETTER VA → BENGALI LETTER RA
+	0x114AD: "ষ",                  // TIRHUTA LETTER SSA → BENGALI LETTER SSA
+	0x114AE: "স",                  // TIRHUTA LETTER SA → BENGALI LETTER SA
+	0x114B0: "া",                  // TIRHUTA VOWEL SIGN AA → BENGALI VOWEL SIGN AA
+	0x114B1: "ি",                  // TIRHUTA VOWEL SIGN I → BENGALI VOWEL SIGN I
+	0x114B9: "ে",                  // TIRHUTA VOWEL SIGN E → BENGALI VOWEL SIGN E
+	0x114BC: "ো",                  // TIRHUTA VOWEL SIGN O → BENGALI VOWEL SIGN O
+	0x114BD: "ৗ",                  // TIRHUTA VOWEL SIGN SHORT O → BENGALI AU LENGTH MARK
+	0x114BE: "ৌ",                  // TIRHUTA VOWEL SIGN AU → BENGALI VOWEL SIGN AU
+	0x114BF: "̆̇",                 // TIRHUTA SIGN CANDRABINDU → COMBINING BREVE + COMBINING DOT ABOVE
+	0x114C1: "ঃ",                  // TIRHUTA SIGN VISARGA → BENGALI SIGN VISARGA
+	0x114C2: "্",                  // TIRHUTA SIGN VIRAMA → BENGALI SIGN VIRAMA
+	0x114C3: "̣",                  // TIRHUTA SIGN NUKTA → COMBINING DOT BELOW
+	0x114C4: "ঽ",                  // TIRHUTA SIGN AVAGRAHA → BENGALI SIGN AVAGRAHA
+	0x114C5: "ẇ",                 // TIRHUTA GVANG → LATIN SMALL LETTER W + COMBINING DOT ABOVE
+	0x114D0: "o",                  // TIRHUTA DIGIT ZERO → LATIN SMALL LETTER O
+	0x114D1: "১",                  // TIRHUTA DIGIT ONE → BENGALI DIGIT ONE
+	0x114D2: "২",                  // TIRHUTA DIGIT TWO → BENGALI DIGIT TWO
+	0x114D6: "৬",                  // TIRHUTA DIGIT SIX → BENGALI DIGIT SIX
+	0x115D8: "𑖂",                  // SIDDHAM LETTER THREE-CIRCLE ALTERNATE I → SIDDHAM LETTER I
+	0x115D9: "𑖂",                  // SIDDHAM LETTER TWO-CIRCLE ALTERNATE I → SIDDHAM LETTER I
+	0x115DA: "𑖃",                  // SIDDHAM LETTER TWO-CIRCLE ALTERNATE II → SIDDHAM LETTER II
+	0x115DB: "𑖄",                  // SIDDHAM LETTER ALTERNATE U → SIDDHAM LETTER U
+	0x115DC: "𑖲",                  // SIDDHAM VOWEL SIGN ALTERNATE U → SIDDHAM VOWEL SIGN U
+	0x115DD: "𑖳",                  // SIDDHAM VOWEL SIGN ALTERNATE UU → SIDDHAM VOWEL SIGN UU
+	0x11642: "𑙁𑙁",                 // MODI DOUBLE DANDA → MODI DANDA + MODI DANDA
+	0x11700: "rn",                 // AHOM LETTER KA → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x11706: "v",                  // AHOM LETTER PA → LATIN SMALL LETTER V
+	0x1170A: "w",                  // AHOM LETTER JA → LATIN SMALL LETTER W
+	0x1170E: "w",                  // AHOM LETTER LA → LATIN SMALL LETTER W
+	0x1170F: "w",                  // AHOM LETTER SA → LATIN SMALL LETTER W
+	0x118A0: "V",                  // WARANG CITI CAPITAL LETTER NGAA → LATIN CAPITAL LETTER V
+	0x118A2: "F",                  // WARANG CITI CAPITAL LETTER WI → LATIN CAPITAL LETTER F
+	0x118A3: "L",                  // WARANG CITI CAPITAL LETTER YU → LATIN CAPITAL LETTER L
+	0x118A4: "Y",                  // WARANG CITI CAPITAL LETTER YA → LATIN CAPITAL LETTER Y
+	0x118A6: "E",                  // WARANG CITI CAPITAL LETTER II → LATIN CAPITAL LETTER E
+	0x118A8: "∇",                  // WARANG CITI CAPITAL LETTER E → NABLA
+	0x118A9: "Z",                  // WARANG CITI CAPITAL LETTER O → LATIN CAPITAL LETTER Z
+	0x118AC: "9",                  // WARANG CITI CAPITAL LETTER KO → DIGIT NINE
+	0x118AE: "E",                  // WARANG CITI CAPITAL LETTER YUJ → LATIN CAPITAL LETTER E
+	0x118AF: "4",                  // WARANG CITI CAPITAL LETTER UC → DIGIT FOUR
+	0x118B2: "L",                  // WARANG CITI CAPITAL LETTER TTE → LATIN CAPITAL LETTER L
+	0x118B5: "O",                  // WARANG CITI CAPITAL LETTER AT → LATIN CAPITAL LETTER O
+	0x118B7: "ᛜ",                  // WARANG CITI CAPITAL LETTER BU → RUNIC LETTER INGWAZ
+	0x118B8: "U",                  // WARANG CITI CAPITAL LETTER PU → LATIN CAPITAL LETTER U
+	0x118BB: "5",                  // WARANG CITI CAPITAL LETTER HORR → DIGIT FIVE
+	0x118BC: "T",                  // WARANG CITI CAPITAL LETTER HAR → LATIN CAPITAL LETTER T
+	0x118C0: "v",                  // WARANG CITI SMALL LETTER NGAA → LATIN SMALL LETTER V
+	0x118C1: "s",                  // WARANG CITI SMALL LETTER A → LATIN SMALL LETTER S
+	0x118C2: "F",                  // WARANG CITI SMALL LETTER WI → LATIN CAPITAL LETTER F
+	0x118C3: "i",                  // WARANG CITI SMALL LETTER YU → LATIN SMALL LETTER I
+	0x118C4: "z",                  // WARANG CITI SMALL LETTER YA → LATIN SMALL LETTER Z
+	0x118C6: "7",                  // WARANG CITI SMALL LETTER II → DIGIT SEVEN
+	0x118C8: "o",                  // WARANG CITI SMALL LETTER E → LATIN SMALL LETTER O
+	0x118CA: "3",                  // WARANG CITI SMALL LETTER ANG → DIGIT THREE
+	0x118CC: "9",                  // WARANG CITI SMALL LETTER KO → DIGIT NINE
+	0x118CE: "ꞓ",                  // WARANG CITI SMALL LETTER YUJ → LATIN SMALL LETTER C WITH BAR
+	0x118D5: "6",                  // WARANG CITI SMALL LETTER AT → DIGIT SIX
+	0x118D6: "9",                  // WARANG CITI SMALL LETTER AM → DIGIT NINE
+	0x118D7: "o",                  // WARANG CITI SMALL LETTER BU → LATIN SMALL LETTER O
+	0x118D8: "u",                  // WARANG CITI SMALL LETTER PU → LATIN SMALL LETTER U
+	0x118DC: "y",                  // WARANG CITI SMALL LETTER HAR → LATIN SMALL LETTER Y
+	0x118E0: "O",                  // WARANG CITI DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x118E3: "rn",                 // WARANG CITI DIGIT THREE → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x118E4: "٩",                  // WARANG CITI DIGIT FOUR → ARABIC-INDIC DIGIT NINE
+	0x118E5: "Z",                  // WARANG CITI DIGIT FIVE → LATIN CAPITAL LETTER Z
+	0x118E6: "W",                  // WARANG CITI DIGIT SIX → LATIN CAPITAL LETTER W
+	0x118E9: "C",                  // WARANG CITI DIGIT NINE → LATIN CAPITAL LETTER C
+	0x118EC: "X",                  // WARANG CITI NUMBER THIRTY → LATIN CAPITAL LETTER X
+	0x118EF: "W",                  // WARANG CITI NUMBER SIXTY → LATIN CAPITAL LETTER W
+	0x118F2: "C",                  // WARANG CITI NUMBER NINETY → LATIN CAPITAL LETTER C
+	0x11AE6: "𑫥𑫯",                 // PAU CIN HAU RISING TONE → PAU CIN HAU RISING TONE LONG + PAU CIN HAU MID-LEVEL TONE
+	0x11AE7: "𑫥𑫰",                 // PAU CIN HAU SANDHI GLOTTAL STOP → PAU CIN HAU RISING TONE LONG + PAU CIN HAU GLOTTAL STOP VARIANT
+	0x11AE8: "𑫥𑫥",                 // PAU CIN HAU RISING TONE LONG FINAL → PAU CIN HAU RISING TONE LONG + PAU CIN HAU RISING TONE LONG
+	0x11AE9: "𑫥𑫥𑫯",                // PAU CIN HAU RISING TONE FINAL → PAU CIN HAU RISING TONE LONG + PAU CIN HAU RISING TONE LONG + PAU CIN HAU MID-LEVEL TONE
+	0x11AEA: "𑫥𑫥𑫰",                // PAU CIN HAU SANDHI GLOTTAL STOP FINAL → PAU CIN HAU RISING TONE LONG + PAU CIN HAU RISING TONE LONG + PAU CIN HAU GLOTTAL STOP VARIANT
+	0x11AEC: "𑫫𑫯",                 // PAU CIN HAU SANDHI TONE → PAU CIN HAU SANDHI TONE LONG + PAU CIN HAU MID-LEVEL TONE
+	0x11AED: "𑫫𑫫",                 // PAU CIN HAU SANDHI TONE LONG FINAL → PAU CIN HAU SANDHI TONE LONG + PAU CIN HAU SANDHI TONE LONG
+	0x11AEE: "𑫫𑫫𑫯",                // PAU CIN HAU SANDHI TONE FINAL → PAU CIN HAU SANDHI TONE LONG + PAU CIN HAU SANDHI TONE LONG + PAU CIN HAU MID-LEVEL TONE
+	0x11AF4: "𑫳𑫯",                 // PAU CIN HAU LOW-FALLING TONE → PAU CIN HAU LOW-FALLING TONE LONG + PAU CIN HAU MID-LEVEL TONE
+	0x11AF5: "𑫳𑫰",                 // PAU CIN HAU GLOTTAL STOP → PAU CIN HAU LOW-FALLING TONE LONG + PAU CIN HAU GLOTTAL STOP VARIANT
+	0x11AF6: "𑫳𑫳",                 // PAU CIN HAU LOW-FALLING TONE LONG FINAL → PAU CIN HAU LOW-FALLING TONE LONG + PAU CIN HAU LOW-FALLING TONE LONG
+	0x11AF7: "𑫳𑫳𑫯",                // PAU CIN HAU LOW-FALLING TONE FINAL → PAU CIN HAU LOW-FALLING TONE LONG + PAU CIN HAU LOW-FALLING TONE LONG + PAU CIN HAU MID-LEVEL TONE
+	0x11AF8: "𑫳𑫳𑫰",                // PAU CIN HAU GLOTTAL STOP FINAL → PAU CIN HAU LOW-FALLING TONE LONG + PAU CIN HAU LOW-FALLING TONE LONG + PAU CIN HAU GLOTTAL STOP VARIANT
+	0x11B60: "ऺ",                  // ( 𑭠 → ऺ )
+	0x11B66: "̆",                  // ( 𑭦 → ̆ )
+	0x11C42: "𑱁𑱁",                 // BHAIKSUKI DOUBLE DANDA → BHAIKSUKI DANDA + BHAIKSUKI DANDA
+	0x11CB2: "𑲪",                  // MARCHEN VOWEL SIGN U → MARCHEN SUBJOINED LETTER RA
+	0x11DD9: ":",                  // ( 𑷙 → : )
+	0x11DDA: "l",                  // ( 𑷚 → l )
+	0x11DE0: "O",                  // ( 𑷠 → O )
+	0x11DE1: "l",                  // ( 𑷡 → l )
+	0x12038: "𐎚",                  // CUNEIFORM SIGN ASH → UGARITIC LETTER TO
+	0x132F9: "𐦞",                  // EGYPTIAN HIEROGLYPH S034 → MEROITIC HIEROGLYPHIC SYMBOL VIDJ
+	0x16EA6: "Π",                  // ( 𖺦 → Π )
+	0x16EAA: "l",                  // ( 𖺪 → l )
+	0x16EB6: "b",                  // ( 𖺶 → b )
+	0x16EC1: "π",                  // ( 𖻁 → π )
+	0x16ED1: "ƅ",                  // ( 𖻑 → ƅ )
+	0x16F07: "Γ",                  // MIAO LETTER FA → GREEK CAPITAL LETTER GAMMA
+	0x16F08: "V",                  // MIAO LETTER VA → LATIN CAPITAL LETTER V
+	0x16F0A: "T",                  // MIAO LETTER TA → LATIN CAPITAL LETTER T
+	0x16F16: "L",                  // MIAO LETTER LA → LATIN CAPITAL LETTER L
+	0x16F1A: "Δ",                  // MIAO LETTER TLHA → GREEK CAPITAL LETTER DELTA
+	0x16F1C: "Ꙙ",                  // MIAO LETTER TLHYA → CYRILLIC CAPITAL LETTER CLOSED LITTLE YUS
+	0x16F26: "ꓶ",                  // MIAO LETTER HA → LISU LETTER UH
+	0x16F28: "l",                  // MIAO LETTER GHA → LATIN SMALL LETTER L
+	0x16F2D: "Ɛ",                  // MIAO LETTER NYHA → LATIN CAPITAL LETTER OPEN E
+	0x16F35: "R",                  // MIAO LETTER ZHA → LATIN CAPITAL LETTER R
+	0x16F3A: "S",                  // MIAO LETTER SA → LATIN CAPITAL LETTER S
+	0x16F3B: "3",                  // MIAO LETTER ZA → DIGIT THREE
+	0x16F3D: "Ʌ",                  // MIAO LETTER ZZA → LATIN CAPITAL LETTER TURNED V
+	0x16F3F: ">",                  // MIAO LETTER ARCHAIC ZZA → GREATER-THAN SIGN
+	0x16F40: "A",                  // MIAO LETTER ZZYA → LATIN CAPITAL LETTER A
+	0x16F42: "U",                  // MIAO LETTER WA → LATIN CAPITAL LETTER U
+	0x16F43: "Y",                  // MIAO LETTER AH → LATIN CAPITAL LETTER Y
+	0x16F51: "'",                  // MIAO SIGN ASPIRATION → APOSTROPHE
+	0x16F52: "'",                  // MIAO SIGN REFORMED VOICING → APOSTROPHE
+	0x16FF2: "儿",                  // ( 𖿲 → 儿 )
+	0x1CCD6: "A",                  // ( 𜳖 → A )
+	0x1CCD7: "B",                  // ( 𜳗 → B )
+	0x1CCD8: "C",                  // ( 𜳘 → C )
+	0x1CCD9: "D",                  // ( 𜳙 → D )
+	0x1CCDA: "E",                  // ( 𜳚 → E )
+	0x1CCDB: "F",                  // ( 𜳛 → F )
+	0x1CCDC: "G",                  // ( 𜳜 → G )
+	0x1CCDD: "H",                  // ( 𜳝 → H )
+	0x1CCDE: "l",                  // ( 𜳞 → l )
+	0x1CCDF: "J",                  // ( 𜳟 → J )
+	0x1CCE0: "K",                  // ( 𜳠 → K )
+	0x1CCE1: "L",                  // ( 𜳡 → L )
+	0x1CCE2: "M",                  // ( 𜳢 → M )
+	0x1CCE3: "N",                  // ( 𜳣 → N )
+	0x1CCE4: "O",                  // ( 𜳤 → O )
+	0x1CCE5: "P",                  // ( 𜳥 → P )
+	0x1CCE6: "Q",                  // ( 𜳦 → Q )
+	0x1CCE7: "R",                  // ( 𜳧 → R )
+	0x1CCE8: "S",                  // ( 𜳨 → S )
+	0x1CCE9: "T",                  // ( 𜳩 → T )
+	0x1CCEA: "U",                  // ( 𜳪 → U )
+	0x1CCEB: "V",                  // ( 𜳫 → V )
+	0x1CCEC: "W",                  // ( 𜳬 → W )
+	0x1CCED: "X",                  // ( 𜳭 → X )
+	0x1CCEE: "Y",                  // ( 𜳮 → Y )
+	0x1CCEF: "Z",                  // ( 𜳯 → Z )
+	0x1CCF0: "O",                  // ( 𜳰 → O )
+	0x1CCF1: "l",                  // ( 𜳱 → l )
+	0x1CCF2: "2",                  // ( 𜳲 → 2 )
+	0x1CCF3: "3",                  // ( 𜳳 → 3 )
+	0x1CCF4: "4",                  // ( 𜳴 → 4 )
+	0x1CCF5: "5",                  // ( 𜳵 → 5 )
+	0x1CCF6: "6",                  // ( 𜳶 → 6 )
+	0x1CCF7: "7",                  // ( 𜳷 → 7 )
+	0x1CCF8: "8",                  // ( 𜳸 → 8 )
+	0x1CCF9: "9",                  // ( 𜳹 → 9 )
+	0x1CCFB: "🛸",                  // ( 𜳻 → 🛸 )
+	0x1CEEF: "ⵂ",                  // ( 𜻯 → ⵂ )
+	0x1D114: "{",                  // MUSICAL SYMBOL BRACE → LEFT CURLY BRACKET
+	0x1D16D: ".",                  // MUSICAL SYMBOL COMBINING AUGMENTATION DOT → FULL STOP
+	0x1D202: "Ӿ",                  // GREEK VOCAL NOTATION SYMBOL-3 → CYRILLIC CAPITAL LETTER HA WITH STROKE
+	0x1D206: "3",                  // GREEK VOCAL NOTATION SYMBOL-7 → DIGIT THREE
+	0x1D20B: "И",                  // GREEK VOCAL NOTATION SYMBOL-12 → CYRILLIC CAPITAL LETTER I
+	0x1D20D: "V",                  // GREEK VOCAL NOTATION SYMBOL-14 → LATIN CAPITAL LETTER V
+	0x1D20F: "\\",                 // GREEK VOCAL NOTATION SYMBOL-16 → REVERSE SOLIDUS
+	0x1D212: "7",                  // GREEK VOCAL NOTATION SYMBOL-19 → DIGIT SEVEN
+	0x1D213: "F",                  // GREEK VOCAL NOTATION SYMBOL-20 → LATIN CAPITAL LETTER F
+	0x1D214: "𐊼",                  // GREEK VOCAL NOTATION SYMBOL-21 → CARIAN LETTER K
+	0x1D215: "ꓶ",                  // GREEK VOCAL NOTATION SYMBOL-22 → LISU LETTER UH
+	0x1D216: "R",                  // GREEK VOCAL NOTATION SYMBOL-23 → LATIN CAPITAL LETTER R
+	0x1D217: "Ɐ",                  // GREEK VOCAL NOTATION SYMBOL-24 → LATIN CAPITAL LETTER TURNED A
+	0x1D21A: "O̵",                 // GREEK VOCAL NOTATION SYMBOL-52 → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D21B: "⅄",                  // GREEK VOCAL NOTATION SYMBOL-53 → TURNED SANS-SERIF CAPITAL Y
+	0x1D21C: "ꓕ",                  // GREEK VOCAL NOTATION SYMBOL-54 → LISU LETTER THA
+	0x1D221: "Ɛ",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-7 → LATIN CAPITAL LETTER OPEN E
+	0x1D222: "Ѡ",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-8 → CYRILLIC CAPITAL LETTER OMEGA
+	0x1D22A: "L",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-23 → LATIN CAPITAL LETTER L
+	0x1D22B: "ꓶ",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-24 → LISU LETTER UH
+	0x1D230: "ꟻ",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-30 → LATIN EPIGRAPHIC LETTER REVERSED F
+	0x1D236: "<",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-40 → LESS-THAN SIGN
+	0x1D237: ">",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-42 → GREATER-THAN SIGN
+	0x1D238: "⊏",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-43 → SQUARE IMAGE OF
+	0x1D239: "⊐",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-45 → SQUARE ORIGINAL OF
+	0x1D23A: "/",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-47 → SOLIDUS
+	0x1D23B: "\\",                 // GREEK INSTRUMENTAL NOTATION SYMBOL-48 → REVERSE SOLIDUS
+	0x1D23F: "ᛋ",                  // GREEK INSTRUMENTAL NOTATION SYMBOL-52 → RUNIC LETTER SIGEL LONG-BRANCH-SOL S
+	0x1D245: "Ո",                  // GREEK MUSICAL LEIMMA → ARMENIAN CAPITAL LETTER VO
+	0x1D400: "A",                  // MATHEMATICAL BOLD CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D401: "B",                  // MATHEMATICAL BOLD CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D402: "C",                  // MATHEMATICAL BOLD CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D403: "D",                  // MATHEMATICAL BOLD CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D404: "E",                  // MATHEMATICAL BOLD CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D405: "F",                  // MATHEMATICAL BOLD CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D406: "G",                  // MATHEMATICAL BOLD CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D407: "H",                  // MATHEMATICAL BOLD CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D408: "l",                  // MATHEMATICAL BOLD CAPITAL I → LATIN SMALL LETTER L
+	0x1D409: "J",                  // MATHEMATICAL BOLD CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D40A: "K",                  // MATHEMATICAL BOLD CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D40B: "L",                  // MATHEMATICAL BOLD CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D40C: "M",                  // MATHEMATICAL BOLD CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D40D: "N",                  // MATHEMATICAL BOLD CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D40E: "O",                  // MATHEMATICAL BOLD CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D40F: "P",                  // MATHEMATICAL BOLD CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D410: "Q",                  // MATHEMATICAL BOLD CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D411: "R",                  // MATHEMATICAL BOLD CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D412: "S",                  // MATHEMATICAL BOLD CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D413: "T",                  // MATHEMATICAL BOLD CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D414: "U",                  // MATHEMATICAL BOLD CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D415: "V",                  // MATHEMATICAL BOLD CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D416: "W",                  // MATHEMATICAL BOLD CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D417: "X",                  // MATHEMATICAL BOLD CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D418: "Y",                  // MATHEMATICAL BOLD CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D419: "Z",                  // MATHEMATICAL BOLD CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D41A: "a",                  // MATHEMATICAL BOLD SMALL A → LATIN SMALL LETTER A
+	0x1D41B: "b",                  // MATHEMATICAL BOLD SMALL B → LATIN SMALL LETTER B
+	0x1D41C: "c",                  // MATHEMATICAL BOLD SMALL C → LATIN SMALL LETTER C
+	0x1D41D: "d",                  // MATHEMATICAL BOLD SMALL D → LATIN SMALL LETTER D
+	0x1D41E: "e",                  // MATHEMATICAL BOLD SMALL E → LATIN SMALL LETTER E
+	0x1D41F: "f",                  // MATHEMATICAL BOLD SMALL F → LATIN SMALL LETTER F
+	0x1D420: "g",                  // MATHEMATICAL BOLD SMALL G → LATIN SMALL LETTER G
+	0x1D421: "h",                  // MATHEMATICAL BOLD SMALL H → LATIN SMALL LETTER H
+	0x1D422: "i",                  // MATHEMATICAL BOLD SMALL I → LATIN SMALL LETTER I
+	0x1D423: "j",                  // MATHEMATICAL BOLD SMALL J → LATIN SMALL LETTER J
+	0x1D424: "k",                  // MATHEMATICAL BOLD SMALL K → LATIN SMALL LETTER K
+	0x1D425: "l",                  // MATHEMATICAL BOLD SMALL L → LATIN SMALL LETTER L
+	0x1D426: "rn",                 // MATHEMATICAL BOLD SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D427: "n",                  // MATHEMATICAL BOLD SMALL N → LATIN SMALL LETTER N
+	0x1D428: "o",                  // MATHEMATICAL BOLD SMALL O → LATIN SMALL LETTER O
+	0x1D429: "p",                  // MATHEMATICAL BOLD SMALL P → LATIN SMALL LETTER P
+	0x1D42A: "q",                  // MATHEMATICAL BOLD SMALL Q → LATIN SMALL LETTER Q
+	0x1D42B: "r",                  // MATHEMATICAL BOLD SMALL R → LATIN SMALL LETTER R
+	0x1D42C: "s",                  // MATHEMATICAL BOLD SMALL S → LATIN SMALL LETTER S
+	0x1D42D: "t",                  // MATHEMATICAL BOLD SMALL T → LATIN SMALL LETTER T
+	0x1D42E: "u",                  // MATHEMATICAL BOLD SMALL U → LATIN SMALL LETTER U
+	0x1D42F: "v",                  // MATHEMATICAL BOLD SMALL V → LATIN SMALL LETTER V
+	0x1D430: "w",                  // MATHEMATICAL BOLD SMALL W → LATIN SMALL LETTER W
+	0x1D431: "x",                  // MATHEMATICAL BOLD SMALL X → LATIN SMALL LETTER X
+	0x1D432: "y",                  // MATHEMATICAL BOLD SMALL Y → LATIN SMALL LETTER Y
+	0x1D433: "z",                  // MATHEMATICAL BOLD SMALL Z → LATIN SMALL LETTER Z
+	0x1D434: "A",                  // MATHEMATICAL ITALIC CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D435: "B",                  // MATHEMATICAL ITALIC CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D436: "C",                  // MATHEMATICAL ITALIC CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D437: "D",                  // MATHEMATICAL ITALIC CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D438: "E",                  // MATHEMATICAL ITALIC CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D439: "F",                  // MATHEMATICAL ITALIC CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D43A: "G",                  // MATHEMATICAL ITALIC CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D43B: "H",                  // MATHEMATICAL ITALIC CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D43C: "l",                  // MATHEMATICAL ITALIC CAPITAL I → LATIN SMALL LETTER L
+	0x1D43D: "J",                  // MATHEMATICAL ITALIC CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D43E: "K",                  // MATHEMATICAL ITALIC CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D43F: "L",                  // MATHEMATICAL ITALIC CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D440: "M",                  // MATHEMATICAL ITALIC CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D441: "N",                  // MATHEMATICAL ITALIC CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D442: "O",                  // MATHEMATICAL ITALIC CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D443: "P",                  // MATHEMATICAL ITALIC CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D444: "Q",                  // MATHEMATICAL ITALIC CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D445: "R",                  // MATHEMATICAL ITALIC CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D446: "S",                  // MATHEMATICAL ITALIC CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D447: "T",                  // MATHEMATICAL ITALIC CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D448: "U",                  // MATHEMATICAL ITALIC CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D449: "V",                  // MATHEMATICAL ITALIC CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D44A: "W",                  // MATHEMATICAL ITALIC CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D44B: "X",                  // MATHEMATICAL ITALIC CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D44C: "Y",                  // MATHEMATICAL ITALIC CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D44D: "Z",                  // MATHEMATICAL ITALIC CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D44E: "a",                  // MATHEMATICAL ITALIC SMALL A → LATIN SMALL LETTER A
+	0x1D44F: "b",                  // MATHEMATICAL ITALIC SMALL B → LATIN SMALL LETTER B
+	0x1D450: "c",                  // MATHEMATICAL ITALIC SMALL C → LATIN SMALL LETTER C
+	0x1D451: "d",                  // MATHEMATICAL ITALIC SMALL D → LATIN SMALL LETTER D
+	0x1D452: "e",                  // MATHEMATICAL ITALIC SMALL E → LATIN SMALL LETTER E
+	0x1D453: "f",                  // MATHEMATICAL ITALIC SMALL F → LATIN SMALL LETTER F
+	0x1D454: "g",                  // MATHEMATICAL ITALIC SMALL G → LATIN SMALL LETTER G
+	0x1D456: "i",                  // MATHEMATICAL ITALIC SMALL I → LATIN SMALL LETTER I
+	0x1D457: "j",                  // MATHEMATICAL ITALIC SMALL J → LATIN SMALL LETTER J
+	0x1D458: "k",                  // MATHEMATICAL ITALIC SMALL K → LATIN SMALL LETTER K
+	0x1D459: "l",                  // MATHEMATICAL ITALIC SMALL L → LATIN SMALL LETTER L
+	0x1D45A: "rn",                 // MATHEMATICAL ITALIC SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D45B: "n",                  // MATHEMATICAL ITALIC SMALL N → LATIN SMALL LETTER N
+	0x1D45C: "o",                  // MATHEMATICAL ITALIC SMALL O → LATIN SMALL LETTER O
+	0x1D45D: "p",                  // MATHEMATICAL ITALIC SMALL P → LATIN SMALL LETTER P
+	0x1D45E: "q",                  // MATHEMATICAL ITALIC SMALL Q → LATIN SMALL LETTER Q
+	0x1D45F: "r",                  // MATHEMATICAL ITALIC SMALL R → LATIN SMALL LETTER R
+	0x1D460: "s",                  // MATHEMATICAL ITALIC SMALL S → LATIN SMALL LETTER S
+	0x1D461: "t",                  // MATHEMATICAL ITALIC SMALL T → LATIN SMALL LETTER T
+	0x1D462: "u",                  // MATHEMATICAL ITALIC SMALL U → LATIN SMALL LETTER U
+	0x1D463: "v",                  // MATHEMATICAL ITALIC SMALL V → LATIN SMALL LETTER V
+	0x1D464: "w",                  // MATHEMATICAL ITALIC SMALL W → LATIN SMALL LETTER W
+	0x1D465: "x",                  // MATHEMATICAL ITALIC SMALL X → LATIN SMALL LETTER X
+	0x1D466: "y",                  // MATHEMATICAL ITALIC SMALL Y → LATIN SMALL LETTER Y
+	0x1D467: "z",                  // MATHEMATICAL ITALIC SMALL Z → LATIN SMALL LETTER Z
+	0x1D468: "A",                  // MATHEMATICAL BOLD ITALIC CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D469: "B",                  // MATHEMATICAL BOLD ITALIC CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D46A: "C",                  // MATHEMATICAL BOLD ITALIC CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D46B: "D",                  // MATHEMATICAL BOLD ITALIC CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D46C: "E",                  // MATHEMATICAL BOLD ITALIC CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D46D: "F",                  // MATHEMATICAL BOLD ITALIC CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D46E: "G",                  // MATHEMATICAL BOLD ITALIC CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D46F: "H",                  // MATHEMATICAL BOLD ITALIC CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D470: "l",                  // MATHEMATICAL BOLD ITALIC CAPITAL I → LATIN SMALL LETTER L
+	0x1D471: "J",                  // MATHEMATICAL BOLD ITALIC CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D472: "K",                  // MATHEMATICAL BOLD ITALIC CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D473: "L",                  // MATHEMATICAL BOLD ITALIC CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D474: "M",                  // MATHEMATICAL BOLD ITALIC CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D475: "N",                  // MATHEMATICAL BOLD ITALIC CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D476: "O",                  // MATHEMATICAL BOLD ITALIC CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D477: "P",                  // MATHEMATICAL BOLD ITALIC CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D478: "Q",                  // MATHEMATICAL BOLD ITALIC CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D479: "R",                  // MATHEMATICAL BOLD ITALIC CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D47A: "S",                  // MATHEMATICAL BOLD ITALIC CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D47B: "T",                  // MATHEMATICAL BOLD ITALIC CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D47C: "U",                  // MATHEMATICAL BOLD ITALIC CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D47D: "V",                  // MATHEMATICAL BOLD ITALIC CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D47E: "W",                  // MATHEMATICAL BOLD ITALIC CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D47F: "X",                  // MATHEMATICAL BOLD ITALIC CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D480: "Y",                  // MATHEMATICAL BOLD ITALIC CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D481: "Z",                  // MATHEMATICAL BOLD ITALIC CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D482: "a",                  // MATHEMATICAL BOLD ITALIC SMALL A → LATIN SMALL LETTER A
+	0x1D483: "b",                  // MATHEMATICAL BOLD ITALIC SMALL B → LATIN SMALL LETTER B
+	0x1D484: "c",                  // MATHEMATICAL BOLD ITALIC SMALL C → LATIN SMALL LETTER C
+	0x1D485: "d",                  // MATHEMATICAL BOLD ITALIC SMALL D → LATIN SMALL LETTER D
+	0x1D486: "e",                  // MATHEMATICAL BOLD ITALIC SMALL E → LATIN SMALL LETTER E
+	0x1D487: "f",                  // MATHEMATICAL BOLD ITALIC SMALL F → LATIN SMALL LETTER F
+	0x1D488: "g",                  // MATHEMATICAL BOLD ITALIC SMALL G → LATIN SMALL LETTER G
+	0x1D489: "h",                  // MATHEMATICAL BOLD ITALIC SMALL H → LATIN SMALL LETTER H
+	0x1D48A: "i",                  // MATHEMATICAL BOLD ITALIC SMALL I → LATIN SMALL LETTER I
+	0x1D48B: "j",                  // MATHEMATICAL BOLD ITALIC SMALL J → LATIN SMALL LETTER J
+	0x1D48C: "k",                  // MATHEMATICAL BOLD ITALIC SMALL K → LATIN SMALL LETTER K
+	0x1D48D: "l",                  // MATHEMATICAL BOLD ITALIC SMALL L → LATIN SMALL LETTER L
+	0x1D48E: "rn",                 // MATHEMATICAL BOLD ITALIC SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D48F: "n",                  // MATHEMATICAL BOLD ITALIC SMALL N → LATIN SMALL LETTER N
+	0x1D490: "o",                  // MATHEMATICAL BOLD ITALIC SMALL O → LATIN SMALL LETTER O
+	0x1D491: "p",                  // MATHEMATICAL BOLD ITALIC SMALL P → LATIN SMALL LETTER P
+	0x1D492: "q",                  // MATHEMATICAL BOLD ITALIC SMALL Q → LATIN SMALL LETTER Q
+	0x1D493: "r",                  // MATHEMATICAL BOLD ITALIC SMALL R → LATIN SMALL LETTER R
+	0x1D494: "s",                  // MATHEMATICAL BOLD ITALIC SMALL S → LATIN SMALL LETTER S
+	0x1D495: "t",                  // MATHEMATICAL BOLD ITALIC SMALL T → LATIN SMALL LETTER T
+	0x1D496: "u",                  // MATHEMATICAL BOLD ITALIC SMALL U → LATIN SMALL LETTER U
+	0x1D497: "v",                  // MATHEMATICAL BOLD ITALIC SMALL V → LATIN SMALL LETTER V
+	0x1D498: "w",                  // MATHEMATICAL BOLD ITALIC SMALL W → LATIN SMALL LETTER W
+	0x1D499: "x",                  // MATHEMATICAL BOLD ITALIC SMALL X → LATIN SMALL LETTER X
+	0x1D49A: "y",                  // MATHEMATICAL BOLD ITALIC SMALL Y → LATIN SMALL LETTER Y
+	0x1D49B: "z",                  // MATHEMATICAL BOLD ITALIC SMALL Z → LATIN SMALL LETTER Z
+	0x1D49C: "A",                  // MATHEMATICAL SCRIPT CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D49E: "C",                  // MATHEMATICAL SCRIPT CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D49F: "D",                  // MATHEMATICAL SCRIPT CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D4A2: "G",                  // MATHEMATICAL SCRIPT CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D4A5: "J",                  // MATHEMATICAL SCRIPT CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D4A6: "K",                  // MATHEMATICAL SCRIPT CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D4A9: "N",                  // MATHEMATICAL SCRIPT CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D4AA: "O",                  // MATHEMATICAL SCRIPT CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D4AB: "P",                  // MATHEMATICAL SCRIPT CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D4AC: "Q",                  // MATHEMATICAL SCRIPT CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D4AE: "S",                  // MATHEMATICAL SCRIPT CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D4AF: "T",                  // MATHEMATICAL SCRIPT CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D4B0: "U",                  // MATHEMATICAL SCRIPT CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D4B1: "V",                  // MATHEMATICAL SCRIPT CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D4B2: "W",                  // MATHEMATICAL SCRIPT CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D4B3: "X",                  // MATHEMATICAL SCRIPT CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D4B4: "Y",                  // MATHEMATICAL SCRIPT CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D4B5: "Z",                  // MATHEMATICAL SCRIPT CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D4B6: "a",                  // MATHEMATICAL SCRIPT SMALL A → LATIN SMALL LETTER A
+	0x1D4B7: "b",                  // MATHEMATICAL SCRIPT SMALL B → LATIN SMALL LETTER B
+	0x1D4B8: "c",                  // MATHEMATICAL SCRIPT SMALL C → LATIN SMALL LETTER C
+	0x1D4B9: "d",                  // MATHEMATICAL SCRIPT SMALL D → LATIN SMALL LETTER D
+	0x1D4BB: "f",                  // MATHEMATICAL SCRIPT SMALL F → LATIN SMALL LETTER F
+	0x1D4BD: "h",                  // MATHEMATICAL SCRIPT SMALL H → LATIN SMALL LETTER H
+	0x1D4BE: "i",                  // MATHEMATICAL SCRIPT SMALL I → LATIN SMALL LETTER I
+	0x1D4BF: "j",                  // MATHEMATICAL SCRIPT SMALL J → LATIN SMALL LETTER J
+	0x1D4C0: "k",                  // MATHEMATICAL SCRIPT SMALL K → LATIN SMALL LETTER K
+	0x1D4C1: "l",                  // MATHEMATICAL SCRIPT SMALL L → LATIN SMALL LETTER L
+	0x1D4C2: "rn",                 // MATHEMATICAL SCRIPT SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D4C3: "n",                  // MATHEMATICAL SCRIPT SMALL N → LATIN SMALL LETTER N
+	0x1D4C5: "p",                  // MATHEMATICAL SCRIPT SMALL P → LATIN SMALL LETTER P
+	0x1D4C6: "q",                  // MATHEMATICAL SCRIPT SMALL Q → LATIN SMALL LETTER Q
+	0x1D4C7: "r",                  // MATHEMATICAL SCRIPT SMALL R → LATIN SMALL LETTER R
+	0x1D4C8: "s",                  // MATHEMATICAL SCRIPT SMALL S → LATIN SMALL LETTER S
+	0x1D4C9: "t",                  // MATHEMATICAL SCRIPT SMALL T → LATIN SMALL LETTER T
+	0x1D4CA: "u",                  // MATHEMATICAL SCRIPT SMALL U → LATIN SMALL LETTER U
+	0x1D4CB: "v",                  // MATHEMATICAL SCRIPT SMALL V → LATIN SMALL LETTER V
+	0x1D4CC: "w",                  // MATHEMATICAL SCRIPT SMALL W → LATIN SMALL LETTER W
+	0x1D4CD: "x",                  // MATHEMATICAL SCRIPT SMALL X → LATIN SMALL LETTER X
+	0x1D4CE: "y",                  // MATHEMATICAL SCRIPT SMALL Y → LATIN SMALL LETTER Y
+	0x1D4CF: "z",                  // MATHEMATICAL SCRIPT SMALL Z → LATIN SMALL LETTER Z
+	0x1D4D0: "A",                  // MATHEMATICAL BOLD SCRIPT CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D4D1: "B",                  // MATHEMATICAL BOLD SCRIPT CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D4D2: "C",                  // MATHEMATICAL BOLD SCRIPT CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D4D3: "D",                  // MATHEMATICAL BOLD SCRIPT CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D4D4: "E",                  // MATHEMATICAL BOLD SCRIPT CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D4D5: "F",                  // MATHEMATICAL BOLD SCRIPT CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D4D6: "G",                  // MATHEMATICAL BOLD SCRIPT CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D4D7: "H",                  // MATHEMATICAL BOLD SCRIPT CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D4D8: "l",                  // MATHEMATICAL BOLD SCRIPT CAPITAL I → LATIN SMALL LETTER L
+	0x1D4D9: "J",                  // MATHEMATICAL BOLD SCRIPT CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D4DA: "K",                  // MATHEMATICAL BOLD SCRIPT CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D4DB: "L",                  // MATHEMATICAL BOLD SCRIPT CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D4DC: "M",                  // MATHEMATICAL BOLD SCRIPT CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D4DD: "N",                  // MATHEMATICAL BOLD SCRIPT CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D4DE: "O",                  // MATHEMATICAL BOLD SCRIPT CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D4DF: "P",                  // MATHEMATICAL BOLD SCRIPT CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D4E0: "Q",                  // MATHEMATICAL BOLD SCRIPT CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D4E1: "R",                  // MATHEMATICAL BOLD SCRIPT CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D4E2: "S",                  // MATHEMATICAL BOLD SCRIPT CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D4E3: "T",                  // MATHEMATICAL BOLD SCRIPT CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D4E4: "U",                  // MATHEMATICAL BOLD SCRIPT CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D4E5: "V",                  // MATHEMATICAL BOLD SCRIPT CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D4E6: "W",                  // MATHEMATICAL BOLD SCRIPT CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D4E7: "X",                  // MATHEMATICAL BOLD SCRIPT CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D4E8: "Y",                  // MATHEMATICAL BOLD SCRIPT CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D4E9: "Z",                  // MATHEMATICAL BOLD SCRIPT CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D4EA: "a",                  // MATHEMATICAL BOLD SCRIPT SMALL A → LATIN SMALL LETTER A
+	0x1D4EB: "b",                  // MATHEMATICAL BOLD SCRIPT SMALL B → LATIN SMALL LETTER B
+	0x1D4EC: "c",                  // MATHEMATICAL BOLD SCRIPT SMALL C → LATIN SMALL LETTER C
+	0x1D4ED: "d",                  // MATHEMATICAL BOLD SCRIPT SMALL D → LATIN SMALL LETTER D
+	0x1D4EE: "e",                  // MATHEMATICAL BOLD SCRIPT SMALL E → LATIN SMALL LETTER E
+	0x1D4EF: "f",                  // MATHEMATICAL BOLD SCRIPT SMALL F → LATIN SMALL LETTER F
+	0x1D4F0: "g",                  // MATHEMATICAL BOLD SCRIPT SMALL G → LATIN SMALL LETTER G
+	0x1D4F1: "h",                  // MATHEMATICAL BOLD SCRIPT SMALL H → LATIN SMALL LETTER H
+	0x1D4F2: "i",                  // MATHEMATICAL BOLD SCRIPT SMALL I → LATIN SMALL LETTER I
+	0x1D4F3: "j",                  // MATHEMATICAL BOLD SCRIPT SMALL J → LATIN SMALL LETTER J
+	0x1D4F4: "k",                  // MATHEMATICAL BOLD SCRIPT SMALL K → LATIN SMALL LETTER K
+	0x1D4F5: "l",                  // MATHEMATICAL BOLD SCRIPT SMALL L → LATIN SMALL LETTER L
+	0x1D4F6: "rn",                 // MATHEMATICAL BOLD SCRIPT SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D4F7: "n",                  // MATHEMATICAL BOLD SCRIPT SMALL N → LATIN SMALL LETTER N
+	0x1D4F8: "o",                  // MATHEMATICAL BOLD SCRIPT SMALL O → LATIN SMALL LETTER O
+	0x1D4F9: "p",                  // MATHEMATICAL BOLD SCRIPT SMALL P → LATIN SMALL LETTER P
+	0x1D4FA: "q",                  // MATHEMATICAL BOLD SCRIPT SMALL Q → LATIN SMALL LETTER Q
+	0x1D4FB: "r",                  // MATHEMATICAL BOLD SCRIPT SMALL R → LATIN SMALL LETTER R
+	0x1D4FC: "s",                  // MATHEMATICAL BOLD SCRIPT SMALL S → LATIN SMALL LETTER S
+	0x1D4FD: "t",                  // MATHEMATICAL BOLD SCRIPT SMALL T → LATIN SMALL LETTER T
+	0x1D4FE: "u",                  // MATHEMATICAL BOLD SCRIPT SMALL U → LATIN SMALL LETTER U
+	0x1D4FF: "v",                  // MATHEMATICAL BOLD SCRIPT SMALL V → LATIN SMALL LETTER V
+	0x1D500: "w",                  // MATHEMATICAL BOLD SCRIPT SMALL W → LATIN SMALL LETTER W
+	0x1D501: "x",                  // MATHEMATICAL BOLD SCRIPT SMALL X → LATIN SMALL LETTER X
+	0x1D502: "y",                  // MATHEMATICAL BOLD SCRIPT SMALL Y → LATIN SMALL LETTER Y
+	0x1D503: "z",                  // MATHEMATICAL BOLD SCRIPT SMALL Z → LATIN SMALL LETTER Z
+	0x1D504: "A",                  // MATHEMATICAL FRAKTUR CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D505: "B",                  // MATHEMATICAL FRAKTUR CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D507: "D",                  // MATHEMATICAL FRAKTUR CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D508: "E",                  // MATHEMATICAL FRAKTUR CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D509: "F",                  // MATHEMATICAL FRAKTUR CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D50A: "G",                  // MATHEMATICAL FRAKTUR CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D50D: "J",                  // MATHEMATICAL FRAKTUR CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D50E: "K",                  // MATHEMATICAL FRAKTUR CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D50F: "L",                  // MATHEMATICAL FRAKTUR CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D510: "M",                  // MATHEMATICAL FRAKTUR CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D511: "N",                  // MATHEMATICAL FRAKTUR CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D512: "O",                  // MATHEMATICAL FRAKTUR CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D513: "P",                  // MATHEMATICAL FRAKTUR CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D514: "Q",                  // MATHEMATICAL FRAKTUR CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D516: "S",                  // MATHEMATICAL FRAKTUR CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D517: "T",                  // MATHEMATICAL FRAKTUR CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D518: "U",                  // MATHEMATICAL FRAKTUR CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D519: "V",                  // MATHEMATICAL FRAKTUR CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D51A: "W",                  // MATHEMATICAL FRAKTUR CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D51B: "X",                  // MATHEMATICAL FRAKTUR CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D51C: "Y",                  // MATHEMATICAL FRAKTUR CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D51E: "a",                  // MATHEMATICAL FRAKTUR SMALL A → LATIN SMALL LETTER A
+	0x1D51F: "b",                  // MATHEMATICAL FRAKTUR SMALL B → LATIN SMALL LETTER B
+	0x1D520: "c",                  // MATHEMATICAL FRAKTUR SMALL C → LATIN SMALL LETTER C
+	0x1D521: "d",                  // MATHEMATICAL FRAKTUR SMALL D → LATIN SMALL LETTER D
+	0x1D522: "e",                  // MATHEMATICAL FRAKTUR SMALL E → LATIN SMALL LETTER E
+	0x1D523: "f",                  // MATHEMATICAL FRAKTUR SMALL F → LATIN SMALL LETTER F
+	0x1D524: "g",                  // MATHEMATICAL FRAKTUR SMALL G → LATIN SMALL LETTER G
+	0x1D525: "h",                  // MATHEMATICAL FRAKTUR SMALL H → LATIN SMALL LETTER H
+	0x1D526: "i",                  // MATHEMATICAL FRAKTUR SMALL I → LATIN SMALL LETTER I
+	0x1D527: "j",                  // MATHEMATICAL FRAKTUR SMALL J → LATIN SMALL LETTER J
+	0x1D528: "k",                  // MATHEMATICAL FRAKTUR SMALL K → LATIN SMALL LETTER K
+	0x1D529: "l",                  // MATHEMATICAL FRAKTUR SMALL L → LATIN SMALL LETTER L
+	0x1D52A: "rn",                 // MATHEMATICAL FRAKTUR SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D52B: "n",                  // MATHEMATICAL FRAKTUR SMALL N → LATIN SMALL LETTER N
+	0x1D52C: "o",                  // MATHEMATICAL FRAKTUR SMALL O → LATIN SMALL LETTER O
+	0x1D52D: "p",                  // MATHEMATICAL FRAKTUR SMALL P → LATIN SMALL LETTER P
+	0x1D52E: "q",                  // MATHEMATICAL FRAKTUR SMALL Q → LATIN SMALL LETTER Q
+	0x1D52F: "r",                  // MATHEMATICAL FRAKTUR SMALL R → LATIN SMALL LETTER R
+	0x1D530: "s",                  // MATHEMATICAL FRAKTUR SMALL S → LATIN SMALL LETTER S
+	0x1D531: "t",                  // MATHEMATICAL FRAKTUR SMALL T → LATIN SMALL LETTER T
+	0x1D532: "u",                  // MATHEMATICAL FRAKTUR SMALL U → LATIN SMALL LETTER U
+	0x1D533: "v",                  // MATHEMATICAL FRAKTUR SMALL V → LATIN SMALL LETTER V
+	0x1D534: "w",                  // MATHEMATICAL FRAKTUR SMALL W → LATIN SMALL LETTER W
+	0x1D535: "x",                  // MATHEMATICAL FRAKTUR SMALL X → LATIN SMALL LETTER X
+	0x1D536: "y",                  // MATHEMATICAL FRAKTUR SMALL Y → LATIN SMALL LETTER Y
+	0x1D537: "z",                  // MATHEMATICAL FRAKTUR SMALL Z → LATIN SMALL LETTER Z
+	0x1D538: "A",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D539: "B",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D53B: "D",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D53C: "E",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D53D: "F",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D53E: "G",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D540: "l",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL I → LATIN SMALL LETTER L
+	0x1D541: "J",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D542: "K",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D543: "L",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D544: "M",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D546: "O",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D54A: "S",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D54B: "T",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D54C: "U",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D54D: "V",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D54E: "W",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D54F: "X",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D550: "Y",                  // MATHEMATICAL DOUBLE-STRUCK CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D552: "a",                  // MATHEMATICAL DOUBLE-STRUCK SMALL A → LATIN SMALL LETTER A
+	0x1D553: "b",                  // MATHEMATICAL DOUBLE-STRUCK SMALL B → LATIN SMALL LETTER B
+	0x1D554: "c",                  // MATHEMATICAL DOUBLE-STRUCK SMALL C → LATIN SMALL LETTER C
+	0x1D555: "d",                  // MATHEMATICAL DOUBLE-STRUCK SMALL D → LATIN SMALL LETTER D
+	0x1D556: "e",                  // MATHEMATICAL DOUBLE-STRUCK SMALL E → LATIN SMALL LETTER E
+	0x1D557: "f",                  // MATHEMATICAL DOUBLE-STRUCK SMALL F → LATIN SMALL LETTER F
+	0x1D558: "g",                  // MATHEMATICAL DOUBLE-STRUCK SMALL G → LATIN SMALL LETTER G
+	0x1D559: "h",                  // MATHEMATICAL DOUBLE-STRUCK SMALL H → LATIN SMALL LETTER H
+	0x1D55A: "i",                  // MATHEMATICAL DOUBLE-STRUCK SMALL I → LATIN SMALL LETTER I
+	0x1D55B: "j",                  // MATHEMATICAL DOUBLE-STRUCK SMALL J → LATIN SMALL LETTER J
+	0x1D55C: "k",                  // MATHEMATICAL DOUBLE-STRUCK SMALL K → LATIN SMALL LETTER K
+	0x1D55D: "l",                  // MATHEMATICAL DOUBLE-STRUCK SMALL L → LATIN SMALL LETTER L
+	0x1D55E: "rn",                 // MATHEMATICAL DOUBLE-STRUCK SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D55F: "n",                  // MATHEMATICAL DOUBLE-STRUCK SMALL N → LATIN SMALL LETTER N
+	0x1D560: "o",                  // MATHEMATICAL DOUBLE-STRUCK SMALL O → LATIN SMALL LETTER O
+	0x1D561: "p",                  // MATHEMATICAL DOUBLE-STRUCK SMALL P → LATIN SMALL LETTER P
+	0x1D562: "q",                  // MATHEMATICAL DOUBLE-STRUCK SMALL Q → LATIN SMALL LETTER Q
+	0x1D563: "r",                  // MATHEMATICAL DOUBLE-STRUCK SMALL R → LATIN SMALL LETTER R
+	0x1D564: "s",                  // MATHEMATICAL DOUBLE-STRUCK SMALL S → LATIN SMALL LETTER S
+	0x1D565: "t",                  // MATHEMATICAL DOUBLE-STRUCK SMALL T → LATIN SMALL LETTER T
+	0x1D566: "u",                  // MATHEMATICAL DOUBLE-STRUCK SMALL U → LATIN SMALL LETTER U
+	0x1D567: "v",                  // MATHEMATICAL DOUBLE-STRUCK SMALL V → LATIN SMALL LETTER V
+	0x1D568: "w",                  // MATHEMATICAL DOUBLE-STRUCK SMALL W → LATIN SMALL LETTER W
+	0x1D569: "x",                  // MATHEMATICAL DOUBLE-STRUCK SMALL X → LATIN SMALL LETTER X
+	0x1D56A: "y",                  // MATHEMATICAL DOUBLE-STRUCK SMALL Y → LATIN SMALL LETTER Y
+	0x1D56B: "z",                  // MATHEMATICAL DOUBLE-STRUCK SMALL Z → LATIN SMALL LETTER Z
+	0x1D56C: "A",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D56D: "B",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D56E: "C",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D56F: "D",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D570: "E",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D571: "F",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D572: "G",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D573: "H",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D574: "l",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL I → LATIN SMALL LETTER L
+	0x1D575: "J",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D576: "K",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D577: "L",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D578: "M",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D579: "N",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D57A: "O",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D57B: "P",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D57C: "Q",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D57D: "R",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D57E: "S",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D57F: "T",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D580: "U",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D581: "V",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D582: "W",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D583: "X",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D584: "Y",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D585: "Z",                  // MATHEMATICAL BOLD FRAKTUR CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D586: "a",                  // MATHEMATICAL BOLD FRAKTUR SMALL A → LATIN SMALL LETTER A
+	0x1D587: "b",                  // MATHEMATICAL BOLD FRAKTUR SMALL B → LATIN SMALL LETTER B
+	0x1D588: "c",                  // MATHEMATICAL BOLD FRAKTUR SMALL C → LATIN SMALL LETTER C
+	0x1D589: "d",                  // MATHEMATICAL BOLD FRAKTUR SMALL D → LATIN SMALL LETTER D
+	0x1D58A: "e",                  // MATHEMATICAL BOLD FRAKTUR SMALL E → LATIN SMALL LETTER E
+	0x1D58B: "f",                  // MATHEMATICAL BOLD FRAKTUR SMALL F → LATIN SMALL LETTER F
+	0x1D58C: "g",                  // MATHEMATICAL BOLD FRAKTUR SMALL G → LATIN SMALL LETTER G
+	0x1D58D: "h",                  // MATHEMATICAL BOLD FRAKTUR SMALL H → LATIN SMALL LETTER H
+	0x1D58E: "i",                  // MATHEMATICAL BOLD FRAKTUR SMALL I → LATIN SMALL LETTER I
+	0x1D58F: "j",                  // MATHEMATICAL BOLD FRAKTUR SMALL J → LATIN SMALL LETTER J
+	0x1D590: "k",                  // MATHEMATICAL BOLD FRAKTUR SMALL K → LATIN SMALL LETTER K
+	0x1D591: "l",                  // MATHEMATICAL BOLD FRAKTUR SMALL L → LATIN SMALL LETTER L
+	0x1D592: "rn",                 // MATHEMATICAL BOLD FRAKTUR SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D593: "n",                  // MATHEMATICAL BOLD FRAKTUR SMALL N → LATIN SMALL LETTER N
+	0x1D594: "o",                  // MATHEMATICAL BOLD FRAKTUR SMALL O → LATIN SMALL LETTER O
+	0x1D595: "p",                  // MATHEMATICAL BOLD FRAKTUR SMALL P → LATIN SMALL LETTER P
+	0x1D596: "q",                  // MATHEMATICAL BOLD FRAKTUR SMALL Q → LATIN SMALL LETTER Q
+	0x1D597: "r",                  // MATHEMATICAL BOLD FRAKTUR SMALL R → LATIN SMALL LETTER R
+	0x1D598: "s",                  // MATHEMATICAL BOLD FRAKTUR SMALL S → LATIN SMALL LETTER S
+	0x1D599: "t",                  // MATHEMATICAL BOLD FRAKTUR SMALL T → LATIN SMALL LETTER T
+	0x1D59A: "u",                  // MATHEMATICAL BOLD FRAKTUR SMALL U → LATIN SMALL LETTER U
+	0x1D59B: "v",                  // MATHEMATICAL BOLD FRAKTUR SMALL V → LATIN SMALL LETTER V
+	0x1D59C: "w",                  // MATHEMATICAL BOLD FRAKTUR SMALL W → LATIN SMALL LETTER W
+	0x1D59D: "x",                  // MATHEMATICAL BOLD FRAKTUR SMALL X → LATIN SMALL LETTER X
+	0x1D59E: "y",                  // MATHEMATICAL BOLD FRAKTUR SMALL Y → LATIN SMALL LETTER Y
+	0x1D59F: "z",                  // MATHEMATICAL BOLD FRAKTUR SMALL Z → LATIN SMALL LETTER Z
+	0x1D5A0: "A",                  // MATHEMATICAL SANS-SERIF CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D5A1: "B",                  // MATHEMATICAL SANS-SERIF CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D5A2: "C",                  // MATHEMATICAL SANS-SERIF CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D5A3: "D",                  // MATHEMATICAL SANS-SERIF CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D5A4: "E",                  // MATHEMATICAL SANS-SERIF CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D5A5: "F",                  // MATHEMATICAL SANS-SERIF CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D5A6: "G",                  // MATHEMATICAL SANS-SERIF CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D5A7: "H",                  // MATHEMATICAL SANS-SERIF CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D5A8: "l",                  // MATHEMATICAL SANS-SERIF CAPITAL I → LATIN SMALL LETTER L
+	0x1D5A9: "J",                  // MATHEMATICAL SANS-SERIF CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D5AA: "K",                  // MATHEMATICAL SANS-SERIF CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D5AB: "L",                  // MATHEMATICAL SANS-SERIF CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D5AC: "M",                  // MATHEMATICAL SANS-SERIF CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D5AD: "N",                  // MATHEMATICAL SANS-SERIF CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D5AE: "O",                  // MATHEMATICAL SANS-SERIF CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D5AF: "P",                  // MATHEMATICAL SANS-SERIF CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D5B0: "Q",                  // MATHEMATICAL SANS-SERIF CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D5B1: "R",                  // MATHEMATICAL SANS-SERIF CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D5B2: "S",                  // MATHEMATICAL SANS-SERIF CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D5B3: "T",                  // MATHEMATICAL SANS-SERIF CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D5B4: "U",                  // MATHEMATICAL SANS-SERIF CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D5B5: "V",                  // MATHEMATICAL SANS-SERIF CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D5B6: "W",                  // MATHEMATICAL SANS-SERIF CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D5B7: "X",                  // MATHEMATICAL SANS-SERIF CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D5B8: "Y",                  // MATHEMATICAL SANS-SERIF CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D5B9: "Z",                  // MATHEMATICAL SANS-SERIF CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D5BA: "a",                  // MATHEMATICAL SANS-SERIF SMALL A → LATIN SMALL LETTER A
+	0x1D5BB: "b",                  // MATHEMATICAL SANS-SERIF SMALL B → LATIN SMALL LETTER B
+	0x1D5BC: "c",                  // MATHEMATICAL SANS-SERIF SMALL C → LATIN SMALL LETTER C
+	0x1D5BD: "d",                  // MATHEMATICAL SANS-SERIF SMALL D → LATIN SMALL LETTER D
+	0x1D5BE: "e",                  // MATHEMATICAL SANS-SERIF SMALL E → LATIN SMALL LETTER E
+	0x1D5BF: "f",                  // MATHEMATICAL SANS-SERIF SMALL F → LATIN SMALL LETTER F
+	0x1D5C0: "g",                  // MATHEMATICAL SANS-SERIF SMALL G → LATIN SMALL LETTER G
+	0x1D5C1: "h",                  // MATHEMATICAL SANS-SERIF SMALL H → LATIN SMALL LETTER H
+	0x1D5C2: "i",                  // MATHEMATICAL SANS-SERIF SMALL I → LATIN SMALL LETTER I
+	0x1D5C3: "j",                  // MATHEMATICAL SANS-SERIF SMALL J → LATIN SMALL LETTER J
+	0x1D5C4: "k",                  // MATHEMATICAL SANS-SERIF SMALL K → LATIN SMALL LETTER K
+	0x1D5C5: "l",                  // MATHEMATICAL SANS-SERIF SMALL L → LATIN SMALL LETTER L
+	0x1D5C6: "rn",                 // MATHEMATICAL SANS-SERIF SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D5C7: "n",                  // MATHEMATICAL SANS-SERIF SMALL N → LATIN SMALL LETTER N
+	0x1D5C8: "o",                  // MATHEMATICAL SANS-SERIF SMALL O → LATIN SMALL LETTER O
+	0x1D5C9: "p",                  // MATHEMATICAL SANS-SERIF SMALL P → LATIN SMALL LETTER P
+	0x1D5CA: "q",                  // MATHEMATICAL SANS-SERIF SMALL Q → LATIN SMALL LETTER Q
+	0x1D5CB: "r",                  // MATHEMATICAL SANS-SERIF SMALL R → LATIN SMALL LETTER R
+	0x1D5CC: "s",                  // MATHEMATICAL SANS-SERIF SMALL S → LATIN SMALL LETTER S
+	0x1D5CD: "t",                  // MATHEMATICAL SANS-SERIF SMALL T → LATIN SMALL LETTER T
+	0x1D5CE: "u",                  // MATHEMATICAL SANS-SERIF SMALL U → LATIN SMALL LETTER U
+	0x1D5CF: "v",                  // MATHEMATICAL SANS-SERIF SMALL V → LATIN SMALL LETTER V
+	0x1D5D0: "w",                  // MATHEMATICAL SANS-SERIF SMALL W → LATIN SMALL LETTER W
+	0x1D5D1: "x",                  // MATHEMATICAL SANS-SERIF SMALL X → LATIN SMALL LETTER X
+	0x1D5D2: "y",                  // MATHEMATICAL SANS-SERIF SMALL Y → LATIN SMALL LETTER Y
+	0x1D5D3: "z",                  // MATHEMATICAL SANS-SERIF SMALL Z → LATIN SMALL LETTER Z
+	0x1D5D4: "A",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D5D5: "B",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D5D6: "C",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D5D7: "D",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D5D8: "E",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D5D9: "F",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D5DA: "G",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D5DB: "H",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D5DC: "l",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL I → LATIN SMALL LETTER L
+	0x1D5DD: "J",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D5DE: "K",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D5DF: "L",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D5E0: "M",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D5E1: "N",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D5E2: "O",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D5E3: "P",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D5E4: "Q",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D5E5: "R",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D5E6: "S",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D5E7: "T",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D5E8: "U",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D5E9: "V",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D5EA: "W",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D5EB: "X",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D5EC: "Y",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D5ED: "Z",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D5EE: "a",                  // MATHEMATICAL SANS-SERIF BOLD SMALL A → LATIN SMALL LETTER A
+	0x1D5EF: "b",                  // MATHEMATICAL SANS-SERIF BOLD SMALL B → LATIN SMALL LETTER B
+	0x1D5F0: "c",                  // MATHEMATICAL SANS-SERIF BOLD SMALL C → LATIN SMALL LETTER C
+	0x1D5F1: "d",                  // MATHEMATICAL SANS-SERIF BOLD SMALL D → LATIN SMALL LETTER D
+	0x1D5F2: "e",                  // MATHEMATICAL SANS-SERIF BOLD SMALL E → LATIN SMALL LETTER E
+	0x1D5F3: "f",                  // MATHEMATICAL SANS-SERIF BOLD SMALL F → LATIN SMALL LETTER F
+	0x1D5F4: "g",                  // MATHEMATICAL SANS-SERIF BOLD SMALL G → LATIN SMALL LETTER G
+	0x1D5F5: "h",                  // MATHEMATICAL SANS-SERIF BOLD SMALL H → LATIN SMALL LETTER H
+	0x1D5F6: "i",                  // MATHEMATICAL SANS-SERIF BOLD SMALL I → LATIN SMALL LETTER I
+	0x1D5F7: "j",                  // MATHEMATICAL SANS-SERIF BOLD SMALL J → LATIN SMALL LETTER J
+	0x1D5F8: "k",                  // MATHEMATICAL SANS-SERIF BOLD SMALL K → LATIN SMALL LETTER K
+	0x1D5F9: "l",                  // MATHEMATICAL SANS-SERIF BOLD SMALL L → LATIN SMALL LETTER L
+	0x1D5FA: "rn",                 // MATHEMATICAL SANS-SERIF BOLD SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D5FB: "n",                  // MATHEMATICAL SANS-SERIF BOLD SMALL N → LATIN SMALL LETTER N
+	0x1D5FC: "o",                  // MATHEMATICAL SANS-SERIF BOLD SMALL O → LATIN SMALL LETTER O
+	0x1D5FD: "p",                  // MATHEMATICAL SANS-SERIF BOLD SMALL P → LATIN SMALL LETTER P
+	0x1D5FE: "q",                  // MATHEMATICAL SANS-SERIF BOLD SMALL Q → LATIN SMALL LETTER Q
+	0x1D5FF: "r",                  // MATHEMATICAL SANS-SERIF BOLD SMALL R → LATIN SMALL LETTER R
+	0x1D600: "s",                  // MATHEMATICAL SANS-SERIF BOLD SMALL S → LATIN SMALL LETTER S
+	0x1D601: "t",                  // MATHEMATICAL SANS-SERIF BOLD SMALL T → LATIN SMALL LETTER T
+	0x1D602: "u",                  // MATHEMATICAL SANS-SERIF BOLD SMALL U → LATIN SMALL LETTER U
+	0x1D603: "v",                  // MATHEMATICAL SANS-SERIF BOLD SMALL V → LATIN SMALL LETTER V
+	0x1D604: "w",                  // MATHEMATICAL SANS-SERIF BOLD SMALL W → LATIN SMALL LETTER W
+	0x1D605: "x",                  // MATHEMATICAL SANS-SERIF BOLD SMALL X → LATIN SMALL LETTER X
+	0x1D606: "y",                  // MATHEMATICAL SANS-SERIF BOLD SMALL Y → LATIN SMALL LETTER Y
+	0x1D607: "z",                  // MATHEMATICAL SANS-SERIF BOLD SMALL Z → LATIN SMALL LETTER Z
+	0x1D608: "A",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D609: "B",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D60A: "C",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D60B: "D",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D60C: "E",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D60D: "F",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D60E: "G",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D60F: "H",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D610: "l",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL I → LATIN SMALL LETTER L
+	0x1D611: "J",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D612: "K",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D613: "L",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D614: "M",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D615: "N",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D616: "O",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D617: "P",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D618: "Q",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D619: "R",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D61A: "S",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D61B: "T",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D61C: "U",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D61D: "V",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D61E: "W",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D61F: "X",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D620: "Y",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D621: "Z",                  // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D622: "a",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL A → LATIN SMALL LETTER A
+	0x1D623: "b",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL B → LATIN SMALL LETTER B
+	0x1D624: "c",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL C → LATIN SMALL LETTER C
+	0x1D625: "d",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL D → LATIN SMALL LETTER D
+	0x1D626: "e",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL E → LATIN SMALL LETTER E
+	0x1D627: "f",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL F → LATIN SMALL LETTER F
+	0x1D628: "g",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL G → LATIN SMALL LETTER G
+	0x1D629: "h",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL H → LATIN SMALL LETTER H
+	0x1D62A: "i",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL I → LATIN SMALL LETTER I
+	0x1D62B: "j",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL J → LATIN SMALL LETTER J
+	0x1D62C: "k",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL K → LATIN SMALL LETTER K
+	0x1D62D: "l",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL L → LATIN SMALL LETTER L
+	0x1D62E: "rn",                 // MATHEMATICAL SANS-SERIF ITALIC SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D62F: "n",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL N → LATIN SMALL LETTER N
+	0x1D630: "o",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL O → LATIN SMALL LETTER O
+	0x1D631: "p",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL P → LATIN SMALL LETTER P
+	0x1D632: "q",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL Q → LATIN SMALL LETTER Q
+	0x1D633: "r",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL R → LATIN SMALL LETTER R
+	0x1D634: "s",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL S → LATIN SMALL LETTER S
+	0x1D635: "t",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL T → LATIN SMALL LETTER T
+	0x1D636: "u",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL U → LATIN SMALL LETTER U
+	0x1D637: "v",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL V → LATIN SMALL LETTER V
+	0x1D638: "w",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL W → LATIN SMALL LETTER W
+	0x1D639: "x",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL X → LATIN SMALL LETTER X
+	0x1D63A: "y",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL Y → LATIN SMALL LETTER Y
+	0x1D63B: "z",                  // MATHEMATICAL SANS-SERIF ITALIC SMALL Z → LATIN SMALL LETTER Z
+	0x1D63C: "A",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D63D: "B",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D63E: "C",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D63F: "D",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D640: "E",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D641: "F",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D642: "G",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D643: "H",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D644: "l",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL I → LATIN SMALL LETTER L
+	0x1D645: "J",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D646: "K",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D647: "L",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D648: "M",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D649: "N",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D64A: "O",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D64B: "P",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D64C: "Q",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D64D: "R",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D64E: "S",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D64F: "T",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D650: "U",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D651: "V",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D652: "W",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D653: "X",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D654: "Y",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D655: "Z",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D656: "a",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL A → LATIN SMALL LETTER A
+	0x1D657: "b",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL B → LATIN SMALL LETTER B
+	0x1D658: "c",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL C → LATIN SMALL LETTER C
+	0x1D659: "d",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL D → LATIN SMALL LETTER D
+	0x1D65A: "e",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL E → LATIN SMALL LETTER E
+	0x1D65B: "f",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL F → LATIN SMALL LETTER F
+	0x1D65C: "g",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL G → LATIN SMALL LETTER G
+	0x1D65D: "h",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL H → LATIN SMALL LETTER H
+	0x1D65E: "i",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL I → LATIN SMALL LETTER I
+	0x1D65F: "j",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL J → LATIN SMALL LETTER J
+	0x1D660: "k",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL K → LATIN SMALL LETTER K
+	0x1D661: "l",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL L → LATIN SMALL LETTER L
+	0x1D662: "rn",                 // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D663: "n",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL N → LATIN SMALL LETTER N
+	0x1D664: "o",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL O → LATIN SMALL LETTER O
+	0x1D665: "p",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL P → LATIN SMALL LETTER P
+	0x1D666: "q",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Q → LATIN SMALL LETTER Q
+	0x1D667: "r",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL R → LATIN SMALL LETTER R
+	0x1D668: "s",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL S → LATIN SMALL LETTER S
+	0x1D669: "t",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL T → LATIN SMALL LETTER T
+	0x1D66A: "u",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL U → LATIN SMALL LETTER U
+	0x1D66B: "v",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL V → LATIN SMALL LETTER V
+	0x1D66C: "w",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL W → LATIN SMALL LETTER W
+	0x1D66D: "x",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL X → LATIN SMALL LETTER X
+	0x1D66E: "y",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Y → LATIN SMALL LETTER Y
+	0x1D66F: "z",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Z → LATIN SMALL LETTER Z
+	0x1D670: "A",                  // MATHEMATICAL MONOSPACE CAPITAL A → LATIN CAPITAL LETTER A
+	0x1D671: "B",                  // MATHEMATICAL MONOSPACE CAPITAL B → LATIN CAPITAL LETTER B
+	0x1D672: "C",                  // MATHEMATICAL MONOSPACE CAPITAL C → LATIN CAPITAL LETTER C
+	0x1D673: "D",                  // MATHEMATICAL MONOSPACE CAPITAL D → LATIN CAPITAL LETTER D
+	0x1D674: "E",                  // MATHEMATICAL MONOSPACE CAPITAL E → LATIN CAPITAL LETTER E
+	0x1D675: "F",                  // MATHEMATICAL MONOSPACE CAPITAL F → LATIN CAPITAL LETTER F
+	0x1D676: "G",                  // MATHEMATICAL MONOSPACE CAPITAL G → LATIN CAPITAL LETTER G
+	0x1D677: "H",                  // MATHEMATICAL MONOSPACE CAPITAL H → LATIN CAPITAL LETTER H
+	0x1D678: "l",                  // MATHEMATICAL MONOSPACE CAPITAL I → LATIN SMALL LETTER L
+	0x1D679: "J",                  // MATHEMATICAL MONOSPACE CAPITAL J → LATIN CAPITAL LETTER J
+	0x1D67A: "K",                  // MATHEMATICAL MONOSPACE CAPITAL K → LATIN CAPITAL LETTER K
+	0x1D67B: "L",                  // MATHEMATICAL MONOSPACE CAPITAL L → LATIN CAPITAL LETTER L
+	0x1D67C: "M",                  // MATHEMATICAL MONOSPACE CAPITAL M → LATIN CAPITAL LETTER M
+	0x1D67D: "N",                  // MATHEMATICAL MONOSPACE CAPITAL N → LATIN CAPITAL LETTER N
+	0x1D67E: "O",                  // MATHEMATICAL MONOSPACE CAPITAL O → LATIN CAPITAL LETTER O
+	0x1D67F: "P",                  // MATHEMATICAL MONOSPACE CAPITAL P → LATIN CAPITAL LETTER P
+	0x1D680: "Q",                  // MATHEMATICAL MONOSPACE CAPITAL Q → LATIN CAPITAL LETTER Q
+	0x1D681: "R",                  // MATHEMATICAL MONOSPACE CAPITAL R → LATIN CAPITAL LETTER R
+	0x1D682: "S",                  // MATHEMATICAL MONOSPACE CAPITAL S → LATIN CAPITAL LETTER S
+	0x1D683: "T",                  // MATHEMATICAL MONOSPACE CAPITAL T → LATIN CAPITAL LETTER T
+	0x1D684: "U",                  // MATHEMATICAL MONOSPACE CAPITAL U → LATIN CAPITAL LETTER U
+	0x1D685: "V",                  // MATHEMATICAL MONOSPACE CAPITAL V → LATIN CAPITAL LETTER V
+	0x1D686: "W",                  // MATHEMATICAL MONOSPACE CAPITAL W → LATIN CAPITAL LETTER W
+	0x1D687: "X",                  // MATHEMATICAL MONOSPACE CAPITAL X → LATIN CAPITAL LETTER X
+	0x1D688: "Y",                  // MATHEMATICAL MONOSPACE CAPITAL Y → LATIN CAPITAL LETTER Y
+	0x1D689: "Z",                  // MATHEMATICAL MONOSPACE CAPITAL Z → LATIN CAPITAL LETTER Z
+	0x1D68A: "a",                  // MATHEMATICAL MONOSPACE SMALL A → LATIN SMALL LETTER A
+	0x1D68B: "b",                  // MATHEMATICAL MONOSPACE SMALL B → LATIN SMALL LETTER B
+	0x1D68C: "c",                  // MATHEMATICAL MONOSPACE SMALL C → LATIN SMALL LETTER C
+	0x1D68D: "d",                  // MATHEMATICAL MONOSPACE SMALL D → LATIN SMALL LETTER D
+	0x1D68E: "e",                  // MATHEMATICAL MONOSPACE SMALL E → LATIN SMALL LETTER E
+	0x1D68F: "f",                  // MATHEMATICAL MONOSPACE SMALL F → LATIN SMALL LETTER F
+	0x1D690: "g",                  // MATHEMATICAL MONOSPACE SMALL G → LATIN SMALL LETTER G
+	0x1D691: "h",                  // MATHEMATICAL MONOSPACE SMALL H → LATIN SMALL LETTER H
+	0x1D692: "i",                  // MATHEMATICAL MONOSPACE SMALL I → LATIN SMALL LETTER I
+	0x1D693: "j",                  // MATHEMATICAL MONOSPACE SMALL J → LATIN SMALL LETTER J
+	0x1D694: "k",                  // MATHEMATICAL MONOSPACE SMALL K → LATIN SMALL LETTER K
+	0x1D695: "l",                  // MATHEMATICAL MONOSPACE SMALL L → LATIN SMALL LETTER L
+	0x1D696: "rn",                 // MATHEMATICAL MONOSPACE SMALL M → LATIN SMALL LETTER R + LATIN SMALL LETTER N
+	0x1D697: "n",                  // MATHEMATICAL MONOSPACE SMALL N → LATIN SMALL LETTER N
+	0x1D698: "o",                  // MATHEMATICAL MONOSPACE SMALL O → LATIN SMALL LETTER O
+	0x1D699: "p",                  // MATHEMATICAL MONOSPACE SMALL P → LATIN SMALL LETTER P
+	0x1D69A: "q",                  // MATHEMATICAL MONOSPACE SMALL Q → LATIN SMALL LETTER Q
+	0x1D69B: "r",                  // MATHEMATICAL MONOSPACE SMALL R → LATIN SMALL LETTER R
+	0x1D69C: "s",                  // MATHEMATICAL MONOSPACE SMALL S → LATIN SMALL LETTER S
+	0x1D69D: "t",                  // MATHEMATICAL MONOSPACE SMALL T → LATIN SMALL LETTER T
+	0x1D69E: "u",                  // MATHEMATICAL MONOSPACE SMALL U → LATIN SMALL LETTER U
+	0x1D69F: "v",                  // MATHEMATICAL MONOSPACE SMALL V → LATIN SMALL LETTER V
+	0x1D6A0: "w",                  // MATHEMATICAL MONOSPACE SMALL W → LATIN SMALL LETTER W
+	0x1D6A1: "x",                  // MATHEMATICAL MONOSPACE SMALL X → LATIN SMALL LETTER X
+	0x1D6A2: "y",                  // MATHEMATICAL MONOSPACE SMALL Y → LATIN SMALL LETTER Y
+	0x1D6A3: "z",                  // MATHEMATICAL MONOSPACE SMALL Z → LATIN SMALL LETTER Z
+	0x1D6A4: "i",                  // MATHEMATICAL ITALIC SMALL DOTLESS I → LATIN SMALL LETTER I
+	0x1D6A5: "ȷ",                  // MATHEMATICAL ITALIC SMALL DOTLESS J → LATIN SMALL LETTER DOTLESS J
+	0x1D6A8: "A",                  // MATHEMATICAL BOLD CAPITAL ALPHA → LATIN CAPITAL LETTER A
+	0x1D6A9: "B",                  // MATHEMATICAL BOLD CAPITAL BETA → LATIN CAPITAL LETTER B
+	0x1D6AA: "Γ",                  // MATHEMATICAL BOLD CAPITAL GAMMA → GREEK CAPITAL LETTER GAMMA
+	0x1D6AB: "Δ",                  // MATHEMATICAL BOLD CAPITAL DELTA → GREEK CAPITAL LETTER DELTA
+	0x1D6AC: "E",                  // MATHEMATICAL BOLD CAPITAL EPSILON → LATIN CAPITAL LETTER E
+	0x1D6AD: "Z",                  // MATHEMATICAL BOLD CAPITAL ZETA → LATIN CAPITAL LETTER Z
+	0x1D6AE: "H",                  // MATHEMATICAL BOLD CAPITAL ETA → LATIN CAPITAL LETTER H
+	0x1D6AF: "O̵",                 // MATHEMATICAL BOLD CAPITAL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D6B0: "l",                  // MATHEMATICAL BOLD CAPITAL IOTA → LATIN SMALL LETTER L
+	0x1D6B1: "K",                  // MATHEMATICAL BOLD CAPITAL KAPPA → LATIN CAPITAL LETTER K
+	0x1D6B2: "Ʌ",                  // MATHEMATICAL BOLD CAPITAL LAMDA → LATIN CAPITAL LETTER TURNED V
+	0x1D6B3: "M",                  // MATHEMATICAL BOLD CAPITAL MU → LATIN CAPITAL LETTER M
+	0x1D6B4: "N",                  // MATHEMATICAL BOLD CAPITAL NU → LATIN CAPITAL LETTER N
+	0x1D6B5: "Ξ",                  // MATHEMATICAL BOLD CAPITAL XI → GREEK CAPITAL LETTER XI
+	0x1D6B6: "O",                  // MATHEMATICAL BOLD CAPITAL OMICRON → LATIN CAPITAL LETTER O
+	0x1D6B7: "Π",                  // MATHEMATICAL BOLD CAPITAL PI → GREEK CAPITAL LETTER PI
+	0x1D6B8: "P",                  // MATHEMATICAL BOLD CAPITAL RHO → LATIN CAPITAL LETTER P
+	0x1D6B9: "O̵",                 // MATHEMATICAL BOLD CAPITAL THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D6BA: "Ʃ",                  // MATHEMATICAL BOLD CAPITAL SIGMA → LATIN CAPITAL LETTER ESH
+	0x1D6BB: "T",                  // MATHEMATICAL BOLD CAPITAL TAU → LATIN CAPITAL LETTER T
+	0x1D6BC: "Y",                  // MATHEMATICAL BOLD CAPITAL UPSILON → LATIN CAPITAL LETTER Y
+	0x1D6BD: "Φ",                  // MATHEMATICAL BOLD CAPITAL PHI → GREEK CAPITAL LETTER PHI
+	0x1D6BE: "X",                  // MATHEMATICAL BOLD CAPITAL CHI → LATIN CAPITAL LETTER X
+	0x1D6BF: "Ψ",                  // MATHEMATICAL BOLD CAPITAL PSI → GREEK CAPITAL LETTER PSI
+	0x1D6C0: "Ω",                  // MATHEMATICAL BOLD CAPITAL OMEGA → GREEK CAPITAL LETTER OMEGA
+	0x1D6C1: "∇",                  // MATHEMATICAL BOLD NABLA → NABLA
+	0x1D6C2: "a",                  // MATHEMATICAL BOLD SMALL ALPHA → LATIN SMALL LETTER A
+	0x1D6C3: "ß",                  // MATHEMATICAL BOLD SMALL BETA → LATIN SMALL LETTER SHARP S
+	0x1D6C4: "y",                  // MATHEMATICAL BOLD SMALL GAMMA → LATIN SMALL LETTER Y
+	0x1D6C5: "ẟ",                  // MATHEMATICAL BOLD SMALL DELTA → LATIN SMALL LETTER DELTA
+	0x1D6C6: "ꞓ",                  // MATHEMATICAL BOLD SMALL EPSILON → LATIN SMALL LETTER C WITH BAR
+	0x1D6C7: "ζ",                  // MATHEMATICAL BOLD SMALL ZETA → GREEK SMALL LETTER ZETA
+	0x1D6C8: "n̩",                 // MATHEMATICAL BOLD SMALL ETA → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x1D6C9: "O̵",                 // MATHEMATICAL BOLD SMALL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D6CA: "i",                  // MATHEMATICAL BOLD SMALL IOTA → LATIN SMALL LETTER I
+	0x1D6CB: "ĸ",                  // MATHEMATICAL BOLD SMALL KAPPA → LATIN SMALL LETTER KRA
+	0x1D6CC: "λ",                  // MATHEMATICAL BOLD SMALL LAMDA → GREEK SMALL LETTER LAMDA
+	0x1D6CD: "μ",                  // MATHEMATICAL BOLD SMALL MU → GREEK SMALL LETTER MU
+	0x1D6CE: "v",                  // MATHEMATICAL BOLD SMALL NU → LATIN SMALL LETTER V
+	0x1D6CF: "ξ",                  // MATHEMATICAL BOLD SMALL XI → GREEK SMALL LETTER XI
+	0x1D6D0: "o",                  // MATHEMATICAL BOLD SMALL OMICRON → LATIN SMALL LETTER O
+	0x1D6D1: "π",                  // MATHEMATICAL BOLD SMALL PI → GREEK SMALL LETTER PI
+	0x1D6D2: "p",                  // MATHEMATICAL BOLD SMALL RHO → LATIN SMALL LETTER P
+	0x1D6D3: "ς",                  // MATHEMATICAL BOLD SMALL FINAL SIGMA → GREEK SMALL LETTER FINAL SIGMA
+	0x1D6D4: "o",                  // MATHEMATICAL BOLD SMALL SIGMA → LATIN SMALL LETTER O
+	0x1D6D5: "ᴛ",                  // MATHEMATICAL BOLD SMALL TAU → LATIN LETTER SMALL CAPITAL T
+	0x1D6D6: "u",                  // MATHEMATICAL BOLD SMALL UPSILON → LATIN SMALL LETTER U
+	0x1D6D7: "ɸ",                  // MATHEMATICAL BOLD SMALL PHI → LATIN SMALL LETTER PHI
+	0x1D6D8: "χ",                  // MATHEMATICAL BOLD SMALL CHI → GREEK SMALL LETTER CHI
+	0x1D6D9: "ψ",                  // MATHEMATICAL BOLD SMALL PSI → GREEK SMALL LETTER PSI
+	0x1D6DA: "ω",                  // MATHEMATICAL BOLD SMALL OMEGA → GREEK SMALL LETTER OMEGA
+	0x1D6DB: "∂",                  // MATHEMATICAL BOLD PARTIAL DIFFERENTIAL → PARTIAL DIFFERENTIAL
+	0x1D6DC: "ꞓ",                  // MATHEMATICAL BOLD EPSILON SYMBOL → LATIN SMALL LETTER C WITH BAR
+	0x1D6DD: "O̵",                 // MATHEMATICAL BOLD THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D6DE: "ĸ",                  // MATHEMATICAL BOLD KAPPA SYMBOL → LATIN SMALL LETTER KRA
+	0x1D6DF: "ɸ",                  // MATHEMATICAL BOLD PHI SYMBOL → LATIN SMALL LETTER PHI
+	0x1D6E0: "p",                  // MATHEMATICAL BOLD RHO SYMBOL → LATIN SMALL LETTER P
+	0x1D6E1: "π",                  // MATHEMATICAL BOLD PI SYMBOL → GREEK SMALL LETTER PI
+	0x1D6E2: "A",                  // MATHEMATICAL ITALIC CAPITAL ALPHA → LATIN CAPITAL LETTER A
+	0x1D6E3: "B",                  // MATHEMATICAL ITALIC CAPITAL BETA → LATIN CAPITAL LETTER B
+	0x1D6E4: "Γ",                  // MATHEMATICAL ITALIC CAPITAL GAMMA → GREEK CAPITAL LETTER GAMMA
+	0x1D6E5: "Δ",                  // MATHEMATICAL ITALIC CAPITAL DELTA → GREEK CAPITAL LETTER DELTA
+	0x1D6E6: "E",                  // MATHEMATICAL ITALIC CAPITAL EPSILON → LATIN CAPITAL LETTER E
+	0x1D6E7: "Z",                  // MATHEMATICAL ITALIC CAPITAL ZETA → LATIN CAPITAL LETTER Z
+	0x1D6E8: "H",                  // MATHEMATICAL ITALIC CAPITAL ETA → LATIN CAPITAL LETTER H
+	0x1D6E9: "O̵",                 // MATHEMATICAL ITALIC CAPITAL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D6EA: "l",                  // MATHEMATICAL ITALIC CAPITAL IOTA → LATIN SMALL LETTER L
+	0x1D6EB: "K",                  // MATHEMATICAL ITALIC CAPITAL KAPPA → LATIN CAPITAL LETTER K
+	0x1D6EC: "Ʌ",                  // MATHEMATICAL ITALIC CAPITAL LAMDA → LATIN CAPITAL LETTER TURNED V
+	0x1D6ED: "M",                  // MATHEMATICAL ITALIC CAPITAL MU → LATIN CAPITAL LETTER M
+	0x1D6EE: "N",                  // MATHEMATICAL ITALIC CAPITAL NU → LATIN CAPITAL LETTER N
+	0x1D6EF: "Ξ",                  // MATHEMATICAL ITALIC CAPITAL XI → GREEK CAPITAL LETTER XI
+	0x1D6F0: "O",                  // MATHEMATICAL ITALIC CAPITAL OMICRON → LATIN CAPITAL LETTER O
+	0x1D6F1: "Π",                  // MATHEMATICAL ITALIC CAPITAL PI → GREEK CAPITAL LETTER PI
+	0x1D6F2: "P",                  // MATHEMATICAL ITALIC CAPITAL RHO → LATIN CAPITAL LETTER P
+	0x1D6F3: "O̵",                 // MATHEMATICAL ITALIC CAPITAL THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D6F4: "Ʃ",                  // MATHEMATICAL ITALIC CAPITAL SIGMA → LATIN CAPITAL LETTER ESH
+	0x1D6F5: "T",                  // MATHEMATICAL ITALIC CAPITAL TAU → LATIN CAPITAL LETTER T
+	0x1D6F6: "Y",                  // MATHEMATICAL ITALIC CAPITAL UPSILON → LATIN CAPITAL LETTER Y
+	0x1D6F7: "Φ",                  // MATHEMATICAL ITALIC CAPITAL PHI → GREEK CAPITAL LETTER PHI
+	0x1D6F8: "X",                  // MATHEMATICAL ITALIC CAPITAL CHI → LATIN CAPITAL LETTER X
+	0x1D6F9: "Ψ",                  // MATHEMATICAL ITALIC CAPITAL PSI → GREEK CAPITAL LETTER PSI
+	0x1D6FA: "Ω",                  // MATHEMATICAL ITALIC CAPITAL OMEGA → GREEK CAPITAL LETTER OMEGA
+	0x1D6FB: "∇",                  // MATHEMATICAL ITALIC NABLA → NABLA
+	0x1D6FC: "a",                  // MATHEMATICAL ITALIC SMALL ALPHA → LATIN SMALL LETTER A
+	0x1D6FD: "ß",                  // MATHEMATICAL ITALIC SMALL BETA → LATIN SMALL LETTER SHARP S
+	0x1D6FE: "y",                  // MATHEMATICAL ITALIC SMALL GAMMA → LATIN SMALL LETTER Y
+	0x1D6FF: "ẟ",                  // MATHEMATICAL ITALIC SMALL DELTA → LATIN SMALL LETTER DELTA
+	0x1D700: "ꞓ",                  // MATHEMATICAL ITALIC SMALL EPSILON → LATIN SMALL LETTER C WITH BAR
+	0x1D701: "ζ",                  // MATHEMATICAL ITALIC SMALL ZETA → GREEK SMALL LETTER ZETA
+	0x1D702: "n̩",                 // MATHEMATICAL ITALIC SMALL ETA → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x1D703: "O̵",                 // MATHEMATICAL ITALIC SMALL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D704: "i",                  // MATHEMATICAL ITALIC SMALL IOTA → LATIN SMALL LETTER I
+	0x1D705: "ĸ",                  // MATHEMATICAL ITALIC SMALL KAPPA → LATIN SMALL LETTER KRA
+	0x1D706: "λ",                  // MATHEMATICAL ITALIC SMALL LAMDA → GREEK SMALL LETTER LAMDA
+	0x1D707: "μ",                  // MATHEMATICAL ITALIC SMALL MU → GREEK SMALL LETTER MU
+	0x1D708: "v",                  // MATHEMATICAL ITALIC SMALL NU → LATIN SMALL LETTER V
+	0x1D709: "ξ",                  // MATHEMATICAL ITALIC SMALL XI → GREEK SMALL LETTER XI
+	0x1D70A: "o",                  // MATHEMATICAL ITALIC SMALL OMICRON → LATIN SMALL LETTER O
+	0x1D70B: "π",                  // MATHEMATICAL ITALIC SMALL PI → GREEK SMALL LETTER PI
+	0x1D70C: "p",                  // MATHEMATICAL ITALIC SMALL RHO → LATIN SMALL LETTER P
+	0x1D70D: "ς",                  // MATHEMATICAL ITALIC SMALL FINAL SIGMA → GREEK SMALL LETTER FINAL SIGMA
+	0x1D70E: "o",                  // MATHEMATICAL ITALIC SMALL SIGMA → LATIN SMALL LETTER O
+	0x1D70F: "ᴛ",                  // MATHEMATICAL ITALIC SMALL TAU → LATIN LETTER SMALL CAPITAL T
+	0x1D710: "u",                  // MATHEMATICAL ITALIC SMALL UPSILON → LATIN SMALL LETTER U
+	0x1D711: "ɸ",                  // MATHEMATICAL ITALIC SMALL PHI → LATIN SMALL LETTER PHI
+	0x1D712: "χ",                  // MATHEMATICAL ITALIC SMALL CHI → GREEK SMALL LETTER CHI
+	0x1D713: "ψ",                  // MATHEMATICAL ITALIC SMALL PSI → GREEK SMALL LETTER PSI
+	0x1D714: "ω",                  // MATHEMATICAL ITALIC SMALL OMEGA → GREEK SMALL LETTER OMEGA
+	0x1D715: "∂",                  // MATHEMATICAL ITALIC PARTIAL DIFFERENTIAL → PARTIAL DIFFERENTIAL
+	0x1D716: "ꞓ",                  // MATHEMATICAL ITALIC EPSILON SYMBOL → LATIN SMALL LETTER C WITH BAR
+	0x1D717: "O̵",                 // MATHEMATICAL ITALIC THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D718: "ĸ",                  // MATHEMATICAL ITALIC KAPPA SYMBOL → LATIN SMALL LETTER KRA
+	0x1D719: "ɸ",                  // MATHEMATICAL ITALIC PHI SYMBOL → LATIN SMALL LETTER PHI
+	0x1D71A: "p",                  // MATHEMATICAL ITALIC RHO SYMBOL → LATIN SMALL LETTER P
+	0x1D71B: "π",                  // MATHEMATICAL ITALIC PI SYMBOL → GREEK SMALL LETTER PI
+	0x1D71C: "A",                  // MATHEMATICAL BOLD ITALIC CAPITAL ALPHA → LATIN CAPITAL LETTER A
+	0x1D71D: "B",                  // MATHEMATICAL BOLD ITALIC CAPITAL BETA → LATIN CAPITAL LETTER B
+	0x1D71E: "Γ",                  // MATHEMATICAL BOLD ITALIC CAPITAL GAMMA → GREEK CAPITAL LETTER GAMMA
+	0x1D71F: "Δ",                  // MATHEMATICAL BOLD ITALIC CAPITAL DELTA → GREEK CAPITAL LETTER DELTA
+	0x1D720: "E",                  // MATHEMATICAL BOLD ITALIC CAPITAL EPSILON → LATIN CAPITAL LETTER E
+	0x1D721: "Z",                  // MATHEMATICAL BOLD ITALIC CAPITAL ZETA → LATIN CAPITAL LETTER Z
+	0x1D722: "H",                  // MATHEMATICAL BOLD ITALIC CAPITAL ETA → LATIN CAPITAL LETTER H
+	0x1D723: "O̵",                 // MATHEMATICAL BOLD ITALIC CAPITAL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D724: "l",                  // MATHEMATICAL BOLD ITALIC CAPITAL IOTA → LATIN SMALL LETTER L
+	0x1D725: "K",                  // MATHEMATICAL BOLD ITALIC CAPITAL KAPPA → LATIN CAPITAL LETTER K
+	0x1D726: "Ʌ",                  // MATHEMATICAL BOLD ITALIC CAPITAL LAMDA → LATIN CAPITAL LETTER TURNED V
+	0x1D727: "M",                  // MATHEMATICAL BOLD ITALIC CAPITAL MU → LATIN CAPITAL LETTER M
+	0x1D728: "N",                  // MATHEMATICAL BOLD ITALIC CAPITAL NU → LATIN CAPITAL LETTER N
+	0x1D729: "Ξ",                  // MATHEMATICAL BOLD ITALIC CAPITAL XI → GREEK CAPITAL LETTER XI
+	0x1D72A: "O",                  // MATHEMATICAL BOLD ITALIC CAPITAL OMICRON → LATIN CAPITAL LETTER O
+	0x1D72B: "Π",                  // MATHEMATICAL BOLD ITALIC CAPITAL PI → GREEK CAPITAL LETTER PI
+	0x1D72C: "P",                  // MATHEMATICAL BOLD ITALIC CAPITAL RHO → LATIN CAPITAL LETTER P
+	0x1D72D: "O̵",                 // MATHEMATICAL BOLD ITALIC CAPITAL THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D72E: "Ʃ",                  // MATHEMATICAL BOLD ITALIC CAPITAL SIGMA → LATIN CAPITAL LETTER ESH
+	0x1D72F: "T",                  // MATHEMATICAL BOLD ITALIC CAPITAL TAU → LATIN CAPITAL LETTER T
+	0x1D730: "Y",                  // MATHEMATICAL BOLD ITALIC CAPITAL UPSILON → LATIN CAPITAL LETTER Y
+	0x1D731: "Φ",                  // MATHEMATICAL BOLD ITALIC CAPITAL PHI → GREEK CAPITAL LETTER PHI
+	0x1D732: "X",                  // MATHEMATICAL BOLD ITALIC CAPITAL CHI → LATIN CAPITAL LETTER X
+	0x1D733: "Ψ",                  // MATHEMATICAL BOLD ITALIC CAPITAL PSI → GREEK CAPITAL LETTER PSI
+	0x1D734: "Ω",                  // MATHEMATICAL BOLD ITALIC CAPITAL OMEGA → GREEK CAPITAL LETTER OMEGA
+	0x1D735: "∇",                  // MATHEMATICAL BOLD ITALIC NABLA → NABLA
+	0x1D736: "a",                  // MATHEMATICAL BOLD ITALIC SMALL ALPHA → LATIN SMALL LETTER A
+	0x1D737: "ß",                  // MATHEMATICAL BOLD ITALIC SMALL BETA → LATIN SMALL LETTER SHARP S
+	0x1D738: "y",                  // MATHEMATICAL BOLD ITALIC SMALL GAMMA → LATIN SMALL LETTER Y
+	0x1D739: "ẟ",                  // MATHEMATICAL BOLD ITALIC SMALL DELTA → LATIN SMALL LETTER DELTA
+	0x1D73A: "ꞓ",                  // MATHEMATICAL BOLD ITALIC SMALL EPSILON → LATIN SMALL LETTER C WITH BAR
+	0x1D73B: "ζ",                  // MATHEMATICAL BOLD ITALIC SMALL ZETA → GREEK SMALL LETTER ZETA
+	0x1D73C: "n̩",                 // MATHEMATICAL BOLD ITALIC SMALL ETA → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x1D73D: "O̵",                 // MATHEMATICAL BOLD ITALIC SMALL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D73E: "i",                  // MATHEMATICAL BOLD ITALIC SMALL IOTA → LATIN SMALL LETTER I
+	0x1D73F: "ĸ",                  // MATHEMATICAL BOLD ITALIC SMALL KAPPA → LATIN SMALL LETTER KRA
+	0x1D740: "λ",                  // MATHEMATICAL BOLD ITALIC SMALL LAMDA → GREEK SMALL LETTER LAMDA
+	0x1D741: "μ",                  // MATHEMATICAL BOLD ITALIC SMALL MU → GREEK SMALL LETTER MU
+	0x1D742: "v",                  // MATHEMATICAL BOLD ITALIC SMALL NU → LATIN SMALL LETTER V
+	0x1D743: "ξ",                  // MATHEMATICAL BOLD ITALIC SMALL XI → GREEK SMALL LETTER XI
+	0x1D744: "o",                  // MATHEMATICAL BOLD ITALIC SMALL OMICRON → LATIN SMALL LETTER O
+	0x1D745: "π",                  // MATHEMATICAL BOLD ITALIC SMALL PI → GREEK SMALL LETTER PI
+	0x1D746: "p",                  // MATHEMATICAL BOLD ITALIC SMALL RHO → LATIN SMALL LETTER P
+	0x1D747: "ς",                  // MATHEMATICAL BOLD ITALIC SMALL FINAL SIGMA → GREEK SMALL LETTER FINAL SIGMA
+	0x1D748: "o",                  // MATHEMATICAL BOLD ITALIC SMALL SIGMA → LATIN SMALL LETTER O
+	0x1D749: "ᴛ",                  // MATHEMATICAL BOLD ITALIC SMALL TAU → LATIN LETTER SMALL CAPITAL T
+	0x1D74A: "u",                  // MATHEMATICAL BOLD ITALIC SMALL UPSILON → LATIN SMALL LETTER U
+	0x1D74B: "ɸ",                  // MATHEMATICAL BOLD ITALIC SMALL PHI → LATIN SMALL LETTER PHI
+	0x1D74C: "χ",                  // MATHEMATICAL BOLD ITALIC SMALL CHI → GREEK SMALL LETTER CHI
+	0x1D74D: "ψ",                  // MATHEMATICAL BOLD ITALIC SMALL PSI → GREEK SMALL LETTER PSI
+	0x1D74E: "ω",                  // MATHEMATICAL BOLD ITALIC SMALL OMEGA → GREEK SMALL LETTER OMEGA
+	0x1D74F: "∂",                  // MATHEMATICAL BOLD ITALIC PARTIAL DIFFERENTIAL → PARTIAL DIFFERENTIAL
+	0x1D750: "ꞓ",                  // MATHEMATICAL BOLD ITALIC EPSILON SYMBOL → LATIN SMALL LETTER C WITH BAR
+	0x1D751: "O̵",                 // MATHEMATICAL BOLD ITALIC THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D752: "ĸ",                  // MATHEMATICAL BOLD ITALIC KAPPA SYMBOL → LATIN SMALL LETTER KRA
+	0x1D753: "ɸ",                  // MATHEMATICAL BOLD ITALIC PHI SYMBOL → LATIN SMALL LETTER PHI
+	0x1D754: "p",                  // MATHEMATICAL BOLD ITALIC RHO SYMBOL → LATIN SMALL LETTER P
+	0x1D755: "π",                  // MATHEMATICAL BOLD ITALIC PI SYMBOL → GREEK SMALL LETTER PI
+	0x1D756: "A",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL ALPHA → LATIN CAPITAL LETTER A
+	0x1D757: "B",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL BETA → LATIN CAPITAL LETTER B
+	0x1D758: "Γ",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL GAMMA → GREEK CAPITAL LETTER GAMMA
+	0x1D759: "Δ",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL DELTA → GREEK CAPITAL LETTER DELTA
+	0x1D75A: "E",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL EPSILON → LATIN CAPITAL LETTER E
+	0x1D75B: "Z",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL ZETA → LATIN CAPITAL LETTER Z
+	0x1D75C: "H",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL ETA → LATIN CAPITAL LETTER H
+	0x1D75D: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D75E: "l",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL IOTA → LATIN SMALL LETTER L
+	0x1D75F: "K",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL KAPPA → LATIN CAPITAL LETTER K
+	0x1D760: "Ʌ",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL LAMDA → LATIN CAPITAL LETTER TURNED V
+	0x1D761: "M",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL MU → LATIN CAPITAL LETTER M
+	0x1D762: "N",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL NU → LATIN CAPITAL LETTER N
+	0x1D763: "Ξ",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL XI → GREEK CAPITAL LETTER XI
+	0x1D764: "O",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL OMICRON → LATIN CAPITAL LETTER O
+	0x1D765: "Π",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL PI → GREEK CAPITAL LETTER PI
+	0x1D766: "P",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL RHO → LATIN CAPITAL LETTER P
+	0x1D767: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D768: "Ʃ",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL SIGMA → LATIN CAPITAL LETTER ESH
+	0x1D769: "T",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL TAU → LATIN CAPITAL LETTER T
+	0x1D76A: "Y",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL UPSILON → LATIN CAPITAL LETTER Y
+	0x1D76B: "Φ",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL PHI → GREEK CAPITAL LETTER PHI
+	0x1D76C: "X",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL CHI → LATIN CAPITAL LETTER X
+	0x1D76D: "Ψ",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL PSI → GREEK CAPITAL LETTER PSI
+	0x1D76E: "Ω",                  // MATHEMATICAL SANS-SERIF BOLD CAPITAL OMEGA → GREEK CAPITAL LETTER OMEGA
+	0x1D76F: "∇",                  // MATHEMATICAL SANS-SERIF BOLD NABLA → NABLA
+	0x1D770: "a",                  // MATHEMATICAL SANS-SERIF BOLD SMALL ALPHA → LATIN SMALL LETTER A
+	0x1D771: "ß",                  // MATHEMATICAL SANS-SERIF BOLD SMALL BETA → LATIN SMALL LETTER SHARP S
+	0x1D772: "y",                  // MATHEMATICAL SANS-SERIF BOLD SMALL GAMMA → LATIN SMALL LETTER Y
+	0x1D773: "ẟ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL DELTA → LATIN SMALL LETTER DELTA
+	0x1D774: "ꞓ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL EPSILON → LATIN SMALL LETTER C WITH BAR
+	0x1D775: "ζ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL ZETA → GREEK SMALL LETTER ZETA
+	0x1D776: "n̩",                 // MATHEMATICAL SANS-SERIF BOLD SMALL ETA → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x1D777: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD SMALL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D778: "i",                  // MATHEMATICAL SANS-SERIF BOLD SMALL IOTA → LATIN SMALL LETTER I
+	0x1D779: "ĸ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL KAPPA → LATIN SMALL LETTER KRA
+	0x1D77A: "λ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL LAMDA → GREEK SMALL LETTER LAMDA
+	0x1D77B: "μ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL MU → GREEK SMALL LETTER MU
+	0x1D77C: "v",                  // MATHEMATICAL SANS-SERIF BOLD SMALL NU → LATIN SMALL LETTER V
+	0x1D77D: "ξ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL XI → GREEK SMALL LETTER XI
+	0x1D77E: "o",                  // MATHEMATICAL SANS-SERIF BOLD SMALL OMICRON → LATIN SMALL LETTER O
+	0x1D77F: "π",                  // MATHEMATICAL SANS-SERIF BOLD SMALL PI → GREEK SMALL LETTER PI
+	0x1D780: "p",                  // MATHEMATICAL SANS-SERIF BOLD SMALL RHO → LATIN SMALL LETTER P
+	0x1D781: "ς",                  // MATHEMATICAL SANS-SERIF BOLD SMALL FINAL SIGMA → GREEK SMALL LETTER FINAL SIGMA
+	0x1D782: "o",                  // MATHEMATICAL SANS-SERIF BOLD SMALL SIGMA → LATIN SMALL LETTER O
+	0x1D783: "ᴛ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL TAU → LATIN LETTER SMALL CAPITAL T
+	0x1D784: "u",                  // MATHEMATICAL SANS-SERIF BOLD SMALL UPSILON → LATIN SMALL LETTER U
+	0x1D785: "ɸ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL PHI → LATIN SMALL LETTER PHI
+	0x1D786: "χ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL CHI → GREEK SMALL LETTER CHI
+	0x1D787: "ψ",                  // MATHEMATICAL SANS-SERIF BOLD SMALL PSI → GREEK SMALL LETTER PSI
+	0x1D788: "ω",                  // MATHEMATICAL SANS-SERIF BOLD SMALL OMEGA → GREEK SMALL LETTER OMEGA
+	0x1D789: "∂",                  // MATHEMATICAL SANS-SERIF BOLD PARTIAL DIFFERENTIAL → PARTIAL DIFFERENTIAL
+	0x1D78A: "ꞓ",                  // MATHEMATICAL SANS-SERIF BOLD EPSILON SYMBOL → LATIN SMALL LETTER C WITH BAR
+	0x1D78B: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D78C: "ĸ",                  // MATHEMATICAL SANS-SERIF BOLD KAPPA SYMBOL → LATIN SMALL LETTER KRA
+	0x1D78D: "ɸ",                  // MATHEMATICAL SANS-SERIF BOLD PHI SYMBOL → LATIN SMALL LETTER PHI
+	0x1D78E: "p",                  // MATHEMATICAL SANS-SERIF BOLD RHO SYMBOL → LATIN SMALL LETTER P
+	0x1D78F: "π",                  // MATHEMATICAL SANS-SERIF BOLD PI SYMBOL → GREEK SMALL LETTER PI
+	0x1D790: "A",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ALPHA → LATIN CAPITAL LETTER A
+	0x1D791: "B",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL BETA → LATIN CAPITAL LETTER B
+	0x1D792: "Γ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL GAMMA → GREEK CAPITAL LETTER GAMMA
+	0x1D793: "Δ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL DELTA → GREEK CAPITAL LETTER DELTA
+	0x1D794: "E",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL EPSILON → LATIN CAPITAL LETTER E
+	0x1D795: "Z",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ZETA → LATIN CAPITAL LETTER Z
+	0x1D796: "H",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ETA → LATIN CAPITAL LETTER H
+	0x1D797: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D798: "l",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL IOTA → LATIN SMALL LETTER L
+	0x1D799: "K",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL KAPPA → LATIN CAPITAL LETTER K
+	0x1D79A: "Ʌ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL LAMDA → LATIN CAPITAL LETTER TURNED V
+	0x1D79B: "M",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL MU → LATIN CAPITAL LETTER M
+	0x1D79C: "N",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL NU → LATIN CAPITAL LETTER N
+	0x1D79D: "Ξ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL XI → GREEK CAPITAL LETTER XI
+	0x1D79E: "O",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMICRON → LATIN CAPITAL LETTER O
+	0x1D79F: "Π",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PI → GREEK CAPITAL LETTER PI
+	0x1D7A0: "P",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL RHO → LATIN CAPITAL LETTER P
+	0x1D7A1: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D7A2: "Ʃ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL SIGMA → LATIN CAPITAL LETTER ESH
+	0x1D7A3: "T",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL TAU → LATIN CAPITAL LETTER T
+	0x1D7A4: "Y",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL UPSILON → LATIN CAPITAL LETTER Y
+	0x1D7A5: "Φ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PHI → GREEK CAPITAL LETTER PHI
+	0x1D7A6: "X",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL CHI → LATIN CAPITAL LETTER X
+	0x1D7A7: "Ψ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PSI → GREEK CAPITAL LETTER PSI
+	0x1D7A8: "Ω",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMEGA → GREEK CAPITAL LETTER OMEGA
+	0x1D7A9: "∇",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC NABLA → NABLA
+	0x1D7AA: "a",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ALPHA → LATIN SMALL LETTER A
+	0x1D7AB: "ß",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL BETA → LATIN SMALL LETTER SHARP S
+	0x1D7AC: "y",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL GAMMA → LATIN SMALL LETTER Y
+	0x1D7AD: "ẟ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL DELTA → LATIN SMALL LETTER DELTA
+	0x1D7AE: "ꞓ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL EPSILON → LATIN SMALL LETTER C WITH BAR
+	0x1D7AF: "ζ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ZETA → GREEK SMALL LETTER ZETA
+	0x1D7B0: "n̩",                 // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ETA → LATIN SMALL LETTER N + COMBINING VERTICAL LINE BELOW
+	0x1D7B1: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL THETA → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D7B2: "i",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL IOTA → LATIN SMALL LETTER I
+	0x1D7B3: "ĸ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL KAPPA → LATIN SMALL LETTER KRA
+	0x1D7B4: "λ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL LAMDA → GREEK SMALL LETTER LAMDA
+	0x1D7B5: "μ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL MU → GREEK SMALL LETTER MU
+	0x1D7B6: "v",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL NU → LATIN SMALL LETTER V
+	0x1D7B7: "ξ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL XI → GREEK SMALL LETTER XI
+	0x1D7B8: "o",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMICRON → LATIN SMALL LETTER O
+	0x1D7B9: "π",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PI → GREEK SMALL LETTER PI
+	0x1D7BA: "p",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL RHO → LATIN SMALL LETTER P
+	0x1D7BB: "ς",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL FINAL SIGMA → GREEK SMALL LETTER FINAL SIGMA
+	0x1D7BC: "o",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL SIGMA → LATIN SMALL LETTER O
+	0x1D7BD: "ᴛ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL TAU → LATIN LETTER SMALL CAPITAL T
+	0x1D7BE: "u",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL UPSILON → LATIN SMALL LETTER U
+	0x1D7BF: "ɸ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PHI → LATIN SMALL LETTER PHI
+	0x1D7C0: "χ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL CHI → GREEK SMALL LETTER CHI
+	0x1D7C1: "ψ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PSI → GREEK SMALL LETTER PSI
+	0x1D7C2: "ω",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMEGA → GREEK SMALL LETTER OMEGA
+	0x1D7C3: "∂",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC PARTIAL DIFFERENTIAL → PARTIAL DIFFERENTIAL
+	0x1D7C4: "ꞓ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC EPSILON SYMBOL → LATIN SMALL LETTER C WITH BAR
+	0x1D7C5: "O̵",                 // MATHEMATICAL SANS-SERIF BOLD ITALIC THETA SYMBOL → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1D7C6: "ĸ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC KAPPA SYMBOL → LATIN SMALL LETTER KRA
+	0x1D7C7: "ɸ",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC PHI SYMBOL → LATIN SMALL LETTER PHI
+	0x1D7C8: "p",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC RHO SYMBOL → LATIN SMALL LETTER P
+	0x1D7C9: "π",                  // MATHEMATICAL SANS-SERIF BOLD ITALIC PI SYMBOL → GREEK SMALL LETTER PI
+	0x1D7CA: "F",                  // MATHEMATICAL BOLD CAPITAL DIGAMMA → LATIN CAPITAL LETTER F
+	0x1D7CB: "ϝ",                  // MATHEMATICAL BOLD SMALL DIGAMMA → GREEK SMALL LETTER DIGAMMA
+	0x1D7CE: "O",                  // MATHEMATICAL BOLD DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x1D7CF: "l",                  // MATHEMATICAL BOLD DIGIT ONE → LATIN SMALL LETTER L
+	0x1D7D0: "2",                  // MATHEMATICAL BOLD DIGIT TWO → DIGIT TWO
+	0x1D7D1: "3",                  // MATHEMATICAL BOLD DIGIT THREE → DIGIT THREE
+	0x1D7D2: "4",                  // MATHEMATICAL BOLD DIGIT FOUR → DIGIT FOUR
+	0x1D7D3: "5",                  // MATHEMATICAL BOLD DIGIT FIVE → DIGIT FIVE
+	0x1D7D4: "6",                  // MATHEMATICAL BOLD DIGIT SIX → DIGIT SIX
+	0x1D7D5: "7",                  // MATHEMATICAL BOLD DIGIT SEVEN → DIGIT SEVEN
+	0x1D7D6: "8",                  // MATHEMATICAL BOLD DIGIT EIGHT → DIGIT EIGHT
+	0x1D7D7: "9",                  // MATHEMATICAL BOLD DIGIT NINE → DIGIT NINE
+	0x1D7D8: "O",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x1D7D9: "l",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT ONE → LATIN SMALL LETTER L
+	0x1D7DA: "2",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT TWO → DIGIT TWO
+	0x1D7DB: "3",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT THREE → DIGIT THREE
+	0x1D7DC: "4",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT FOUR → DIGIT FOUR
+	0x1D7DD: "5",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT FIVE → DIGIT FIVE
+	0x1D7DE: "6",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT SIX → DIGIT SIX
+	0x1D7DF: "7",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT SEVEN → DIGIT SEVEN
+	0x1D7E0: "8",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT EIGHT → DIGIT EIGHT
+	0x1D7E1: "9",                  // MATHEMATICAL DOUBLE-STRUCK DIGIT NINE → DIGIT NINE
+	0x1D7E2: "O",                  // MATHEMATICAL SANS-SERIF DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x1D7E3: "l",                  // MATHEMATICAL SANS-SERIF DIGIT ONE → LATIN SMALL LETTER L
+	0x1D7E4: "2",                  // MATHEMATICAL SANS-SERIF DIGIT TWO → DIGIT TWO
+	0x1D7E5: "3",                  // MATHEMATICAL SANS-SERIF DIGIT THREE → DIGIT THREE
+	0x1D7E6: "4",                  // MATHEMATICAL SANS-SERIF DIGIT FOUR → DIGIT FOUR
+	0x1D7E7: "5",                  // MATHEMATICAL SANS-SERIF DIGIT FIVE → DIGIT FIVE
+	0x1D7E8: "6",                  // MATHEMATICAL SANS-SERIF DIGIT SIX → DIGIT SIX
+	0x1D7E9: "7",                  // MATHEMATICAL SANS-SERIF DIGIT SEVEN → DIGIT SEVEN
+	0x1D7EA: "8",                  // MATHEMATICAL SANS-SERIF DIGIT EIGHT → DIGIT EIGHT
+	0x1D7EB: "9",                  // MATHEMATICAL SANS-SERIF DIGIT NINE → DIGIT NINE
+	0x1D7EC: "O",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x1D7ED: "l",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT ONE → LATIN SMALL LETTER L
+	0x1D7EE: "2",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT TWO → DIGIT TWO
+	0x1D7EF: "3",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT THREE → DIGIT THREE
+	0x1D7F0: "4",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT FOUR → DIGIT FOUR
+	0x1D7F1: "5",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT FIVE → DIGIT FIVE
+	0x1D7F2: "6",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT SIX → DIGIT SIX
+	0x1D7F3: "7",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT SEVEN → DIGIT SEVEN
+	0x1D7F4: "8",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT EIGHT → DIGIT EIGHT
+	0x1D7F5: "9",                  // MATHEMATICAL SANS-SERIF BOLD DIGIT NINE → DIGIT NINE
+	0x1D7F6: "O",                  // MATHEMATICAL MONOSPACE DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x1D7F7: "l",                  // MATHEMATICAL MONOSPACE DIGIT ONE → LATIN SMALL LETTER L
+	0x1D7F8: "2",                  // MATHEMATICAL MONOSPACE DIGIT TWO → DIGIT TWO
+	0x1D7F9: "3",                  // MATHEMATICAL MONOSPACE DIGIT THREE → DIGIT THREE
+	0x1D7FA: "4",                  // MATHEMATICAL MONOSPACE DIGIT FOUR → DIGIT FOUR
+	0x1D7FB: "5",                  // MATHEMATICAL MONOSPACE DIGIT FIVE → DIGIT FIVE
+	0x1D7FC: "6",                  // MATHEMATICAL MONOSPACE DIGIT SIX → DIGIT SIX
+	0x1D7FD: "7",                  // MATHEMATICAL MONOSPACE DIGIT SEVEN → DIGIT SEVEN
+	0x1D7FE: "8",                  // MATHEMATICAL MONOSPACE DIGIT EIGHT → DIGIT EIGHT
+	0x1D7FF: "9",                  // MATHEMATICAL MONOSPACE DIGIT NINE → DIGIT NINE
+	0x1E6E9: "+",                  // ( 𞛩 → + )
+	0x1E6EE: "᫈",                  // ( 𞛮 → ᫈ )
+	0x1E8C7: "l",                  // MENDE KIKAKUI DIGIT ONE → LATIN SMALL LETTER L
+	0x1E8C8: "∠",                  // MENDE KIKAKUI DIGIT TWO → ANGLE
+	0x1E8C9: "٣",                  // MENDE KIKAKUI DIGIT THREE → ARABIC-INDIC DIGIT THREE
+	0x1E8CB: "8",                  // MENDE KIKAKUI DIGIT FIVE → DIGIT EIGHT
+	0x1E8CC: "∂",                  // MENDE KIKAKUI DIGIT SIX → PARTIAL DIFFERENTIAL
+	0x1E8CD: "∂̵",                 // MENDE KIKAKUI DIGIT SEVEN → PARTIAL DIFFERENTIAL + COMBINING SHORT STROKE OVERLAY
+	0x1EE00: "l",                  // ARABIC MATHEMATICAL ALEF → LATIN SMALL LETTER L
+	0x1EE01: "ب",                  // ARABIC MATHEMATICAL BEH → ARABIC LETTER BEH
+	0x1EE02: "ج",                  // ARABIC MATHEMATICAL JEEM → ARABIC LETTER JEEM
+	0x1EE03: "د",                  // ARABIC MATHEMATICAL DAL → ARABIC LETTER DAL
+	0x1EE05: "و",                  // ARABIC MATHEMATICAL WAW → ARABIC LETTER WAW
+	0x1EE06: "ز",                  // ARABIC MATHEMATICAL ZAIN → ARABIC LETTER ZAIN
+	0x1EE07: "ح",                  // ARABIC MATHEMATICAL HAH → ARABIC LETTER HAH
+	0x1EE08: "ط",                  // ARABIC MATHEMATICAL TAH → ARABIC LETTER TAH
+	0x1EE09: "ى",                  // ARABIC MATHEMATICAL YEH → ARABIC LETTER ALEF MAKSURA
+	0x1EE0A: "ك",                  // ARABIC MATHEMATICAL KAF → ARABIC LETTER KAF
+	0x1EE0B: "ل",                  // ARABIC MATHEMATICAL LAM → ARABIC LETTER LAM
+	0x1EE0C: "م",                  // ARABIC MATHEMATICAL MEEM → ARABIC LETTER MEEM
+	0x1EE0D: "ن",                  // ARABIC MATHEMATICAL NOON → ARABIC LETTER NOON
+	0x1EE0E: "س",                  // ARABIC MATHEMATICAL SEEN → ARABIC LETTER SEEN
+	0x1EE0F: "ع",                  // ARABIC MATHEMATICAL AIN → ARABIC LETTER AIN
+	0x1EE10: "ف",                  // ARABIC MATHEMATICAL FEH → ARABIC LETTER FEH
+	0x1EE11: "ص",                  // ARABIC MATHEMATICAL SAD → ARABIC LETTER SAD
+	0x1EE12: "ق",                  // ARABIC MATHEMATICAL QAF → ARABIC LETTER QAF
+	0x1EE13: "ر",                  // ARABIC MATHEMATICAL REH → ARABIC LETTER REH
+	0x1EE14: "سۛ",                 // ARABIC MATHEMATICAL SHEEN → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0x1EE15: "ت",                  // ARABIC MATHEMATICAL TEH → ARABIC LETTER TEH
+	0x1EE16: "ىۛ",                 // ARABIC MATHEMATICAL THEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x1EE17: "خ",                  // ARABIC MATHEMATICAL KHAH → ARABIC LETTER KHAH
+	0x1EE18: "ذ",                  // ARABIC MATHEMATICAL THAL → ARABIC LETTER THAL
+	0x1EE19: "ض",                  // ARABIC MATHEMATICAL DAD → ARABIC LETTER DAD
+	0x1EE1A: "ظ",                  // ARABIC MATHEMATICAL ZAH → ARABIC LETTER ZAH
+	0x1EE1B: "غ",                  // ARABIC MATHEMATICAL GHAIN → ARABIC LETTER GHAIN
+	0x1EE1C: "ى",                  // ARABIC MATHEMATICAL DOTLESS BEH → ARABIC LETTER ALEF MAKSURA
+	0x1EE1D: "ى",                  // ARABIC MATHEMATICAL DOTLESS NOON → ARABIC LETTER ALEF MAKSURA
+	0x1EE1E: "ڡ",                  // ARABIC MATHEMATICAL DOTLESS FEH → ARABIC LETTER DOTLESS FEH
+	0x1EE1F: "ڡ",                  // ARABIC MATHEMATICAL DOTLESS QAF → ARABIC LETTER DOTLESS FEH
+	0x1EE21: "ب",                  // ARABIC MATHEMATICAL INITIAL BEH → ARABIC LETTER BEH
+	0x1EE22: "ج",                  // ARABIC MATHEMATICAL INITIAL JEEM → ARABIC LETTER JEEM
+	0x1EE24: "o",                  // ARABIC MATHEMATICAL INITIAL HEH → LATIN SMALL LETTER O
+	0x1EE27: "ح",                  // ARABIC MATHEMATICAL INITIAL HAH → ARABIC LETTER HAH
+	0x1EE29: "ى",                  // ARABIC MATHEMATICAL INITIAL YEH → ARABIC LETTER ALEF MAKSURA
+	0x1EE2A: "ك",                  // ARABIC MATHEMATICAL INITIAL KAF → ARABIC LETTER KAF
+	0x1EE2B: "ل",                  // ARABIC MATHEMATICAL INITIAL LAM → ARABIC LETTER LAM
+	0x1EE2C: "م",                  // ARABIC MATHEMATICAL INITIAL MEEM → ARABIC LETTER MEEM
+	0x1EE2D: "ن",                  // ARABIC MATHEMATICAL INITIAL NOON → ARABIC LETTER NOON
+	0x1EE2E: "س",                  // ARABIC MATHEMATICAL INITIAL SEEN → ARABIC LETTER SEEN
+	0x1EE2F: "ع",                  // ARABIC MATHEMATICAL INITIAL AIN → ARABIC LETTER AIN
+	0x1EE30: "ف",                  // ARABIC MATHEMATICAL INITIAL FEH → ARABIC LETTER FEH
+	0x1EE31: "ص",                  // ARABIC MATHEMATICAL INITIAL SAD → ARABIC LETTER SAD
+	0x1EE32: "ق",                  // ARABIC MATHEMATICAL INITIAL QAF → ARABIC LETTER QAF
+	0x1EE34: "سۛ",                 // ARABIC MATHEMATICAL INITIAL SHEEN → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0x1EE35: "ت",                  // ARABIC MATHEMATICAL INITIAL TEH → ARABIC LETTER TEH
+	0x1EE36: "ىۛ",                 // ARABIC MATHEMATICAL INITIAL THEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x1EE37: "خ",                  // ARABIC MATHEMATICAL INITIAL KHAH → ARABIC LETTER KHAH
+	0x1EE39: "ض",                  // ARABIC MATHEMATICAL INITIAL DAD → ARABIC LETTER DAD
+	0x1EE3B: "غ",                  // ARABIC MATHEMATICAL INITIAL GHAIN → ARABIC LETTER GHAIN
+	0x1EE42: "ج",                  // ARABIC MATHEMATICAL TAILED JEEM → ARABIC LETTER JEEM
+	0x1EE47: "ح",                  // ARABIC MATHEMATICAL TAILED HAH → ARABIC LETTER HAH
+	0x1EE49: "ى",                  // ARABIC MATHEMATICAL TAILED YEH → ARABIC LETTER ALEF MAKSURA
+	0x1EE4B: "ل",                  // ARABIC MATHEMATICAL TAILED LAM → ARABIC LETTER LAM
+	0x1EE4D: "ن",                  // ARABIC MATHEMATICAL TAILED NOON → ARABIC LETTER NOON
+	0x1EE4E: "س",                  // ARABIC MATHEMATICAL TAILED SEEN → ARABIC LETTER SEEN
+	0x1EE4F: "ع",                  // ARABIC MATHEMATICAL TAILED AIN → ARABIC LETTER AIN
+	0x1EE51: "ص",                  // ARABIC MATHEMATICAL TAILED SAD → ARABIC LETTER SAD
+	0x1EE52: "ق",                  // ARABIC MATHEMATICAL TAILED QAF → ARABIC LETTER QAF
+	0x1EE54: "سۛ",                 // ARABIC MATHEMATICAL TAILED SHEEN → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0x1EE57: "خ",                  // ARABIC MATHEMATICAL TAILED KHAH → ARABIC LETTER KHAH
+	0x1EE59: "ض",                  // ARABIC MATHEMATICAL TAILED DAD → ARABIC LETTER DAD
+	0x1EE5B: "غ",                  // ARABIC MATHEMATICAL TAILED GHAIN → ARABIC LETTER GHAIN
+	0x1EE5D: "ى",                  // ARABIC MATHEMATICAL TAILED DOTLESS NOON → ARABIC LETTER ALEF MAKSURA
+	0x1EE5F: "ڡ",                  // ARABIC MATHEMATICAL TAILED DOTLESS QAF → ARABIC LETTER DOTLESS FEH
+	0x1EE61: "ب",                  // ARABIC MATHEMATICAL STRETCHED BEH → ARABIC LETTER BEH
+	0x1EE62: "ج",                  // ARABIC MATHEMATICAL STRETCHED JEEM → ARABIC LETTER JEEM
+	0x1EE64: "o",                  // ARABIC MATHEMATICAL STRETCHED HEH → LATIN SMALL LETTER O
+	0x1EE67: "ح",                  // ARABIC MATHEMATICAL STRETCHED HAH → ARABIC LETTER HAH
+	0x1EE68: "ط",                  // ARABIC MATHEMATICAL STRETCHED TAH → ARABIC LETTER TAH
+	0x1EE69: "ى",                  // ARABIC MATHEMATICAL STRETCHED YEH → ARABIC LETTER ALEF MAKSURA
+	0x1EE6A: "ك",                  // ARABIC MATHEMATICAL STRETCHED KAF → ARABIC LETTER KAF
+	0x1EE6C: "م",                  // ARABIC MATHEMATICAL STRETCHED MEEM → ARABIC LETTER MEEM
+	0x1EE6D: "ن",                  // ARABIC MATHEMATICAL STRETCHED NOON → ARABIC LETTER NOON
+	0x1EE6E: "س",                  // ARABIC MATHEMATICAL STRETCHED SEEN → ARABIC LETTER SEEN
+	0x1EE6F: "ع",                  // ARABIC MATHEMATICAL STRETCHED AIN → ARABIC LETTER AIN
+	0x1EE70: "ف",                  // ARABIC MATHEMATICAL STRETCHED FEH → ARABIC LETTER FEH
+	0x1EE71: "ص",                  // ARABIC MATHEMATICAL STRETCHED SAD → ARABIC LETTER SAD
+	0x1EE72: "ق",                  // ARABIC MATHEMATICAL STRETCHED QAF → ARABIC LETTER QAF
+	0x1EE74: "سۛ",                 // ARABIC MATHEMATICAL STRETCHED SHEEN → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0x1EE75: "ت",                  // ARABIC MATHEMATICAL STRETCHED TEH → ARABIC LETTER TEH
+	0x1EE76: "ىۛ",                 // ARABIC MATHEMATICAL STRETCHED THEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x1EE77: "خ",                  // ARABIC MATHEMATICAL STRETCHED KHAH → ARABIC LETTER KHAH
+	0x1EE79: "ض",                  // ARABIC MATHEMATICAL STRETCHED DAD → ARABIC LETTER DAD
+	0x1EE7A: "ظ",                  // ARABIC MATHEMATICAL STRETCHED ZAH → ARABIC LETTER ZAH
+	0x1EE7B: "غ",                  // ARABIC MATHEMATICAL STRETCHED GHAIN → ARABIC LETTER GHAIN
+	0x1EE7C: "ى",                  // ARABIC MATHEMATICAL STRETCHED DOTLESS BEH → ARABIC LETTER ALEF MAKSURA
+	0x1EE7E: "ڡ",                  // ARABIC MATHEMATICAL STRETCHED DOTLESS FEH → ARABIC LETTER DOTLESS FEH
+	0x1EE80: "l",                  // ARABIC MATHEMATICAL LOOPED ALEF → LATIN SMALL LETTER L
+	0x1EE81: "ب",                  // ARABIC MATHEMATICAL LOOPED BEH → ARABIC LETTER BEH
+	0x1EE82: "ج",                  // ARABIC MATHEMATICAL LOOPED JEEM → ARABIC LETTER JEEM
+	0x1EE83: "د",                  // ARABIC MATHEMATICAL LOOPED DAL → ARABIC LETTER DAL
+	0x1EE84: "o",                  // ARABIC MATHEMATICAL LOOPED HEH → LATIN SMALL LETTER O
+	0x1EE85: "و",                  // ARABIC MATHEMATICAL LOOPED WAW → ARABIC LETTER WAW
+	0x1EE86: "ز",                  // ARABIC MATHEMATICAL LOOPED ZAIN → ARABIC LETTER ZAIN
+	0x1EE87: "ح",                  // ARABIC MATHEMATICAL LOOPED HAH → ARABIC LETTER HAH
+	0x1EE88: "ط",                  // ARABIC MATHEMATICAL LOOPED TAH → ARABIC LETTER TAH
+	0x1EE89: "ى",                  // ARABIC MATHEMATICAL LOOPED YEH → ARABIC LETTER ALEF MAKSURA
+	0x1EE8B: "ل",                  // ARABIC MATHEMATICAL LOOPED LAM → ARABIC LETTER LAM
+	0x1EE8C: "م",                  // ARABIC MATHEMATICAL LOOPED MEEM → ARABIC LETTER MEEM
+	0x1EE8D: "ن",                  // ARABIC MATHEMATICAL LOOPED NOON → ARABIC LETTER NOON
+	0x1EE8E: "س",                  // ARABIC MATHEMATICAL LOOPED SEEN → ARABIC LETTER SEEN
+	0x1EE8F: "ع",                  // ARABIC MATHEMATICAL LOOPED AIN → ARABIC LETTER AIN
+	0x1EE90: "ف",                  // ARABIC MATHEMATICAL LOOPED FEH → ARABIC LETTER FEH
+	0x1EE91: "ص",                  // ARABIC MATHEMATICAL LOOPED SAD → ARABIC LETTER SAD
+	0x1EE92: "ق",                  // ARABIC MATHEMATICAL LOOPED QAF → ARABIC LETTER QAF
+	0x1EE93: "ر",                  // ARABIC MATHEMATICAL LOOPED REH → ARABIC LETTER REH
+	0x1EE94: "سۛ",                 // ARABIC MATHEMATICAL LOOPED SHEEN → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0x1EE95: "ت",                  // ARABIC MATHEMATICAL LOOPED TEH → ARABIC LETTER TEH
+	0x1EE96: "ىۛ",                 // ARABIC MATHEMATICAL LOOPED THEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x1EE97: "خ",                  // ARABIC MATHEMATICAL LOOPED KHAH → ARABIC LETTER KHAH
+	0x1EE98: "ذ",                  // ARABIC MATHEMATICAL LOOPED THAL → ARABIC LETTER THAL
+	0x1EE99: "ض",                  // ARABIC MATHEMATICAL LOOPED DAD → ARABIC LETTER DAD
+	0x1EE9A: "ظ",                  // ARABIC MATHEMATICAL LOOPED ZAH → ARABIC LETTER ZAH
+	0x1EE9B: "غ",                  // ARABIC MATHEMATICAL LOOPED GHAIN → ARABIC LETTER GHAIN
+	0x1EEA1: "ب",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK BEH → ARABIC LETTER BEH
+	0x1EEA2: "ج",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK JEEM → ARABIC LETTER JEEM
+	0x1EEA3: "د",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK DAL → ARABIC LETTER DAL
+	0x1EEA5: "و",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK WAW → ARABIC LETTER WAW
+	0x1EEA6: "ز",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK ZAIN → ARABIC LETTER ZAIN
+	0x1EEA7: "ح",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK HAH → ARABIC LETTER HAH
+	0x1EEA8: "ط",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK TAH → ARABIC LETTER TAH
+	0x1EEA9: "ى",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK YEH → ARABIC LETTER ALEF MAKSURA
+	0x1EEAB: "ل",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK LAM → ARABIC LETTER LAM
+	0x1EEAC: "م",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK MEEM → ARABIC LETTER MEEM
+	0x1EEAD: "ن",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK NOON → ARABIC LETTER NOON
+	0x1EEAE: "س",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK SEEN → ARABIC LETTER SEEN
+	0x1EEAF: "ع",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK AIN → ARABIC LETTER AIN
+	0x1EEB0: "ف",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK FEH → ARABIC LETTER FEH
+	0x1EEB1: "ص",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK SAD → ARABIC LETTER SAD
+	0x1EEB2: "ق",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK QAF → ARABIC LETTER QAF
+	0x1EEB3: "ر",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK REH → ARABIC LETTER REH
+	0x1EEB4: "سۛ",                 // ARABIC MATHEMATICAL DOUBLE-STRUCK SHEEN → ARABIC LETTER SEEN + ARABIC SMALL HIGH THREE DOTS
+	0x1EEB5: "ت",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK TEH → ARABIC LETTER TEH
+	0x1EEB6: "ىۛ",                 // ARABIC MATHEMATICAL DOUBLE-STRUCK THEH → ARABIC LETTER ALEF MAKSURA + ARABIC SMALL HIGH THREE DOTS
+	0x1EEB7: "خ",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK KHAH → ARABIC LETTER KHAH
+	0x1EEB8: "ذ",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK THAL → ARABIC LETTER THAL
+	0x1EEB9: "ض",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK DAD → ARABIC LETTER DAD
+	0x1EEBA: "ظ",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK ZAH → ARABIC LETTER ZAH
+	0x1EEBB: "غ",                  // ARABIC MATHEMATICAL DOUBLE-STRUCK GHAIN → ARABIC LETTER GHAIN
+	0x1F100: "O.",                 // DIGIT ZERO FULL STOP → LATIN CAPITAL LETTER O + FULL STOP
+	0x1F101: "O,",                 // DIGIT ZERO COMMA → LATIN CAPITAL LETTER O + COMMA
+	0x1F102: "l,",                 // DIGIT ONE COMMA → LATIN SMALL LETTER L + COMMA
+	0x1F103: "2,",                 // DIGIT TWO COMMA → DIGIT TWO + COMMA
+	0x1F104: "3,",                 // DIGIT THREE COMMA → DIGIT THREE + COMMA
+	0x1F105: "4,",                 // DIGIT FOUR COMMA → DIGIT FOUR + COMMA
+	0x1F106: "5,",                 // DIGIT FIVE COMMA → DIGIT FIVE + COMMA
+	0x1F107: "6,",                 // DIGIT SIX COMMA → DIGIT SIX + COMMA
+	0x1F108: "7,",                 // DIGIT SEVEN COMMA → DIGIT SEVEN + COMMA
+	0x1F109: "8,",                 // DIGIT EIGHT COMMA → DIGIT EIGHT + COMMA
+	0x1F10A: "9,",                 // DIGIT NINE COMMA → DIGIT NINE + COMMA
+	0x1F10F: "$⃠",                 // CIRCLED DOLLAR SIGN WITH OVERLAID BACKSLASH → DOLLAR SIGN + COMBINING ENCLOSING CIRCLE BACKSLASH
+	0x1F110: "(A)",                // ) PARENTHESIZED LATIN CAPITAL LETTER A → LEFT PARENTHESIS + LATIN CAPITAL LETTER A + RIGHT PARENTHESIS
+	0x1F111: "(B)",                // ) PARENTHESIZED LATIN CAPITAL LETTER B → LEFT PARENTHESIS + LATIN CAPITAL LETTER B + RIGHT PARENTHESIS
+	0x1F112: "(C)",                // ) PARENTHESIZED LATIN CAPITAL LETTER C → LEFT PARENTHESIS + LATIN CAPITAL LETTER C + RIGHT PARENTHESIS
+	0x1F113: "(D)",                // ) PARENTHESIZED LATIN CAPITAL LETTER D → LEFT PARENTHESIS + LATIN CAPITAL LETTER D + RIGHT PARENTHESIS
+	0x1F114: "(E)",                // ) PARENTHESIZED LATIN CAPITAL LETTER E → LEFT PARENTHESIS + LATIN CAPITAL LETTER E + RIGHT PARENTHESIS
+	0x1F115: "(F)",                // ) PARENTHESIZED LATIN CAPITAL LETTER F → LEFT PARENTHESIS + LATIN CAPITAL LETTER F + RIGHT PARENTHESIS
+	0x1F116: "(G)",                // ) PARENTHESIZED LATIN CAPITAL LETTER G → LEFT PARENTHESIS + LATIN CAPITAL LETTER G + RIGHT PARENTHESIS
+	0x1F117: "(H)",                // ) PARENTHESIZED LATIN CAPITAL LETTER H → LEFT PARENTHESIS + LATIN CAPITAL LETTER H + RIGHT PARENTHESIS
+	0x1F118: "(l)",                // ) PARENTHESIZED LATIN CAPITAL LETTER I → LEFT PARENTHESIS + LATIN SMALL LETTER L + RIGHT PARENTHESIS
+	0x1F119: "(J)",                // ) PARENTHESIZED LATIN CAPITAL LETTER J → LEFT PARENTHESIS + LATIN CAPITAL LETTER J + RIGHT PARENTHESIS
+	0x1F11A: "(K)",                // ) PARENTHESIZED LATIN CAPITAL LETTER K → LEFT PARENTHESIS + LATIN CAPITAL LETTER K + RIGHT PARENTHESIS
+	0x1F11B: "(L)",                // ) PARENTHESIZED LATIN CAPITAL LETTER L → LEFT PARENTHESIS + LATIN CAPITAL LETTER L + RIGHT PARENTHESIS
+	0x1F11C: "(M)",                // ) PARENTHESIZED LATIN CAPITAL LETTER M → LEFT PARENTHESIS + LATIN CAPITAL LETTER M + RIGHT PARENTHESIS
+	0x1F11D: "(N)",                // ) PARENTHESIZED LATIN CAPITAL LETTER N → LEFT PARENTHESIS + LATIN CAPITAL LETTER N + RIGHT PARENTHESIS
+	0x1F11E: "(O)",                // ) PARENTHESIZED LATIN CAPITAL LETTER O → LEFT PARENTHESIS + LATIN CAPITAL LETTER O + RIGHT PARENTHESIS
+	0x1F11F: "(P)",                // ) PARENTHESIZED LATIN CAPITAL LETTER P → LEFT PARENTHESIS + LATIN CAPITAL LETTER P + RIGHT PARENTHESIS
+	0x1F120: "(Q)",                // ) PARENTHESIZED LATIN CAPITAL LETTER Q → LEFT PARENTHESIS + LATIN CAPITAL LETTER Q + RIGHT PARENTHESIS
+	0x1F121: "(R)",                // ) PARENTHESIZED LATIN CAPITAL LETTER R → LEFT PARENTHESIS + LATIN CAPITAL LETTER R + RIGHT PARENTHESIS
+	0x1F122: "(S)",                // ) PARENTHESIZED LATIN CAPITAL LETTER S → LEFT PARENTHESIS + LATIN CAPITAL LETTER S + RIGHT PARENTHESIS
+	0x1F123: "(T)",                // ) PARENTHESIZED LATIN CAPITAL LETTER T → LEFT PARENTHESIS + LATIN CAPITAL LETTER T + RIGHT PARENTHESIS
+	0x1F124: "(U)",                // ) PARENTHESIZED LATIN CAPITAL LETTER U → LEFT PARENTHESIS + LATIN CAPITAL LETTER U + RIGHT PARENTHESIS
+	0x1F125: "(V)",                // ) PARENTHESIZED LATIN CAPITAL LETTER V → LEFT PARENTHESIS + LATIN CAPITAL LETTER V + RIGHT PARENTHESIS
+	0x1F126: "(W)",                // ) PARENTHESIZED LATIN CAPITAL LETTER W → LEFT PARENTHESIS + LATIN CAPITAL LETTER W + RIGHT PARENTHESIS
+	0x1F127: "(X)",                // ) PARENTHESIZED LATIN CAPITAL LETTER X → LEFT PARENTHESIS + LATIN CAPITAL LETTER X + RIGHT PARENTHESIS
+	0x1F128: "(Y)",                // ) PARENTHESIZED LATIN CAPITAL LETTER Y → LEFT PARENTHESIS + LATIN CAPITAL LETTER Y + RIGHT PARENTHESIS
+	0x1F129: "(Z)",                // ) PARENTHESIZED LATIN CAPITAL LETTER Z → LEFT PARENTHESIS + LATIN CAPITAL LETTER Z + RIGHT PARENTHESIS
+	0x1F12A: "(S)",                // ) TORTOISE SHELL BRACKETED LATIN CAPITAL LETTER S → LEFT PARENTHESIS + LATIN CAPITAL LETTER S + RIGHT PARENTHESIS
+	0x1F16D: "㏄\t⃝",               // CIRCLED CC → SQUARE CC + <control> + COMBINING ENCLOSING CIRCLE
+	0x1F16E: "C⃠",                 // CIRCLED C WITH OVERLAID BACKSLASH → LATIN CAPITAL LETTER C + COMBINING ENCLOSING CIRCLE BACKSLASH
+	0x1F240: "(本)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-672C → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F241: "(三)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E09 → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F242: "(二)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E8C → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F243: "(安)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-5B89 → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F244: "(点)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-70B9 → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F245: "(打)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6253 → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F246: "(盗)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-76D7 → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F247: "(勝)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-52DD → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F248: "(敗)",                // ) TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6557 → LEFT PARENTHESIS + <CJK Ideograph> + RIGHT PARENTHESIS
+	0x1F312: "☽",                  // WAXING CRESCENT MOON SYMBOL → FIRST QUARTER MOON
+	0x1F318: "☾",                  // WANING CRESCENT MOON SYMBOL → LAST QUARTER MOON
+	0x1F319: "☽",                  // CRESCENT MOON → FIRST QUARTER MOON
+	0x1F333: "𜺼",                  // ( 🌳 → 𜺼 )
+	0x1F34E: "𜺽",                  // ( 🍎 → 𜺽 )
+	0x1F34F: "𜺽",                  // ( 🍏 → 𜺽 )
+	0x1F352: "𜺾",                  // ( 🍒 → 𜺾 )
+	0x1F353: "𜺿",                  // ( 🍓 → 𜺿 )
+	0x1F377: "𜺺",                  // ( 🍷 → 𜺺 )
+	0x1F3E2: "𜺻",                  // ( 🏢 → 𜺻 )
+	0x1F40D: "𜳺",                  // ( 🐍 → 𜳺 )
+	0x1F443: "𜳼",                  // ( 👃 → 𜳼 )
+	0x1F514: "🯺",                  // ( 🔔 → 🯺 )
+	0x1F700: "QE",                 // ALCHEMICAL SYMBOL FOR QUINTESSENCE → LATIN CAPITAL LETTER Q + LATIN CAPITAL LETTER E
+	0x1F701: "Ꙙ",                  // ALCHEMICAL SYMBOL FOR AIR → CYRILLIC CAPITAL LETTER CLOSED LITTLE YUS
+	0x1F702: "Δ",                  // ALCHEMICAL SYMBOL FOR FIRE → GREEK CAPITAL LETTER DELTA
+	0x1F704: "𐊼",                  // ALCHEMICAL SYMBOL FOR WATER → CARIAN LETTER K
+	0x1F707: "AR",                 // ALCHEMICAL SYMBOL FOR AQUA REGIA-2 → LATIN CAPITAL LETTER A + LATIN CAPITAL LETTER R
+	0x1F708: "Vᷤ",                 // ALCHEMICAL SYMBOL FOR AQUA VITAE → LATIN CAPITAL LETTER V + COMBINING LATIN SMALL LETTER S
+	0x1F70A: "☩",                  // ALCHEMICAL SYMBOL FOR VINEGAR → CROSS OF JERUSALEM
+	0x1F714: "O̵",                 // ALCHEMICAL SYMBOL FOR SALT → LATIN CAPITAL LETTER O + COMBINING SHORT STROKE OVERLAY
+	0x1F728: "𐊨",                  // ALCHEMICAL SYMBOL FOR VERDIGRIS → CARIAN LETTER Q
+	0x1F73A: "⧟",                  // ALCHEMICAL SYMBOL FOR ARSENIC → DOUBLE-ENDED MULTIMAP
+	0x1F74C: "C",                  // ALCHEMICAL SYMBOL FOR CALX → LATIN CAPITAL LETTER C
+	0x1F754: "ᛜ",                  // ALCHEMICAL SYMBOL FOR SOAP → RUNIC LETTER INGWAZ
+	0x1F755: "⊡",                  // ALCHEMICAL SYMBOL FOR URINE → SQUARED DOT OPERATOR
+	0x1F75C: "sss",                // ALCHEMICAL SYMBOL FOR STRATUM SUPER STRATUM → LATIN SMALL LETTER S + LATIN SMALL LETTER S + LATIN SMALL LETTER S
+	0x1F75E: "≏",                  // ALCHEMICAL SYMBOL FOR SUBLIMATION → DIFFERENCE BETWEEN
+	0x1F768: "T",                  // ALCHEMICAL SYMBOL FOR CRUCIBLE-4 → LATIN CAPITAL LETTER T
+	0x1F76B: "MB",                 // ALCHEMICAL SYMBOL FOR BATH OF MARY → LATIN CAPITAL LETTER M + LATIN CAPITAL LETTER B
+	0x1F76C: "VB",                 // ALCHEMICAL SYMBOL FOR BATH OF VAPOURS → LATIN CAPITAL LETTER V + LATIN CAPITAL LETTER B
+	0x1F771: "⊠",                  // ALCHEMICAL SYMBOL FOR MONTH → SQUARED TIMES
+	0x1FBF0: "O",                  // SEGMENTED DIGIT ZERO → LATIN CAPITAL LETTER O
+	0x1FBF1: "l",                  // SEGMENTED DIGIT ONE → LATIN SMALL LETTER L
+	0x1FBF2: "2",                  // SEGMENTED DIGIT TWO → DIGIT TWO
+	0x1FBF3: "3",                  // SEGMENTED DIGIT THREE → DIGIT THREE
+	0x1FBF4: "4",                  // SEGMENTED DIGIT FOUR → DIGIT FOUR
+	0x1FBF5: "5",                  // SEGMENTED DIGIT FIVE → DIGIT FIVE
+	0x1FBF6: "6",                  // SEGMENTED DIGIT SIX → DIGIT SIX
+	0x1FBF7: "7",                  // SEGMENTED DIGIT SEVEN → DIGIT SEVEN
+	0x1FBF8: "8",                  // SEGMENTED DIGIT EIGHT → DIGIT EIGHT
+	0x1FBF9: "9",                  // SEGMENTED DIGIT NINE → DIGIT NINE
+	0x20674: "凵",                  // <CJK Ideograph Extension B> → <CJK Ideograph>
+	0x21533: "壷",                  // <CJK Ideograph Extension B> → <CJK Ideograph>
+	0x21587: "多",                  // <CJK Ideograph Extension B> → <CJK Ideograph>
+	0x216A7: "𡚨",                  // <CJK Ideograph Extension B> → <CJK Ideograph Extension B>
+	0x21FE8: "❬",                  // <CJK Ideograph Extension B> → MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT
+	0x22505: "徚",                  // <CJK Ideograph Extension B> → <CJK Ideograph>
+	0x23D40: "涅",                  // <CJK Ideograph Extension B> → <CJK Ideograph>
+	0x248FD: "玥",                  // <CJK Ideograph Extension B> → <CJK Ideograph>
+	0x2511A: "𥄙",                  // <CJK Ideograph Extension B> → <CJK Ideograph Extension B>
+	0x25AD4: "贛",                  // <CJK Ideograph Extension B> → <CJK Ideograph>
+	0x2659D: "𦖨",                  // <CJK Ideograph Extension B> → <CJK Ideograph Extension B>
+	0x26D06: "𦰶",                  // <CJK Ideograph Extension B> → <CJK Ideograph Extension B>
+	0x2AEC5: "𤠔",                  // <CJK Ideograph Extension C> → <CJK Ideograph Extension B>
+	0x2B73A: "峀",                  // ( 𫜺 → 峀 )
+	0x2B73E: "𣍟",                  // ( 𫜾 → 𣍟 )
+	0x2D161: "卑",                  // <CJK Ideograph Extension F> → <CJK Ideograph>
+	0x2F800: "丽",                  // CJK COMPATIBILITY IDEOGRAPH-2F800 → <CJK Ideograph>
+	0x2F801: "丸",                  // CJK COMPATIBILITY IDEOGRAPH-2F801 → <CJK Ideograph>
+	0x2F802: "乁",                  // CJK COMPATIBILITY IDEOGRAPH-2F802 → <CJK Ideograph>
+	0x2F803: "𠄢",                  // CJK COMPATIBILITY IDEOGRAPH-2F803 → <CJK Ideograph Extension B>
+	0x2F804: "你",                  // CJK COMPATIBILITY IDEOGRAPH-2F804 → <CJK Ideograph>
+	0x2F805: "侮",                  // CJK COMPATIBILITY IDEOGRAPH-2F805 → <CJK Ideograph>
+	0x2F806: "侻",                  // CJK COMPATIBILITY IDEOGRAPH-2F806 → <CJK Ideograph>
+	0x2F807: "併",                  // CJK COMPATIBILITY IDEOGRAPH-2F807 → <CJK Ideograph>
+	0x2F808: "偺",                  // CJK COMPATIBILITY IDEOGRAPH-2F808 → <CJK Ideograph>
+	0x2F809: "備",                  // CJK COMPATIBILITY IDEOGRAPH-2F809 → <CJK Ideograph>
+	0x2F80A: "僧",                  // CJK COMPATIBILITY IDEOGRAPH-2F80A → <CJK Ideograph>
+	0x2F80B: "像",                  // CJK COMPATIBILITY IDEOGRAPH-2F80B → <CJK Ideograph>
+	0x2F80C: "㒞",                  // CJK COMPATIBILITY IDEOGRAPH-2F80C → <CJK Ideograph Extension A>
+	0x2F80D: "𠘺",                  // CJK COMPATIBILITY IDEOGRAPH-2F80D → <CJK Ideograph Extension B>
+	0x2F80E: "免",                  // CJK COMPATIBILITY IDEOGRAPH-2F80E → <CJK Ideograph>
+	0x2F80F: "兔",                  // CJK COMPATIBILITY IDEOGRAPH-2F80F → <CJK Ideograph>
+	0x2F810: "兤",                  // CJK COMPATIBILITY IDEOGRAPH-2F810 → <CJK Ideograph>
+	0x2F811: "具",                  // CJK COMPATIBILITY IDEOGRAPH-2F811 → <CJK Ideograph>
+	0x2F812: "𠔜",                  // CJK COMPATIBILITY IDEOGRAPH-2F812 → <CJK Ideograph Extension B>
+	0x2F813: "㒹",                  // CJK COMPATIBILITY IDEOGRAPH-2F813 → <CJK Ideograph Extension A>
+	0x2F814: "內",                  // CJK COMPATIBILITY IDEOGRAPH-2F814 → <CJK Ideograph>
+	0x2F815: "再",                  // CJK COMPATIBILITY IDEOGRAPH-2F815 → <CJK Ideograph>
+	0x2F816: "𠕋",                  // CJK COMPATIBILITY IDEOGRAPH-2F816 → <CJK Ideograph Extension B>
+	0x2F817: "冗",                  // CJK COMPATIBILITY IDEOGRAPH-2F817 → <CJK Ideograph>
+	0x2F818: "冤",                  // CJK COMPATIBILITY IDEOGRAPH-2F818 → <CJK Ideograph>
+	0x2F819: "仌",                  // CJK COMPATIBILITY IDEOGRAPH-2F819 → <CJK Ideograph>
+	0x2F81A: "冬",                  // CJK COMPATIBILITY IDEOGRAPH-2F81A → <CJK Ideograph>
+	0x2F81B: "况",                  // CJK COMPATIBILITY IDEOGRAPH-2F81B → <CJK Ideograph>
+	0x2F81C: "𩇟",                  // CJK COMPATIBILITY IDEOGRAPH-2F81C → <CJK Ideograph Extension B>
+	0x2F81D: "凵",                  // CJK COMPATIBILITY IDEOGRAPH-2F81D → <CJK Ideograph>
+	0x2F81E: "刃",                  // CJK COMPATIBILITY IDEOGRAPH-2F81E → <CJK Ideograph>
+	0x2F81F: "㓟",                  // CJK COMPATIBILITY IDEOGRAPH-2F81F → <CJK Ideograph Extension A>
+	0x2F820: "刻",                  // CJK COMPATIBILITY IDEOGRAPH-2F820 → <CJK Ideograph>
+	0x2F821: "剆",                  // CJK COMPATIBILITY IDEOGRAPH-2F821 → <CJK Ideograph>
+	0x2F822: "割",                  // CJK COMPATIBILITY IDEOGRAPH-2F822 → <CJK Ideograph>
+	0x2F823: "剷",                  // CJK COMPATIBILITY IDEOGRAPH-2F823 → <CJK Ideograph>
+	0x2F824: "㔕",                  // CJK COMPATIBILITY IDEOGRAPH-2F824 → <CJK Ideograph Extension A>
+	0x2F825: "勇",                  // CJK COMPATIBILITY IDEOGRAPH-2F825 → <CJK Ideograph>
+	0x2F826: "勉",                  // CJK COMPATIBILITY IDEOGRAPH-2F826 → <CJK Ideograph>
+	0x2F827: "勤",                  // CJK COMPATIBILITY IDEOGRAPH-2F827 → <CJK Ideograph>
+	0x2F828: "勺",                  // CJK COMPATIBILITY IDEOGRAPH-2F828 → <CJK Ideograph>
+	0x2F829: "包",                  // CJK COMPATIBILITY IDEOGRAPH-2F829 → <CJK Ideograph>
+	0x2F82A: "匆",                  // CJK COMPATIBILITY IDEOGRAPH-2F82A → <CJK Ideograph>
+	0x2F82B: "北",                  // CJK COMPATIBILITY IDEOGRAPH-2F82B → <CJK Ideograph>
+	0x2F82C: "卉",                  // CJK COMPATIBILITY IDEOGRAPH-2F82C → <CJK Ideograph>
+	0x2F82D: "卑",                  // CJK COMPATIBILITY IDEOGRAPH-2F82D → <CJK Ideograph>
+	0x2F82E: "博",                  // CJK COMPATIBILITY IDEOGRAPH-2F82E → <CJK Ideograph>
+	0x2F82F: "即",                  // CJK COMPATIBILITY IDEOGRAPH-2F82F → <CJK Ideograph>
+	0x2F830: "卽",                  // CJK COMPATIBILITY IDEOGRAPH-2F830 → <CJK Ideograph>
+	0x2F831: "卿",                  // CJK COMPATIBILITY IDEOGRAPH-2F831 → <CJK Ideograph>
+	0x2F832: "卿",                  // CJK COMPATIBILITY IDEOGRAPH-2F832 → <CJK Ideograph>
+	0x2F833: "卿",                  // CJK COMPATIBILITY IDEOGRAPH-2F833 → <CJK Ideograph>
+	0x2F834: "𠨬",                  // CJK COMPATIBILITY IDEOGRAPH-2F834 → <CJK Ideograph Extension B>
+	0x2F835: "灰",                  // CJK COMPATIBILITY IDEOGRAPH-2F835 → <CJK Ideograph>
+	0x2F836: "及",                  // CJK COMPATIBILITY IDEOGRAPH-2F836 → <CJK Ideograph>
+	0x2F837: "叟",                  // CJK COMPATIBILITY IDEOGRAPH-2F837 → <CJK Ideograph>
+	0x2F838: "𠭣",                  // CJK COMPATIBILITY IDEOGRAPH-2F838 → <CJK Ideograph Extension B>
+	0x2F839: "叫",                  // CJK COMPATIBILITY IDEOGRAPH-2F839 → <CJK Ideograph>
+	0x2F83A: "叱",                  // CJK COMPATIBILITY IDEOGRAPH-2F83A → <CJK Ideograph>
+	0x2F83B: "吆",                  // CJK COMPATIBILITY IDEOGRAPH-2F83B → <CJK Ideograph>
+	0x2F83C: "咞",                  // CJK COMPATIBILITY IDEOGRAPH-2F83C → <CJK Ideograph>
+	0x2F83D: "吸",                  // CJK COMPATIBILITY IDEOGRAPH-2F83D → <CJK Ideograph>
+	0x2F83E: "呈",                  // CJK COMPATIBILITY IDEOGRAPH-2F83E → <CJK Ideograph>
+	0x2F83F: "周",                  // CJK COMPATIBILITY IDEOGRAPH-2F83F → <CJK Ideograph>
+	0x2F840: "咢",                  // CJK COMPATIBILITY IDEOGRAPH-2F840 → <CJK Ideograph>
+	0x2F841: "哶",                  // CJK COMPATIBILITY IDEOGRAPH-2F841 → <CJK Ideograph>
+	0x2F842: "唐",                  // CJK COMPATIBILITY IDEOGRAPH-2F842 → <CJK Ideograph>
+	0x2F843: "啓",                  // CJK COMPATIBILITY IDEOGRAPH-2F843 → <CJK Ideograph>
+	0x2F844: "啣",                  // CJK COMPATIBILITY IDEOGRAPH-2F844 → <CJK Ideograph>
+	0x2F845: "善",                  // CJK COMPATIBILITY IDEOGRAPH-2F845 → <CJK Ideograph>
+	0x2F846: "善",                  // CJK COMPATIBILITY IDEOGRAPH-2F846 → <CJK Ideograph>
+	0x2F847: "喙",                  // CJK COMPATIBILITY IDEOGRAPH-2F847 → <CJK Ideograph>
+	0x2F848: "喫",                  // CJK COMPATIBILITY IDEOGRAPH-2F848 → <CJK Ideograph>
+	0x2F849: "喳",                  // CJK COMPATIBILITY IDEOGRAPH-2F849 → <CJK Ideograph>
+	0x2F84A: "嗂",                  // CJK COMPATIBILITY IDEOGRAPH-2F84A → <CJK Ideograph>
+	0x2F84B: "圖",                  // CJK COMPATIBILITY IDEOGRAPH-2F84B → <CJK Ideograph>
+	0x2F84C: "嘆",                  // CJK COMPATIBILITY IDEOGRAPH-2F84C → <CJK Ideograph>
+	0x2F84D: "圗",                  // CJK COMPATIBILITY IDEOGRAPH-2F84D → <CJK Ideograph>
+	0x2F84E: "噑",                  // CJK COMPATIBILITY IDEOGRAPH-2F84E → <CJK Ideograph>
+	0x2F84F: "噴",                  // CJK COMPATIBILITY IDEOGRAPH-2F84F → <CJK Ideograph>
+	0x2F850: "切",                  // CJK COMPATIBILITY IDEOGRAPH-2F850 → <CJK Ideograph>
+	0x2F851: "壮",                  // CJK COMPATIBILITY IDEOGRAPH-2F851 → <CJK Ideograph>
+	0x2F852: "城",                  // CJK COMPATIBILITY IDEOGRAPH-2F852 → <CJK Ideograph>
+	0x2F853: "埴",                  // CJK COMPATIBILITY IDEOGRAPH-2F853 → <CJK Ideograph>
+	0x2F854: "堍",                  // CJK COMPATIBILITY IDEOGRAPH-2F854 → <CJK Ideograph>
+	0x2F855: "型",                  // CJK COMPATIBILITY IDEOGRAPH-2F855 → <CJK Ideograph>
+	0x2F856: "堲",                  // CJK COMPATIBILITY IDEOGRAPH-2F856 → <CJK Ideograph>
+	0x2F857: "報",                  // CJK COMPATIBILITY IDEOGRAPH-2F857 → <CJK Ideograph>
+	0x2F858: "墬",                  // CJK COMPATIBILITY IDEOGRAPH-2F858 → <CJK Ideograph>
+	0x2F859: "𡓤",                  // CJK COMPATIBILITY IDEOGRAPH-2F859 → <CJK Ideograph Extension B>
+	0x2F85A: "売",                  // CJK COMPATIBILITY IDEOGRAPH-2F85A → <CJK Ideograph>
+	0x2F85B: "壷",                  // CJK COMPATIBILITY IDEOGRAPH-2F85B → <CJK Ideograph>
+	0x2F85C: "夆",                  // CJK COMPATIBILITY IDEOGRAPH-2F85C → <CJK Ideograph>
+	0x2F85D: "多",                  // CJK COMPATIBILITY IDEOGRAPH-2F85D → <CJK Ideograph>
+	0x2F85E: "夢",                  // CJK COMPATIBILITY IDEOGRAPH-2F85E → <CJK Ideograph>
+	0x2F85F: "奢",                  // CJK COMPATIBILITY IDEOGRAPH-2F85F → <CJK Ideograph>
+	0x2F860: "𡚨",                  // CJK COMPATIBILITY IDEOGRAPH-2F860 → <CJK Ideograph Extension B>
+	0x2F861: "𡛪",                  // CJK COMPATIBILITY IDEOGRAPH-2F861 → <CJK Ideograph Extension B>
+	0x2F862: "姬",                  // CJK COMPATIBILITY IDEOGRAPH-2F862 → <CJK Ideograph>
+	0x2F863: "娛",                  // CJK COMPATIBILITY IDEOGRAPH-2F863 → <CJK Ideograph>
+	0x2F864: "娧",                  // CJK COMPATIBILITY IDEOGRAPH-2F864 → <CJK Ideograph>
+	0x2F865: "姘",                  // CJK COMPATIBILITY IDEOGRAPH-2F865 → <CJK Ideograph>
+	0x2F866: "婦",                  // CJK COMPATIBILITY IDEOGRAPH-2F866 → <CJK Ideograph>
+	0x2F867: "㛮",                  // CJK COMPATIBILITY IDEOGRAPH-2F867 → <CJK Ideograph Extension A>
+	0x2F868: "㛼",                  // CJK COMPATIBILITY IDEOGRAPH-2F868 → <CJK Ideograph Extension A>
+	0x2F869: "嬈",                  // CJK COMPATIBILITY IDEOGRAPH-2F869 → <CJK Ideograph>
+	0x2F86A: "嬾",                  // CJK COMPATIBILITY IDEOGRAPH-2F86A → <CJK Ideograph>
+	0x2F86B: "嬾",                  // CJK COMPATIBILITY IDEOGRAPH-2F86B → <CJK Ideograph>
+	0x2F86C: "𡧈",                  // CJK COMPATIBILITY IDEOGRAPH-2F86C → <CJK Ideograph Extension B>
+	0x2F86D: "寃",                  // CJK COMPATIBILITY IDEOGRAPH-2F86D → <CJK Ideograph>
+	0x2F86E: "寘",                  // CJK COMPATIBILITY IDEOGRAPH-2F86E → <CJK Ideograph>
+	0x2F86F: "寧",                  // CJK COMPATIBILITY IDEOGRAPH-2F86F → <CJK Ideograph>
+	0x2F870: "寳",                  // CJK COMPATIBILITY IDEOGRAPH-2F870 → <CJK Ideograph>
+	0x2F871: "𡬘",                  // CJK COMPATIBILITY IDEOGRAPH-2F871 → <CJK Ideograph Extension B>
+	0x2F872: "寿",                  // CJK COMPATIBILITY IDEOGRAPH-2F872 → <CJK Ideograph>
+	0x2F873: "将",                  // CJK COMPATIBILITY IDEOGRAPH-2F873 → <CJK Ideograph>
+	0x2F874: "当",                  // CJK COMPATIBILITY IDEOGRAPH-2F874 → <CJK Ideograph>
+	0x2F875: "尢",                  // CJK COMPATIBILITY IDEOGRAPH-2F875 → <CJK Ideograph>
+	0x2F876: "㞁",                  // CJK COMPATIBILITY IDEOGRAPH-2F876 → <CJK Ideograph Extension A>
+	0x2F877: "屠",                  // CJK COMPATIBILITY IDEOGRAPH-2F877 → <CJK Ideograph>
+	0x2F878: "屮",                  // CJK COMPATIBILITY IDEOGRAPH-2F878 → <CJK Ideograph>
+	0x2F879: "峀",                  // CJK COMPATIBILITY IDEOGRAPH-2F879 → <CJK Ideograph>
+	0x2F87A: "岍",                  // CJK COMPATIBILITY IDEOGRAPH-2F87A → <CJK Ideograph>
+	0x2F87B: "𡷤",                  // CJK COMPATIBILITY IDEOGRAPH-2F87B → <CJK Ideograph Extension B>
+	0x2F87C: "嵃",                  // CJK COMPATIBILITY IDEOGRAPH-2F87C → <CJK Ideograph>
+	0x2F87D: "𡷦",                  // CJK COMPATIBILITY IDEOGRAPH-2F87D → <CJK Ideograph Extension B>
+	0x2F87E: "嵮",                  // CJK COMPATIBILITY IDEOGRAPH-2F87E → <CJK Ideograph>
+	0x2F87F: "嵫",                  // CJK COMPATIBILITY IDEOGRAPH-2F87F → <CJK Ideograph>
+	0x2F880: "嵼",                  // CJK COMPATIBILITY IDEOGRAPH-2F880 → <CJK Ideograph>
+	0x2F881: "巡",                  // CJK COMPATIBILITY IDEOGRAPH-2F881 → <CJK Ideograph>
+	0x2F882: "巢",                  // CJK COMPATIBILITY IDEOGRAPH-2F882 → <CJK Ideograph>
+	0x2F883: "㠯",                  // CJK COMPATIBILITY IDEOGRAPH-2F883 → <CJK Ideograph Extension A>
+	0x2F884: "巽",                  // CJK COMPATIBILITY IDEOGRAPH-2F884 → <CJK Ideograph>
+	0x2F885: "帨",                  // CJK COMPATIBILITY IDEOGRAPH-2F885 → <CJK Ideograph>
+	0x2F886: "帽",                  // CJK COMPATIBILITY IDEOGRAPH-2F886 → <CJK Ideograph>
+	0x2F887: "幩",                  // CJK COMPATIBILITY IDEOGRAPH-2F887 → <CJK Ideograph>
+	0x2F888: "㡢",                  // CJK COMPATIBILITY IDEOGRAPH-2F888 → <CJK Ideograph Extension A>
+	0x2F889: "𢆃",                  // CJK COMPATIBILITY IDEOGRAPH-2F889 → <CJK Ideograph Extension B>
+	0x2F88A: "㡼",                  // CJK COMPATIBILITY IDEOGRAPH-2F88A → <CJK Ideograph Extension A>
+	0x2F88B: "庰",                  // CJK COMPATIBILITY IDEOGRAPH-2F88B → <CJK Ideograph>
+	0x2F88C: "庳",                  // CJK COMPATIBILITY IDEOGRAPH-2F88C → <CJK Ideograph>
+	0x2F88D: "庶",                  // CJK COMPATIBILITY IDEOGRAPH-2F88D → <CJK Ideograph>
+	0x2F88E: "廊",                  // CJK COMPATIBILITY IDEOGRAPH-2F88E → <CJK Ideograph>
+	0x2F88F: "𪎒",                  // CJK COMPATIBILITY IDEOGRAPH-2F88F → <CJK Ideograph Extension B>
+	0x2F890: "廾",                  // CJK COMPATIBILITY IDEOGRAPH-2F890 → <CJK Ideograph>
+	0x2F891: "𢌱",                  // CJK COMPATIBILITY IDEOGRAPH-2F891 → <CJK Ideograph Extension B>
+	0x2F892: "𢌱",                  // CJK COMPATIBILITY IDEOGRAPH-2F892 → <CJK Ideograph Extension B>
+	0x2F893: "舁",                  // CJK COMPATIBILITY IDEOGRAPH-2F893 → <CJK Ideograph>
+	0x2F894: "弢",                  // CJK COMPATIBILITY IDEOGRAPH-2F894 → <CJK Ideograph>
+	0x2F895: "弢",                  // CJK COMPATIBILITY IDEOGRAPH-2F895 → <CJK Ideograph>
+	0x2F896: "㣇",                  // CJK COMPATIBILITY IDEOGRAPH-2F896 → <CJK Ideograph Extension A>
+	0x2F897: "𣊸",                  // CJK COMPATIBILITY IDEOGRAPH-2F897 → <CJK Ideograph Extension B>
+	0x2F898: "𦇚",                  // CJK COMPATIBILITY IDEOGRAPH-2F898 → <CJK Ideograph Extension B>
+	0x2F899: "形",                  // CJK COMPATIBILITY IDEOGRAPH-2F899 → <CJK Ideograph>
+	0x2F89A: "彫",                  // CJK COMPATIBILITY IDEOGRAPH-2F89A → <CJK Ideograph>
+	0x2F89B: "㣣",                  // CJK COMPATIBILITY IDEOGRAPH-2F89B → <CJK Ideograph Extension A>
+	0x2F89C: "徚",                  // CJK COMPATIBILITY IDEOGRAPH-2F89C → <CJK Ideograph>
+	0x2F89D: "忍",                  // CJK COMPATIBILITY IDEOGRAPH-2F89D → <CJK Ideograph>
+	0x2F89E: "志",                  // CJK COMPATIBILITY IDEOGRAPH-2F89E → <CJK Ideograph>
+	0x2F89F: "忹",                  // CJK COMPATIBILITY IDEOGRAPH-2F89F → <CJK Ideograph>
+	0x2F8A0: "悁",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A0 → <CJK Ideograph>
+	0x2F8A1: "㤺",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A1 → <CJK Ideograph Extension A>
+	0x2F8A2: "㤜",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A2 → <CJK Ideograph Extension A>
+	0x2F8A3: "悔",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A3 → <CJK Ideograph>
+	0x2F8A4: "𢛔",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A4 → <CJK Ideograph Extension B>
+	0x2F8A5: "惇",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A5 → <CJK Ideograph>
+	0x2F8A6: "慈",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A6 → <CJK Ideograph>
+	0x2F8A7: "慌",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A7 → <CJK Ideograph>
+	0x2F8A8: "慎",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A8 → <CJK Ideograph>
+	0x2F8A9: "慌",                  // CJK COMPATIBILITY IDEOGRAPH-2F8A9 → <CJK Ideograph>
+	0x2F8AA: "慺",                  // CJK COMPATIBILITY IDEOGRAPH-2F8AA → <CJK Ideograph>
+	0x2F8AB: "憎",                  // CJK COMPATIBILITY IDEOGRAPH-2F8AB → <CJK Ideograph>
+	0x2F8AC: "憲",                  // CJK COMPATIBILITY IDEOGRAPH-2F8AC → <CJK Ideograph>
+	0x2F8AD: "憤",                  // CJK COMPATIBILITY IDEOGRAPH-2F8AD → <CJK Ideograph>
+	0x2F8AE: "憯",                  // CJK COMPATIBILITY IDEOGRAPH-2F8AE → <CJK Ideograph>
+	0x2F8AF: "懞",                  // CJK COMPATIBILITY IDEOGRAPH-2F8AF → <CJK Ideograph>
+	0x2F8B0: "懲",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B0 → <CJK Ideograph>
+	0x2F8B1: "懶",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B1 → <CJK Ideograph>
+	0x2F8B2: "成",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B2 → <CJK Ideograph>
+	0x2F8B3: "戛",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B3 → <CJK Ideograph>
+	0x2F8B4: "扝",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B4 → <CJK Ideograph>
+	0x2F8B5: "抱",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B5 → <CJK Ideograph>
+	0x2F8B6: "拔",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B6 → <CJK Ideograph>
+	0x2F8B7: "捐",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B7 → <CJK Ideograph>
+	0x2F8B8: "𢬌",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B8 → <CJK Ideograph Extension B>
+	0x2F8B9: "挽",                  // CJK COMPATIBILITY IDEOGRAPH-2F8B9 → <CJK Ideograph>
+	0x2F8BA: "拼",                  // CJK COMPATIBILITY IDEOGRAPH-2F8BA → <CJK Ideograph>
+	0x2F8BB: "捨",                  // CJK COMPATIBILITY IDEOGRAPH-2F8BB → <CJK Ideograph>
+	0x2F8BC: "掃",                  // CJK COMPATIBILITY IDEOGRAPH-2F8BC → <CJK Ideograph>
+	0x2F8BD: "揤",                  // CJK COMPATIBILITY IDEOGRAPH-2F8BD → <CJK Ideograph>
+	0x2F8BE: "𢯱",                  // CJK COMPATIBILITY IDEOGRAPH-2F8BE → <CJK Ideograph Extension B>
+	0x2F8BF: "搢",                  // CJK COMPATIBILITY IDEOGRAPH-2F8BF → <CJK Ideograph>
+	0x2F8C0: "揅",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C0 → <CJK Ideograph>
+	0x2F8C1: "掩",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C1 → <CJK Ideograph>
+	0x2F8C2: "㨮",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C2 → <CJK Ideograph Extension A>
+	0x2F8C3: "摩",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C3 → <CJK Ideograph>
+	0x2F8C4: "摾",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C4 → <CJK Ideograph>
+	0x2F8C5: "撝",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C5 → <CJK Ideograph>
+	0x2F8C6: "摷",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C6 → <CJK Ideograph>
+	0x2F8C7: "㩬",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C7 → <CJK Ideograph Extension A>
+	0x2F8C8: "敏",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C8 → <CJK Ideograph>
+	0x2F8C9: "敬",                  // CJK COMPATIBILITY IDEOGRAPH-2F8C9 → <CJK Ideograph>
+	0x2F8CA: "𣀊",                  // CJK COMPATIBILITY IDEOGRAPH-2F8CA → <CJK Ideograph Extension B>
+	0x2F8CB: "旣",                  // CJK COMPATIBILITY IDEOGRAPH-2F8CB → <CJK Ideograph>
+	0x2F8CC: "書",                  // CJK COMPATIBILITY IDEOGRAPH-2F8CC → <CJK Ideograph>
+	0x2F8CD: "晉",                  // CJK COMPATIBILITY IDEOGRAPH-2F8CD → <CJK Ideograph>
+	0x2F8CE: "㬙",                  // CJK COMPATIBILITY IDEOGRAPH-2F8CE → <CJK Ideograph Extension A>
+	0x2F8CF: "暑",                  // CJK COMPATIBILITY IDEOGRAPH-2F8CF → <CJK Ideograph>
+	0x2F8D0: "㬈",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D0 → <CJK Ideograph Extension A>
+	0x2F8D1: "㫤",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D1 → <CJK Ideograph Extension A>
+	0x2F8D2: "冒",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D2 → <CJK Ideograph>
+	0x2F8D3: "冕",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D3 → <CJK Ideograph>
+	0x2F8D4: "最",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D4 → <CJK Ideograph>
+	0x2F8D5: "暜",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D5 → <CJK Ideograph>
+	0x2F8D6: "肭",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D6 → <CJK Ideograph>
+	0x2F8D7: "䏙",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D7 → <CJK Ideograph Extension A>
+	0x2F8D8: "朗",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D8 → <CJK Ideograph>
+	0x2F8D9: "望",                  // CJK COMPATIBILITY IDEOGRAPH-2F8D9 → <CJK Ideograph>
+	0x2F8DA: "朡",                  // CJK COMPATIBILITY IDEOGRAPH-2F8DA → <CJK Ideograph>
+	0x2F8DB: "杞",                  // CJK COMPATIBILITY IDEOGRAPH-2F8DB → <CJK Ideograph>
+	0x2F8DC: "杓",                  // CJK COMPATIBILITY IDEOGRAPH-2F8DC → <CJK Ideograph>
+	0x2F8DD: "𣏃",                  // CJK COMPATIBILITY IDEOGRAPH-2F8DD → <CJK Ideograph Extension B>
+	0x2F8DE: "㭉",                  // CJK COMPATIBILITY IDEOGRAPH-2F8DE → <CJK Ideograph Extension A>
+	0x2F8DF: "柺",                  // CJK COMPATIBILITY IDEOGRAPH-2F8DF → <CJK Ideograph>
+	0x2F8E0: "枅",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E0 → <CJK Ideograph>
+	0x2F8E1: "桒",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E1 → <CJK Ideograph>
+	0x2F8E2: "梅",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E2 → <CJK Ideograph>
+	0x2F8E3: "𣑭",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E3 → <CJK Ideograph Extension B>
+	0x2F8E4: "梎",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E4 → <CJK Ideograph>
+	0x2F8E5: "栟",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E5 → <CJK Ideograph>
+	0x2F8E6: "椔",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E6 → <CJK Ideograph>
+	0x2F8E7: "㮝",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E7 → <CJK Ideograph Extension A>
+	0x2F8E8: "楂",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E8 → <CJK Ideograph>
+	0x2F8E9: "榣",                  // CJK COMPATIBILITY IDEOGRAPH-2F8E9 → <CJK Ideograph>
+	0x2F8EA: "槪",                  // CJK COMPATIBILITY IDEOGRAPH-2F8EA → <CJK Ideograph>
+	0x2F8EB: "檨",                  // CJK COMPATIBILITY IDEOGRAPH-2F8EB → <CJK Ideograph>
+	0x2F8EC: "𣚣",                  // CJK COMPATIBILITY IDEOGRAPH-2F8EC → <CJK Ideograph Extension B>
+	0x2F8ED: "櫛",                  // CJK COMPATIBILITY IDEOGRAPH-2F8ED → <CJK Ideograph>
+	0x2F8EE: "㰘",                  // CJK COMPATIBILITY IDEOGRAPH-2F8EE → <CJK Ideograph Extension A>
+	0x2F8EF: "次",                  // CJK COMPATIBILITY IDEOGRAPH-2F8EF → <CJK Ideograph>
+	0x2F8F0: "𣢧",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F0 → <CJK Ideograph Extension B>
+	0x2F8F1: "歔",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F1 → <CJK Ideograph>
+	0x2F8F2: "㱎",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F2 → <CJK Ideograph Extension A>
+	0x2F8F3: "歲",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F3 → <CJK Ideograph>
+	0x2F8F4: "殟",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F4 → <CJK Ideograph>
+	0x2F8F5: "殺",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F5 → <CJK Ideograph>
+	0x2F8F6: "殻",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F6 → <CJK Ideograph>
+	0x2F8F7: "𣪍",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F7 → <CJK Ideograph Extension B>
+	0x2F8F8: "𡴋",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F8 → <CJK Ideograph Extension B>
+	0x2F8F9: "𣫺",                  // CJK COMPATIBILITY IDEOGRAPH-2F8F9 → <CJK Ideograph Extension B>
+	0x2F8FA: "汎",                  // CJK COMPATIBILITY IDEOGRAPH-2F8FA → <CJK Ideograph>
+	0x2F8FB: "𣲼",                  // CJK COMPATIBILITY IDEOGRAPH-2F8FB → <CJK Ideograph Extension B>
+	0x2F8FC: "沿",                  // CJK COMPATIBILITY IDEOGRAPH-2F8FC → <CJK Ideograph>
+	0x2F8FD: "泍",                  // CJK COMPATIBILITY IDEOGRAPH-2F8FD → <CJK Ideograph>
+	0x2F8FE: "汧",                  // CJK COMPATIBILITY IDEOGRAPH-2F8FE → <CJK Ideograph>
+	0x2F8FF: "洖",                  // CJK COMPATIBILITY IDEOGRAPH-2F8FF → <CJK Ideograph>
+	0x2F900: "派",                  // CJK COMPATIBILITY IDEOGRAPH-2F900 → <CJK Ideograph>
+	0x2F901: "海",                  // CJK COMPATIBILITY IDEOGRAPH-2F901 → <CJK Ideograph>
+	0x2F902: "流",                  // CJK COMPATIBILITY IDEOGRAPH-2F902 → <CJK Ideograph>
+	0x2F903: "浩",                  // CJK COMPATIBILITY IDEOGRAPH-2F903 → <CJK Ideograph>
+	0x2F904: "浸",                  // CJK COMPATIBILITY IDEOGRAPH-2F904 → <CJK Ideograph>
+	0x2F905: "涅",                  // CJK COMPATIBILITY IDEOGRAPH-2F905 → <CJK Ideograph>
+	0x2F906: "𣴞",                  // CJK COMPATIBILITY IDEOGRAPH-2F906 → <CJK Ideograph Extension B>
+	0x2F907: "洴",                  // CJK COMPATIBILITY IDEOGRAPH-2F907 → <CJK Ideograph>
+	0x2F908: "港",                  // CJK COMPATIBILITY IDEOGRAPH-2F908 → <CJK Ideograph>
+	0x2F909: "湮",                  // CJK COMPATIBILITY IDEOGRAPH-2F909 → <CJK Ideograph>
+	0x2F90A: "㴳",                  // CJK COMPATIBILITY IDEOGRAPH-2F90A → <CJK Ideograph Extension A>
+	0x2F90B: "滋",                  // CJK COMPATIBILITY IDEOGRAPH-2F90B → <CJK Ideograph>
+	0x2F90C: "滇",                  // CJK COMPATIBILITY IDEOGRAPH-2F90C → <CJK Ideograph>
+	0x2F90D: "𣻑",                  // CJK COMPATIBILITY IDEOGRAPH-2F90D → <CJK Ideograph Extension B>
+	0x2F90E: "淹",                  // CJK COMPATIBILITY IDEOGRAPH-2F90E → <CJK Ideograph>
+	0x2F90F: "潮",                  // CJK COMPATIBILITY IDEOGRAPH-2F90F → <CJK Ideograph>
+	0x2F910: "𣽞",                  // CJK COMPATIBILITY IDEOGRAPH-2F910 → <CJK Ideograph Extension B>
+	0x2F911: "𣾎",                  // CJK COMPATIBILITY IDEOGRAPH-2F911 → <CJK Ideograph Extension B>
+	0x2F912: "濆",                  // CJK COMPATIBILITY IDEOGRAPH-2F912 → <CJK Ideograph>
+	0x2F913: "瀹",                  // CJK COMPATIBILITY IDEOGRAPH-2F913 → <CJK Ideograph>
+	0x2F914: "瀞",                  // CJK COMPATIBILITY IDEOGRAPH-2F914 → <CJK Ideograph>
+	0x2F915: "瀛",                  // CJK COMPATIBILITY IDEOGRAPH-2F915 → <CJK Ideograph>
+	0x2F916: "㶖",                  // CJK COMPATIBILITY IDEOGRAPH-2F916 → <CJK Ideograph Extension A>
+	0x2F917: "灊",                  // CJK COMPATIBILITY IDEOGRAPH-2F917 → <CJK Ideograph>
+	0x2F918: "災",                  // CJK COMPATIBILITY IDEOGRAPH-2F918 → <CJK Ideograph>
+	0x2F919: "灷",                  // CJK COMPATIBILITY IDEOGRAPH-2F919 → <CJK Ideograph>
+	0x2F91A: "炭",                  // CJK COMPATIBILITY IDEOGRAPH-2F91A → <CJK Ideograph>
+	0x2F91B: "𠔥",                  // CJK COMPATIBILITY IDEOGRAPH-2F91B → <CJK Ideograph Extension B>
+	0x2F91C: "煅",                  // CJK COMPATIBILITY IDEOGRAPH-2F91C → <CJK Ideograph>
+	0x2F91D: "𤉣",                  // CJK COMPATIBILITY IDEOGRAPH-2F91D → <CJK Ideograph Extension B>
+	0x2F91E: "熜",                  // CJK COMPATIBILITY IDEOGRAPH-2F91E → <CJK Ideograph>
+	0x2F91F: "𤎫",                  // CJK COMPATIBILITY IDEOGRAPH-2F91F → <CJK Ideograph Extension B>
+	0x2F920: "爨",                  // CJK COMPATIBILITY IDEOGRAPH-2F920 → <CJK Ideograph>
+	0x2F921: "爵",                  // CJK COMPATIBILITY IDEOGRAPH-2F921 → <CJK Ideograph>
+	0x2F922: "牐",                  // CJK COMPATIBILITY IDEOGRAPH-2F922 → <CJK Ideograph>
+	0x2F923: "𤘈",                  // CJK COMPATIBILITY IDEOGRAPH-2F923 → <CJK Ideograph Extension B>
+	0x2F924: "犀",                  // CJK COMPATIBILITY IDEOGRAPH-2F924 → <CJK Ideograph>
+	0x2F925: "犕",                  // CJK COMPATIBILITY IDEOGRAPH-2F925 → <CJK Ideograph>
+	0x2F926: "𤜵",                  // CJK COMPATIBILITY IDEOGRAPH-2F926 → <CJK Ideograph Extension B>
+	0x2F927: "𤠔",                  // CJK COMPATIBILITY IDEOGRAPH-2F927 → <CJK Ideograph Extension B>
+	0x2F928: "獺",                  // CJK COMPATIBILITY IDEOGRAPH-2F928 → <CJK Ideograph>
+	0x2F929: "王",                  // CJK COMPATIBILITY IDEOGRAPH-2F929 → <CJK Ideograph>
+	0x2F92A: "㺬",                  // CJK COMPATIBILITY IDEOGRAPH-2F92A → <CJK Ideograph Extension A>
+	0x2F92B: "玥",                  // CJK COMPATIBILITY IDEOGRAPH-2F92B → <CJK Ideograph>
+	0x2F92C: "㺸",                  // CJK COMPATIBILITY IDEOGRAPH-2F92C → <CJK Ideograph Extension A>
+	0x2F92D: "㺸",                  // CJK COMPATIBILITY IDEOGRAPH-2F92D → <CJK Ideograph Extension A>
+	0x2F92E: "瑇",                  // CJK COMPATIBILITY IDEOGRAPH-2F92E → <CJK Ideograph>
+	0x2F92F: "瑜",                  // CJK COMPATIBILITY IDEOGRAPH-2F92F → <CJK Ideograph>
+	0x2F930: "瑱",                  // CJK COMPATIBILITY IDEOGRAPH-2F930 → <CJK Ideograph>
+	0x2F931: "璅",                  // CJK COMPATIBILITY IDEOGRAPH-2F931 → <CJK Ideograph>
+	0x2F932: "瓊",                  // CJK COMPATIBILITY IDEOGRAPH-2F932 → <CJK Ideograph>
+	0x2F933: "㼛",                  // CJK COMPATIBILITY IDEOGRAPH-2F933 → <CJK Ideograph Extension A>
+	0x2F934: "甤",                  // CJK COMPATIBILITY IDEOGRAPH-2F934 → <CJK Ideograph>
+	0x2F935: "𤰶",                  // CJK COMPATIBILITY IDEOGRAPH-2F935 → <CJK Ideograph Extension B>
+	0x2F936: "甾",                  // CJK COMPATIBILITY IDEOGRAPH-2F936 → <CJK Ideograph>
+	0x2F937: "𤲒",                  // CJK COMPATIBILITY IDEOGRAPH-2F937 → <CJK Ideograph Extension B>
+	0x2F938: "異",                  // CJK COMPATIBILITY IDEOGRAPH-2F938 → <CJK Ideograph>
+	0x2F939: "𢆟",                  // CJK COMPATIBILITY IDEOGRAPH-2F939 → <CJK Ideograph Extension B>
+	0x2F93A: "瘐",                  // CJK COMPATIBILITY IDEOGRAPH-2F93A → <CJK Ideograph>
+	0x2F93B: "𤾡",                  // CJK COMPATIBILITY IDEOGRAPH-2F93B → <CJK Ideograph Extension B>
+	0x2F93C: "𤾸",                  // CJK COMPATIBILITY IDEOGRAPH-2F93C → <CJK Ideograph Extension B>
+	0x2F93D: "𥁄",                  // CJK COMPATIBILITY IDEOGRAPH-2F93D → <CJK Ideograph Extension B>
+	0x2F93E: "㿼",                  // CJK COMPATIBILITY IDEOGRAPH-2F93E → <CJK Ideograph Extension A>
+	0x2F93F: "䀈",                  // CJK COMPATIBILITY IDEOGRAPH-2F93F → <CJK Ideograph Extension A>
+	0x2F940: "直",                  // CJK COMPATIBILITY IDEOGRAPH-2F940 → <CJK Ideograph>
+	0x2F941: "𥃳",                  // CJK COMPATIBILITY IDEOGRAPH-2F941 → <CJK Ideograph Extension B>
+	0x2F942: "𥃲",                  // CJK COMPATIBILITY IDEOGRAPH-2F942 → <CJK Ideograph Extension B>
+	0x2F943: "𥄙",                  // CJK COMPATIBILITY IDEOGRAPH-2F943 → <CJK Ideograph Extension B>
+	0x2F944: "𥄳",                  // CJK COMPATIBILITY IDEOGRAPH-2F944 → <CJK Ideograph Extension B>
+	0x2F945: "眞",                  // CJK COMPATIBILITY IDEOGRAPH-2F945 → <CJK Ideograph>
+	0x2F946: "真",                  // CJK COMPATIBILITY IDEOGRAPH-2F946 → <CJK Ideograph>
+	0x2F947: "真",                  // CJK COMPATIBILITY IDEOGRAPH-2F947 → <CJK Ideograph>
+	0x2F948: "睊",                  // CJK COMPATIBILITY IDEOGRAPH-2F948 → <CJK Ideograph>
+	0x2F949: "䀹",                  // CJK COMPATIBILITY IDEOGRAPH-2F949 → <CJK Ideograph Extension A>
+	0x2F94A: "瞋",                  // CJK COMPATIBILITY IDEOGRAPH-2F94A → <CJK Ideograph>
+	0x2F94B: "䁆",                  // CJK COMPATIBILITY IDEOGRAPH-2F94B → <CJK Ideograph Extension A>
+	0x2F94C: "䂖",                  // CJK COMPATIBILITY IDEOGRAPH-2F94C → <CJK Ideograph Extension A>
+	0x2F94D: "𥐝",                  // CJK COMPATIBILITY IDEOGRAPH-2F94D → <CJK Ideograph Extension B>
+	0x2F94E: "硎",                  // CJK COMPATIBILITY IDEOGRAPH-2F94E → <CJK Ideograph>
+	0x2F94F: "碌",                  // CJK COMPATIBILITY IDEOGRAPH-2F94F → <CJK Ideograph>
+	0x2F950: "磌",                  // CJK COMPATIBILITY IDEOGRAPH-2F950 → <CJK Ideograph>
+	0x2F951: "䃣",                  // CJK COMPATIBILITY IDEOGRAPH-2F951 → <CJK Ideograph Extension A>
+	0x2F952: "𥘦",                  // CJK COMPATIBILITY IDEOGRAPH-2F952 → <CJK Ideograph Extension B>
+	0x2F953: "祖",                  // CJK COMPATIBILITY IDEOGRAPH-2F953 → <CJK Ideograph>
+	0x2F954: "𥚚",                  // CJK COMPATIBILITY IDEOGRAPH-2F954 → <CJK Ideograph Extension B>
+	0x2F955: "𥛅",                  // CJK COMPATIBILITY IDEOGRAPH-2F955 → <CJK Ideograph Extension B>
+	0x2F956: "福",                  // CJK COMPATIBILITY IDEOGRAPH-2F956 → <CJK Ideograph>
+	0x2F957: "秫",                  // CJK COMPATIBILITY IDEOGRAPH-2F957 → <CJK Ideograph>
+	0x2F958: "䄯",                  // CJK COMPATIBILITY IDEOGRAPH-2F958 → <CJK Ideograph Extension A>
+	0x2F959: "穀",                  // CJK COMPATIBILITY IDEOGRAPH-2F959 → <CJK Ideograph>
+	0x2F95A: "穊",                  // CJK COMPATIBILITY IDEOGRAPH-2F95A → <CJK Ideograph>
+	0x2F95B: "穏",                  // CJK COMPATIBILITY IDEOGRAPH-2F95B → <CJK Ideograph>
+	0x2F95C: "𥥼",                  // CJK COMPATIBILITY IDEOGRAPH-2F95C → <CJK Ideograph Extension B>
+	0x2F95D: "𥪧",                  // CJK COMPATIBILITY IDEOGRAPH-2F95D → <CJK Ideograph Extension B>
+	0x2F95E: "𥪧",                  // CJK COMPATIBILITY IDEOGRAPH-2F95E → <CJK Ideograph Extension B>
+	0x2F95F: "竮",                  // CJK COMPATIBILITY IDEOGRAPH-2F95F → <CJK Ideograph>
+	0x2F960: "䈂",                  // CJK COMPATIBILITY IDEOGRAPH-2F960 → <CJK Ideograph Extension A>
+	0x2F961: "𥮫",                  // CJK COMPATIBILITY IDEOGRAPH-2F961 → <CJK Ideograph Extension B>
+	0x2F962: "篆",                  // CJK COMPATIBILITY IDEOGRAPH-2F962 → <CJK Ideograph>
+	0x2F963: "築",                  // CJK COMPATIBILITY IDEOGRAPH-2F963 → <CJK Ideograph>
+	0x2F964: "䈧",                  // CJK COMPATIBILITY IDEOGRAPH-2F964 → <CJK Ideograph Extension A>
+	0x2F965: "𥲀",                  // CJK COMPATIBILITY IDEOGRAPH-2F965 → <CJK Ideograph Extension B>
+	0x2F966: "糒",                  // CJK COMPATIBILITY IDEOGRAPH-2F966 → <CJK Ideograph>
+	0x2F967: "䊠",                  // CJK COMPATIBILITY IDEOGRAPH-2F967 → <CJK Ideograph Extension A>
+	0x2F968: "糨",                  // CJK COMPATIBILITY IDEOGRAPH-2F968 → <CJK Ideograph>
+	0x2F969: "糣",                  // CJK COMPATIBILITY IDEOGRAPH-2F969 → <CJK Ideograph>
+	0x2F96A: "紀",                  // CJK COMPATIBILITY IDEOGRAPH-2F96A → <CJK Ideograph>
+	0x2F96B: "𥾆",                  // CJK COMPATIBILITY IDEOGRAPH-2F96B → <CJK Ideograph Extension B>
+	0x2F96C: "絣",                  // CJK COMPATIBILITY IDEOGRAPH-2F96C → <CJK Ideograph>
+	0x2F96D: "䌁",                  // CJK COMPATIBILITY IDEOGRAPH-2F96D → <CJK Ideograph Extension A>
+	0x2F96E: "緇",                  // CJK COMPATIBILITY IDEOGRAPH-2F96E → <CJK Ideograph>
+	0x2F96F: "縂",                  // CJK COMPATIBILITY IDEOGRAPH-2F96F → <CJK Ideograph>
+	0x2F970: "繅",                  // CJK COMPATIBILITY IDEOGRAPH-2F970 → <CJK Ideograph>
+	0x2F971: "䌴",                  // CJK COMPATIBILITY IDEOGRAPH-2F971 → <CJK Ideograph Extension A>
+	0x2F972: "𦈨",                  // CJK COMPATIBILITY IDEOGRAPH-2F972 → <CJK Ideograph Extension B>
+	0x2F973: "𦉇",                  // CJK COMPATIBILITY IDEOGRAPH-2F973 → <CJK Ideograph Extension B>
+	0x2F974: "䍙",                  // CJK COMPATIBILITY IDEOGRAPH-2F974 → <CJK Ideograph Extension A>
+	0x2F975: "𦋙",                  // CJK COMPATIBILITY IDEOGRAPH-2F975 → <CJK Ideograph Extension B>
+	0x2F976: "罺",                  // CJK COMPATIBILITY IDEOGRAPH-2F976 → <CJK Ideograph>
+	0x2F977: "𦌾",                  // CJK COMPATIBILITY IDEOGRAPH-2F977 → <CJK Ideograph Extension B>
+	0x2F978: "羕",                  // CJK COMPATIBILITY IDEOGRAPH-2F978 → <CJK Ideograph>
+	0x2F979: "翺",                  // CJK COMPATIBILITY IDEOGRAPH-2F979 → <CJK Ideograph>
+	0x2F97A: "者",                  // CJK COMPATIBILITY IDEOGRAPH-2F97A → <CJK Ideograph>
+	0x2F97B: "𦓚",                  // CJK COMPATIBILITY IDEOGRAPH-2F97B → <CJK Ideograph Extension B>
+	0x2F97C: "𦔣",                  // CJK COMPATIBILITY IDEOGRAPH-2F97C → <CJK Ideograph Extension B>
+	0x2F97D: "聠",                  // CJK COMPATIBILITY IDEOGRAPH-2F97D → <CJK Ideograph>
+	0x2F97E: "𦖨",                  // CJK COMPATIBILITY IDEOGRAPH-2F97E → <CJK Ideograph Extension B>
+	0x2F97F: "聰",                  // CJK COMPATIBILITY IDEOGRAPH-2F97F → <CJK Ideograph>
+	0x2F980: "𣍟",                  // CJK COMPATIBILITY IDEOGRAPH-2F980 → <CJK Ideograph Extension B>
+	0x2F981: "䏕",                  // CJK COMPATIBILITY IDEOGRAPH-2F981 → <CJK Ideograph Extension A>
+	0x2F982: "育",                  // CJK COMPATIBILITY IDEOGRAPH-2F982 → <CJK Ideograph>
+	0x2F983: "脃",                  // CJK COMPATIBILITY IDEOGRAPH-2F983 → <CJK Ideograph>
+	0x2F984: "䐋",                  // CJK COMPATIBILITY IDEOGRAPH-2F984 → <CJK Ideograph Extension A>
+	0x2F985: "脾",                  // CJK COMPATIBILITY IDEOGRAPH-2F985 → <CJK Ideograph>
+	0x2F986: "媵",                  // CJK COMPATIBILITY IDEOGRAPH-2F986 → <CJK Ideograph>
+	0x2F987: "𦞧",                  // CJK COMPATIBILITY IDEOGRAPH-2F987 → <CJK Ideograph Extension B>
+	0x2F988: "𦞵",                  // CJK COMPATIBILITY IDEOGRAPH-2F988 → <CJK Ideograph Extension B>
+	0x2F989: "𣎓",                  // CJK COMPATIBILITY IDEOGRAPH-2F989 → <CJK Ideograph Extension B>
+	0x2F98A: "𣎜",                  // CJK COMPATIBILITY IDEOGRAPH-2F98A → <CJK Ideograph Extension B>
+	0x2F98B: "舁",                  // CJK COMPATIBILITY IDEOGRAPH-2F98B → <CJK Ideograph>
+	0x2F98C: "舄",                  // CJK COMPATIBILITY IDEOGRAPH-2F98C → <CJK Ideograph>
+	0x2F98D: "辞",                  // CJK COMPATIBILITY IDEOGRAPH-2F98D → <CJK Ideograph>
+	0x2F98E: "䑫",                  // CJK COMPATIBILITY IDEOGRAPH-2F98E → <CJK Ideograph Extension A>
+	0x2F98F: "芑",                  // CJK COMPATIBILITY IDEOGRAPH-2F98F → <CJK Ideograph>
+	0x2F990: "芋",                  // CJK COMPATIBILITY IDEOGRAPH-2F990 → <CJK Ideograph>
+	0x2F991: "芝",                  // CJK COMPATIBILITY IDEOGRAPH-2F991 → <CJK Ideograph>
+	0x2F992: "劳",                  // CJK COMPATIBILITY IDEOGRAPH-2F992 → <CJK Ideograph>
+	0x2F993: "花",                  // CJK COMPATIBILITY IDEOGRAPH-2F993 → <CJK Ideograph>
+	0x2F994: "芳",                  // CJK COMPATIBILITY IDEOGRAPH-2F994 → <CJK Ideograph>
+	0x2F995: "芽",                  // CJK COMPATIBILITY IDEOGRAPH-2F995 → <CJK Ideograph>
+	0x2F996: "苦",                  // CJK COMPATIBILITY IDEOGRAPH-2F996 → <CJK Ideograph>
+	0x2F997: "𦬼",                  // CJK COMPATIBILITY IDEOGRAPH-2F997 → <CJK Ideograph Extension B>
+	0x2F998: "若",                  // CJK COMPATIBILITY IDEOGRAPH-2F998 → <CJK Ideograph>
+	0x2F999: "茝",                  // CJK COMPATIBILITY IDEOGRAPH-2F999 → <CJK Ideograph>
+	0x2F99A: "荣",                  // CJK COMPATIBILITY IDEOGRAPH-2F99A → <CJK Ideograph>
+	0x2F99B: "莭",                  // CJK COMPATIBILITY IDEOGRAPH-2F99B → <CJK Ideograph>
+	0x2F99C: "茣",                  // CJK COMPATIBILITY IDEOGRAPH-2F99C → <CJK Ideograph>
+	0x2F99D: "莽",                  // CJK COMPATIBILITY IDEOGRAPH-2F99D → <CJK Ideograph>
+	0x2F99E: "菧",                  // CJK COMPATIBILITY IDEOGRAPH-2F99E → <CJK Ideograph>
+	0x2F99F: "著",                  // CJK COMPATIBILITY IDEOGRAPH-2F99F → <CJK Ideograph>
+	0x2F9A0: "荓",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A0 → <CJK Ideograph>
+	0x2F9A1: "菊",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A1 → <CJK Ideograph>
+	0x2F9A2: "菌",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A2 → <CJK Ideograph>
+	0x2F9A3: "菜",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A3 → <CJK Ideograph>
+	0x2F9A4: "𦰶",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A4 → <CJK Ideograph Extension B>
+	0x2F9A5: "𦵫",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A5 → <CJK Ideograph Extension B>
+	0x2F9A6: "𦳕",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A6 → <CJK Ideograph Extension B>
+	0x2F9A7: "䔫",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A7 → <CJK Ideograph Extension A>
+	0x2F9A8: "蓱",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A8 → <CJK Ideograph>
+	0x2F9A9: "蓳",                  // CJK COMPATIBILITY IDEOGRAPH-2F9A9 → <CJK Ideograph>
+	0x2F9AA: "蔖",                  // CJK COMPATIBILITY IDEOGRAPH-2F9AA → <CJK Ideograph>
+	0x2F9AB: "𧏊",                  // CJK COMPATIBILITY IDEOGRAPH-2F9AB → <CJK Ideograph Extension B>
+	0x2F9AC: "蕤",                  // CJK COMPATIBILITY IDEOGRAPH-2F9AC → <CJK Ideograph>
+	0x2F9AD: "𦼬",                  // CJK COMPATIBILITY IDEOGRAPH-2F9AD → <CJK Ideograph Extension B>
+	0x2F9AE: "䕝",                  // CJK COMPATIBILITY IDEOGRAPH-2F9AE → <CJK Ideograph Extension A>
+	0x2F9AF: "䕡",                  // CJK COMPATIBILITY IDEOGRAPH-2F9AF → <CJK Ideograph Extension A>
+	0x2F9B0: "𦾱",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B0 → <CJK Ideograph Extension B>
+	0x2F9B1: "𧃒",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B1 → <CJK Ideograph Extension B>
+	0x2F9B2: "䕫",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B2 → <CJK Ideograph Extension A>
+	0x2F9B3: "虐",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B3 → <CJK Ideograph>
+	0x2F9B4: "虜",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B4 → <CJK Ideograph>
+	0x2F9B5: "虧",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B5 → <CJK Ideograph>
+	0x2F9B6: "虩",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B6 → <CJK Ideograph>
+	0x2F9B7: "蚩",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B7 → <CJK Ideograph>
+	0x2F9B8: "蚈",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B8 → <CJK Ideograph>
+	0x2F9B9: "蜎",                  // CJK COMPATIBILITY IDEOGRAPH-2F9B9 → <CJK Ideograph>
+	0x2F9BA: "蛢",                  // CJK COMPATIBILITY IDEOGRAPH-2F9BA → <CJK Ideograph>
+	0x2F9BB: "蝹",                  // CJK COMPATIBILITY IDEOGRAPH-2F9BB → <CJK Ideograph>
+	0x2F9BC: "蜨",                  // CJK COMPATIBILITY IDEOGRAPH-2F9BC → <CJK Ideograph>
+	0x2F9BD: "蝫",                  // CJK COMPATIBILITY IDEOGRAPH-2F9BD → <CJK Ideograph>
+	0x2F9BE: "螆",                  // CJK COMPATIBILITY IDEOGRAPH-2F9BE → <CJK Ideograph>
+	0x2F9BF: "䗗",                  // CJK COMPATIBILITY IDEOGRAPH-2F9BF → <CJK Ideograph Extension A>
+	0x2F9C0: "蟡",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C0 → <CJK Ideograph>
+	0x2F9C1: "蠁",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C1 → <CJK Ideograph>
+	0x2F9C2: "䗹",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C2 → <CJK Ideograph Extension A>
+	0x2F9C3: "衠",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C3 → <CJK Ideograph>
+	0x2F9C4: "衣",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C4 → <CJK Ideograph>
+	0x2F9C5: "𧙧",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C5 → <CJK Ideograph Extension B>
+	0x2F9C6: "裗",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C6 → <CJK Ideograph>
+	0x2F9C7: "裞",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C7 → <CJK Ideograph>
+	0x2F9C8: "䘵",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C8 → <CJK Ideograph Extension A>
+	0x2F9C9: "裺",                  // CJK COMPATIBILITY IDEOGRAPH-2F9C9 → <CJK Ideograph>
+	0x2F9CA: "㒻",                  // CJK COMPATIBILITY IDEOGRAPH-2F9CA → <CJK Ideograph Extension A>
+	0x2F9CB: "𧢮",                  // CJK COMPATIBILITY IDEOGRAPH-2F9CB → <CJK Ideograph Extension B>
+	0x2F9CC: "𧥦",                  // CJK COMPATIBILITY IDEOGRAPH-2F9CC → <CJK Ideograph Extension B>
+	0x2F9CD: "䚾",                  // CJK COMPATIBILITY IDEOGRAPH-2F9CD → <CJK Ideograph Extension A>
+	0x2F9CE: "䛇",                  // CJK COMPATIBILITY IDEOGRAPH-2F9CE → <CJK Ideograph Extension A>
+	0x2F9CF: "誠",                  // CJK COMPATIBILITY IDEOGRAPH-2F9CF → <CJK Ideograph>
+	0x2F9D0: "諭",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D0 → <CJK Ideograph>
+	0x2F9D1: "變",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D1 → <CJK Ideograph>
+	0x2F9D2: "豕",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D2 → <CJK Ideograph>
+	0x2F9D3: "𧲨",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D3 → <CJK Ideograph Extension B>
+	0x2F9D4: "貫",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D4 → <CJK Ideograph>
+	0x2F9D5: "賁",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D5 → <CJK Ideograph>
+	0x2F9D6: "贛",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D6 → <CJK Ideograph>
+	0x2F9D7: "起",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D7 → <CJK Ideograph>
+	0x2F9D8: "𧼯",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D8 → <CJK Ideograph Extension B>
+	0x2F9D9: "𠠄",                  // CJK COMPATIBILITY IDEOGRAPH-2F9D9 → <CJK Ideograph Extension B>
+	0x2F9DA: "跋",                  // CJK COMPATIBILITY IDEOGRAPH-2F9DA → <CJK Ideograph>
+	0x2F9DB: "趼",                  // CJK COMPATIBILITY IDEOGRAPH-2F9DB → <CJK Ideograph>
+	0x2F9DC: "跰",                  // CJK COMPATIBILITY IDEOGRAPH-2F9DC → <CJK Ideograph>
+	0x2F9DD: "𠣞",                  // CJK COMPATIBILITY IDEOGRAPH-2F9DD → <CJK Ideograph Extension B>
+	0x2F9DE: "軔",                  // CJK COMPATIBILITY IDEOGRAPH-2F9DE → <CJK Ideograph>
+	0x2F9DF: "輸",                  // CJK COMPATIBILITY IDEOGRAPH-2F9DF → <CJK Ideograph>
+	0x2F9E0: "𨗒",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E0 → <CJK Ideograph Extension B>
+	0x2F9E1: "𨗭",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E1 → <CJK Ideograph Extension B>
+	0x2F9E2: "邔",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E2 → <CJK Ideograph>
+	0x2F9E3: "郱",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E3 → <CJK Ideograph>
+	0x2F9E4: "鄑",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E4 → <CJK Ideograph>
+	0x2F9E5: "𨜮",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E5 → <CJK Ideograph Extension B>
+	0x2F9E6: "鄛",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E6 → <CJK Ideograph>
+	0x2F9E7: "鈸",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E7 → <CJK Ideograph>
+	0x2F9E8: "鋗",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E8 → <CJK Ideograph>
+	0x2F9E9: "鋘",                  // CJK COMPATIBILITY IDEOGRAPH-2F9E9 → <CJK Ideograph>
+	0x2F9EA: "鉼",                  // CJK COMPATIBILITY IDEOGRAPH-2F9EA → <CJK Ideograph>
+	0x2F9EB: "鏹",                  // CJK COMPATIBILITY IDEOGRAPH-2F9EB → <CJK Ideograph>
+	0x2F9EC: "鐕",                  // CJK COMPATIBILITY IDEOGRAPH-2F9EC → <CJK Ideograph>
+	0x2F9ED: "𨯺",                  // CJK COMPATIBILITY IDEOGRAPH-2F9ED → <CJK Ideograph Extension B>
+	0x2F9EE: "開",                  // CJK COMPATIBILITY IDEOGRAPH-2F9EE → <CJK Ideograph>
+	0x2F9EF: "䦕",                  // CJK COMPATIBILITY IDEOGRAPH-2F9EF → <CJK Ideograph Extension A>
+	0x2F9F0: "閷",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F0 → <CJK Ideograph>
+	0x2F9F1: "𨵷",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F1 → <CJK Ideograph Extension B>
+	0x2F9F2: "䧦",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F2 → <CJK Ideograph Extension A>
+	0x2F9F3: "雃",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F3 → <CJK Ideograph>
+	0x2F9F4: "嶲",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F4 → <CJK Ideograph>
+	0x2F9F5: "霣",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F5 → <CJK Ideograph>
+	0x2F9F6: "𩅅",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F6 → <CJK Ideograph Extension B>
+	0x2F9F7: "𩈚",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F7 → <CJK Ideograph Extension B>
+	0x2F9F8: "䩮",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F8 → <CJK Ideograph Extension A>
+	0x2F9F9: "䩶",                  // CJK COMPATIBILITY IDEOGRAPH-2F9F9 → <CJK Ideograph Extension A>
+	0x2F9FA: "韠",                  // CJK COMPATIBILITY IDEOGRAPH-2F9FA → <CJK Ideograph>
+	0x2F9FB: "𩐊",                  // CJK COMPATIBILITY IDEOGRAPH-2F9FB → <CJK Ideograph Extension B>
+	0x2F9FC: "䪲",                  // CJK COMPATIBILITY IDEOGRAPH-2F9FC → <CJK Ideograph Extension A>
+	0x2F9FD: "𩒖",                  // CJK COMPATIBILITY IDEOGRAPH-2F9FD → <CJK Ideograph Extension B>
+	0x2F9FE: "頋",                  // CJK COMPATIBILITY IDEOGRAPH-2F9FE → <CJK Ideograph>
+	0x2F9FF: "頋",                  // CJK COMPATIBILITY IDEOGRAPH-2F9FF → <CJK Ideograph>
+	0x2FA00: "頩",                  // CJK COMPATIBILITY IDEOGRAPH-2FA00 → <CJK Ideograph>
+	0x2FA01: "𩖶",                  // CJK COMPATIBILITY IDEOGRAPH-2FA01 → <CJK Ideograph Extension B>
+	0x2FA02: "飢",                  // CJK COMPATIBILITY IDEOGRAPH-2FA02 → <CJK Ideograph>
+	0x2FA03: "䬳",                  // CJK COMPATIBILITY IDEOGRAPH-2FA03 → <CJK Ideograph Extension A>
+	0x2FA04: "餩",                  // CJK COMPATIBILITY IDEOGRAPH-2FA04 → <CJK Ideograph>
+	0x2FA05: "馧",                  // CJK COMPATIBILITY IDEOGRAPH-2FA05 → <CJK Ideograph>
+	0x2FA06: "駂",                  // CJK COMPATIBILITY IDEOGRAPH-2FA06 → <CJK Ideograph>
+	0x2FA07: "駾",                  // CJK COMPATIBILITY IDEOGRAPH-2FA07 → <CJK Ideograph>
+	0x2FA08: "䯎",                  // CJK COMPATIBILITY IDEOGRAPH-2FA08 → <CJK Ideograph Extension A>
+	0x2FA09: "𩬰",                  // CJK COMPATIBILITY IDEOGRAPH-2FA09 → <CJK Ideograph Extension B>
+	0x2FA0A: "鬒",                  // CJK COMPATIBILITY IDEOGRAPH-2FA0A → <CJK Ideograph>
+	0x2FA0B: "鱀",                  // CJK COMPATIBILITY IDEOGRAPH-2FA0B → <CJK Ideograph>
+	0x2FA0C: "鳽",                  // CJK COMPATIBILITY IDEOGRAPH-2FA0C → <CJK Ideograph>
+	0x2FA0D: "䳎",                  // CJK COMPATIBILITY IDEOGRAPH-2FA0D → <CJK Ideograph Extension A>
+	0x2FA0E: "䳭",                  // CJK COMPATIBILITY IDEOGRAPH-2FA0E → <CJK Ideograph Extension A>
+	0x2FA0F: "鵧",                  // CJK COMPATIBILITY IDEOGRAPH-2FA0F → <CJK Ideograph>
+	0x2FA10: "𪃎",                  // CJK COMPATIBILITY IDEOGRAPH-2FA10 → <CJK Ideograph Extension B>
+	0x2FA11: "䳸",                  // CJK COMPATIBILITY IDEOGRAPH-2FA11 → <CJK Ideograph Extension A>
+	0x2FA12: "𪄅",                  // CJK COMPATIBILITY IDEOGRAPH-2FA12 → <CJK Ideograph Extension B>
+	0x2FA13: "𪈎",                  // CJK COMPATIBILITY IDEOGRAPH-2FA13 → <CJK Ideograph Extension B>
+	0x2FA14: "𪊑",                  // CJK COMPATIBILITY IDEOGRAPH-2FA14 → <CJK Ideograph Extension B>
+	0x2FA15: "麻",                  // CJK COMPATIBILITY IDEOGRAPH-2FA15 → <CJK Ideograph>
+	0x2FA16: "䵖",                  // CJK COMPATIBILITY IDEOGRAPH-2FA16 → <CJK Ideograph Extension A>
+	0x2FA17: "黹",                  // CJK COMPATIBILITY IDEOGRAPH-2FA17 → <CJK Ideograph>
+	0x2FA18: "黾",                  // CJK COMPATIBILITY IDEOGRAPH-2FA18 → <CJK Ideograph>
+	0x2FA19: "鼅",                  // CJK COMPATIBILITY IDEOGRAPH-2FA19 → <CJK Ideograph>
+	0x2FA1A: "鼏",                  // CJK COMPATIBILITY IDEOGRAPH-2FA1A → <CJK Ideograph>
+	0x2FA1B: "鼖",                  // CJK COMPATIBILITY IDEOGRAPH-2FA1B → <CJK Ideograph>
+	0x2FA1C: "鼻",                  // CJK COMPATIBILITY IDEOGRAPH-2FA1C → <CJK Ideograph>
+	0x2FA1D: "𪘀",                  // CJK COMPATIBILITY IDEOGRAPH-2FA1D → <CJK Ideograph Extension B>
+	0x31E7C: "緇",                  // <CJK Ideograph Extension H> → <CJK Ideograph>
+}
@@ -0,0 +1,102 @@
+package confusables
+
+import (
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// IsMixedScript determines whether the token mixes letters from more than one script, e.g. pаypal with a Cyrillic а,
+// which is a sign of spoofing. Common characters such as digits and punctuation don't count, and scripts which are
+// written together, such as Han, Hiragana and Katakana in Japanese, are not considered mixed. Use it with TokenStream.Where.
+func IsMixedScript(token *jargon.Token) bool {
+	return isMixedScript(token.String())
+}
+
+func isMixedScript(s string) bool {
+	var scripts []string
+	for _, r := range s {
+		script := scriptOf(r)
+		if script == "" {
+			continue
+		}
+
+		found := false
+		for _, existing := range scripts {
+			if existing == script {
+				found = true
+				break
+			}
+		}
+		if !found {
+			scripts = append(scripts, script)
+		}
+	}
+
+	if len(scripts) < 2 {
+		return false
+	}
+
+	// Scripts which are commonly written together, after UTS #39's augmented script sets
+	for _, set := range together {
+		if subset(scripts, set) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var together = [][]string{
+	{"Han", "Hiragana", "Katakana"}, // Japanese
+	{"Han", "Hangul"},               // Korean
+	{"Han", "Bopomofo"},             // Chinese
+}
+
+// subset determines whether all of a are in b
+func subset(a, b []string) bool {
+	for _, s := range a {
+		found := false
+		for _, t := range b {
+			if s == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// likely are the scripts to check first, in order
+var likely = []string{"Latin", "Cyrillic", "Greek", "Han", "Hiragana", "Katakana", "Hangul", "Arabic", "Hebrew", "Armenian", "Cherokee"}
+
+// scriptOf returns the script of r, or "" for Common or Inherited characters, such as digits, punctuation and combining marks
+func scriptOf(r rune) string {
+	if r < 0x80 {
+		if unicode.IsLetter(r) {
+			return "Latin"
+		}
+		return ""
+	}
+
+	if unicode.Is(unicode.Common, r) || unicode.Is(unicode.Inherited, r) {
+		return ""
+	}
+
+	for _, script := range likely {
+		if unicode.Is(unicode.Scripts[script], r) {
+			return script
+		}
+	}
+
+	for script, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return script
+		}
+	}
+
+	return ""
+}
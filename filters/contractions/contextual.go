@@ -0,0 +1,241 @@
+package contractions
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Contextual expands contractions as Expand does, but looks ahead at the following word(s) to choose
+// the expansion of 's and 'd, and treats 's on other words as possessive. Examples:
+// he's been → he has been
+// he's happy → he is happy
+// what's happened → what has happened
+// she'd gone → she had gone
+// she'd go → she would go
+// John's book → John 's book (possessive, split into its own token)
+// John's been → John has been
+//
+// The choice is a heuristic: 's is "has" before a past participle, such as been, got, gone, or a word ending in -ed or -en
+// (what's happened → what has happened), except for those commonly used as adjectives or in the passive, such as tired or called.
+var Contextual = NewContextual(false)
+
+// NewContextual creates a contextual contractions filter, see Contextual. If dropPossessive is true,
+// possessive 's is removed (John's book → John book), otherwise it is split into its own token.
+func NewContextual(dropPossessive bool) jargon.Filter {
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		t := &contextual{
+			dropPossessive: dropPossessive,
+			incoming:       incoming,
+			buffer:         tokenqueue.New(),
+			outgoing:       tokenqueue.New(),
+		}
+		return jargon.NewTokenStream(t.next)
+	}
+}
+
+type contextual struct {
+	dropPossessive bool
+	incoming       *jargon.TokenStream
+	// a 'lookahead' buffer for incoming tokens
+	buffer   *tokenqueue.TokenQueue
+	outgoing *tokenqueue.TokenQueue
+}
+
+// lookahead is the most tokens to read ahead, looking for the next word
+const lookahead = 8
+
+func (t *contextual) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.read()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.Kind() != jargon.Word {
+		return token, nil
+	}
+
+	stem, clitic, found := cutClitic(token.String())
+	if !found {
+		return t.expand(token)
+	}
+
+	lower := strings.ToLower(stem)
+	letter := strings.ToLower(clitic[len(clitic)-1:])
+
+	if lower == "let" && letter == "s" {
+		return t.push(stem + " " + recaseVerb("us", clitic))
+	}
+
+	following, err := t.following()
+	if err != nil {
+		return nil, err
+	}
+	perfect := participle[following] || following == "got"
+
+	switch letter {
+	case "s":
+		if perfect || regular(following) {
+			return t.push(stem + " " + recaseVerb("has", clitic))
+		}
+		if subjects[lower] {
+			return t.push(stem + " " + recaseVerb("is", clitic))
+		}
+
+		// Possessive
+		t.outgoing.Push(jargon.NewToken(stem, true))
+		if !t.dropPossessive {
+			t.outgoing.Push(jargon.NewToken(clitic, true))
+		}
+		return t.outgoing.Pop(), nil
+	case "d":
+		if !subjects[lower] {
+			return token, nil
+		}
+		if perfect || following == "better" || (len(following) > 3 && strings.HasSuffix(following, "ed")) {
+			return t.push(stem + " " + recaseVerb("had", clitic))
+		}
+		return t.push(stem + " " + recaseVerb("would", clitic))
+	}
+
+	return token, nil
+}
+
+// read returns the next token, from the lookahead buffer if any, otherwise from incoming
+func (t *contextual) read() (*jargon.Token, error) {
+	if t.buffer.Any() {
+		return t.buffer.Pop(), nil
+	}
+	return t.incoming.Next()
+}
+
+// following returns the next word, lowercased, skipping white space and adverbs such as "already"; or "" if punctuation comes first
+func (t *contextual) following() (string, error) {
+	for t.buffer.Len() < lookahead {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return "", err
+		}
+		if token == nil {
+			break
+		}
+		t.buffer.Push(token)
+	}
+
+	for _, token := range t.buffer.Tokens {
+		if token.IsSpace() {
+			continue
+		}
+		if token.IsPunct() {
+			return "", nil
+		}
+
+		word := strings.ToLower(token.String())
+		if adverbs[word] {
+			continue
+		}
+		return word, nil
+	}
+
+	return "", nil
+}
+
+// push tokenizes and queues the expansion, returning the first token
+func (t *contextual) push(expansion string) (*jargon.Token, error) {
+	tokens, err := jargon.TokenizeString(expansion).ToSlice()
+	if err != nil {
+		return nil, err
+	}
+	t.outgoing.Push(tokens...)
+	return t.outgoing.Pop(), nil
+}
+
+// expand looks up other contractions, as Expand does
+func (t *contextual) expand(token *jargon.Token) (*jargon.Token, error) {
+	expansion, found := mappings[token.String()]
+	if !found {
+		expansion, found = mappings[strings.ToLower(token.String())]
+	}
+	if !found {
+		return token, nil
+	}
+	return t.push(expansion)
+}
+
+// cutClitic splits a trailing 's or 'd (either apostrophe, any case) from s, e.g. he's → he, 's
+func cutClitic(s string) (stem, clitic string, found bool) {
+	for _, apostrophe := range []string{"'", "’"} {
+		i := strings.LastIndex(s, apostrophe)
+		if i < 1 {
+			continue
+		}
+
+		clitic = s[i:]
+		switch clitic[len(apostrophe):] {
+		case "s", "S", "d", "D":
+			return s[:i], clitic, true
+		}
+	}
+
+	return "", "", false
+}
+
+// recaseVerb uppercases the verb if the clitic is uppercase, e.g. HE'S → HE IS
+func recaseVerb(verb, clitic string) string {
+	if unicode.IsUpper(rune(clitic[len(clitic)-1])) {
+		return strings.ToUpper(verb)
+	}
+	return verb
+}
+
+// subjects are words which take 's as "is" or "has", and 'd as "would" or "had", i.e. not possessive
+var subjects = map[string]bool{
+	"i": true, "you": true, "he": true, "she": true, "it": true, "we": true, "they": true,
+	"that": true, "this": true, "there": true, "here": true,
+	"what": true, "who": true, "where": true, "when": true, "why": true, "how": true,
+	"everyone": true, "everybody": true, "someone": true, "somebody": true, "nobody": true,
+	"everything": true, "something": true, "nothing": true,
+}
+
+// adverbs may come between the contraction and the verb, e.g. he's already gone
+var adverbs = map[string]bool{
+	"already": true, "just": true, "never": true, "always": true, "ever": true, "not": true, "recently": true,
+	"finally": true, "also": true, "only": true, "really": true, "probably": true, "still": true, "often": true,
+	"certainly": true, "definitely": true, "actually": true, "since": true,
+}
+
+// regular determines whether the word looks like a regular past participle, i.e. ends in -ed or -en, and is not one of adjectival
+func regular(word string) bool {
+	return len(word) > 3 && (strings.HasSuffix(word, "ed") || strings.HasSuffix(word, "en")) && !adjectival[word]
+}
+
+// adjectival are words ending in -ed or -en which, following 's, are usually adjectives, the passive, or not verbs at all
+var adjectival = map[string]bool{
+	"called": true, "named": true, "based": true, "located": true, "used": true, "supposed": true, "related": true,
+	"tired": true, "married": true, "interested": true, "excited": true, "scared": true, "worried": true, "bored": true,
+	"pleased": true, "surprised": true, "concerned": true, "confused": true, "allowed": true, "required": true,
+	"involved": true, "closed": true, "broken": true, "hidden": true, "frozen": true,
+	"open": true, "even": true, "often": true, "seven": true, "eleven": true, "green": true, "then": true, "when": true,
+	"children": true, "women": true, "chicken": true, "kitchen": true, "garden": true, "golden": true, "wooden": true,
+	"hundred": true, "need": true, "speed": true, "seed": true, "indeed": true,
+}
+
+// participle are past participles which are not also base verbs or (commonly) adjectives; "been" is the most common
+var participle = map[string]bool{
+	"been": true, "gone": true, "gotten": true, "done": true, "had": true, "seen": true, "made": true, "taken": true,
+	"given": true, "known": true, "begun": true, "brought": true, "bought": true, "thought": true, "told": true,
+	"said": true, "found": true, "left": true, "written": true, "spoken": true, "eaten": true, "driven": true,
+	"forgotten": true, "heard": true, "kept": true, "met": true, "paid": true, "sent": true, "slept": true,
+	"spent": true, "stood": true, "won": true, "chosen": true, "shown": true, "flown": true, "drawn": true,
+	"felt": true, "held": true, "built": true, "caught": true, "taught": true, "fought": true, "sold": true,
+	"understood": true, "fallen": true, "grown": true, "thrown": true, "worn": true, "ridden": true, "risen": true,
+}
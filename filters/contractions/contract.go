@@ -0,0 +1,56 @@
+package contractions
+
+import (
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/synonyms"
+)
+
+// Contract is the inverse of Expand, converting expanded forms into contractions, using a straight ' apostrophe. Examples:
+// do not → don't
+// I am → I'm
+// WE HAVE → WE'VE
+var Contract = NewContract('\'')
+
+// NewContract creates a filter which converts expanded forms into contractions, using the given apostrophe, typically ' or ’.
+// Case is preserved for lower, Title and UPPER case, as with Expand. Informal contractions such as "gonna" are
+// not produced, nor is "cannot"; "can not" becomes "can't".
+func NewContract(apostrophe rune) jargon.Filter {
+	reversed := make(map[string]string)
+	for contraction, expansion := range mappings {
+		if !strings.Contains(contraction, "'") {
+			// Informal, e.g. gonna, or cannot; or the smart ’ variation
+			continue
+		}
+		reversed[expansion] = strings.ReplaceAll(contraction, "'", string(apostrophe))
+	}
+
+	// Prefer negation of the verb, e.g. she would not → she wouldn't, rather than she'd not.
+	// The longest match wins, so add those three-word forms.
+	negations := make(map[string]string) // verb → verb not
+	for expansion := range reversed {
+		verb, not, found := strings.Cut(expansion, " ")
+		if found && strings.EqualFold(not, "not") {
+			negations[verb] = expansion
+		}
+	}
+	negated := make(map[string]string)
+	for expansion := range reversed {
+		subject, verb, found := strings.Cut(expansion, " ")
+		if !found {
+			continue
+		}
+		negation, found := negations[verb]
+		if !found {
+			continue
+		}
+		negated[subject+" "+negation] = subject + " " + reversed[negation]
+	}
+	for expansion, contraction := range negated {
+		reversed[expansion] = contraction
+	}
+
+	// Case-sensitive, since the case variations are in the mappings
+	return synonyms.NewFilter(reversed, false, nil)
+}
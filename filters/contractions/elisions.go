@@ -0,0 +1,147 @@
+package contractions
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// French expands elisions, where a word's final vowel is dropped before a vowel or h. Examples:
+// l'homme → le homme
+// J’ai → Je ai
+// QU'IL → QUE IL
+// s'il → si il
+func French(incoming *jargon.TokenStream) *jargon.TokenStream {
+	return expandElisions(incoming, frenchElisions, frenchBefore)
+}
+
+// frenchBefore are elisions whose expansion depends on the following word: s' is si before il(s), otherwise se
+var frenchBefore = map[string]map[string]string{
+	"s'": {"il": "si", "ils": "si"},
+	"s’": {"il": "si", "ils": "si"},
+}
+
+// Italian expands elisions, where a word's final vowel is dropped before a vowel or h. Examples:
+// dell'anno → dello anno
+// C’è → Ci è
+// L'AMICO → LO AMICO
+func Italian(incoming *jargon.TokenStream) *jargon.TokenStream {
+	return expandElisions(incoming, italianElisions, nil)
+}
+
+func expandElisions(incoming *jargon.TokenStream, elisions map[string]string, before map[string]map[string]string) *jargon.TokenStream {
+	t := &elided{
+		incoming: incoming,
+		outgoing: tokenqueue.New(),
+		elisions: elisions,
+		before:   before,
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type elided struct {
+	incoming *jargon.TokenStream
+	outgoing *tokenqueue.TokenQueue
+	elisions map[string]string
+	// before are expansions of elisions which depend on the rest of the word, e.g. s'il → si il
+	before map[string]map[string]string
+}
+
+func (t *elided) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.incoming.Next()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.Kind() != jargon.Word {
+		return token, nil
+	}
+
+	prefix, rest, found := cutElision(token.String())
+	if !found {
+		return token, nil
+	}
+
+	lower := strings.ToLower(prefix)
+	expansion, found := t.elisions[lower]
+	if !found {
+		return token, nil
+	}
+	if e, ok := t.before[lower][strings.ToLower(rest)]; ok {
+		expansion = e
+	}
+
+	tokens, err := jargon.TokenizeString(recase(expansion, prefix, rest) + " " + rest).ToSlice()
+	if err != nil {
+		return nil, err
+	}
+	t.outgoing.Push(tokens...)
+
+	return t.outgoing.Pop(), nil
+}
+
+// cutElision splits s after its first apostrophe, if followed by a letter, e.g. l'homme → l', homme
+func cutElision(s string) (prefix, rest string, found bool) {
+	i := strings.IndexAny(s, "'’")
+	if i < 1 {
+		return "", "", false
+	}
+
+	_, size := utf8.DecodeRuneInString(s[i:])
+	prefix, rest = s[:i+size], s[i+size:]
+
+	r, _ := utf8.DecodeRuneInString(rest)
+	if !unicode.IsLetter(r) {
+		return "", "", false
+	}
+
+	return prefix, rest, true
+}
+
+// recase applies the case of the original token to the (lowercase) expansion.
+// An uppercase prefix is ambiguous if it is a single letter, e.g. L' is Title or UPPER, so the rest of the token decides.
+func recase(expansion, prefix, rest string) string {
+	first, _ := utf8.DecodeRuneInString(prefix)
+	if !unicode.IsUpper(first) {
+		return expansion
+	}
+
+	// All caps, unless too short to tell, e.g. L'A
+	if isUpper(prefix) && isUpper(rest) && letters(prefix+rest) > 2 {
+		return strings.ToUpper(expansion)
+	}
+
+	r, size := utf8.DecodeRuneInString(expansion)
+	return string(unicode.ToUpper(r)) + expansion[size:]
+}
+
+// letters counts the letters in s
+func letters(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// isUpper determines whether all letters in s are uppercase
+func isUpper(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) && !unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,14 +1,18 @@
-// Package contractions provides a filter to expand English contractions, such as "don't" → "does not", for use with jargon
+// Package contractions provides filters to expand English contractions, such as "don't" → "does not",
+// and French and Italian elisions, such as "l'homme" → "le homme", for use with jargon
 package contractions
 
 import (
 	"strings"
 
 	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/language"
 	"github.com/clipperhouse/jargon/tokenqueue"
 )
 
 //go:generate go run generate/main.go
+//go:generate go run generate/main.go -lang french
+//go:generate go run generate/main.go -lang italian
 
 // Expand converts single-token contractions to non-contracted version. Examples:
 // don't → does not
@@ -78,3 +82,22 @@ func (t *tokens) tryExpansion(token *jargon.Token, ignoreCase bool) (bool, error
 
 	return found, nil
 }
+
+// Language returns the contractions filter for a language, by name (such as "english") or ISO 639-1 code (such as "en").
+// found will be false if the language is not available.
+func Language(lang string) (filter jargon.Filter, found bool) {
+	switch language.Name(lang) {
+	case "english":
+		return Expand, true
+	case "french":
+		return French, true
+	case "italian":
+		return Italian, true
+	}
+	return nil, false
+}
+
+// Languages returns the names of the available languages, sorted
+func Languages() []string {
+	return []string{"english", "french", "italian"}
+}
@@ -21,3 +21,153 @@ func TestContractions(t *testing.T) {
 		t.Errorf("given %q, expected %q, got %q", given, expected, got)
 	}
 }
+
+func TestFrench(t *testing.T) {
+	given := "l'homme J’ai qu'il QU'IL L'Homme jusqu'à aujourd'hui d'aujourd'hui L'A l' don't s'il s’ils S'IL s'est"
+	expected := "le homme Je ai que il QUE IL Le Homme jusque à aujourd'hui de aujourd'hui Le A l' don't si il si ils SI IL se est"
+
+	tokens := jargon.TokenizeString(given)
+	got, err := contractions.French(tokens).String()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
+func TestItalian(t *testing.T) {
+	given := "dell'anno C’è l'amico L'AMICO un'altra nell’acqua quell'uomo l'homme"
+	expected := "dello anno Ci è lo amico LO AMICO una altra nello acqua quello uomo lo homme"
+
+	tokens := jargon.TokenizeString(given)
+	got, err := contractions.Italian(tokens).String()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	for _, lang := range []string{"english", "EN", "french", "fr", "italian", "it"} {
+		if _, found := contractions.Language(lang); !found {
+			t.Errorf("expected contractions for %q", lang)
+		}
+	}
+
+	if _, found := contractions.Language("german"); found {
+		t.Errorf("did not expect contractions for german")
+	}
+}
+
+func TestContextual(t *testing.T) {
+	tests := []struct {
+		given, expected string
+	}{
+		{"he's been there", "he has been there"},
+		{"He's happy", "He is happy"},
+		{"she's already gone", "she has already gone"},
+		{"SHE'S GOT IT", "SHE HAS GOT IT"},
+		{"it’s raining", "it is raining"},
+		{"she'd gone home", "she had gone home"},
+		{"she'd go home", "she would go home"},
+		{"I’d never seen it", "I had never seen it"},
+		{"we'd better leave", "we had better leave"},
+		{"they'd finished", "they had finished"},
+		{"you'd like it", "you would like it"},
+		{"he'd.", "he would."},
+		{"John's book", "John's book"},
+		{"John's been here", "John has been here"},
+		{"What's happened?", "What has happened?"},
+		{"she's finally arrived", "she has finally arrived"},
+		{"it's fallen", "it has fallen"},
+		{"it's called jargon", "it is called jargon"},
+		{"he's tired", "he is tired"},
+		{"let's go", "let us go"},
+		{"don't stop", "do not stop"},
+		{"the cat's", "the cat's"},
+	}
+
+	for _, test := range tests {
+		tokens := jargon.TokenizeString(test.given)
+		got, err := contractions.Contextual(tokens).String()
+		if err != nil {
+			t.Error(err)
+		}
+
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+
+	// Possessive is split into its own token
+	tokens, err := contractions.Contextual(jargon.TokenizeString("John’s book")).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+	if len(tokens) != 4 || tokens[0].String() != "John" || tokens[1].String() != "’s" {
+		t.Errorf("expected possessive to be split, got %q", tokens)
+	}
+
+	// ...or dropped
+	got, err := contractions.NewContextual(true)(jargon.TokenizeString("John's book")).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if expected := "John book"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestContract(t *testing.T) {
+	tests := []struct {
+		given, expected string
+	}{
+		{"I do not know", "I don't know"},
+		{"I am here", "I'm here"},
+		{"i am here", "i'm here"},
+		{"Do not go", "Don't go"},
+		{"WE HAVE TO GO", "WE'VE TO GO"},
+		{"they are not, he is", "they aren't, he's"},
+		{"you can not", "you can't"},
+		{"I am going to go", "I'm going to go"},
+		{"do nothing", "do nothing"},
+		{"dO nOt", "dO nOt"},
+	}
+
+	for _, test := range tests {
+		tokens := jargon.TokenizeString(test.given)
+		got, err := contractions.Contract(tokens).String()
+		if err != nil {
+			t.Error(err)
+		}
+
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+
+	smart := contractions.NewContract('’')
+	got, err := smart(jargon.TokenizeString("She would not")).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if expected := "She wouldn’t"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	// Round trip
+	given := "Don't worry, I'm sure they'll find we've gone"
+	expanded := contractions.Expand(jargon.TokenizeString(given))
+	got, err = contractions.Contract(expanded).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if got != given {
+		t.Errorf("expected %q, got %q", given, got)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"os"
@@ -10,7 +11,15 @@ import (
 )
 
 func main() {
-	err := write()
+	lang := flag.String("lang", "english", "the language to generate: english, french or italian")
+	flag.Parse()
+
+	var err error
+	if *lang == "english" {
+		err = write()
+	} else {
+		err = writeElisions(*lang)
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -81,6 +90,75 @@ func write() error {
 	return nil
 }
 
+// getElisions returns the elided prefixes for a language, with apostrophe variations. Keys are lowercase;
+// case is determined by the filter at runtime, since e.g. L' could be Title or UPPER.
+func getElisions(lang string) (map[string]string, error) {
+	elided, found := elisions[lang]
+	if !found {
+		return nil, fmt.Errorf("elisions for %q are not known", lang)
+	}
+
+	mappings := make(map[string]string)
+	for prefix, expansion := range elided {
+		if !strings.HasSuffix(prefix, a) {
+			return nil, fmt.Errorf("the elided prefix %q should end with an apostrophe", prefix)
+		}
+		for _, apostrophed := range apostrophes(prefix) {
+			mappings[apostrophed] = expansion
+		}
+	}
+
+	return mappings, nil
+}
+
+func writeElisions(lang string) error {
+	mappings, err := getElisions(lang)
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		Lang     string
+		Mappings map[string]string
+	}{
+		Lang:     lang,
+		Mappings: mappings,
+	}
+
+	var source bytes.Buffer
+
+	tmplErr := elisionsTmpl.Execute(&source, data)
+	if tmplErr != nil {
+		return tmplErr
+	}
+
+	// Break up some lines for readability
+	split := strings.ReplaceAll(source.String(), `", "`, `",
+"`)
+	split = strings.ReplaceAll(split, `{"`, `{
+"`)
+	split = strings.ReplaceAll(split, `"}`, `",
+}`)
+
+	formatted, fmtErr := format.Source([]byte(split))
+	if fmtErr != nil {
+		return fmtErr
+	}
+
+	f, createErr := os.Create("generated_" + lang + ".go")
+	if createErr != nil {
+		return createErr
+	}
+	defer f.Close()
+
+	_, writeErr := f.Write(formatted)
+	if writeErr != nil {
+		return writeErr
+	}
+
+	return nil
+}
+
 const a = "'"
 
 func apostrophes(s string) []string {
@@ -107,6 +185,66 @@ package contractions
 var mappings = {{ printf "%#v" . }}
 `))
 
+var elisionsTmpl = template.Must(template.New("").Parse(`
+package contractions
+
+// This file is generated. Best not to modify it, as it will likely be overwritten.
+
+// maps do not guarantee order, so this will look random
+var {{ .Lang }}Elisions = {{ printf "%#v" .Mappings }}
+`))
+
+// elisions are prefixes, by language, whose vowel is elided before a word beginning with a vowel or h, e.g. l'homme → le homme.
+// Where the full form is ambiguous, e.g. l' could be le or la, the masculine is preferred.
+var elisions = map[string]map[string]string{
+	"french": {
+		"c'":      "ce",
+		"ç'":      "ce",
+		"d'":      "de",
+		"j'":      "je",
+		"l'":      "le",
+		"m'":      "me",
+		"n'":      "ne",
+		"s'":      "se",
+		"t'":      "te",
+		"qu'":     "que",
+		"jusqu'":  "jusque",
+		"lorsqu'": "lorsque",
+		"puisqu'": "puisque",
+		"quoiqu'": "quoique",
+		"quelqu'": "quelque",
+		"presqu'": "presque",
+	},
+	"italian": {
+		"l'":      "lo",
+		"gl'":     "gli",
+		"un'":     "una",
+		"all'":    "allo",
+		"dall'":   "dallo",
+		"dell'":   "dello",
+		"nell'":   "nello",
+		"sull'":   "sullo",
+		"coll'":   "collo",
+		"quest'":  "questo",
+		"quell'":  "quello",
+		"bell'":   "bello",
+		"sant'":   "santo",
+		"nessun'": "nessuna",
+		"tutt'":   "tutto",
+		"mezz'":   "mezzo",
+		"c'":      "ci",
+		"d'":      "di",
+		"m'":      "mi",
+		"t'":      "ti",
+		"s'":      "si",
+		"v'":      "vi",
+		"anch'":   "anche",
+		"com'":    "come",
+		"dov'":    "dove",
+		"cos'":    "cosa",
+	},
+}
+
 var contractions = map[string]string{
 	// prefer a map of explicit lookups, vs some logic/loop to generalize
 	// downside: to handle case consistently, it gets verbose
@@ -39,3 +39,28 @@ func TestMappings(t *testing.T) {
 		t.Errorf("generated variations should have %d items, but got %d", expected, got)
 	}
 }
+
+func TestElisions(t *testing.T) {
+	for lang, elided := range elisions {
+		mappings, err := getElisions(lang)
+		if err != nil {
+			t.Error(err)
+		}
+
+		expected := 2 * len(elided) // two apostrophe variations (', ’); case is handled by the filter
+		if got := len(mappings); got != expected {
+			t.Errorf("generated variations for %s should have %d items, but got %d", lang, expected, got)
+		}
+
+		for prefix, expansion := range elided {
+			// first letters should match, intended to catch dumb typos
+			if []rune(prefix)[0] != []rune(expansion)[0] && !(prefix == "ç'" && expansion == "ce") {
+				t.Errorf("the first character of the mapping %q → %q should match", prefix, expansion)
+			}
+		}
+	}
+
+	if _, err := getElisions("klingon"); err == nil {
+		t.Errorf("expected an error for an unknown language")
+	}
+}
@@ -0,0 +1,39 @@
+package contractions
+
+// This file is generated. Best not to modify it, as it will likely be overwritten.
+
+// maps do not guarantee order, so this will look random
+var frenchElisions = map[string]string{
+	"c'":      "ce",
+	"c’":      "ce",
+	"d'":      "de",
+	"d’":      "de",
+	"j'":      "je",
+	"jusqu'":  "jusque",
+	"jusqu’":  "jusque",
+	"j’":      "je",
+	"l'":      "le",
+	"lorsqu'": "lorsque",
+	"lorsqu’": "lorsque",
+	"l’":      "le",
+	"m'":      "me",
+	"m’":      "me",
+	"n'":      "ne",
+	"n’":      "ne",
+	"presqu'": "presque",
+	"presqu’": "presque",
+	"puisqu'": "puisque",
+	"puisqu’": "puisque",
+	"qu'":     "que",
+	"quelqu'": "quelque",
+	"quelqu’": "quelque",
+	"quoiqu'": "quoique",
+	"quoiqu’": "quoique",
+	"qu’":     "que",
+	"s'":      "se",
+	"s’":      "se",
+	"t'":      "te",
+	"t’":      "te",
+	"ç'":      "ce",
+	"ç’":      "ce",
+}
@@ -0,0 +1,59 @@
+package contractions
+
+// This file is generated. Best not to modify it, as it will likely be overwritten.
+
+// maps do not guarantee order, so this will look random
+var italianElisions = map[string]string{
+	"all'":    "allo",
+	"all’":    "allo",
+	"anch'":   "anche",
+	"anch’":   "anche",
+	"bell'":   "bello",
+	"bell’":   "bello",
+	"c'":      "ci",
+	"coll'":   "collo",
+	"coll’":   "collo",
+	"com'":    "come",
+	"com’":    "come",
+	"cos'":    "cosa",
+	"cos’":    "cosa",
+	"c’":      "ci",
+	"d'":      "di",
+	"dall'":   "dallo",
+	"dall’":   "dallo",
+	"dell'":   "dello",
+	"dell’":   "dello",
+	"dov'":    "dove",
+	"dov’":    "dove",
+	"d’":      "di",
+	"gl'":     "gli",
+	"gl’":     "gli",
+	"l'":      "lo",
+	"l’":      "lo",
+	"m'":      "mi",
+	"mezz'":   "mezzo",
+	"mezz’":   "mezzo",
+	"m’":      "mi",
+	"nell'":   "nello",
+	"nell’":   "nello",
+	"nessun'": "nessuna",
+	"nessun’": "nessuna",
+	"quell'":  "quello",
+	"quell’":  "quello",
+	"quest'":  "questo",
+	"quest’":  "questo",
+	"s'":      "si",
+	"sant'":   "santo",
+	"sant’":   "santo",
+	"sull'":   "sullo",
+	"sull’":   "sullo",
+	"s’":      "si",
+	"t'":      "ti",
+	"tutt'":   "tutto",
+	"tutt’":   "tutto",
+	"t’":      "ti",
+	"un'":     "una",
+	"un’":     "una",
+	"v'":      "vi",
+	"v’":      "vi",
+}
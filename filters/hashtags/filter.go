@@ -0,0 +1,165 @@
+// Package hashtags provides a filter to segment hashtags, such as #MachineLearning or #machinelearning, into their component words
+package hashtags
+
+import (
+	_ "embed" // for words.txt
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/identifiers"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Segment breaks hashtags into their component words, first by case changes, and then by dictionary-based
+// word segmentation for lowercase tags. The leading # is dropped. Examples:
+// #MachineLearning → Machine Learning
+// #machinelearning → machine learning
+// #ThrowbackThursday → Throwback Thursday
+//
+// Segment operates on tokens of kind jargon.Hashtag, so it should follow twitter.Hashtags:
+//
+//	tokens.Filter(twitter.Hashtags, hashtags.Segment)
+//
+// The resulting words can be passed along to the synonyms or stackoverflow filters, i.e. machine learning → machine-learning.
+func Segment(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &tokens{
+		incoming: incoming,
+		outgoing: tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type tokens struct {
+	incoming *jargon.TokenStream
+	outgoing *tokenqueue.TokenQueue
+}
+
+var space = jargon.NewToken(" ", false)
+
+func (t *tokens) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.incoming.Next()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.Kind() != jargon.Hashtag {
+		return token, nil
+	}
+
+	tag := strings.TrimPrefix(token.String(), "#")
+	for i, word := range Words(tag) {
+		if i > 0 {
+			t.outgoing.Push(space)
+		}
+		t.outgoing.Push(jargon.NewToken(word, true))
+	}
+
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	return token, nil
+}
+
+// Words is a utility method for segmenting a single hashtag (without the leading #) into words. Use the Segment filter to process a token stream.
+func Words(tag string) []string {
+	var result []string
+	for _, part := range identifiers.SplitString(tag) {
+		if isLower(part) {
+			result = append(result, segment(part)...)
+			continue
+		}
+		result = append(result, part)
+	}
+	return result
+}
+
+func isLower(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLower(r) {
+			return false
+		}
+	}
+	return true
+}
+
+//go:embed words.txt
+var wordsFile string
+
+var (
+	once     sync.Once
+	costs    map[string]float64
+	maxRunes int
+)
+
+// load builds a cost for each word in the dictionary, which is ordered by frequency: words are ranked by the Wiktionary
+// frequency list of English in television and film (https://en.wiktionary.org/wiki/Wiktionary:Frequency_lists,
+// as packaged by github.com/nbutton23/zxcvbn-go), followed by words absent from that list.
+// Costs follow Zipf's law, i.e. a word's probability is inversely proportional to its rank.
+func load() {
+	words := strings.Fields(wordsFile)
+	costs = make(map[string]float64, len(words))
+	logN := math.Log(float64(len(words)))
+	for i, word := range words {
+		costs[word] = math.Log(float64(i+1) * logN)
+		if n := len([]rune(word)); n > maxRunes {
+			maxRunes = n
+		}
+	}
+}
+
+// segment finds the most probable series of dictionary words comprising s, using dynamic programming (Viterbi).
+// If s is itself a word, or can't be segmented entirely into known words, it is returned as-is.
+func segment(s string) []string {
+	once.Do(load)
+
+	if _, known := costs[s]; known {
+		return []string{s}
+	}
+
+	runes := []rune(s)
+	n := len(runes)
+
+	// best[i] is the lowest cost of segmenting runes[:i]; from[i] is the start of the final word in that segmentation
+	best := make([]float64, n+1)
+	from := make([]int, n+1)
+	for i := 1; i <= n; i++ {
+		best[i] = math.Inf(1)
+		for j := max(0, i-maxRunes); j < i; j++ {
+			cost, known := costs[string(runes[j:i])]
+			if !known {
+				continue
+			}
+			if c := best[j] + cost; c < best[i] {
+				best[i] = c
+				from[i] = j
+			}
+		}
+	}
+
+	if math.IsInf(best[n], 1) {
+		return []string{s}
+	}
+
+	var words []string
+	for i := n; i > 0; i = from[i] {
+		words = append(words, string(runes[from[i]:i]))
+	}
+
+	// Reverse
+	for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+		words[i], words[j] = words[j], words[i]
+	}
+
+	return words
+}
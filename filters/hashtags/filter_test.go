@@ -0,0 +1,49 @@
+package hashtags_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/hashtags"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/filters/twitter"
+)
+
+func TestSegment(t *testing.T) {
+	type test struct {
+		input    string
+		expected string
+	}
+
+	tests := []test{
+		{"I love #MachineLearning!", "I love Machine Learning!"},
+		{"I love #machinelearning!", "I love machine learning!"},
+		{"#throwbackthursday pics", "throwback thursday pics"},
+		{"#DeepLearning and #opensource", "Deep Learning and open source"},
+		{"#xyzzy stays", "xyzzy stays"},
+		{"not a # tag", "not a # tag"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(test.input).Filter(twitter.Hashtags, hashtags.Segment).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestStackOverflow(t *testing.T) {
+	input := "#machinelearning"
+	expected := "machine-learning"
+
+	got, err := jargon.TokenizeString(input).Filter(twitter.Hashtags, hashtags.Segment, stackoverflow.Tags).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", input, expected, got)
+	}
+}
@@ -0,0 +1,252 @@
+// Package identifiers provides a filter to split source-code identifiers, such as getHTTPResponseCode or snake_case_name, into their component words
+package identifiers
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Split splits identifiers into their component words, separated by spaces. Examples:
+// getHTTPResponseCode → get HTTP Response Code
+// XMLHttpRequest → XML Http Request
+// snake_case_name → snake case name
+// kebab-case → kebab case
+// utf8 → utf 8
+//
+// Qualified names, such as os.Exit or std::vector, are passed through unchanged.
+var Split = NewFilter(false)
+
+// NewFilter creates a filter which splits identifiers, as Split. If preserveOriginal is true, the original identifier is
+// also emitted, following the first component word, with a position increment of 0 (i.e. at the same position),
+// as ascii.PreserveOriginal does. The original is not a lemma; the component words are. Example:
+// getHTTPResponseCode → get getHTTPResponseCode HTTP Response Code
+func NewFilter(preserveOriginal bool) jargon.Filter {
+	f := &filter{
+		preserveOriginal: preserveOriginal,
+	}
+	return f.filter
+}
+
+type filter struct {
+	preserveOriginal bool
+}
+
+func (f *filter) filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &stream{
+		filter:   f,
+		incoming: incoming,
+		buffer:   tokenqueue.New(),
+		outgoing: tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type stream struct {
+	filter *filter
+
+	incoming *jargon.TokenStream
+	// a 'lookahead' buffer for incoming tokens
+	buffer *tokenqueue.TokenQueue
+	// outgoing queue of filtered tokens
+	outgoing *tokenqueue.TokenQueue
+	// the last two tokens sent out, to identify qualified names such as std::vector
+	previous [2]*jargon.Token
+}
+
+var space = jargon.NewToken(" ", false)
+
+func (s *stream) next() (*jargon.Token, error) {
+	if s.outgoing.Any() {
+		return s.send(s.outgoing.Pop()), nil
+	}
+
+	current, err := s.peek(0)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, nil
+	}
+
+	if current.Kind() != jargon.Word {
+		return s.send(s.buffer.Pop()), nil
+	}
+
+	// Gather a kebab-case run, i.e. words joined by hyphens
+	end := 1
+	for {
+		hyphen, err := s.is(end, "-")
+		if err != nil {
+			return nil, err
+		}
+		if !hyphen {
+			break
+		}
+
+		word, err := s.peek(end + 1)
+		if err != nil {
+			return nil, err
+		}
+		if word == nil || word.Kind() != jargon.Word {
+			break
+		}
+
+		end += 2
+	}
+
+	qualified, err := s.qualified(end)
+	if err != nil {
+		return nil, err
+	}
+
+	run := s.buffer.Tokens[:end]
+
+	var parts []string
+	if !qualified {
+		for _, token := range run {
+			if token.String() == "-" {
+				continue
+			}
+			parts = append(parts, split(token.String())...)
+		}
+	}
+
+	if len(parts) <= 1 {
+		// Nothing to split, send the run along verbatim
+		for range run {
+			s.buffer.PopTo(s.outgoing)
+		}
+		return s.send(s.outgoing.Pop()), nil
+	}
+
+	for i, part := range parts {
+		if i > 0 {
+			s.outgoing.Push(space)
+			s.outgoing.Push(jargon.NewToken(part, true))
+			continue
+		}
+
+		// The first word takes the place (and increment) of the original
+		s.outgoing.Push(jargon.NewToken(part, true).WithPositionIncrement(run[0].PositionIncrement()))
+
+		if s.filter.preserveOriginal {
+			var b strings.Builder
+			for _, token := range run {
+				b.WriteString(token.String())
+			}
+			// The original is not a lemma, whether it was one token or several, as in ascii.PreserveOriginal
+			original := jargon.NewToken(b.String(), false).WithPositionIncrement(0)
+			s.outgoing.Push(original)
+		}
+	}
+
+	s.buffer.Drop(end)
+
+	return s.send(s.outgoing.Pop()), nil
+}
+
+// qualified determines whether the run of tokens ending at end is part of a qualified name, such as std::vector
+func (s *stream) qualified(end int) (bool, error) {
+	if s.previous[0] != nil && s.previous[1] != nil && s.previous[0].String() == ":" && s.previous[1].String() == ":" {
+		return true, nil
+	}
+
+	for i := end; i < end+2; i++ {
+		colon, err := s.is(i, ":")
+		if err != nil || !colon {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// send records the outgoing token, for lookbehind, and returns it
+func (s *stream) send(token *jargon.Token) *jargon.Token {
+	s.previous[0], s.previous[1] = s.previous[1], token
+	return token
+}
+
+// peek ensures that the buffer has been filled to index i, and returns the token at i; nil indicates EOF
+func (s *stream) peek(i int) (*jargon.Token, error) {
+	for s.buffer.Len() <= i {
+		token, err := s.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			// EOF
+			return nil, nil
+		}
+		s.buffer.Push(token)
+	}
+
+	return s.buffer.Tokens[i], nil
+}
+
+// is determines whether the token at i has the value v
+func (s *stream) is(i int, v string) (bool, error) {
+	token, err := s.peek(i)
+	if err != nil {
+		return false, err
+	}
+	return token != nil && token.String() == v, nil
+}
+
+// SplitString is a utility method for splitting a single identifier into its component words, such as getHTTPResponseCode → get, HTTP, Response, Code.
+// Use the Split filter to process a token stream.
+func SplitString(s string) []string {
+	return split(s)
+}
+
+// split breaks a single identifier into its component words, on underscores, case changes and digits.
+// Identifiers containing dots, such as os.Exit, are considered qualified and are not split.
+func split(s string) []string {
+	if strings.Contains(s, ".") {
+		return []string{s}
+	}
+
+	var parts []string
+	for _, snake := range strings.Split(s, "_") {
+		if snake == "" {
+			continue
+		}
+		parts = append(parts, splitCase(snake)...)
+	}
+
+	return parts
+}
+
+// splitCase breaks camelCase, PascalCase and digit boundaries
+func splitCase(s string) []string {
+	runes := []rune(s)
+
+	var parts []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, curr := runes[i-1], runes[i]
+
+		boundary := false
+		switch {
+		case unicode.IsLower(prev) && unicode.IsUpper(curr):
+			// camelCase
+			boundary = true
+		case unicode.IsUpper(prev) && unicode.IsUpper(curr) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			// the end of an acronym, i.e. HTTPResponse
+			boundary = true
+		case unicode.IsDigit(prev) != unicode.IsDigit(curr):
+			boundary = true
+		}
+
+		if boundary {
+			parts = append(parts, string(runes[start:i]))
+			start = i
+		}
+	}
+	parts = append(parts, string(runes[start:]))
+
+	return parts
+}
@@ -0,0 +1,98 @@
+package identifiers_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/identifiers"
+)
+
+func TestSplit(t *testing.T) {
+	type test struct {
+		input    string
+		expected string
+	}
+
+	tests := []test{
+		{"getHTTPResponseCode", "get HTTP Response Code"},
+		{"XMLHttpRequest", "XML Http Request"},
+		{"snake_case_name", "snake case name"},
+		{"call kebab-case-name now", "call kebab case name now"},
+		{"utf8 and base64Encode", "utf 8 and base 64 Encode"},
+		{"Hello, world.", "Hello, world."},
+		{"os.Exit and stackoverflow.Tags", "os.Exit and stackoverflow.Tags"},
+		{"std::vector::push_back and HashMap::new", "std::vector::push_back and HashMap::new"},
+		{"__init__", "__init__"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(test.input).Filter(identifiers.Split).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestPreserveOriginal(t *testing.T) {
+	type test struct {
+		input    string
+		expected []string
+	}
+
+	tests := []test{
+		{"getHTTPResponseCode", []string{"get", "getHTTPResponseCode", " ", "HTTP", " ", "Response", " ", "Code"}},
+		{"kebab-case", []string{"kebab", "kebab-case", " ", "case"}},
+		{"plain", []string{"plain"}},
+	}
+
+	filter := identifiers.NewFilter(true)
+
+	for _, test := range tests {
+		tokens, err := jargon.TokenizeString(test.input).Filter(filter).ToSlice()
+		if err != nil {
+			t.Error(err)
+		}
+
+		if len(tokens) != len(test.expected) {
+			t.Fatalf("given %q, expected %q, got %q", test.input, test.expected, tokens)
+		}
+		for i := range tokens {
+			if tokens[i].String() != test.expected[i] {
+				t.Errorf("given %q, expected %q, got %q", test.input, test.expected, tokens)
+				break
+			}
+		}
+	}
+
+	// The original is not a lemma, whether or not the tokenizer split it
+	for _, input := range []string{"getHTTPResponseCode", "kebab-case"} {
+		tokens, err := jargon.TokenizeString(input).Filter(filter).ToSlice()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if original := tokens[1]; original.String() != input || original.IsLemma() {
+			t.Errorf("expected %q not to be a lemma, got %q (lemma: %t)", input, original, original.IsLemma())
+		}
+		if first := tokens[0]; !first.IsLemma() {
+			t.Errorf("expected %q to be a lemma", first)
+		}
+	}
+
+	// The original is at the same position as the first word
+	tokens, err := jargon.TokenizeString("call getHTTPResponseCode now").Filter(filter).Words().ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int
+	for _, token := range tokens {
+		got = append(got, token.PositionIncrement())
+	}
+	expected := []int{1, 1, 0, 1, 1, 1, 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected position increments %v, got %v (%q)", expected, got, tokens)
+	}
+}
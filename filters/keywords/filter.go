@@ -0,0 +1,46 @@
+// Package keywords provides a filter to mark tokens as keywords, protecting them from modification by subsequent filters, such as stemmers
+package keywords
+
+import (
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/mapper"
+	"golang.org/x/text/cases"
+)
+
+// NewFilter creates a filter which marks the supplied words as keywords (see jargon.Token.IsKeyword), such that
+// stemmers and lemmatizers will leave them alone. If ignoreCase is true, words are compared using Unicode case folding.
+//
+//	protect := keywords.NewFilter([]string{"kubernetes", "rails"}, true)
+//	tokens.Filter(protect, stemmer.English)
+func NewFilter(keywords []string, ignoreCase bool) jargon.Filter {
+	fold := cases.Fold()
+
+	includes := make(map[string]bool)
+	for _, s := range keywords {
+		key := s
+		if ignoreCase {
+			key = fold.String(s)
+		}
+		includes[key] = true
+	}
+
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		// A Caser is stateful, and should not be shared across streams
+		fold := cases.Fold()
+
+		f := func(token *jargon.Token) *jargon.Token {
+			key := token.String()
+			if ignoreCase {
+				key = fold.String(key)
+			}
+
+			if includes[key] {
+				return token.WithKeyword(true)
+			}
+
+			return token
+		}
+
+		return mapper.NewFilter(f)(incoming)
+	}
+}
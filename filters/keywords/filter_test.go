@@ -0,0 +1,39 @@
+package keywords_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/keywords"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/filters/stemmer"
+)
+
+func TestStemmer(t *testing.T) {
+	protect := keywords.NewFilter([]string{"Kubernetes", "Managers"}, true)
+
+	given := "kubernetes managers are running"
+	expected := "kubernetes managers are run"
+
+	got, err := jargon.TokenizeString(given).Filter(protect, stemmer.English).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	// Canonical terms from stackoverflow.Tags are keywords, and should survive stemming
+	given := "Ruby on Rails and Kubernetes"
+	expected := "ruby-on-rails and kubernetes"
+
+	got, err := jargon.TokenizeString(given).Filter(stackoverflow.Tags, stemmer.English).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
@@ -0,0 +1,35 @@
+package language
+
+import "strings"
+
+// codes are ISO 639-1 language codes, and the names by which languages are known in jargon
+var codes = map[string]string{
+	"da": "danish",
+	"de": "german",
+	"en": "english",
+	"es": "spanish",
+	"fi": "finnish",
+	"fr": "french",
+	"it": "italian",
+	"ja": "japanese",
+	"nb": "norwegian",
+	"nl": "dutch",
+	"nn": "norwegian",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ru": "russian",
+	"sv": "swedish",
+	"th": "thai",
+	"zh": "chinese",
+}
+
+// Name resolves a language, by name (such as "German") or ISO 639-1 code (such as "de"), to its lowercase English name
+// (such as "german"), as returned by Detect and understood by the Language funcs of packages such as stemmer and stopwords.
+// Names and unknown codes are returned lowercased.
+func Name(lang string) string {
+	lang = strings.ToLower(lang)
+	if name, ok := codes[lang]; ok {
+		return name
+	}
+	return lang
+}
@@ -0,0 +1,73 @@
+package language
+
+import (
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// NewRouter creates a filter which detects the language of each segment of the incoming tokens (see NewSegments),
+// and applies the filters found by lookups for that language, in order. Segments whose language is not detected,
+// or not found by a lookup, pass through that lookup unchanged. Lookups are funcs such as stemmer.Language,
+// stopwords.Language or contractions.Language:
+//
+//	route := language.NewRouter(contractions.Language, stopwords.Language, stemmer.Language)
+//	tokens.Filter(route)
+func NewRouter(lookups ...func(lang string) (jargon.Filter, bool)) jargon.Filter {
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		r := &router{
+			lookups:  lookups,
+			segments: NewSegments(incoming),
+			outgoing: tokenqueue.New(),
+		}
+		return jargon.NewTokenStream(r.next)
+	}
+}
+
+type router struct {
+	lookups  []func(lang string) (jargon.Filter, bool)
+	segments *Segments
+	outgoing *tokenqueue.TokenQueue
+}
+
+func (r *router) next() (*jargon.Token, error) {
+	for !r.outgoing.Any() {
+		segment, err := r.segments.Next()
+		if err != nil {
+			return nil, err
+		}
+		if segment == nil {
+			return nil, nil
+		}
+
+		tokens := fromSlice(segment.Tokens)
+		if segment.Language != "" {
+			for _, lookup := range r.lookups {
+				if filter, found := lookup(segment.Language); found {
+					tokens = tokens.Filter(filter)
+				}
+			}
+		}
+
+		filtered, err := tokens.ToSlice()
+		if err != nil {
+			return nil, err
+		}
+		r.outgoing.Push(filtered...)
+	}
+
+	return r.outgoing.Pop(), nil
+}
+
+// fromSlice creates a token stream from a slice of tokens
+func fromSlice(tokens []*jargon.Token) *jargon.TokenStream {
+	i := 0
+	next := func() (*jargon.Token, error) {
+		if i == len(tokens) {
+			return nil, nil
+		}
+		token := tokens[i]
+		i++
+		return token, nil
+	}
+	return jargon.NewTokenStream(next)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+func main() {
+	err := write()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// size is the number of n-grams kept for each language's profile
+const size = 300
+
+// profile returns the most frequent n-grams of text, in order of frequency, ties broken alphabetically
+func profile(text string) []string {
+	counts := make(map[string]int)
+	for _, gram := range ngrams(text) {
+		counts[gram]++
+	}
+
+	grams := make([]string, 0, len(counts))
+	for gram := range counts {
+		grams = append(grams, gram)
+	}
+
+	sort.Slice(grams, func(i, j int) bool {
+		if counts[grams[i]] != counts[grams[j]] {
+			return counts[grams[i]] > counts[grams[j]]
+		}
+		return grams[i] < grams[j]
+	})
+
+	if len(grams) > size {
+		grams = grams[:size]
+	}
+
+	return grams
+}
+
+// ngrams must match the func of the same name in the language package: lowercased letters, 1-3 runes,
+// where each word is padded with a space on either side.
+func ngrams(text string) []string {
+	var result []string
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, word := range words {
+		rs := []rune(" " + word + " ")
+		for n := 1; n <= 3; n++ {
+			for i := 0; i+n <= len(rs); i++ {
+				gram := string(rs[i : i+n])
+				if gram == " " {
+					continue
+				}
+				result = append(result, gram)
+			}
+		}
+	}
+	return result
+}
+
+func write() error {
+	files, err := filepath.Glob("generate/corpus/*.txt")
+	if err != nil {
+		return err
+	}
+
+	// Profiles are broken into lines of n-grams, for readability
+	const perLine = 16
+	profiles := make(map[string][][]string)
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		lang := strings.TrimSuffix(filepath.Base(file), ".txt")
+		grams := profile(string(b))
+		for len(grams) > perLine {
+			profiles[lang] = append(profiles[lang], grams[:perLine])
+			grams = grams[perLine:]
+		}
+		profiles[lang] = append(profiles[lang], grams)
+	}
+
+	var source bytes.Buffer
+
+	tmplErr := tmpl.Execute(&source, profiles)
+	if tmplErr != nil {
+		return tmplErr
+	}
+
+	formatted, fmtErr := format.Source(source.Bytes())
+	if fmtErr != nil {
+		return fmtErr
+	}
+
+	f, createErr := os.Create("generated.go")
+	if createErr != nil {
+		return createErr
+	}
+	defer f.Close()
+
+	_, writeErr := f.Write(formatted)
+	if writeErr != nil {
+		return writeErr
+	}
+
+	return nil
+}
+
+var tmpl = template.Must(template.New("").Parse(`
+package language
+
+// This file is generated from generate/corpus. Best not to modify it, as it will likely be overwritten.
+
+// profiles are the most frequent n-grams for each language, in order of frequency
+var profiles = map[string][]string{
+{{- range $lang, $lines := . }}
+	{{ printf "%q" $lang }}: {
+	{{- range $lines }}
+		{{ range . }}{{ printf "%q" . }}, {{ end }}
+	{{- end }}
+	},
+{{- end }}
+}
+`))
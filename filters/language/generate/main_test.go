@@ -0,0 +1,43 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNgrams(t *testing.T) {
+	got := ngrams("Hi, yo!")
+	expected := []string{"h", "i", " h", "hi", "i ", " hi", "hi ", "y", "o", " y", "yo", "o ", " yo", "yo "}
+
+	if len(got) != len(expected) {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], got[i])
+		}
+	}
+}
+
+func TestCorpus(t *testing.T) {
+	files, err := filepath.Glob("corpus/*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("expected corpus files")
+	}
+
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// A full profile requires a reasonable amount of text
+		if got := len(profile(string(b))); got != size {
+			t.Errorf("expected %s to produce a profile of %d n-grams, got %d", file, size, got)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package language
+
+// This file is generated from generate/corpus. Best not to modify it, as it will likely be overwritten.
+
+// profiles are the most frequent n-grams for each language, in order of frequency
+var profiles = map[string][]string{
+	"danish": {
+		"e", "r", "n", "t", "g", "s", "d", "o", "i", "l", "a", "e ", "r ", "er", "t ", "m",
+		"k", "v", "en", " s", "er ", "n ", "de", "ge", "et", "et ", " v", "b", " m", "en ", " d", "g ",
+		"h", " e", " h", " o", "f", "ne", "og", " b", "le", "me", "or", "d ", "re", "i ", "j", "u",
+		"vi", "å", " a", " de", "an", " i", "il", "p", "st", "ve", " g", " og", "eg", "ke", "og ", " me",
+		" vi", "ed", "l ", "s ", " k", " t", "es", "men", "te", "ø", " er", " f", " l", "de ", "in", "nd",
+		"ne ", " n", "det", "gen", "je", "m ", "om", "or ", "se", "ti", "y", " hv", " i ", "der", "hv", "ka",
+		"ler", "ng", "om ", "rn", "vil", "å ", "æ", " ve", "ar", "at", "ed ", "ger", "ig", "kk", "kke", "nge",
+		"re ", "rne", "sk", " en", " j", " je", " ma", " p", "al", "at ", "be", "eg ", "el", "ere", "ern", "es ",
+		"fo", "for", "ge ", "ik", "il ", "jeg", "ke ", "le ", "ll", "lle", "ma", "no", "rg", "ør", " at", " et",
+		" fo", " ha", " ka", " no", " r", " re", " sk", " so", " st", "ag", "and", "ege", "ha", "ing", "ko", "li",
+		"man", "mm", "mme", "nn", "nog", "so", "va", "vi ", " du", " ik", " ko", " om", " på", " ti", "ad", "af",
+		"age", "an ", "ar ", "du", "du ", "ede", "ej", "ft", "fte", "get", "gl", "gt", "he", "ikk", "ill", "ket",
+		"nde", "nes", "nne", "nt", "od", "på", "på ", "rge", "ri", "rt", "se ", "som", "sp", "st ", "ste", "ta",
+		"ter", "til", "u ", "ved", "ver", "vo", "år", " af", " ar", " bo", " bø", " go", " he", " hj", " le", " mi",
+		" se", " si", " sp", " va", " å", "ad ", "al ", "am", "amm", "arb", "av", "ave", "bej", "bl", "bo", "br",
+		"bø", "bør", "den", "dr", "ejd", "ene", "enn", "ens", "gle", "go", "god", "gs", "gt ", "har", "hel", "hj",
+		"hvi", "id", "igt", "in ", "jd", "jde", "kal", "la", "lt", "mi", "mo", "mor", "nd ", "ns", "ns ", "oge",
+		"org", "ort", "os", "pe", "ra", "rb", "rbe", "rd", "reg", "res", "rin", "ro", "rt ", "sa", "si", "ska",
+		"så", "så ", "te ", "to", "tr", "år ", "æl", " al", " an", " ba", " be", " bi",
+	},
+	"dutch": {
+		"e", "n", "n ", "a", "i", "en", "t", "r", "en ", "o", "d", "l", "s", "k", "e ", "g",
+		"t ", "w", "j", "m", "u", " d", "h", "de", "r ", "s ", " w", " e", "aa", "er", "v", "z",
+		"et", "ij", " h", "b", "ee", "ie", " m", "an", " i", " z", "ar", "el", "ge", "te", "et ", "we",
+		" de", " v", "he", "k ", " g", " he", " s", " b", " we", "aar", "d ", "de ", " k", "nd", " a", "at",
+		"le", "oe", "p", "st", " en", "in", "is", "ke", " t", "ar ", "er ", "re", "an ", "een", "het", "is ",
+		"l ", "li", " ee", " n", "be", "da", "f", "me", "oo", "ri", "ve", "wa", "we ", " ge", " is", " o",
+		"ek", "g ", "ijn", "jn", "jn ", "on", "ra", "ze", " be", " j", " st", " wa", " zi", "at ", "c", "gen",
+		"ken", "ko", "len", "ma", "nde", "or", "ro", "ta", "ter", "zi", " da", " ma", " me", " te", " zo", "aan",
+		"al", "eg", "es", "gr", "ijk", "ik", "je", "jk", "ll", "lle", "ng", "nt", "pe", "wi", "zij", "zo",
+		" al", " ik", " je", " ko", " l", " ni", " r", " va", "ag", "den", "ed", "el ", "ere", "ie ", "ik ", "in ",
+		"je ", "la", "lij", "mo", "ne", "ni", "nie", "om", "ou", "pen", "ren", "sta", "te ", "va", "zen", " aa",
+		" in", " ki", " mo", " p", " re", " vr", "ag ", "and", "ate", "bl", "ch", "dat", "der", "ei", "ek ", "ev",
+		"eve", "ez", "eze", "hu", "iet", "il", "ind", "jk ", "ki", "ls", "men", "mi", "nd ", "oor", "op", "or ",
+		"ot", "rg", "rk", "se", "ten", "ti", "ts", "u ", "ui", "van", "ver", "vi", "vo", "vr", "wer", " br",
+		" go", " gr", " mi", " na", " op", " u", " vi", " vo", " wi", " zu", "als", "art", "as", "as ", "bli", "br",
+		"di", "do", "eb", "ed ", "ee ", "eel", "ege", "eli", "end", "erk", "est", "ets", "eu", "ft", "go", "goe",
+		"gro", "hee", "ho", "hui", "ien", "ing", "ke ", "kt", "kt ", "lie", "ls ", "m ", "maa", "met", "mij", "moe",
+		"na", "naa", "nen", "ng ", "nk", "nt ", "oc", "oed", "ond", "raa", "ran", "rge", "rie", "rij", "roe", "rt",
+		"sen", "ts ", "tw", "un", "ur", "us", "uw", "ven", "waa", "wat", "wee", "wil",
+	},
+	"english": {
+		"e", "t", "o", "a", "n", "h", "i", "s", "r", "e ", "l", " t", "d", "th", "u", "w",
+		"s ", " th", "he", "y", "c", " a", " w", "d ", "the", "t ", "an", "er", " i", "m", "ou", "n ",
+		"f", "in", "b", "g", "he ", "k", "r ", "y ", "p", " c", "nd", "v", "ve", " s", " an", "hi",
+		"o ", "re", " b", "er ", "nd ", " o", "and", "at", "en", "es", "st", " m", " to", " y", "ch", "ea",
+		"is", "ld", "to", " h", "is ", "k ", "ng", "or", "ta", " wh", " yo", "a ", "ar", "ha", "ld ", "te",
+		"ul", "wh", "yo", "you", " d", " f", " p", "g ", "h ", "her", "ing", "it", "ng ", "oo", "ou ", "thi",
+		"to ", "u ", "wo", " a ", " is", " l", " we", " wo", "de", "ho", "le", "nt", "of", "on", "se", "we",
+		" co", " n", "al", "as", "ay", "co", "es ", "hin", "il", "la", "on ", "oul", "re ", "uld", "ver", "wa",
+		" e", " g", " in", " of", " r", "at ", "bo", "ch ", "en ", "et", "f ", "in ", "l ", "li", "me", "no",
+		"pe", "pl", "ry", "so", "ter", "ut", "ut ", "ve ", " be", " ch", " i ", " li", " so", " wa", "be", "ca",
+		"ed", "ed ", "ee", "el", "est", "ev", "eve", "i ", "ic", "iv", "ive", "ke", "ll", "ne", "ns", "od",
+		"of ", "ow", "ra", "ro", "rs", "ry ", "se ", "sta", "tha", "ti", "un", "us", "w ", "we ", " ca", " do",
+		" go", " pl", " st", " wi", "ab", "ad", "an ", "are", "ay ", "bou", "de ", "do", "ec", "ery", "ge", "go",
+		"hat", "hil", "io", "ion", "it ", "le ", "ll ", "m ", "ma", "mo", "ni", "nin", "nk", "nk ", "nt ", "om",
+		"op", "os", "ot", "rk", "rs ", "tio", "whi", "wi", "wor", "wou", " ab", " al", " ar", " bu", " ev", " fo",
+		" fr", " ha", " he", " ho", " it", " k", " ma", " mo", " mu", " ne", " no", " on", " q", " qu", " re", " u",
+		" v", " ve", "abo", "ac", "ant", "av", "ave", "br", "bu", "but", "ce", "da", "day", "di", "ead", "ent",
+		"ere", "ers", "ew", "fe", "fo", "for", "fr", "ft", "goo", "han", "his", "hou", "id", "ide", "ie", "ink",
+		"ke ", "les", "lo", "ls", "mor", "mu", "ns ", "od ", "ok", "ol", "ood", "ook",
+	},
+	"finnish": {
+		"a", "i", "t", "s", "e", "n", "k", "m", "u", "ä", "l", "o", "a ", "n ", "ä ", "j",
+		"v", " k", "y", " m", "h", "tä", " j", "en", "ta", "in", "is", "mi", "p", "si", "t ", "e ",
+		"ka", "st", " t", "an", "it", "ja", "r", " v", " o", "me", "ut", " s", "aa", "al", "at", "d",
+		"in ", "ll", "tä ", " h", " mi", "el", "ja ", "le", "mm", "sa", "tt", " ja", " p", "ai", "en ", "i ",
+		"jo", "la", "on", "ss", "va", " a", " ka", "ie", "se", "ti", "uu", " l", "as", "ei", "ik", "isi",
+		"ko", "ku", "sa ", "sä", " jo", "et", "ki", "mme", "on ", "ssa", "ta ", "te", "to", "un", "us", "vi",
+		"ö", " e", "aa ", "an ", "at ", "es", "ii", "il", "ks", "lu", "me ", "min", "mu", "nn", "oi", "stä",
+		"ää", " mu", " on", "ha", "im", "ke", "lm", "ns", "ol", "s ", "su", "tta", "vat", "än", " y", "au",
+		"de", "he", "imm", "ist", "le ", "li", "lla", "ma", "men", "na", "ni", "nt", "o ", "os", "ot", "pa",
+		"sä ", "ua", "utt", "vä", "yö", " ki", " ko", " ku", " pa", " r", " su", " tä", " vi", "aik", "all", "dä",
+		"ia", "ill", "iä", "iä ", "jot", "ky", "la ", "mie", "nu", "ra", "sin", "uo", "ää ", " au", " ei", " ha",
+		" hy", " i", " ky", " la", " ol", " ra", " u", " va", "ak", "aut", "dä ", "ea", "ed", "em", "ens", "ent",
+		"et ", "ett", "hal", "hy", "hyv", "ia ", "iel", "ir", "itä", "je", "ka ", "kan", "kk", "ksi", "lj", "lle",
+		"mis", "my", "na ", "ne", "nk", "ois", "ok", "ov", "ova", "sit", "sk", "sta", "suu", "taa", "tk", "ttä",
+		"tu", "uk", "un ", "ut ", "uut", "ym", "ys", "yv", "äi", "än ", " he", " ma", " n", " ov", " se", " ta",
+		" to", " ve", " vo", "ais", "alu", "am", "ann", "ans", "av", "ee", "ei ", "ek", "ell", "elm", "ess", "est",
+		"hd", "hu", "ih", "iit", "ikk", "irj", "iss", "it ", "ita", "itt", "jat", "kir", "kun", "kä", "lli", "lua",
+		"mmi", "mo", "mut", "nna", "nsä", "nta", "nä", "nä ", "od", "ole", "om", "pal", "pä", "re", "ri", "rj",
+		"se ", "set", "siä", "ssä", "sti", "sy", "tel", "ten", "tie", "ty", "tää", "ua ",
+	},
+	"french": {
+		"e", "s", "n", "r", "o", "u", "a", "i", "s ", "t", "e ", "l", "d", "t ", "c", "es",
+		"m", "p", " d", "ou", "v", "es ", " l", " p", "on", "de", "le", " a", " c", " e", "er", "en",
+		"n ", "re", " de", " m", "nt", "r ", "é", " s", "ai", "q", "qu", " n", "ns", " v", "an", "f",
+		"g", "h", "ur", "us", "de ", "et", "is", "ue", " q", " qu", "au", "ma", "ous", "us ", " le", " t",
+		"a ", "no", "ns ", "nt ", "que", "il", "ne", "so", " no", "er ", "est", "et ", "les", "re ", "st", "tr",
+		"u ", "ve", "vo", " et", "b", "ch", "is ", "j", "è", " ma", " vo", "ent", "i ", "it", "la", "le ",
+		"me", "nou", "se", " i", " so", " tr", " u", " un", "ie", "in", "l ", "oi", "po", "ro", "un", " f",
+		" la", " r", "ais", "ce", "co", "eu", "la ", "nd", "on ", "ons", "our", "pe", "ri", "rs", "son", "te",
+		"ue ", " b", " ch", " g", " j", " pa", " pe", " po", "ar", "au ", "av", "ge", "he", "io", "je", "mai",
+		"ne ", "ouv", "pa", "pr", "ra", "res", "rè", "ta", "ti", "ts", "ts ", "uv", " au", " ce", " es", "ci",
+		"d ", "ea", "eau", "el", "il ", "ion", "ll", "ont", "or", "pou", "rs ", "si", "st ", "un ", "ur ", "uve",
+		"vi", "vou", " co", " en", " h", " il", " je", " pr", "ant", "c ", "che", "di", "em", "ens", "fa", "id",
+		"ir", "it ", "je ", "li", "lle", "om", "rc", "rt", "rès", "te ", "ui", "x", "x ", "ès", "ès ", "é ",
+		" a ", " av", " bo", " du", " fr", " li", " mo", " o", " re", " vi", " à", " à ", "ag", "ain", "and", "ans",
+		"at", "bo", "ce ", "da", "dan", "des", "du", "du ", "eur", "ez", "ez ", "fr", "ill", "im", "iv", "jo",
+		"jou", "lo", "mo", "nes", "nn", "nse", "nts", "os", "pen", "si ", "tio", "ues", "urs", "ut", "ux", "ux ",
+		"ven", "z", "z ", "à", "à ", "èr", "ère", " ai", " c ", " da", " di", " fa", " n ", " ne", " pl", " si",
+		" to", " é", "age", "ail", "as", "ati", "ava", "bi", "bon", "cho", "der", "do", "dr", "dé", "en ", "end",
+		"ers", "ha", "ho", "ide", "iez", "in ", "ir ", "lé", "men", "mer", "mes", "mi",
+	},
+	"german": {
+		"e", "n", "i", "s", "r", "n ", "t", "en", "a", "d", "en ", "h", "u", "l", "e ", "b",
+		"er", "g", "m", "r ", "w", "c", " d", "o", "t ", "ch", " s", " w", "ei", "in", "k", "nd",
+		"s ", "de", "er ", "ie", "ge", " a", "es", "st", "te", " i", "be", "d ", "f", "nd ", " e", " m",
+		"ne", "se", "un", " g", "ein", " k", "he", "ie ", "le", "re", " u", "an", "di", " di", " un", "die",
+		"und", " ei", "ü", " b", " de", "den", "el", "hr", "ic", "sc", "sch", "st ", "we", "z", " ge", "as",
+		"ich", "is", "m ", "me", "ä", "au", "ber", "che", "es ", "in ", "ine", " f", " h", " si", " v", " we",
+		" z", "ar", "ben", "ch ", "der", "gen", "h ", "hen", "ist", "si", "ss", "u ", "v", "wa", "wi", " is",
+		" n", " sc", " wi", "et", "ha", "ind", "nn", "nt", "ten", "us", "ö", " da", " es", " l", " me", " zu",
+		"ab", "abe", "al", "as ", "cht", "da", "eb", "est", "eu", "hre", "ht", "ir", "ir ", "it", "ng", "p",
+		"ra", "sen", "ste", "wo", "zu", " an", " ha", " ic", " so", " st", " wo", "am", "an ", "das", "eh", "em",
+		"hn", "len", "li", "ll", "ma", "nde", "ne ", "nen", "nk", "or", "rd", "rde", "rg", "rge", "sin", "so",
+		"ta", "te ", "ut", "wir", "zu ", "ür", " ab", " am", " au", " le", " ma", " o", " r", " re", " se", " wa",
+		"ag", "am ", "ass", "bi", "du", "ebe", "ee", "ek", "ern", "f ", "g ", "ib", "ieb", "iel", "ig", "ka",
+		"ke", "l ", "lie", "lt", "man", "men", "nge", "ni", "ns", "on", "rb", "ren", "rn", "ro", "se ", "ss ",
+		"sse", "uc", "uch", "ute", "was", "wei", "ß", "ön", " al", " ar", " be", " bi", " du", " fr", " fü", " gu",
+		" he", " ih", " im", " in", " ka", " kö", " mi", " mo", " ne", " ni", " p", " t", " vi", " vo", " wä", " wü",
+		"ac", "ach", "ah", "and", "arb", "at", "auf", "bei", "bl", "br", "chw", "de ", "des", "du ", "eg", "eit",
+		"ele", "em ", "end", "enk", "ens", "ent", "ese", "et ", "fe", "fr", "ft", "fü", "ge ", "ges", "gu", "gut",
+		"hl", "hr ", "hte", "hw", "ih", "im", "it ", "j", "k ", "ken", "kö", "kön",
+	},
+	"italian": {
+		"e", "a", "i", "o", "n", "r", "e ", "t", "s", "l", "c", "o ", "a ", "u", "i ", "m",
+		"d", "p", "re", "g", " s", " c", " d", " a", " p", "er", " m", "an", "b", "re ", "to", "v",
+		"ia", "no", "on", " l", "f", "le", " i", "to ", "ar", "h", "or", "st", "ta", " e", "ch", "ma",
+		"no ", "ra", "te", " n", "al", "do", "en", "le ", "mo", "n ", "pe", " e ", "am", "ci", "co", "di",
+		"la", "ne", "q", "qu", "si", "tt", "un", " f", "at", "io", "nt", "os", "so", "ti", " g", " pe",
+		" u", "are", "bi", "ca", "de", "es", "in", "l ", "li", "me", "na", "sa", " co", " di", " la", " q",
+		" qu", " t", " un", "che", "he", "he ", "ic", "il", "na ", "ni", "ri", "ua", "è", "è ", " b", " do",
+		" ma", " è", " è ", "da", "di ", "gi", "iam", "ie", "la ", "ll", "mo ", "nd", "ne ", "ol", "per", "ro",
+		"ta ", "te ", "tr", "uo", "ve", " al", " ch", " so", " v", "amo", "eg", "ent", "qua", "sa ", "ti ", "vo",
+		" i ", " no", "ac", "and", "cos", "ere", "est", "et", "ett", "gl", "gli", "men", "mi", "ni ", "on ", "pi",
+		"po", "pr", "se", "si ", "sta", "ut", " an", " ca", " de", " le", " mo", " ne", " r", " se", " si", "as",
+		"ato", "ce", "el", "ge", "gg", "gio", "iu", "li ", "lt", "man", "om", "ono", "ov", "ove", "pa", "ro ",
+		"sc", "sia", "son", "ss", "sto", "su", "ue", "un ", "vor", " bi", " da", " fa", " il", " me", " mi", " pa",
+		" pr", " su", "ab", "ag", "ann", "av", "avo", "bb", "bia", "cc", "chi", "cu", "da ", "do ", "dom", "ec",
+		"ed", "er ", "fa", "hi", "ici", "il ", "im", "io ", "it", "lle", "lo", "nn", "ns", "one", "ost", "que",
+		"r ", "ran", "rn", "rt", "tre", "tti", "tto", "una", "ve ", "vi", " a ", " ab", " bu", " fr", " in", " pi",
+		" po", " sc", " st", " tr", " vi", "abb", "agg", "al ", "alc", "all", "ano", "ant", "ap", "ate", "az", "azi",
+		"bbi", "bu", "buo", "cch", "cin", "cr", "de ", "egl", "ei", "ei ", "ell", "em", "ens", "era", "erc", "eri",
+		"ess", "fe", "fi", "fr", "ggi", "gia", "ia ", "id", "ier", "ile", "ior", "is",
+	},
+	"norwegian": {
+		"e", "n", "r", "t", "s", "i", "o", "g", "l", "a", "e ", "d", "en", "k", "m", "r ",
+		"t ", "v", "er", "n ", " s", "er ", "en ", "et", " m", " v", "g ", " e", "j", "et ", "b", " d",
+		"h", "ne", "å", "de", " h", " o", "le", "me", "or", "te", "st", "ke", "re", "u", "vi", "an",
+		"el", "f", "ge", "i ", "p", "å ", " b", " de", "je", " i", " me", " og", " t", "il", "og", " g",
+		" k", " vi", "d ", "es", "in", "men", "og ", "s ", "ve", " f", "nn", "ø", "eg", "ne ", "ng", "se",
+		"y", " a", " er", " j", " l", " ve", "ar", "det", "m ", "om", "or ", " n", "eg ", "ene", "kk", "l ",
+		"ll", "om ", "ti", "va", " en", " et", " hv", " i ", " je", " p", "a ", "ed", "gen", "hv", "ka", "ler",
+		"lle", "nne", "sk", "vil", " ka", " st", "ed ", "fo", "for", "gj", "ig", "ik", "il ", "ing", "jeg", "jo",
+		"ke ", "li", "nge", "på", "på ", "ste", "ter", "tt", " fo", " ha", " le", " på", " r", " so", " va", " å",
+		"ak", "ar ", "de ", "es ", "ha", "is", "kke", "le ", "mm", "mme", "nd", "nes", "nt", "od", "re ", "se ",
+		"so", "som", "ta", "te ", "ten", "vi ", "ør", " du", " ma", " no", " om", " re", " si", " ti", "ag", "al",
+		"an ", "as", "at", "be", "du", "du ", "enn", "ere", "ett", "ge ", "har", "he", "id", "ikk", "jø", "k ",
+		"ker", "kt", "ld", "let", "lt", "ma", "man", "må", "nen", "no", "noe", "ns", "oe", "org", "ra", "ren",
+		"rg", "rge", "ri", "rn", "rt", "rt ", "si", "sj", "st ", "til", "u ", "us", "van", "vel", "vo", " av",
+		" bo", " gj", " go", " he", " hj", " ik", " mi", " mo", " se", " sk", " sp", " sy", " å ", "akk", "am", "amm",
+		"ann", "av", "ba", "bl", "bo", "br", "da", "dag", "den", "di", "dr", "ek", "eld", "ell", "ent", "est",
+		"ga", "go", "god", "hel", "hj", "hje", "hvo", "ig ", "ill", "in ", "jel", "jer", "ket", "kk ", "ko", "kt ",
+		"ku", "la", "lig", "lt ", "med", "mi", "mo", "mor", "na", "od ", "ok", "ort", "os", "pe", "res", "rin",
+		"ro", "rs", "sa", "sin", "ske", "sp", "sy", "så", "så ", "to", "tr", "tt ",
+	},
+	"portuguese": {
+		"a", "o", "e", "s", "r", "m", "s ", "u", "i", "o ", "n", "t", "d", "c", "a ", "e ",
+		"p", "as", "os", " d", "as ", " p", "os ", " e", "de", "r ", " a", "l", " c", " m", "es", "g",
+		" o", "ar", "er", "q", "qu", "ta", " de", " n", "an", "b", "ma", "h", "m ", "ã", "do", "f",
+		"ra", "to", "v", "de ", " q", " qu", "co", "que", "ue", "um", "ão", "ão ", "mo", "nt", "st", "to ",
+		" e ", " f", "am", "re", " s", "ar ", "do ", "ia", "or", " co", " es", " t", "ca", "da", "est", "mos",
+		"no", "pr", "sa", "ss", "te", "á", " mu", " pe", "en", "er ", "gu", "it", "mu", "om", "pe", "po",
+		"ua", "ue ", " o ", " v", "ad", "al", "ci", "em", "is", "ri", "se", "é", " b", " do", " os", " pr",
+		" u", " um", "ha", "me", "ro", "sta", "u ", "uma", "ve", " as", " ca", " i", " ma", " no", " po", "ant",
+		"bo", "di", "in", "j", "lh", "ma ", "mui", "na", "nd", "nh", "oi", "ra ", "so", "ui", "uit", "un",
+		" l", " na", " pa", " se", " é", " é ", "ab", "com", "ei", "es ", "eu", "ga", "go", "ho", "ia ", "ic",
+		"io", "mas", "or ", "pa", "por", "pre", "qua", "rt", "sa ", "sso", "ta ", "tr", "ud", "um ", "z", "é ",
+		" a ", " bo", " fa", " g", " me", " r", " tr", "ado", "amo", "ara", "cr", "da ", "esc", "ess", "eu ", "fa",
+		"fi", "id", "ig", "ir", "ita", "ito", "le", "mp", "nde", "nos", "nta", "nte", "nto", "oc", "ois", "om ",
+		"on", "ou", "par", "per", "res", "sc", "tas", "ti", "uan", "ç", " al", " an", " da", " di", " en", " fi",
+		" le", " mo", " nã", " on", " ve", " à", "aba", "ada", "aj", "alg", "alh", "am ", "ama", "anh", "ba", "bal",
+		"br", "car", "ch", "cre", "cu", "ec", "em ", "ert", "et", "gos", "gr", "gra", "gum", "gun", "ias", "il",
+		"im", "is ", "iss", "ju", "lg", "lgu", "lho", "man", "mb", "mi", "na ", "nc", "ng", "nha", "ns", "nu",
+		"nã", "não", "oa", "ol", "ora", "ou ", "ov", "pro", "rab", "rto", "se ", "so ", "ssa", "te ", "tes", "tra",
+		"ua ", "uda", "uer", "unt", "us", "ver", "vo", "à", "á ", "ám", "ámo", "ã ",
+	},
+	"russian": {
+		"о", "т", "е", "и", "а", "н", "р", "м", "с", "л", "в", "д", "о ", "ы", "к", "у",
+		"и ", "б", "е ", "п", "ь", "то", " п", "ч", "ы ", "я", " в", " н", "г", " м", "ь ", "ст",
+		" д", " с", "а ", "ро", "ра", "ш", " б", " и", " по", "от", "по", "та", "ко", "м ", "но", "т ",
+		"я ", "во", "ом", "ор", "то ", "х", " и ", " о", "з", "ли", "ос", "ть", " ч", "ж", "не", "ть ",
+		" к", " т", "бы", "де", "ес", "й", "ю", " бы", " л", " р", " х", "бо", "ен", "й ", "мо", "ни",
+		"но ", " не", " хо", "ат", "ли ", "ог", "ол", "пр", "те", "тр", "у ", "хо", "ать", "бы ", "го", "до",
+		"ет", "ль", "на", "ов", "од", "ое", "ры", "се", "че", "э", "эт", " во", " г", " до", " з", " ко",
+		" мо", " у", " чт", "в ", "ел", "ест", "ил", "ит", "ле", "ма", "ом ", "про", "ре", "сто", "тор", "чт",
+		"что", "это", " в ", " де", " мы", " но", " пр", " ра", " э", " эт", "ал", "ень", "за", "им", "ло", "ме",
+		"му", "мы", "мы ", "не ", "нь", "ок", "оль", "оро", "ост", "ото", "ста", "ти", "ти ", "том", "ут", "ше",
+		" го", " за", " лю", " на", " ст", " я", " я ", "аб", "або", "ав", "ак", "аю", "бот", "ва", "ви", "вы",
+		"гд", "дн", "ду", "ег", "ек", "ет ", "ие", "ие ", "им ", "ка", "л ", "лу", "лю", "ми", "мог", "н ",
+		"об", "ое ", "оры", "оч", "раб", "рос", "ру", "сл", "тел", "ты", "ты ", "уж", "ум", "чи", "ят", " бо",
+		" вы", " ду", " лу", " мн", " ни", " об", " ре", "ае", "ан", "ас", "ают", "бол", "бр", "ги", "д ", "да",
+		"де ", "дум", "его", "ед", "ей", "ей ", "ем", "ер", "еш", "же", "жи", "жн", "зн", "иб", "или", "ита",
+		"к ", "ки", "кол", "кот", "кр", "кт", "ку", "ло ", "луч", "ми ", "мн", "му ", "на ", "ны", "ны ", "нь ",
+		"ово", "ои", "ой", "ой ", "ому", "он", "оте", "оче", "ош", "пос", "ра ", "ром", "с ", "сег", "ск", "со",
+		"та ", "так", "тат", "тро", "уд", "ума", "ут ", "уч", "учш", "х ", "хот", "ц", "чит", "чш", "чше", "ше ",
+		"шк", "шко", "ые", "ые ", "ьк", "ю ", "ют", "ют ", "ё", " ва", " ве", " вс",
+	},
+	"spanish": {
+		"a", "e", "s", "o", "n", "r", "u", "s ", "d", "l", "i", "t", "c", "a ", "o ", "m",
+		"e ", "as", "p", "n ", "os", " l", "as ", "os ", " e", "en", "es", " d", " p", "er", "ue", " c",
+		"de", "r ", "g", " a", "b", "la", "y", " m", "an", "q", "qu", "ta", " de", "ar", "un", "do",
+		"h", "que", " la", " q", " qu", " t", "de ", "nt", "ra", "re", "y ", " es", "na", " y", "ci", "ie",
+		"v", " n", " s", " y ", "en ", "es ", "st", " h", " lo", " u", "ca", "f", "lo", "no", "ro", "ue ",
+		" en", " un", "am", "da", "do ", "l ", "las", "ma", "ad", "ar ", "est", "ha", "j", "le", "mo", "sa",
+		"te", "to", "tr", "í", " v", "co", "el", "los", "me", "mos", "or", "pr", "so", " ca", " co", " ha",
+		"ab", "al", "er ", "gu", "la ", "na ", "nd", "on", "sta", "ta ", "á", " b", " g", " mu", " no", " pe",
+		" pr", " tr", "bi", "cu", "di", "eg", "el ", "ent", "go", "ier", "mi", "mu", "no ", "pe", "po", "ro ",
+		"un ", "us", "ñ", " el", " f", " po", "ac", "an ", "ay", "ch", "em", "id", "nte", "or ", "por", "pre",
+		"to ", "tra", "ua", "ud", "ve", "é", "ía", "ó", " a ", " al", " bu", " cu", " do", " ma", " me", " mi",
+		" pa", " r", " so", "ado", "aj", "amo", "ana", "añ", "ba", "bu", "ec", "ed", "ero", "he", "i ", "ia",
+		"ien", "ir", "nas", "nde", "nta", "nu", "oc", "on ", "pa", "per", "pi", "ras", "rí", "te ", "ti", "una",
+		"unt", "vi", " ay", " j", " ju", " su", " ta", " ve", " vi", "aba", "aci", "ada", "aja", "and", "ant", "asa",
+		"aña", "baj", "be", "bl", "bue", "ce", "cer", "ció", "cr", "dam", "dar", "des", "die", "ee", "ens", "ga",
+		"go ", "gr", "gua", "gun", "ha ", "ib", "ic", "ig", "im", "in", "ir ", "ió", "ja", "jo", "ju", "ll",
+		"lo ", "man", "men", "mp", "muc", "ndo", "nos", "ns", "nsa", "nto", "od", "om", "ot", "pu", "rab", "ran",
+		"re ", "reg", "res", "ri", "rm", "rt", "sa ", "sar", "sc", "si", "so ", "son", "stá", "su", "tá", "ua ",
+		"uc", "uch", "uda", "ued", "uen", "ui", "va", "ver", "án", "ía ", "ña", "ño",
+	},
+	"swedish": {
+		"t", "a", "e", "r", "n", "l", "s", "i", "o", "t ", "r ", "d", "m", "g", "k", "n ",
+		"v", "ä", "a ", " s", " v", "en", "h", " m", "et", "en ", "å", "ar", "f", "b", "c", "e ",
+		" d", "de", "er", "ll", "u", " o", "et ", "st", " t", "at", "j", "ta", "tt", " a", " f", "an",
+		"or", "p", "te", " b", " vi", "vi", "är", " h", " oc", "ar ", "er ", "me", "oc", " e", " i", " k",
+		"ch", "ch ", "h ", "il", "in", "och", "ra", "tt ", " de", " ä", "i ", "re", "ti", "är ", "å ", "ö",
+		" p", "att", "d ", "ga", "ng", "om", " me", " är", "ck", "g ", "ke", "la", "na", "y", "äl", "ag",
+		"an ", "ja", "ko", "le", "m ", "ma", "or ", "s ", "sk", "än", " at", " g", " j", " l", " n", " st",
+		" ti", "ge", "ill", "ka", "mm", "om ", "rn", "va", "vä", " en", " i ", " sk", " va", "ag ", "de ", "det",
+		"ed", "fö", "för", "ig", "ing", "med", "na ", "nd", "nn", "ra ", "sta", "ter", "vil", "ör", " fö", " ha",
+		" ja", " ka", " på", " vä", "as", "br", "den", "ft", "go", "ha", "he", "io", "jag", "ku", "l ", "ll ",
+		"lle", "lt", "ne", "nge", "på", "på ", "re ", "sa", "so", "som", "te ", "til", "ve", "vi ", "åg", " br",
+		" du", " fr", " he", " in", " ko", " ma", " r", " re", " so", "ad", "al", "cke", "da", "du", "du ", "el",
+		"es", "fr", "ga ", "gr", "id", "it", "jä", "k ", "ker", "ket", "llt", "lt ", "man", "men", "mma", "ns",
+		"on", "rg", "ri", "rna", "ro", "rt", "rå", "st ", "ste", "så", "så ", "tar", "tr", "u ", "ul", "ull",
+		"var", "väl", "yc", "yck", "äll", "år", "ör ", " ar", " bi", " bo", " ef", " et", " lä", " mi", " mo", " nå",
+		" om", " pr", " så", " ve", "ade", "all", "am", "amm", "arn", "ast", "av", "ba", "be", "bi", "bo", "ck ",
+		"di", "dig", "ed ", "ef", "eft", "ek", "ett", "frå", "fte", "gar", "gen", "get", "gra", "gt", "gt ", "har",
+		"hel", "hu", "id ", "in ", "ja ", "jäl", "kor", "kt", "kul", "ld", "le ", "lj", "lja", "lä", "mer", "mi",
+		"mme", "mo", "mor", "nde", "nga", "ni", "ns ", "nå", "någ", "o ", "od", "omm",
+	},
+}
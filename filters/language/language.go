@@ -0,0 +1,120 @@
+// Package language provides offline language identification, using character n-gram profiles, and a filter to
+// route text to language-specific filters, such as stemmers or stop words, by its detected language
+package language
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:generate go run generate/main.go
+
+// minGrams is the fewest n-grams for which Detect will attempt identification; shorter text is ambiguous
+const minGrams = 20
+
+var (
+	once  sync.Once
+	ranks map[string]map[string]int
+)
+
+// load converts profiles, which are ordered by frequency, to a rank lookup
+func load() {
+	ranks = make(map[string]map[string]int, len(profiles))
+	for lang, grams := range profiles {
+		r := make(map[string]int, len(grams))
+		for i, gram := range grams {
+			r[gram] = i
+		}
+		ranks[lang] = r
+	}
+}
+
+// Detect identifies the language of s, returning its lowercase English name, such as "english" or "french",
+// which is understood by stopwords.Language and stemmer.Language. It returns false if s is too short to identify,
+// or has no letters. Detection uses the "out-of-place" distance between n-gram profiles (Cavnar & Trenkle).
+func Detect(s string) (string, bool) {
+	once.Do(load)
+
+	doc := rank(ngrams(s))
+	if len(doc) < minGrams {
+		return "", false
+	}
+
+	result, best := "", -1
+	for _, lang := range Languages() {
+		profile := ranks[lang]
+		penalty := len(profile)
+
+		distance := 0
+		for _, gram := range doc {
+			r, found := profile[gram]
+			if !found {
+				distance += penalty
+				continue
+			}
+			distance += r
+		}
+
+		if best < 0 || distance < best {
+			result, best = lang, distance
+		}
+	}
+
+	return result, result != ""
+}
+
+// Languages returns the names of languages which can be detected, sorted
+func Languages() []string {
+	result := make([]string, 0, len(profiles))
+	for lang := range profiles {
+		result = append(result, lang)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// rank returns the distinct n-grams in order of frequency, ties broken alphabetically
+func rank(grams []string) []string {
+	counts := make(map[string]int)
+	for _, gram := range grams {
+		counts[gram]++
+	}
+
+	result := make([]string, 0, len(counts))
+	for gram := range counts {
+		result = append(result, gram)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if counts[result[i]] != counts[result[j]] {
+			return counts[result[i]] > counts[result[j]]
+		}
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+// ngrams returns the 1-, 2- and 3-grams of lowercased letters, where each word is padded with a space on either side.
+// It must match the func of the same name in generate/main.go.
+func ngrams(text string) []string {
+	var result []string
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, word := range words {
+		rs := []rune(" " + word + " ")
+		for n := 1; n <= 3; n++ {
+			for i := 0; i+n <= len(rs); i++ {
+				gram := string(rs[i : i+n])
+				if gram == " " {
+					continue
+				}
+				result = append(result, gram)
+			}
+		}
+	}
+	return result
+}
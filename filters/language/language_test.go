@@ -0,0 +1,143 @@
+package language_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/contractions"
+	"github.com/clipperhouse/jargon/filters/language"
+	"github.com/clipperhouse/jargon/filters/stemmer"
+	"github.com/clipperhouse/jargon/filters/stopwords"
+)
+
+func TestDetect(t *testing.T) {
+	tests := map[string]string{
+		"My neighbours are planning a party for the weekend.":                       "english",
+		"Mis vecinos están preparando una fiesta para el fin de semana.":            "spanish",
+		"Mes voisins préparent une fête pour le week-end.":                          "french",
+		"Meine Nachbarn planen eine Party für das Wochenende.":                      "german",
+		"I miei vicini stanno organizzando una festa per il fine settimana.":        "italian",
+		"Os meus vizinhos estão a planear uma festa para o fim de semana.":          "portuguese",
+		"Mijn buren plannen een feest voor het weekend.":                            "dutch",
+		"Mina grannar planerar en fest till helgen.":                                "swedish",
+		"Naboene mine planlegger en fest til helgen.":                               "norwegian",
+		"Naapurini suunnittelevat juhlia viikonlopuksi.":                            "finnish",
+		"Мои соседи планируют вечеринку на выходные.":                               "russian",
+		"Maskinlæring er et område inden for kunstig intelligens":                   "danish",
+		"L'apprendimento automatico è un campo dell'intelligenza artificiale":       "italian",
+		"A aprendizagem automática é um campo da inteligência artificial":           "portuguese",
+		"El aprendizaje automático es un campo de la inteligencia artificial":       "spanish",
+		"L'apprentissage automatique est un domaine de l'intelligence artificielle": "french",
+	}
+
+	for input, expected := range tests {
+		got, found := language.Detect(input)
+		if !found {
+			t.Errorf("expected to detect %q as %s, but it was not detected", input, expected)
+			continue
+		}
+		if got != expected {
+			t.Errorf("expected to detect %q as %s, got %s", input, expected, got)
+		}
+	}
+
+	// Too short, or not letters
+	for _, input := range []string{"Hello", "123 456", ""} {
+		if got, found := language.Detect(input); found {
+			t.Errorf("expected %q not to be detected, got %s", input, got)
+		}
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	for _, lang := range language.Languages() {
+		if _, found := stemmer.Language(lang); !found {
+			t.Errorf("expected a stemmer for %s", lang)
+		}
+	}
+}
+
+func TestSegments(t *testing.T) {
+	text := "The children are playing in the garden. OK! Los niños están jugando en el jardín.\nLes enfants jouent dans le jardin"
+	segments := language.NewSegments(jargon.TokenizeString(text))
+
+	expected := []struct {
+		lang, text string
+	}{
+		{"english", "The children are playing in the garden."},
+		{"english", " OK!"}, // too short, so takes the previous language
+		{"spanish", " Los niños están jugando en el jardín."},
+		{"spanish", "\n"},
+		{"french", "Les enfants jouent dans le jardin"},
+	}
+
+	for _, e := range expected {
+		segment, err := segments.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if segment == nil {
+			t.Fatalf("expected segment %q, got nil", e.text)
+		}
+
+		got := ""
+		for _, token := range segment.Tokens {
+			got += token.String()
+		}
+
+		if got != e.text {
+			t.Errorf("expected segment %q, got %q", e.text, got)
+		}
+		if segment.Language != e.lang {
+			t.Errorf("expected segment %q to be %s, got %q", e.text, e.lang, segment.Language)
+		}
+	}
+
+	segment, err := segments.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if segment != nil {
+		t.Errorf("expected nil segment at end, got %v", segment.Tokens)
+	}
+}
+
+func TestRouter(t *testing.T) {
+	route := language.NewRouter(contractions.Language, stopwords.Language, stemmer.Language)
+
+	text := "We don't like the houses. Nos gustan las casas grandes."
+	tokens, err := jargon.TokenizeString(text).Filter(route).Words().ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, token := range tokens {
+		got = append(got, token.String())
+	}
+
+	// English: contractions expanded, stop words removed, stemmed; Spanish: stop words removed, stemmed
+	expected := []string{"like", "hous", "gust", "cas", "grand"}
+	if strings.Join(got, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestName(t *testing.T) {
+	tests := map[string]string{
+		"de":      "german",
+		"DE":      "german",
+		"nb":      "norwegian",
+		"zh":      "chinese",
+		"German":  "german",
+		"english": "english",
+		"xx":      "xx",
+	}
+
+	for input, expected := range tests {
+		if got := language.Name(input); got != expected {
+			t.Errorf("expected %q to be %q, got %q", input, expected, got)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package language
+
+import (
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// maxTokens is the longest segment; a longer run of tokens without a sentence boundary is split
+const maxTokens = 512
+
+// Segment is a run of tokens, typically a sentence, and its detected language
+type Segment struct {
+	// Language is the detected language, such as "english", or "" if not detected
+	Language string
+	Tokens   []*jargon.Token
+}
+
+// Segments is an iterator of language-tagged segments, see NewSegments
+type Segments struct {
+	incoming *jargon.TokenStream
+	previous string
+}
+
+// NewSegments splits incoming tokens into segments, at sentence-ending punctuation or line breaks, and detects the language of each.
+// A segment too short to identify, such as "OK.", takes the language of the previous segment.
+func NewSegments(incoming *jargon.TokenStream) *Segments {
+	return &Segments{
+		incoming: incoming,
+	}
+}
+
+// Next returns the next segment, or nil at the end of the incoming stream
+func (s *Segments) Next() (*Segment, error) {
+	var tokens []*jargon.Token
+	for len(tokens) < maxTokens {
+		token, err := s.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			break
+		}
+
+		tokens = append(tokens, token)
+
+		if isBoundary(token) {
+			break
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	for _, token := range tokens {
+		// Only words, not URLs, hashtags and so on
+		if token.Kind() == jargon.Word {
+			b.WriteString(token.String())
+			b.WriteByte(' ')
+		}
+	}
+
+	if lang, found := Detect(b.String()); found {
+		s.previous = lang
+	}
+
+	segment := &Segment{
+		Language: s.previous,
+		Tokens:   tokens,
+	}
+	return segment, nil
+}
+
+func isBoundary(token *jargon.Token) bool {
+	if token.IsSpace() {
+		return strings.ContainsRune(token.String(), '\n')
+	}
+	if token.IsPunct() {
+		switch token.String() {
+		case ".", "!", "?", "…", "。", "！", "？":
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+// Package lemmatizer provides a filter to map inflected English words to their dictionary forms (lemmas), such as ran → run, for use with jargon
+package lemmatizer
+
+import (
+	_ "embed" // for words.txt
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/mapper"
+)
+
+//go:generate go run generate/main.go
+
+// English maps inflected English words to their dictionary forms (lemmas). Examples:
+// ran → run
+// mice → mouse
+// better → good
+// studies → study
+// Managed → Manage
+//
+// Irregular forms are looked up in a lexicon; regular inflections are handled by rules, whose results are checked
+// against known words, so that Texas is not taken for a plural. Unlike a stemmer, which may produce non-words such as
+// "manag", words which are not recognized are left alone. Case is preserved.
+// Tokens marked as keywords (see jargon.Token.IsKeyword) are left alone.
+var English = mapper.NewFilter(lemmatize)
+
+func lemmatize(token *jargon.Token) *jargon.Token {
+	if token.Kind() != jargon.Word || token.IsKeyword() {
+		return token
+	}
+
+	s := token.String()
+	lemma, found := String(s)
+	if !found || lemma == s {
+		// Had no effect, send back the original
+		return token
+	}
+
+	return jargon.NewToken(lemma, true)
+}
+
+// String is a utility method for lemmatizing a single word. Use the English filter to process a token stream.
+// found will be false if the word was not recognized as an inflected form.
+func String(s string) (lemma string, found bool) {
+	lower := strings.ToLower(s)
+
+	lemma, found = lexicon[lower]
+	if found && lemma == lower {
+		// An exception, which is not an inflected form
+		return s, false
+	}
+	if !found {
+		lemma, found = rules(lower)
+	}
+	if !found {
+		return s, false
+	}
+
+	return recase(s, lemma), true
+}
+
+// recase applies the case of the original to the lemma, i.e. lower, Title or UPPER
+func recase(original, lemma string) string {
+	first, _ := utf8.DecodeRuneInString(original)
+	if !unicode.IsUpper(first) {
+		return lemma
+	}
+
+	if utf8.RuneCountInString(original) > 1 && strings.ToUpper(original) == original {
+		return strings.ToUpper(lemma)
+	}
+
+	r, size := utf8.DecodeRuneInString(lemma)
+	return string(unicode.ToUpper(r)) + lemma[size:]
+}
+
+// rules are fallbacks for regular inflections; s is expected to be lowercase
+func rules(s string) (string, bool) {
+	if len(s) < 4 {
+		return s, false
+	}
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return s, false
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		// studies → study, movies → movie
+		once.Do(load)
+		return known(words, s[:len(s)-3]+"y", s[:len(s)-1])
+	case strings.HasSuffix(s, "ied"):
+		// tried → try
+		return known(verbs, s[:len(s)-3]+"y")
+	case strings.HasSuffix(s, "ed"):
+		return known(verbs, candidates(s[:len(s)-2])...)
+	case strings.HasSuffix(s, "ing"):
+		return known(verbs, candidates(s[:len(s)-3])...)
+	case strings.HasSuffix(s, "iest"):
+		// happiest → happy
+		return known(adjectives, s[:len(s)-4]+"y")
+	case strings.HasSuffix(s, "ier"):
+		// happier → happy
+		return known(adjectives, s[:len(s)-3]+"y")
+	case strings.HasSuffix(s, "est"):
+		return known(adjectives, candidates(s[:len(s)-3])...)
+	case strings.HasSuffix(s, "er"):
+		return known(adjectives, candidates(s[:len(s)-2])...)
+	case strings.HasSuffix(s, "sses"), strings.HasSuffix(s, "xes"), strings.HasSuffix(s, "zes"),
+		strings.HasSuffix(s, "ches"), strings.HasSuffix(s, "shes"):
+		// boxes → box, caches → cache
+		once.Do(load)
+		return known(words, s[:len(s)-2], s[:len(s)-1])
+	case strings.HasSuffix(s, "ss"), strings.HasSuffix(s, "us"), strings.HasSuffix(s, "is"):
+		// class, status, analysis
+		return s, false
+	case strings.HasSuffix(s, "s"):
+		// cats → cat, but not Texas → Texa
+		once.Do(load)
+		return known(words, s[:len(s)-1])
+	}
+
+	return s, false
+}
+
+// candidates are possible lemmas of a stem whose suffix was removed, e.g. manag(ed) → manage, walk(ed), stopp(ed) → stop.
+// The -e form is first, since hop(ing) is more likely hope than hop.
+func candidates(stem string) []string {
+	result := []string{stem + "e", stem}
+
+	n := len(stem)
+	if n >= 2 && stem[n-1] == stem[n-2] {
+		result = append(result, stem[:n-1])
+	}
+
+	return result
+}
+
+// known returns the first candidate which is a known lemma
+func known(lemmas map[string]bool, candidates ...string) (string, bool) {
+	for _, c := range candidates {
+		if lemmas[c] {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+//go:embed words.txt
+var wordsFile string
+
+var (
+	once  sync.Once
+	words map[string]bool
+)
+
+// load reads the known words, which validate the fallbacks for plurals. They are from the Wiktionary frequency list
+// of English in television and film (https://en.wiktionary.org/wiki/Wiktionary:Frequency_lists, as packaged by
+// github.com/nbutton23/zxcvbn-go), followed by the words of Snowball's English vocabulary which it lacks
+// (https://github.com/snowballstem/snowball-data, as packaged by github.com/kljensen/snowball), limited to lowercase
+// words of three or more letters.
+func load() {
+	fields := strings.Fields(wordsFile)
+	words = make(map[string]bool, len(fields))
+	for _, word := range fields {
+		words[word] = true
+	}
+}
@@ -0,0 +1,92 @@
+package lemmatizer
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+)
+
+func TestEnglish(t *testing.T) {
+	type test struct {
+		input  string
+		output string
+	}
+
+	tests := []test{
+		{"He ran while the mice ate", "He run while the mouse eat"},
+		{"Better studies, worst boxes", "Good study, bad box"},
+		{"She managed, stopped and tried", "She manage, stop and try"},
+		{"RUNNING Writing walking", "RUN Write walk"},
+		{"bigger happier latest", "big happy late"},
+		{"always news during the morning, perhaps", "always news during the morning, perhaps"},
+		{"The manager's class status", "The manager's class status"},
+		{"watch movies about kubernetes", "watch movie about kubernetes"},
+		{"123 cats @", "123 cat @"},
+		{"Texas, James and postgres", "Texas, James and postgres"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(test.input).Filter(English).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.output {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.output, got)
+		}
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	tokens, err := jargon.TokenizeString("ran home").Filter(English).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !tokens[0].IsLemma() {
+		t.Errorf("expected %q to be a lemma", tokens[0])
+	}
+	if tokens[2].IsLemma() {
+		t.Errorf("expected %q to not be a lemma", tokens[2])
+	}
+}
+
+func TestString(t *testing.T) {
+	type test struct {
+		input string
+		lemma string
+		found bool
+	}
+
+	tests := []test{
+		{"mice", "mouse", true},
+		{"Cats", "Cat", true},
+		{"cat", "cat", false},
+		// Exceptions are not inflected forms
+		{"news", "news", false},
+		{"always", "always", false},
+		// Plurals are validated against known words
+		{"Texas", "Texas", false},
+		{"Postgres", "Postgres", false},
+		{"caches", "cache", true},
+		{"niches", "niche", true},
+		{"headaches", "headache", true},
+		{"sizes", "size", true},
+		{"prizes", "prize", true},
+		{"mazes", "maze", true},
+		{"boxes", "box", true},
+		{"churches", "church", true},
+		{"movies", "movie", true},
+		{"studies", "study", true},
+		{"techies", "techies", false},
+		{"hoping", "hope", true},
+		{"hoped", "hope", true},
+		{"hopping", "hop", true},
+	}
+
+	for _, test := range tests {
+		lemma, found := String(test.input)
+		if lemma != test.lemma || found != test.found {
+			t.Errorf("given %q, expected %q (found: %t), got %q (found: %t)", test.input, test.lemma, test.found, lemma, found)
+		}
+	}
+}
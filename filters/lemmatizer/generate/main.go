@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	err := write()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// lexicon is the parsed content of lexicon.txt
+type lexicon struct {
+	// Mappings are inflected forms → lemmas; exceptions map to themselves
+	Mappings map[string]string
+	// Verbs and Adjectives are known lemmas; Verbs includes irregular lemmas
+	Verbs, Adjectives map[string]bool
+}
+
+func parse(r io.Reader) (*lexicon, error) {
+	lex := &lexicon{
+		Mappings:   make(map[string]string),
+		Verbs:      make(map[string]bool),
+		Adjectives: make(map[string]bool),
+	}
+
+	section := ""
+	sc := bufio.NewScanner(r)
+	line := 0
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			section = strings.Trim(text, "[]")
+			continue
+		}
+
+		fields := strings.Fields(text)
+
+		switch section {
+		case "irregular":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %d: expected an inflected form and a lemma, got %q", line, text)
+			}
+			if err := lex.add(fields[0], fields[1]); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			// Irregular lemmas take regular suffixes too, e.g. running → run
+			lex.Verbs[fields[1]] = true
+		case "exceptions":
+			if len(fields) != 1 {
+				return nil, fmt.Errorf("line %d: expected a single word, got %q", line, text)
+			}
+			if err := lex.add(fields[0], fields[0]); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		case "verbs":
+			lex.Verbs[fields[0]] = true
+		case "adjectives":
+			lex.Adjectives[fields[0]] = true
+		default:
+			return nil, fmt.Errorf("line %d: unknown section %q", line, section)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return lex, nil
+}
+
+func (lex *lexicon) add(word, lemma string) error {
+	existing, exists := lex.Mappings[word]
+	if exists {
+		return fmt.Errorf("attempting to re-add %q (previous value was %q)", word, existing)
+	}
+	lex.Mappings[word] = lemma
+	return nil
+}
+
+func write() error {
+	in, err := os.Open("generate/lexicon.txt")
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	lex, err := parse(in)
+	if err != nil {
+		return err
+	}
+
+	var source bytes.Buffer
+
+	tmplErr := tmpl.Execute(&source, lex)
+	if tmplErr != nil {
+		return tmplErr
+	}
+
+	// Break up some lines for readability
+	split := strings.ReplaceAll(source.String(), `, "`, `,
+"`)
+	split = strings.ReplaceAll(split, `{"`, `{
+"`)
+	split = strings.ReplaceAll(split, `"}`, `",
+}`)
+	split = strings.ReplaceAll(split, `true}`, `true,
+}`)
+
+	formatted, fmtErr := format.Source([]byte(split))
+	if fmtErr != nil {
+		return fmtErr
+	}
+
+	f, createErr := os.Create("generated.go")
+	if createErr != nil {
+		return createErr
+	}
+	defer f.Close()
+
+	_, writeErr := f.Write(formatted)
+	if writeErr != nil {
+		return writeErr
+	}
+
+	return nil
+}
+
+var tmpl = template.Must(template.New("").Parse(`
+package lemmatizer
+
+// This file is generated from generate/lexicon.txt. Best not to modify it, as it will likely be overwritten.
+
+// maps do not guarantee order, so this will look random
+var lexicon = {{ printf "%#v" .Mappings }}
+
+var verbs = {{ printf "%#v" .Verbs }}
+
+var adjectives = {{ printf "%#v" .Adjectives }}
+`))
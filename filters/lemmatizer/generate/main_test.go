@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLexicon(t *testing.T) {
+	f, err := os.Open("lexicon.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	lex, err := parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for word, lemma := range lex.Mappings {
+		// Lemmas should not themselves be inflected forms, i.e. no chains
+		if next, found := lex.Mappings[lemma]; found && next != lemma {
+			t.Errorf("the mapping %q → %q leads to another mapping %q → %q", word, lemma, lemma, next)
+		}
+	}
+
+	if len(lex.Verbs) == 0 || len(lex.Adjectives) == 0 {
+		t.Errorf("expected verbs and adjectives")
+	}
+}
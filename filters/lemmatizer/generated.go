@@ -0,0 +1,1333 @@
+package lemmatizer
+
+// This file is generated from generate/lexicon.txt. Best not to modify it, as it will likely be overwritten.
+
+// maps do not guarantee order, so this will look random
+var lexicon = map[string]string{
+	"afterwards":   "afterwards",
+	"alias":        "alias",
+	"alumni":       "alumnus",
+	"always":       "always",
+	"am":           "be",
+	"analyses":     "analysis",
+	"analysis":     "analysis",
+	"antennae":     "antenna",
+	"anything":     "anything",
+	"anyways":      "anyways",
+	"appendices":   "appendix",
+	"are":          "be",
+	"arisen":       "arise",
+	"arose":        "arise",
+	"as":           "as",
+	"ate":          "eat",
+	"athletics":    "athletics",
+	"atlas":        "atlas",
+	"aunties":      "auntie",
+	"awoke":        "awake",
+	"awoken":       "awake",
+	"axes":         "axis",
+	"backwards":    "backwards",
+	"bacteria":     "bacterium",
+	"basis":        "basis",
+	"beaten":       "beat",
+	"became":       "become",
+	"bed":          "bed",
+	"been":         "be",
+	"began":        "begin",
+	"begun":        "begin",
+	"being":        "be",
+	"bent":         "bend",
+	"besides":      "besides",
+	"best":         "good",
+	"better":       "good",
+	"bias":         "bias",
+	"bit":          "bite",
+	"bitten":       "bite",
+	"bled":         "bleed",
+	"bleed":        "bleed",
+	"blew":         "blow",
+	"blown":        "blow",
+	"bore":         "bear",
+	"born":         "bear",
+	"borne":        "bear",
+	"bought":       "buy",
+	"bound":        "bind",
+	"bred":         "breed",
+	"breed":        "breed",
+	"bring":        "bring",
+	"broke":        "break",
+	"broken":       "break",
+	"brought":      "bring",
+	"brownies":     "brownie",
+	"building":     "building",
+	"built":        "build",
+	"burnt":        "burn",
+	"bus":          "bus",
+	"buses":        "bus",
+	"cacti":        "cactus",
+	"calories":     "calorie",
+	"calves":       "calf",
+	"came":         "come",
+	"canvas":       "canvas",
+	"caught":       "catch",
+	"ceiling":      "ceiling",
+	"chaos":        "chaos",
+	"children":     "child",
+	"chose":        "choose",
+	"chosen":       "choose",
+	"clung":        "cling",
+	"cookies":      "cookie",
+	"cortices":     "cortex",
+	"cosmos":       "cosmos",
+	"crept":        "creep",
+	"crises":       "crisis",
+	"crisis":       "crisis",
+	"criteria":     "criterion",
+	"curricula":    "curriculum",
+	"dealt":        "deal",
+	"deed":         "deed",
+	"diagnoses":    "diagnosis",
+	"dice":         "die",
+	"did":          "do",
+	"dies":         "die",
+	"does":         "do",
+	"doing":        "do",
+	"done":         "do",
+	"drank":        "drink",
+	"drawn":        "draw",
+	"dreamt":       "dream",
+	"drew":         "draw",
+	"driven":       "drive",
+	"drove":        "drive",
+	"drunk":        "drink",
+	"dug":          "dig",
+	"during":       "during",
+	"eaten":        "eat",
+	"echoes":       "echo",
+	"economics":    "economics",
+	"elder":        "old",
+	"eldest":       "old",
+	"elves":        "elf",
+	"ethics":       "ethics",
+	"evening":      "evening",
+	"everything":   "everything",
+	"fallen":       "fall",
+	"farther":      "far",
+	"farthest":     "far",
+	"fed":          "feed",
+	"feed":         "feed",
+	"feeling":      "feeling",
+	"feet":         "foot",
+	"fell":         "fall",
+	"felt":         "feel",
+	"fled":         "flee",
+	"flew":         "fly",
+	"flown":        "fly",
+	"flung":        "fling",
+	"foci":         "focus",
+	"forbade":      "forbid",
+	"forbidden":    "forbid",
+	"forgave":      "forgive",
+	"forgiven":     "forgive",
+	"forgot":       "forget",
+	"forgotten":    "forget",
+	"formulae":     "formula",
+	"fought":       "fight",
+	"found":        "find",
+	"freebies":     "freebie",
+	"froze":        "freeze",
+	"frozen":       "freeze",
+	"fungi":        "fungus",
+	"further":      "far",
+	"furthest":     "far",
+	"gas":          "gas",
+	"gases":        "gas",
+	"gave":         "give",
+	"geese":        "goose",
+	"given":        "give",
+	"glasses":      "glasses",
+	"goes":         "go",
+	"gone":         "go",
+	"goodies":      "goodie",
+	"got":          "get",
+	"gotten":       "get",
+	"greed":        "greed",
+	"grew":         "grow",
+	"grown":        "grow",
+	"had":          "have",
+	"halves":       "half",
+	"has":          "have",
+	"having":       "have",
+	"headquarters": "headquarters",
+	"heard":        "hear",
+	"heed":         "heed",
+	"held":         "hold",
+	"heroes":       "hero",
+	"hers":         "hers",
+	"hid":          "hide",
+	"hidden":       "hide",
+	"hippies":      "hippie",
+	"his":          "his",
+	"hooves":       "hoof",
+	"hundred":      "hundred",
+	"hung":         "hang",
+	"hypotheses":   "hypothesis",
+	"indices":      "index",
+	"iris":         "iris",
+	"is":           "be",
+	"its":          "its",
+	"jeans":        "jeans",
+	"jenkins":      "jenkins",
+	"kept":         "keep",
+	"kindred":      "kindred",
+	"king":         "king",
+	"knelt":        "kneel",
+	"knew":         "know",
+	"knives":       "knife",
+	"known":        "know",
+	"kubernetes":   "kubernetes",
+	"laid":         "lay",
+	"lain":         "lie",
+	"larvae":       "larva",
+	"leant":        "lean",
+	"leapt":        "leap",
+	"learnt":       "learn",
+	"least":        "little",
+	"leaves":       "leaf",
+	"led":          "lead",
+	"left":         "leave",
+	"lens":         "lens",
+	"lent":         "lend",
+	"less":         "little",
+	"lice":         "louse",
+	"lies":         "lie",
+	"lit":          "light",
+	"lives":        "life",
+	"loaves":       "loaf",
+	"lost":         "lose",
+	"made":         "make",
+	"mathematics":  "mathematics",
+	"matrices":     "matrix",
+	"meaning":      "meaning",
+	"means":        "means",
+	"meant":        "mean",
+	"memoranda":    "memorandum",
+	"men":          "man",
+	"met":          "meet",
+	"mice":         "mouse",
+	"mistaken":     "mistake",
+	"mistook":      "mistake",
+	"more":         "many",
+	"morning":      "morning",
+	"most":         "many",
+	"movies":       "movie",
+	"need":         "need",
+	"nevertheless": "nevertheless",
+	"newbies":      "newbie",
+	"news":         "news",
+	"nothing":      "nothing",
+	"nuclei":       "nucleus",
+	"odds":         "odds",
+	"oops":         "oops",
+	"ours":         "ours",
+	"overcame":     "overcome",
+	"oxen":         "ox",
+	"paid":         "pay",
+	"painting":     "painting",
+	"pants":        "pants",
+	"parentheses":  "parenthesis",
+	"people":       "person",
+	"perhaps":      "perhaps",
+	"phenomena":    "phenomenon",
+	"physics":      "physics",
+	"pies":         "pie",
+	"politics":     "politics",
+	"potatoes":     "potato",
+	"proved":       "prove",
+	"proven":       "prove",
+	"quizzes":      "quiz",
+	"radii":        "radius",
+	"rails":        "rails",
+	"ran":          "run",
+	"rang":         "ring",
+	"red":          "red",
+	"reed":         "reed",
+	"ridden":       "ride",
+	"ring":         "ring",
+	"risen":        "rise",
+	"rode":         "ride",
+	"rookies":      "rookie",
+	"rose":         "rise",
+	"rung":         "ring",
+	"said":         "say",
+	"sang":         "sing",
+	"sank":         "sink",
+	"sat":          "sit",
+	"saw":          "see",
+	"says":         "say",
+	"scarves":      "scarf",
+	"scissors":     "scissors",
+	"seed":         "seed",
+	"seem":         "seem",
+	"seen":         "see",
+	"selfies":      "selfie",
+	"selves":       "self",
+	"sent":         "send",
+	"series":       "series",
+	"sewed":        "sew",
+	"sewn":         "sew",
+	"shaken":       "shake",
+	"shed":         "shed",
+	"shelves":      "shelf",
+	"shone":        "shine",
+	"shook":        "shake",
+	"shot":         "shoot",
+	"showed":       "show",
+	"shown":        "show",
+	"shrank":       "shrink",
+	"shrunk":       "shrink",
+	"sing":         "sing",
+	"slept":        "sleep",
+	"slid":         "slide",
+	"slung":        "sling",
+	"smoothies":    "smoothie",
+	"sold":         "sell",
+	"something":    "something",
+	"sometimes":    "sometimes",
+	"sought":       "seek",
+	"spat":         "spit",
+	"species":      "species",
+	"sped":         "speed",
+	"speed":        "speed",
+	"spent":        "spend",
+	"spoke":        "speak",
+	"spoken":       "speak",
+	"sprang":       "spring",
+	"spring":       "spring",
+	"sprung":       "spring",
+	"spun":         "spin",
+	"stank":        "stink",
+	"stimuli":      "stimulus",
+	"stole":        "steal",
+	"stolen":       "steal",
+	"stood":        "stand",
+	"strata":       "stratum",
+	"stridden":     "stride",
+	"string":       "string",
+	"striven":      "strive",
+	"strode":       "stride",
+	"strove":       "strive",
+	"struck":       "strike",
+	"strung":       "string",
+	"stuck":        "stick",
+	"stung":        "sting",
+	"stunk":        "stink",
+	"sung":         "sing",
+	"sunk":         "sink",
+	"swam":         "swim",
+	"swelled":      "swell",
+	"swept":        "sweep",
+	"swing":        "swing",
+	"swollen":      "swell",
+	"swore":        "swear",
+	"sworn":        "swear",
+	"swum":         "swim",
+	"swung":        "swing",
+	"syllabi":      "syllabus",
+	"synopses":     "synopsis",
+	"taken":        "take",
+	"taught":       "teach",
+	"teeth":        "tooth",
+	"thanks":       "thanks",
+	"theirs":       "theirs",
+	"theses":       "thesis",
+	"thesis":       "thesis",
+	"thieves":      "thief",
+	"thing":        "thing",
+	"this":         "this",
+	"thought":      "think",
+	"threw":        "throw",
+	"thrown":       "throw",
+	"ties":         "tie",
+	"told":         "tell",
+	"tomatoes":     "tomato",
+	"took":         "take",
+	"tore":         "tear",
+	"torn":         "tear",
+	"towards":      "towards",
+	"trod":         "tread",
+	"trodden":      "tread",
+	"understood":   "understand",
+	"undertaken":   "undertake",
+	"undertook":    "undertake",
+	"us":           "us",
+	"vertebrae":    "vertebra",
+	"vertices":     "vertex",
+	"vetoes":       "veto",
+	"was":          "be",
+	"wed":          "wed",
+	"wedding":      "wedding",
+	"weed":         "weed",
+	"went":         "go",
+	"wept":         "weep",
+	"were":         "be",
+	"whereas":      "whereas",
+	"wing":         "wing",
+	"withdrawn":    "withdraw",
+	"withdrew":     "withdraw",
+	"wives":        "wife",
+	"woke":         "wake",
+	"woken":        "wake",
+	"wolves":       "wolf",
+	"women":        "woman",
+	"won":          "win",
+	"wore":         "wear",
+	"worn":         "wear",
+	"worse":        "bad",
+	"worst":        "bad",
+	"wove":         "weave",
+	"woven":        "weave",
+	"written":      "write",
+	"wrote":        "write",
+	"yes":          "yes",
+	"yours":        "yours",
+	"zombies":      "zombie",
+}
+
+var verbs = map[string]bool{
+	"accept":      true,
+	"accuse":      true,
+	"achieve":     true,
+	"act":         true,
+	"add":         true,
+	"admire":      true,
+	"admit":       true,
+	"adopt":       true,
+	"advise":      true,
+	"afford":      true,
+	"agree":       true,
+	"aim":         true,
+	"allow":       true,
+	"alumnus":     true,
+	"analysis":    true,
+	"announce":    true,
+	"annoy":       true,
+	"answer":      true,
+	"antenna":     true,
+	"apologise":   true,
+	"apologize":   true,
+	"appear":      true,
+	"appendix":    true,
+	"applaud":     true,
+	"apply":       true,
+	"appreciate":  true,
+	"approve":     true,
+	"argue":       true,
+	"arise":       true,
+	"arrange":     true,
+	"arrest":      true,
+	"arrive":      true,
+	"ask":         true,
+	"attach":      true,
+	"attack":      true,
+	"attempt":     true,
+	"attend":      true,
+	"attract":     true,
+	"auntie":      true,
+	"avoid":       true,
+	"awake":       true,
+	"axis":        true,
+	"back":        true,
+	"bacterium":   true,
+	"bad":         true,
+	"bake":        true,
+	"balance":     true,
+	"ban":         true,
+	"bang":        true,
+	"bathe":       true,
+	"battle":      true,
+	"be":          true,
+	"beam":        true,
+	"bear":        true,
+	"beat":        true,
+	"become":      true,
+	"beg":         true,
+	"begin":       true,
+	"behave":      true,
+	"belong":      true,
+	"bend":        true,
+	"bind":        true,
+	"bite":        true,
+	"bleed":       true,
+	"bless":       true,
+	"blind":       true,
+	"blink":       true,
+	"blow":        true,
+	"boast":       true,
+	"boil":        true,
+	"bolt":        true,
+	"bomb":        true,
+	"book":        true,
+	"bore":        true,
+	"borrow":      true,
+	"bounce":      true,
+	"bow":         true,
+	"box":         true,
+	"brake":       true,
+	"branch":      true,
+	"break":       true,
+	"breathe":     true,
+	"breed":       true,
+	"bring":       true,
+	"brownie":     true,
+	"bruise":      true,
+	"brush":       true,
+	"bubble":      true,
+	"build":       true,
+	"bump":        true,
+	"burn":        true,
+	"bury":        true,
+	"bus":         true,
+	"buy":         true,
+	"buzz":        true,
+	"cactus":      true,
+	"calculate":   true,
+	"calf":        true,
+	"call":        true,
+	"calorie":     true,
+	"camp":        true,
+	"care":        true,
+	"carry":       true,
+	"carve":       true,
+	"catch":       true,
+	"cause":       true,
+	"challenge":   true,
+	"change":      true,
+	"charge":      true,
+	"chase":       true,
+	"cheat":       true,
+	"check":       true,
+	"cheer":       true,
+	"chew":        true,
+	"child":       true,
+	"choke":       true,
+	"choose":      true,
+	"chop":        true,
+	"claim":       true,
+	"clap":        true,
+	"clean":       true,
+	"clear":       true,
+	"cling":       true,
+	"clip":        true,
+	"close":       true,
+	"coach":       true,
+	"code":        true,
+	"coil":        true,
+	"collect":     true,
+	"color":       true,
+	"colour":      true,
+	"comb":        true,
+	"come":        true,
+	"command":     true,
+	"commit":      true,
+	"communicate": true,
+	"compare":     true,
+	"compete":     true,
+	"compile":     true,
+	"complain":    true,
+	"complete":    true,
+	"concentrate": true,
+	"concern":     true,
+	"confess":     true,
+	"configure":   true,
+	"confuse":     true,
+	"connect":     true,
+	"consider":    true,
+	"consist":     true,
+	"contain":     true,
+	"continue":    true,
+	"cookie":      true,
+	"copy":        true,
+	"correct":     true,
+	"cortex":      true,
+	"cough":       true,
+	"count":       true,
+	"cover":       true,
+	"crack":       true,
+	"crash":       true,
+	"crawl":       true,
+	"create":      true,
+	"creep":       true,
+	"crisis":      true,
+	"criterion":   true,
+	"cross":       true,
+	"crush":       true,
+	"cry":         true,
+	"cure":        true,
+	"curl":        true,
+	"curriculum":  true,
+	"curve":       true,
+	"cycle":       true,
+	"dam":         true,
+	"damage":      true,
+	"dance":       true,
+	"dare":        true,
+	"deal":        true,
+	"debug":       true,
+	"decay":       true,
+	"deceive":     true,
+	"decide":      true,
+	"decorate":    true,
+	"delay":       true,
+	"delight":     true,
+	"deliver":     true,
+	"depend":      true,
+	"deploy":      true,
+	"describe":    true,
+	"desert":      true,
+	"deserve":     true,
+	"destroy":     true,
+	"detect":      true,
+	"develop":     true,
+	"diagnosis":   true,
+	"die":         true,
+	"dig":         true,
+	"disagree":    true,
+	"disappear":   true,
+	"disapprove":  true,
+	"disarm":      true,
+	"discover":    true,
+	"dislike":     true,
+	"divide":      true,
+	"do":          true,
+	"double":      true,
+	"doubt":       true,
+	"drag":        true,
+	"drain":       true,
+	"draw":        true,
+	"dream":       true,
+	"dress":       true,
+	"drink":       true,
+	"drip":        true,
+	"drive":       true,
+	"drop":        true,
+	"drown":       true,
+	"dry":         true,
+	"dump":        true,
+	"dust":        true,
+	"earn":        true,
+	"eat":         true,
+	"echo":        true,
+	"educate":     true,
+	"elf":         true,
+	"embarrass":   true,
+	"employ":      true,
+	"empty":       true,
+	"encourage":   true,
+	"end":         true,
+	"enjoy":       true,
+	"enter":       true,
+	"entertain":   true,
+	"escape":      true,
+	"examine":     true,
+	"excite":      true,
+	"excuse":      true,
+	"exercise":    true,
+	"exist":       true,
+	"expand":      true,
+	"expect":      true,
+	"explain":     true,
+	"explode":     true,
+	"extend":      true,
+	"face":        true,
+	"fade":        true,
+	"fail":        true,
+	"fall":        true,
+	"fancy":       true,
+	"far":         true,
+	"fasten":      true,
+	"fax":         true,
+	"fear":        true,
+	"feed":        true,
+	"feel":        true,
+	"fence":       true,
+	"fetch":       true,
+	"fight":       true,
+	"file":        true,
+	"fill":        true,
+	"film":        true,
+	"find":        true,
+	"fire":        true,
+	"fit":         true,
+	"fix":         true,
+	"flap":        true,
+	"flash":       true,
+	"flee":        true,
+	"fling":       true,
+	"float":       true,
+	"flood":       true,
+	"flow":        true,
+	"flower":      true,
+	"fly":         true,
+	"focus":       true,
+	"fold":        true,
+	"follow":      true,
+	"fool":        true,
+	"foot":        true,
+	"forbid":      true,
+	"force":       true,
+	"forget":      true,
+	"forgive":     true,
+	"form":        true,
+	"formula":     true,
+	"frame":       true,
+	"freebie":     true,
+	"freeze":      true,
+	"frighten":    true,
+	"fry":         true,
+	"fungus":      true,
+	"gas":         true,
+	"gather":      true,
+	"gaze":        true,
+	"get":         true,
+	"give":        true,
+	"glow":        true,
+	"glue":        true,
+	"go":          true,
+	"good":        true,
+	"goodie":      true,
+	"goose":       true,
+	"grab":        true,
+	"grate":       true,
+	"grease":      true,
+	"greet":       true,
+	"grin":        true,
+	"grip":        true,
+	"groan":       true,
+	"grow":        true,
+	"guarantee":   true,
+	"guard":       true,
+	"guess":       true,
+	"guide":       true,
+	"half":        true,
+	"hammer":      true,
+	"hand":        true,
+	"handle":      true,
+	"hang":        true,
+	"happen":      true,
+	"harm":        true,
+	"hate":        true,
+	"haunt":       true,
+	"have":        true,
+	"head":        true,
+	"heal":        true,
+	"heap":        true,
+	"hear":        true,
+	"heat":        true,
+	"help":        true,
+	"hero":        true,
+	"hide":        true,
+	"hippie":      true,
+	"hold":        true,
+	"hoof":        true,
+	"hook":        true,
+	"hop":         true,
+	"hope":        true,
+	"hover":       true,
+	"hug":         true,
+	"hum":         true,
+	"hunt":        true,
+	"hurry":       true,
+	"hypothesis":  true,
+	"identify":    true,
+	"ignore":      true,
+	"imagine":     true,
+	"impress":     true,
+	"improve":     true,
+	"include":     true,
+	"increase":    true,
+	"index":       true,
+	"influence":   true,
+	"inform":      true,
+	"inject":      true,
+	"injure":      true,
+	"install":     true,
+	"instruct":    true,
+	"intend":      true,
+	"interest":    true,
+	"interfere":   true,
+	"interrupt":   true,
+	"introduce":   true,
+	"invent":      true,
+	"invite":      true,
+	"irritate":    true,
+	"itch":        true,
+	"jail":        true,
+	"jam":         true,
+	"jog":         true,
+	"join":        true,
+	"joke":        true,
+	"judge":       true,
+	"juggle":      true,
+	"jump":        true,
+	"keep":        true,
+	"kick":        true,
+	"kill":        true,
+	"kiss":        true,
+	"kneel":       true,
+	"knife":       true,
+	"knit":        true,
+	"knock":       true,
+	"knot":        true,
+	"know":        true,
+	"label":       true,
+	"land":        true,
+	"larva":       true,
+	"last":        true,
+	"laugh":       true,
+	"launch":      true,
+	"lay":         true,
+	"lead":        true,
+	"leaf":        true,
+	"lean":        true,
+	"leap":        true,
+	"learn":       true,
+	"leave":       true,
+	"lend":        true,
+	"level":       true,
+	"license":     true,
+	"lick":        true,
+	"lie":         true,
+	"life":        true,
+	"light":       true,
+	"lighten":     true,
+	"like":        true,
+	"list":        true,
+	"listen":      true,
+	"little":      true,
+	"live":        true,
+	"load":        true,
+	"loaf":        true,
+	"lock":        true,
+	"long":        true,
+	"look":        true,
+	"lose":        true,
+	"louse":       true,
+	"love":        true,
+	"make":        true,
+	"man":         true,
+	"manage":      true,
+	"many":        true,
+	"march":       true,
+	"mark":        true,
+	"marry":       true,
+	"match":       true,
+	"mate":        true,
+	"matrix":      true,
+	"matter":      true,
+	"mean":        true,
+	"measure":     true,
+	"meet":        true,
+	"melt":        true,
+	"memorandum":  true,
+	"memorise":    true,
+	"memorize":    true,
+	"mend":        true,
+	"merge":       true,
+	"milk":        true,
+	"mine":        true,
+	"miss":        true,
+	"mistake":     true,
+	"mix":         true,
+	"moan":        true,
+	"moor":        true,
+	"mourn":       true,
+	"mouse":       true,
+	"move":        true,
+	"movie":       true,
+	"muddle":      true,
+	"mug":         true,
+	"multiply":    true,
+	"murder":      true,
+	"nail":        true,
+	"name":        true,
+	"need":        true,
+	"nest":        true,
+	"newbie":      true,
+	"nod":         true,
+	"note":        true,
+	"notice":      true,
+	"nucleus":     true,
+	"number":      true,
+	"obey":        true,
+	"object":      true,
+	"observe":     true,
+	"obtain":      true,
+	"occur":       true,
+	"offend":      true,
+	"offer":       true,
+	"old":         true,
+	"open":        true,
+	"order":       true,
+	"overcome":    true,
+	"overflow":    true,
+	"owe":         true,
+	"own":         true,
+	"ox":          true,
+	"pack":        true,
+	"paddle":      true,
+	"paint":       true,
+	"parenthesis": true,
+	"park":        true,
+	"parse":       true,
+	"part":        true,
+	"pass":        true,
+	"pause":       true,
+	"pay":         true,
+	"peck":        true,
+	"pedal":       true,
+	"peel":        true,
+	"peep":        true,
+	"perform":     true,
+	"permit":      true,
+	"person":      true,
+	"phenomenon":  true,
+	"phone":       true,
+	"pick":        true,
+	"pie":         true,
+	"pinch":       true,
+	"pine":        true,
+	"place":       true,
+	"plan":        true,
+	"plant":       true,
+	"play":        true,
+	"please":      true,
+	"plug":        true,
+	"point":       true,
+	"poke":        true,
+	"polish":      true,
+	"pop":         true,
+	"possess":     true,
+	"post":        true,
+	"potato":      true,
+	"pour":        true,
+	"practice":    true,
+	"practise":    true,
+	"pray":        true,
+	"preach":      true,
+	"precede":     true,
+	"prefer":      true,
+	"prepare":     true,
+	"present":     true,
+	"preserve":    true,
+	"press":       true,
+	"pretend":     true,
+	"prevent":     true,
+	"prick":       true,
+	"print":       true,
+	"produce":     true,
+	"program":     true,
+	"promise":     true,
+	"protect":     true,
+	"prove":       true,
+	"provide":     true,
+	"pull":        true,
+	"pump":        true,
+	"punch":       true,
+	"puncture":    true,
+	"punish":      true,
+	"push":        true,
+	"query":       true,
+	"question":    true,
+	"queue":       true,
+	"quiz":        true,
+	"race":        true,
+	"radiate":     true,
+	"radius":      true,
+	"rain":        true,
+	"raise":       true,
+	"reach":       true,
+	"realise":     true,
+	"realize":     true,
+	"receive":     true,
+	"recognise":   true,
+	"recognize":   true,
+	"record":      true,
+	"reduce":      true,
+	"refactor":    true,
+	"reflect":     true,
+	"refuse":      true,
+	"regret":      true,
+	"reign":       true,
+	"reject":      true,
+	"rejoice":     true,
+	"relax":       true,
+	"release":     true,
+	"rely":        true,
+	"remain":      true,
+	"remember":    true,
+	"remind":      true,
+	"remove":      true,
+	"render":      true,
+	"repair":      true,
+	"repeat":      true,
+	"replace":     true,
+	"reply":       true,
+	"report":      true,
+	"reproduce":   true,
+	"request":     true,
+	"rescue":      true,
+	"retire":      true,
+	"return":      true,
+	"rhyme":       true,
+	"ride":        true,
+	"ring":        true,
+	"rinse":       true,
+	"rise":        true,
+	"risk":        true,
+	"rob":         true,
+	"rock":        true,
+	"roll":        true,
+	"rookie":      true,
+	"rot":         true,
+	"rub":         true,
+	"ruin":        true,
+	"rule":        true,
+	"run":         true,
+	"rush":        true,
+	"sack":        true,
+	"sail":        true,
+	"satisfy":     true,
+	"save":        true,
+	"say":         true,
+	"scare":       true,
+	"scarf":       true,
+	"scatter":     true,
+	"scold":       true,
+	"scorch":      true,
+	"scrape":      true,
+	"scratch":     true,
+	"scream":      true,
+	"screw":       true,
+	"scribble":    true,
+	"scrub":       true,
+	"seal":        true,
+	"search":      true,
+	"see":         true,
+	"seek":        true,
+	"self":        true,
+	"selfie":      true,
+	"sell":        true,
+	"send":        true,
+	"separate":    true,
+	"serve":       true,
+	"settle":      true,
+	"sew":         true,
+	"shade":       true,
+	"shake":       true,
+	"share":       true,
+	"shave":       true,
+	"shelf":       true,
+	"shelter":     true,
+	"shine":       true,
+	"shiver":      true,
+	"shock":       true,
+	"shoot":       true,
+	"shop":        true,
+	"show":        true,
+	"shrink":      true,
+	"shrug":       true,
+	"sigh":        true,
+	"sign":        true,
+	"signal":      true,
+	"sin":         true,
+	"sing":        true,
+	"sink":        true,
+	"sip":         true,
+	"sit":         true,
+	"ski":         true,
+	"skip":        true,
+	"slap":        true,
+	"sleep":       true,
+	"slide":       true,
+	"sling":       true,
+	"slip":        true,
+	"slow":        true,
+	"smash":       true,
+	"smell":       true,
+	"smile":       true,
+	"smoke":       true,
+	"smoothie":    true,
+	"snatch":      true,
+	"sneeze":      true,
+	"sniff":       true,
+	"snore":       true,
+	"snow":        true,
+	"soak":        true,
+	"soothe":      true,
+	"sound":       true,
+	"spare":       true,
+	"spark":       true,
+	"sparkle":     true,
+	"speak":       true,
+	"speed":       true,
+	"spell":       true,
+	"spend":       true,
+	"spill":       true,
+	"spin":        true,
+	"spit":        true,
+	"spoil":       true,
+	"spot":        true,
+	"spray":       true,
+	"spring":      true,
+	"sprout":      true,
+	"squash":      true,
+	"squeak":      true,
+	"squeal":      true,
+	"squeeze":     true,
+	"stain":       true,
+	"stamp":       true,
+	"stand":       true,
+	"stare":       true,
+	"start":       true,
+	"stay":        true,
+	"steal":       true,
+	"steer":       true,
+	"step":        true,
+	"stick":       true,
+	"stimulus":    true,
+	"sting":       true,
+	"stink":       true,
+	"stir":        true,
+	"stitch":      true,
+	"stop":        true,
+	"store":       true,
+	"strap":       true,
+	"stratum":     true,
+	"strengthen":  true,
+	"stretch":     true,
+	"stride":      true,
+	"strike":      true,
+	"string":      true,
+	"strip":       true,
+	"strive":      true,
+	"stroke":      true,
+	"stuff":       true,
+	"subtract":    true,
+	"succeed":     true,
+	"suck":        true,
+	"suffer":      true,
+	"suggest":     true,
+	"suit":        true,
+	"supply":      true,
+	"support":     true,
+	"suppose":     true,
+	"surprise":    true,
+	"surround":    true,
+	"suspect":     true,
+	"suspend":     true,
+	"swear":       true,
+	"sweep":       true,
+	"swell":       true,
+	"swim":        true,
+	"swing":       true,
+	"switch":      true,
+	"syllabus":    true,
+	"synopsis":    true,
+	"take":        true,
+	"talk":        true,
+	"tame":        true,
+	"tap":         true,
+	"taste":       true,
+	"teach":       true,
+	"tear":        true,
+	"tease":       true,
+	"telephone":   true,
+	"tell":        true,
+	"tempt":       true,
+	"terrify":     true,
+	"test":        true,
+	"thank":       true,
+	"thaw":        true,
+	"thesis":      true,
+	"thief":       true,
+	"think":       true,
+	"throw":       true,
+	"tick":        true,
+	"tickle":      true,
+	"tie":         true,
+	"time":        true,
+	"tip":         true,
+	"tire":        true,
+	"tomato":      true,
+	"tooth":       true,
+	"touch":       true,
+	"tour":        true,
+	"tow":         true,
+	"trace":       true,
+	"trade":       true,
+	"train":       true,
+	"transport":   true,
+	"trap":        true,
+	"travel":      true,
+	"tread":       true,
+	"treat":       true,
+	"tremble":     true,
+	"trick":       true,
+	"trip":        true,
+	"trot":        true,
+	"trouble":     true,
+	"trust":       true,
+	"try":         true,
+	"tug":         true,
+	"tumble":      true,
+	"turn":        true,
+	"twist":       true,
+	"type":        true,
+	"understand":  true,
+	"undertake":   true,
+	"undress":     true,
+	"unfasten":    true,
+	"unite":       true,
+	"unlock":      true,
+	"unpack":      true,
+	"untidy":      true,
+	"use":         true,
+	"vanish":      true,
+	"vertebra":    true,
+	"vertex":      true,
+	"veto":        true,
+	"visit":       true,
+	"wail":        true,
+	"wait":        true,
+	"wake":        true,
+	"walk":        true,
+	"wander":      true,
+	"want":        true,
+	"warm":        true,
+	"warn":        true,
+	"wash":        true,
+	"waste":       true,
+	"watch":       true,
+	"water":       true,
+	"wave":        true,
+	"wear":        true,
+	"weave":       true,
+	"weep":        true,
+	"weigh":       true,
+	"welcome":     true,
+	"whine":       true,
+	"whip":        true,
+	"whirl":       true,
+	"whisper":     true,
+	"whistle":     true,
+	"wife":        true,
+	"win":         true,
+	"wink":        true,
+	"wipe":        true,
+	"wish":        true,
+	"withdraw":    true,
+	"wobble":      true,
+	"wolf":        true,
+	"woman":       true,
+	"wonder":      true,
+	"work":        true,
+	"worry":       true,
+	"wrap":        true,
+	"wreck":       true,
+	"wrestle":     true,
+	"wriggle":     true,
+	"write":       true,
+	"yawn":        true,
+	"yell":        true,
+	"zip":         true,
+	"zombie":      true,
+	"zoom":        true,
+}
+
+var adjectives = map[string]bool{
+	"angry":  true,
+	"big":    true,
+	"brave":  true,
+	"bright": true,
+	"busy":   true,
+	"calm":   true,
+	"cheap":  true,
+	"clean":  true,
+	"clear":  true,
+	"close":  true,
+	"cold":   true,
+	"cool":   true,
+	"dark":   true,
+	"deep":   true,
+	"dirty":  true,
+	"early":  true,
+	"easy":   true,
+	"fair":   true,
+	"fast":   true,
+	"fat":    true,
+	"fine":   true,
+	"fit":    true,
+	"fresh":  true,
+	"full":   true,
+	"funny":  true,
+	"gentle": true,
+	"great":  true,
+	"happy":  true,
+	"hard":   true,
+	"heavy":  true,
+	"high":   true,
+	"hot":    true,
+	"hungry": true,
+	"kind":   true,
+	"large":  true,
+	"late":   true,
+	"lazy":   true,
+	"light":  true,
+	"long":   true,
+	"loud":   true,
+	"low":    true,
+	"lucky":  true,
+	"mad":    true,
+	"narrow": true,
+	"near":   true,
+	"new":    true,
+	"nice":   true,
+	"old":    true,
+	"plain":  true,
+	"poor":   true,
+	"pretty": true,
+	"proud":  true,
+	"pure":   true,
+	"quick":  true,
+	"quiet":  true,
+	"rare":   true,
+	"rich":   true,
+	"ripe":   true,
+	"rough":  true,
+	"sad":    true,
+	"safe":   true,
+	"sharp":  true,
+	"short":  true,
+	"simple": true,
+	"slow":   true,
+	"small":  true,
+	"smart":  true,
+	"soft":   true,
+	"strong": true,
+	"sure":   true,
+	"sweet":  true,
+	"tall":   true,
+	"thick":  true,
+	"thin":   true,
+	"tiny":   true,
+	"tough":  true,
+	"true":   true,
+	"ugly":   true,
+	"warm":   true,
+	"weak":   true,
+	"wet":    true,
+	"wide":   true,
+	"wise":   true,
+	"young":  true,
+}
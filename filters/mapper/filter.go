@@ -43,9 +43,29 @@ func (t *tokens) next() (*jargon.Token, error) {
 		return nil, nil
 	}
 
+	original := token
 	for _, f := range t.filter.funcs {
 		token = f(token)
 	}
 
+	if token == nil {
+		return nil, nil
+	}
+
+	// Preserve any gap left by removed words
+	if token.PositionIncrement() < original.PositionIncrement() {
+		token = token.WithPositionIncrement(original.PositionIncrement())
+	}
+
+	// A keyword remains a keyword
+	if original.IsKeyword() && !token.IsKeyword() {
+		token = token.WithKeyword(true)
+	}
+
+	// Verbatim text remains verbatim
+	if original.IsVerbatim() && !token.IsVerbatim() {
+		token = token.WithVerbatim(true)
+	}
+
 	return token, nil
 }
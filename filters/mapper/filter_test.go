@@ -29,3 +29,69 @@ func TestNewFilter(t *testing.T) {
 		t.Errorf("given %q, expected %q, got %s", text, expected, got)
 	}
 }
+
+func TestPositionIncrement(t *testing.T) {
+	upper := func(token *jargon.Token) *jargon.Token {
+		return jargon.NewToken(strings.ToUpper(token.String()), true)
+	}
+	filter := NewFilter(upper)
+
+	token := jargon.NewToken("foo", false).WithPositionIncrement(3)
+	incoming := jargon.NewTokenStream(func() (*jargon.Token, error) {
+		t := token
+		token = nil
+		return t, nil
+	})
+
+	got, err := filter(incoming).Next()
+	if err != nil {
+		t.Error(err)
+	}
+	if got.String() != "FOO" || got.PositionIncrement() != 3 {
+		t.Errorf("expected FOO with increment 3, got %q with increment %d", got, got.PositionIncrement())
+	}
+}
+
+func TestKeyword(t *testing.T) {
+	upper := func(token *jargon.Token) *jargon.Token {
+		return jargon.NewToken(strings.ToUpper(token.String()), true)
+	}
+	filter := NewFilter(upper)
+
+	token := jargon.NewToken("foo", false).WithKeyword(true)
+	incoming := jargon.NewTokenStream(func() (*jargon.Token, error) {
+		t := token
+		token = nil
+		return t, nil
+	})
+
+	got, err := filter(incoming).Next()
+	if err != nil {
+		t.Error(err)
+	}
+	if got.String() != "FOO" || !got.IsKeyword() {
+		t.Errorf("expected FOO as a keyword, got %q (keyword: %t)", got, got.IsKeyword())
+	}
+}
+
+func TestVerbatim(t *testing.T) {
+	upper := func(token *jargon.Token) *jargon.Token {
+		return jargon.NewToken(strings.ToUpper(token.String()), true)
+	}
+	filter := NewFilter(upper)
+
+	token := jargon.NewToken("foo", false).WithKeyword(true).WithVerbatim(true)
+	incoming := jargon.NewTokenStream(func() (*jargon.Token, error) {
+		t := token
+		token = nil
+		return t, nil
+	})
+
+	got, err := filter(incoming).Next()
+	if err != nil {
+		t.Error(err)
+	}
+	if got.String() != "FOO" || !got.IsVerbatim() {
+		t.Errorf("expected FOO as verbatim, got %q (verbatim: %t)", got, got.IsVerbatim())
+	}
+}
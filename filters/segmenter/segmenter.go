@@ -0,0 +1,277 @@
+// Package segmenter provides dictionary-based word segmentation for Chinese, Japanese and Thai, which are written
+// without spaces between words. The tokenizer emits each Han ideograph, and each Thai character, as its own token;
+// the segmenter joins runs of such tokens into words, so that filters such as synonyms, and frequency counts, see real words.
+//
+// The dictionaries are ICU's word break dictionaries: cjdict for Chinese and Japanese, whose words have costs (lower is
+// more frequent), and thaidict for Thai, whose words do not; see dict. Segmentation chooses the sequence of dictionary words
+// with the lowest total cost, as ICU's CJK break engine does. A character which is not in the dictionary costs more than
+// any word, and is left as a single token.
+package segmenter
+
+import (
+	"bufio"
+	_ "embed"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/language"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+//go:embed dict/cjdict.txt
+var cjdict string
+
+//go:embed dict/thaidict.txt
+var thaidict string
+
+// cj is shared by Chinese and Japanese
+var cj = &dictionary{data: cjdict}
+
+// Chinese segments runs of Han characters into words, e.g. 我喜欢学习中文 → 我 喜欢 学习 中文
+var Chinese = newFilter(cj, ideographic, jargon.Ideographic)
+
+// Japanese segments runs of Han, hiragana and katakana into words, e.g. 私は東京大学で → 私 は 東京 大学 で
+var Japanese = newFilter(cj, ideographic, jargon.Ideographic)
+
+// Thai segments runs of Thai characters into words, e.g. ภาษาไทย → ภาษา ไทย
+var Thai = newFilter(&dictionary{data: thaidict}, thai, jargon.Word)
+
+// maxRun is the most tokens to buffer before segmenting, to bound memory on long runs without spaces or punctuation
+const maxRun = 256
+
+const (
+	// wordCost is the cost of a word for which the dictionary has none, i.e. in thaidict
+	wordCost = 1
+	// unknownCost is the cost of a character which is not in the dictionary, as in ICU's CJK break engine
+	unknownCost = 255
+)
+
+// dictionary is a lazily-loaded lookup of words' costs
+type dictionary struct {
+	// data is the dictionary file: a word per line, optionally followed by a tab and its cost
+	data string
+	once sync.Once
+
+	costs map[string]int
+	// maxLen is the length, in runes, of the longest word
+	maxLen int
+}
+
+func (d *dictionary) load() {
+	d.costs = make(map[string]int)
+
+	scanner := bufio.NewScanner(strings.NewReader(d.data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		word, cost := line, wordCost
+		if i := strings.IndexByte(line, '\t'); i >= 0 {
+			word = line[:i]
+			c, err := strconv.Atoi(line[i+1:])
+			if err != nil {
+				// The dictionary is embedded, and tested
+				panic(err)
+			}
+			cost = c
+		}
+
+		d.costs[word] = cost
+		if n := utf8.RuneCountInString(word); n > d.maxLen {
+			d.maxLen = n
+		}
+	}
+	// Release the file
+	d.data = ""
+}
+
+func newFilter(d *dictionary, accepts func(*jargon.Token) bool, kind jargon.Kind) jargon.Filter {
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		d.once.Do(d.load)
+
+		t := &tokens{
+			incoming: incoming,
+			run:      tokenqueue.New(),
+			outgoing: tokenqueue.New(),
+			dict:     d,
+			accepts:  accepts,
+			kind:     kind,
+		}
+		return jargon.NewTokenStream(t.next)
+	}
+}
+
+type tokens struct {
+	incoming *jargon.TokenStream
+	// run is the current run of tokens to be segmented
+	run      *tokenqueue.TokenQueue
+	outgoing *tokenqueue.TokenQueue
+	dict     *dictionary
+	accepts  func(*jargon.Token) bool
+	kind     jargon.Kind
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	for {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			// EOF
+			t.flush()
+			break
+		}
+
+		if t.accepts(token) && !token.IsKeyword() {
+			t.run.Push(token)
+			if t.run.Len() >= maxRun {
+				t.flush()
+				break
+			}
+			continue
+		}
+
+		// The run (if any) is over
+		t.flush()
+		t.outgoing.Push(token)
+		break
+	}
+
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	return nil, nil
+}
+
+// flush segments the run, and queues the resulting words to go out
+func (t *tokens) flush() {
+	if !t.run.Any() {
+		return
+	}
+	t.outgoing.Push(t.segment(t.run.Tokens)...)
+	t.run.Clear()
+}
+
+// segment finds the sequence of words in a run of tokens with the lowest total cost. Word boundaries fall between tokens, so
+// characters (e.g. Thai with combining vowels) are never split.
+func (t *tokens) segment(run []*jargon.Token) []*jargon.Token {
+	n := len(run)
+
+	// best[i] is the cost of the best segmentation of run[:i], whose last word begins at start[i]
+	best := make([]int, n+1)
+	start := make([]int, n+1)
+
+	for i := 1; i <= n; i++ {
+		best[i] = math.MaxInt
+
+		var word string
+		for j := i - 1; j >= 0; j-- {
+			word = run[j].String() + word
+			if utf8.RuneCountInString(word) > t.dict.maxLen && j < i-1 {
+				break
+			}
+
+			cost, found := t.dict.costs[word]
+			if !found {
+				if j < i-1 {
+					continue
+				}
+				// A single token not in the dictionary
+				cost = unknownCost
+			}
+
+			if total := best[j] + cost; total < best[i] {
+				best[i] = total
+				start[i] = j
+			}
+		}
+	}
+
+	// Walk back through the starts to find the words
+	var bounds []int
+	for i := n; i > 0; i = start[i] {
+		bounds = append(bounds, i)
+	}
+	sort.Ints(bounds)
+
+	result := make([]*jargon.Token, 0, len(bounds))
+	j := 0
+	for _, i := range bounds {
+		if i-j == 1 {
+			// A single token, pass it along verbatim
+			result = append(result, run[j])
+			j = i
+			continue
+		}
+
+		var b strings.Builder
+		for _, token := range run[j:i] {
+			b.WriteString(token.String())
+		}
+
+		// The text is unchanged, so it's not a lemma
+		word := jargon.NewTokenOfKind(b.String(), false, t.kind)
+		if increment := run[j].PositionIncrement(); increment != 1 {
+			word = word.WithPositionIncrement(increment)
+		}
+		result = append(result, word)
+		j = i
+	}
+
+	return result
+}
+
+// ideographic accepts Han, hiragana and katakana tokens
+func ideographic(token *jargon.Token) bool {
+	return token.Kind() == jargon.Ideographic
+}
+
+// thai accepts tokens of Thai characters
+func thai(token *jargon.Token) bool {
+	if token.Kind() != jargon.Word {
+		return false
+	}
+	for _, r := range token.String() {
+		if !unicode.Is(unicode.Thai, r) {
+			return false
+		}
+	}
+	return true
+}
+
+var filters = map[string]jargon.Filter{
+	"chinese":  Chinese,
+	"japanese": Japanese,
+	"thai":     Thai,
+}
+
+// Language returns the segmenter for a language, by name (such as "chinese") or ISO 639-1 code (such as "zh").
+// found will be false if the language is not available.
+func Language(lang string) (filter jargon.Filter, found bool) {
+	filter, found = filters[language.Name(lang)]
+	return filter, found
+}
+
+// Languages returns the names of the available languages, sorted
+func Languages() []string {
+	result := make([]string, 0, len(filters))
+	for lang := range filters {
+		result = append(result, lang)
+	}
+	sort.Strings(result)
+	return result
+}
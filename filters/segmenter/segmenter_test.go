@@ -0,0 +1,86 @@
+package segmenter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/segmenter"
+)
+
+func TestSegmenters(t *testing.T) {
+	type test struct {
+		filter   jargon.Filter
+		given    string
+		expected []string
+	}
+
+	tests := []test{
+		{segmenter.Chinese, "我喜欢学习中文和计算机科学", []string{"我", "喜欢", "学习", "中文", "和", "计算", "机", "科学"}},
+		{segmenter.Chinese, "北京大学的学生们在图书馆看书。", []string{"北京", "大学", "的", "学生", "们", "在", "图书", "馆", "看书", "。"}},
+		{segmenter.Chinese, "我們今天去台灣旅行", []string{"我們", "今天", "去", "台灣", "旅行"}},
+		{segmenter.Chinese, "学习 and 中文", []string{"学习", " ", "and", " ", "中文"}},
+		{segmenter.Japanese, "私は東京大学で勉強しています", []string{"私", "は", "東京", "大学", "で", "勉強", "し", "てい", "ます"}},
+		{segmenter.Japanese, "日本語のテキストを読みます", []string{"日本語", "の", "テキスト", "を", "読み", "ます"}},
+		{segmenter.Thai, "ภาษาไทยเป็นภาษาที่สวยงาม", []string{"ภาษา", "ไทย", "เป็น", "ภาษา", "ที่", "สวยงาม"}},
+		{segmenter.Thai, "ฉันชอบกินข้าวผัด", []string{"ฉัน", "ชอบ", "กิน", "ข้าว", "ผัด"}},
+	}
+
+	for _, test := range tests {
+		tokens, err := jargon.TokenizeString(test.given).Filter(test.filter).ToSlice()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]string, len(tokens))
+		for i, token := range tokens {
+			got[i] = token.String()
+		}
+
+		if strings.Join(got, "|") != strings.Join(test.expected, "|") {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	given := "Jargon 支持中文，日本語のテキスト、และภาษาไทย 😀"
+	for _, lang := range segmenter.Languages() {
+		filter, found := segmenter.Language(lang)
+		if !found {
+			t.Fatalf("expected to find %q", lang)
+		}
+
+		got, err := jargon.TokenizeString(given).Filter(filter).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != given {
+			t.Errorf("expected %s to round-trip %q, got %q", lang, given, got)
+		}
+	}
+}
+
+func TestKinds(t *testing.T) {
+	tokens, err := jargon.TokenizeString("计算机").Filter(segmenter.Chinese).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 {
+		t.Fatalf("expected two tokens, got %q", tokens)
+	}
+	if token := tokens[0]; token.Kind() != jargon.Ideographic || token.IsLemma() {
+		t.Errorf("expected an Ideographic non-lemma token, got %s %t", token.Kind(), token.IsLemma())
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	for _, lang := range []string{"chinese", "zh", "Japanese", "ja", "th"} {
+		if _, found := segmenter.Language(lang); !found {
+			t.Errorf("expected to find %q", lang)
+		}
+	}
+	if _, found := segmenter.Language("klingon"); found {
+		t.Errorf("expected not to find klingon")
+	}
+}
@@ -1,7 +1,7 @@
 package sigil
 
 import (
-	"fmt"
+	"strings"
 
 	"github.com/clipperhouse/jargon"
 	"github.com/clipperhouse/jargon/tokenqueue"
@@ -9,23 +9,39 @@ import (
 
 // NewFilter creates a new filter for leading characters. sigil is the leading character; legal defines legality for the following token.
 func NewFilter(sigil string, legal func(s string) bool) jargon.Filter {
+	return NewFilterOfKind(sigil, jargon.Word, legal)
+}
+
+// NewFilterOfKind creates a new filter for leading characters, as NewFilter, where resulting tokens will be of the specified kind, such as jargon.Hashtag.
+func NewFilterOfKind(sigil string, kind jargon.Kind, legal func(s string) bool) jargon.Filter {
+	return NewLookaheadFilter(sigil, kind, 1, legal)
+}
+
+// NewLookaheadFilter creates a new filter for leading characters, as NewFilterOfKind, where the sigil may be followed by up to lookahead tokens,
+// for example "@" + "user" + "@" + "example.com". legal is called with the following tokens joined into a single string; the longest legal string wins.
+// White space ends the lookahead.
+func NewLookaheadFilter(sigil string, kind jargon.Kind, lookahead int, legal func(s string) bool) jargon.Filter {
 	f := &filter{
-		sigil: sigil,
-		legal: legal,
+		sigil:     sigil,
+		kind:      kind,
+		lookahead: lookahead,
+		legal:     legal,
 	}
 	return f.filter
 }
 
 type filter struct {
-	sigil string
-	legal func(s string) bool
+	sigil     string
+	kind      jargon.Kind
+	lookahead int
+	legal     func(s string) bool
 }
 
 func (f *filter) filter(incoming *jargon.TokenStream) *jargon.TokenStream {
 	t := &stream{
 		filter:   f,
 		incoming: incoming,
-		outgoing: tokenqueue.New(),
+		buffer:   tokenqueue.New(),
 	}
 	return jargon.NewTokenStream(t.next)
 }
@@ -35,15 +51,12 @@ type stream struct {
 
 	incoming *jargon.TokenStream
 	previous *jargon.Token
-	outgoing *tokenqueue.TokenQueue
+	// a 'lookahead' buffer for incoming tokens
+	buffer *tokenqueue.TokenQueue
 }
 
 func (s *stream) next() (*jargon.Token, error) {
-	if s.outgoing.Any() {
-		return s.outgoing.Pop(), nil
-	}
-
-	current, err := s.incoming.Next()
+	current, err := s.read()
 	if err != nil {
 		return nil, err
 	}
@@ -59,15 +72,11 @@ func (s *stream) next() (*jargon.Token, error) {
 		return current, nil
 	}
 
-	success, handle, err := s.try(s.filter.sigil, current, s.filter.legal)
+	success, handle, err := s.try(current)
 	if err != nil {
 		return nil, err
 	}
 	if success {
-		// There should be nothing in outgoing
-		if s.outgoing.Any() {
-			return nil, fmt.Errorf("there should be nothing in outgoing, got %s", s.outgoing)
-		}
 		s.previous = handle
 		return handle, nil
 	}
@@ -76,29 +85,56 @@ func (s *stream) next() (*jargon.Token, error) {
 	return current, nil
 }
 
-func (s *stream) try(sigil string, current *jargon.Token, legal func(string) bool) (bool, *jargon.Token, error) {
-	if current.String() != sigil {
-		return false, nil, nil
+// read returns the next token, from the lookahead buffer if any, otherwise from incoming
+func (s *stream) read() (*jargon.Token, error) {
+	if s.buffer.Any() {
+		return s.buffer.Pop(), nil
 	}
+	return s.incoming.Next()
+}
 
-	lookahead, err := s.incoming.Next()
-	if err != nil {
-		return false, nil, err
-	}
-	if lookahead == nil {
-		// EOF
+func (s *stream) try(current *jargon.Token) (bool, *jargon.Token, error) {
+	if current.String() != s.filter.sigil {
 		return false, nil, nil
 	}
 
-	if legal(lookahead.String()) {
-		// Drop current & lookahead, replace with new token
-		s := sigil + lookahead.String()
-		token := jargon.NewToken(s, true)
-		return true, token, nil
+	// Fill the buffer
+	for s.buffer.Len() < s.filter.lookahead {
+		token, err := s.incoming.Next()
+		if err != nil {
+			return false, nil, err
+		}
+		if token == nil {
+			// EOF
+			break
+		}
+		s.buffer.Push(token)
+	}
+
+	// Lookahead ends at white space
+	n := 0
+	for _, token := range s.buffer.Tokens {
+		if n == s.filter.lookahead || token.IsSpace() {
+			break
+		}
+		n++
 	}
 
-	// Queue the lookahead for later
-	s.outgoing.Push(lookahead)
+	// Prefer the longest legal match
+	for ; n > 0; n-- {
+		var b strings.Builder
+		for _, token := range s.buffer.Tokens[:n] {
+			b.WriteString(token.String())
+		}
+
+		if s.filter.legal(b.String()) {
+			// Drop current & lookahead, replace with new token
+			s.buffer.Drop(n)
+			token := jargon.NewTokenOfKind(s.filter.sigil+b.String(), true, s.filter.kind)
+			return true, token, nil
+		}
+	}
 
+	// Lookahead remains in the buffer for later
 	return false, nil, nil
 }
@@ -2,7 +2,56 @@ package sigil
 
 import (
 	"testing"
+
+	"github.com/clipperhouse/jargon"
 )
 
 func TestFilter(t *testing.T) {
+	legal := func(s string) bool {
+		return s == "foo"
+	}
+	filter := NewFilter("%", legal)
+
+	given := "% foo %foo %bar x%foo %"
+	expected := []string{"%", " ", "foo", " ", "%foo", " ", "%", "bar", " ", "x", "%", "foo", " ", "%"}
+
+	got, err := jargon.TokenizeString(given).Filter(filter).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("given %q, expected %q, got %q", given, expected, got)
+	}
+	for i := range got {
+		if got[i].String() != expected[i] {
+			t.Errorf("given %q, expected %q, got %q", given, expected, got)
+			break
+		}
+	}
+}
+
+func TestLookahead(t *testing.T) {
+	legal := func(s string) bool {
+		return s == "a" || s == "a-b"
+	}
+	filter := NewLookaheadFilter("%", jargon.Word, 3, legal)
+
+	given := "%a-b %a-c %a"
+	expected := []string{"%a-b", " ", "%a", "-", "c", " ", "%a"}
+
+	got, err := jargon.TokenizeString(given).Filter(filter).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("given %q, expected %q, got %q", given, expected, got)
+	}
+	for i := range got {
+		if got[i].String() != expected[i] {
+			t.Errorf("given %q, expected %q, got %q", given, expected, got)
+			break
+		}
+	}
 }
@@ -0,0 +1,109 @@
+// Package social provides filters to identify cashtags and federated (Mastodon-style) handles, and coalesce them into single tokens
+package social
+
+import (
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/sigil"
+)
+
+// Cashtags will identify stock symbols such as $AAPL or $BRK.B, combining the $ and symbol into a single token of kind jargon.Cashtag
+var Cashtags = sigil.NewFilterOfKind("$", jargon.Cashtag, legalCashtag)
+
+// Handles will identify Mastodon-style handles, such as @user or @user@mastodon.social, combining them into a single token of kind jargon.Mention
+var Handles = sigil.NewLookaheadFilter("@", jargon.Mention, 9, legalHandle)
+
+// Cashtags are 1-6 letters, with an optional share class suffix, such as BRK.B
+func legalCashtag(s string) bool {
+	symbol, class, dotted := strings.Cut(s, ".")
+	if !dotted {
+		symbol, class, dotted = strings.Cut(s, "_")
+	}
+
+	if len(symbol) < 1 || len(symbol) > 6 || !letters(symbol) {
+		return false
+	}
+
+	if dotted {
+		return len(class) >= 1 && len(class) <= 2 && letters(class)
+	}
+
+	return true
+}
+
+func letters(s string) bool {
+	for _, r := range s {
+		if !('A' <= r && r <= 'Z') && !('a' <= r && r <= 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+// https://docs.joinmastodon.org/spec/webfinger/, a username, and optionally @ and a domain
+func legalHandle(s string) bool {
+	user, domain, federated := strings.Cut(s, "@")
+
+	if !legalUser(user) {
+		return false
+	}
+
+	if federated {
+		return legalDomain(domain)
+	}
+
+	return true
+}
+
+func legalUser(s string) bool {
+	if len(s) < 1 || len(s) > 30 {
+		return false
+	}
+
+	for _, r := range s {
+		switch {
+		case
+			'A' <= r && r <= 'Z',
+			'a' <= r && r <= 'z',
+			'0' <= r && r <= '9',
+			r == '_':
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func legalDomain(s string) bool {
+	labels := strings.Split(s, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if len(label) < 1 || len(label) > 63 {
+			return false
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+
+		for _, r := range label {
+			switch {
+			case
+				'A' <= r && r <= 'Z',
+				'a' <= r && r <= 'z',
+				'0' <= r && r <= '9',
+				r == '-':
+				continue
+			}
+
+			return false
+		}
+	}
+
+	return true
+}
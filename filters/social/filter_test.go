@@ -0,0 +1,85 @@
+package social_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/social"
+)
+
+func TestCashtags(t *testing.T) {
+	text := "Buying $AAPL and $BRK.B, not $200 or $TOOLONGX."
+	expected := map[string]bool{
+		"$AAPL":  true,
+		"$BRK.B": true,
+	}
+
+	tokens, err := jargon.TokenizeString(text).Filter(social.Cashtags).Lemmas().ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(tokens) != len(expected) {
+		t.Errorf("expected %d cashtags, got %q", len(expected), tokens)
+	}
+
+	for _, token := range tokens {
+		if !expected[token.String()] {
+			t.Errorf("did not expect %q", token)
+		}
+		if token.Kind() != jargon.Cashtag {
+			t.Errorf("expected %q to be of kind %s, got %s", token, jargon.Cashtag, token.Kind())
+		}
+	}
+}
+
+func TestHandles(t *testing.T) {
+	type test struct {
+		input    string
+		expected []string
+	}
+
+	tests := []test{
+		{"Follow @user@mastodon.social today", []string{"@user@mastodon.social"}},
+		{"Follow @user@my-instance.example.org.", []string{"@user@my-instance.example.org"}},
+		{"Follow @user on here", []string{"@user"}},
+		{"Follow @user@localhost", []string{"@user"}},
+		{"Email me@example.com", nil},
+	}
+
+	for _, test := range tests {
+		tokens, err := jargon.TokenizeString(test.input).Filter(social.Handles).ToSlice()
+		if err != nil {
+			t.Error(err)
+		}
+
+		var got []string
+		for _, token := range tokens {
+			if token.IsLemma() {
+				got = append(got, token.String())
+				if token.Kind() != jargon.Mention {
+					t.Errorf("expected %q to be of kind %s, got %s", token, jargon.Mention, token.Kind())
+				}
+			}
+		}
+
+		if len(got) != len(test.expected) {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.expected[i] {
+				t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+			}
+		}
+
+		// Round trip
+		s, err := jargon.TokenizeString(test.input).Filter(social.Handles).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if s != test.input {
+			t.Errorf("expected %q to round trip, got %q", test.input, s)
+		}
+	}
+}
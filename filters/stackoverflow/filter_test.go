@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/keywords"
 )
 
 func TestFilter(t *testing.T) {
@@ -34,6 +35,21 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+func TestKeywords(t *testing.T) {
+	// Keywords from a preceding filter are matched as part of multi-word tags
+	given := "Ruby on Rails and Ruby"
+	expected := "ruby-on-rails and ruby"
+
+	tokens := jargon.TokenizeString(given).Filter(keywords.NewFilter([]string{"Ruby", "node"}, true))
+	got, err := Tags(tokens).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
 func BenchmarkTags(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
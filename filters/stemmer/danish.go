@@ -0,0 +1,75 @@
+package stemmer
+
+import "strings"
+
+var danishSuffixes = []string{
+	"hed", "ethed", "ered", "e", "erede", "ende", "erende", "ene", "erne", "ere", "en", "heden", "eren", "er", "heder", "erer",
+	"heds", "es", "endes", "erendes", "enes", "ernes", "eres", "ens", "hedens", "erens", "ers", "ets", "erets", "et", "eret",
+	"s",
+}
+
+// danish implements https://snowballstem.org/algorithms/danish/stemmer.html
+func danish(word string) string {
+	word = strings.ToLower(word)
+	rs := []rune(word)
+
+	p1 := min3(region(rs, 0, isDanishVowel), rs)
+
+	// Step 1, searching within R1
+	switch s := longest(rs, p1, danishSuffixes...); s {
+	case "":
+	case "s":
+		if len(rs) > 1 && isDanishSEnding(rs[len(rs)-2]) {
+			rs = trim(rs, s)
+		}
+	default:
+		rs = trim(rs, s)
+	}
+
+	// Step 2
+	rs = danishConsonantPair(rs, p1)
+
+	// Step 3
+	if hasSuffix(rs, "igst") {
+		rs = trim(rs, "st")
+	}
+	switch s := longest(rs, p1, "ig", "lig", "elig", "els", "løst"); s {
+	case "":
+	case "løst":
+		rs = trim(rs, "t")
+	default:
+		rs = trim(rs, s)
+		rs = danishConsonantPair(rs, p1)
+	}
+
+	// Step 4, undouble a final consonant in R1
+	if n := len(rs); n >= 2 && n-1 >= p1 && !isDanishVowel(rs[n-1]) && rs[n-1] == rs[n-2] {
+		rs = rs[:n-1]
+	}
+
+	return string(rs)
+}
+
+// danishConsonantPair deletes the last letter of gd, dt, gt or kt in R1
+func danishConsonantPair(rs []rune, p1 int) []rune {
+	if s := longest(rs, p1, "gd", "dt", "gt", "kt"); s != "" {
+		return rs[:len(rs)-1]
+	}
+	return rs
+}
+
+func isDanishVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'æ', 'å', 'ø':
+		return true
+	}
+	return false
+}
+
+func isDanishSEnding(r rune) bool {
+	switch r {
+	case 'a', 'b', 'c', 'd', 'f', 'g', 'h', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'r', 't', 'v', 'y', 'z', 'å':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// dutch implements https://snowballstem.org/algorithms/dutch/stemmer.html
+func dutch(word string) string {
+	word = strings.ToLower(word)
+	rs := []rune(word)
+
+	for i, r := range rs {
+		switch r {
+		case 'ä', 'á':
+			rs[i] = 'a'
+		case 'ë', 'é':
+			rs[i] = 'e'
+		case 'ï', 'í':
+			rs[i] = 'i'
+		case 'ö', 'ó':
+			rs[i] = 'o'
+		case 'ü', 'ú':
+			rs[i] = 'u'
+		}
+	}
+
+	// Initial y, y after a vowel, and i between vowels, are treated as consonants
+	if len(rs) > 0 && rs[0] == 'y' {
+		rs[0] = 'Y'
+	}
+	for i := 1; i < len(rs); i++ {
+		if !isDutchVowel(rs[i-1]) {
+			continue
+		}
+		switch {
+		case rs[i] == 'y':
+			rs[i] = 'Y'
+		case rs[i] == 'i' && i < len(rs)-1 && isDutchVowel(rs[i+1]):
+			rs[i] = 'I'
+		}
+	}
+
+	p1 := region(rs, 0, isDutchVowel)
+	p2 := region(rs, p1, isDutchVowel)
+	p1 = dutchMin3(p1, rs)
+
+	// Step 1
+	switch s := longest(rs, 0, "heden", "en", "ene", "s", "se"); s {
+	case "heden":
+		if in(rs, s, p1) {
+			rs = replace(rs, s, "heid")
+		}
+	case "en", "ene":
+		rs = dutchENEnding(rs, s, p1)
+	case "s", "se":
+		if in(rs, s, p1) && len(rs) > len(s) {
+			if r := rs[len(rs)-len(s)-1]; !isDutchVowel(r) && r != 'j' {
+				rs = trim(rs, s)
+			}
+		}
+	}
+
+	// Step 2
+	var eFound bool
+	rs, eFound = dutchEEnding(rs, p1)
+
+	// Step 3a
+	if hasSuffix(rs, "heid") && in(rs, "heid", p2) && !precededBy(rs, "heid", "c") {
+		rs = trim(rs, "heid")
+		if hasSuffix(rs, "en") {
+			rs = dutchENEnding(rs, "en", p1)
+		}
+	}
+
+	// Step 3b, d-suffixes
+	switch s := longest(rs, 0, "end", "ing", "ig", "lijk", "baar", "bar"); s {
+	case "end", "ing":
+		if in(rs, s, p2) {
+			rs = trim(rs, s)
+			if hasSuffix(rs, "ig") && in(rs, "ig", p2) && !precededBy(rs, "ig", "e") {
+				rs = trim(rs, "ig")
+			} else {
+				rs = dutchUndouble(rs)
+			}
+		}
+	case "ig":
+		if in(rs, s, p2) && !precededBy(rs, s, "e") {
+			rs = trim(rs, s)
+		}
+	case "lijk":
+		if in(rs, s, p2) {
+			rs = trim(rs, s)
+			rs, _ = dutchEEnding(rs, p1)
+		}
+	case "baar":
+		if in(rs, s, p2) {
+			rs = trim(rs, s)
+		}
+	case "bar":
+		if in(rs, s, p2) && eFound {
+			rs = trim(rs, s)
+		}
+	}
+
+	// Step 4, undouble vowel: CVD, where V is aa, ee, oo or uu
+	if n := len(rs); n >= 4 {
+		d, v1, v2, c := rs[n-1], rs[n-2], rs[n-3], rs[n-4]
+		if !isDutchVowel(d) && d != 'I' && v1 == v2 && strings.ContainsRune("aeou", v1) && !isDutchVowel(c) {
+			rs = append(rs[:n-2], d)
+		}
+	}
+
+	for i, r := range rs {
+		switch r {
+		case 'Y':
+			rs[i] = 'y'
+		case 'I':
+			rs[i] = 'i'
+		}
+	}
+
+	return string(rs)
+}
+
+// dutchENEnding deletes en or ene if in R1 and preceded by a non-vowel other than gem, and then undoubles
+func dutchENEnding(rs []rune, s string, p1 int) []rune {
+	if !in(rs, s, p1) {
+		return rs
+	}
+	stem := trim(rs, s)
+	if len(stem) == 0 || isDutchVowel(last(stem)) || hasSuffix(stem, "gem") {
+		return rs
+	}
+	return dutchUndouble(stem)
+}
+
+// dutchEEnding deletes a final e if in R1 and preceded by a non-vowel, and then undoubles; it reports whether the e was removed
+func dutchEEnding(rs []rune, p1 int) ([]rune, bool) {
+	if !hasSuffix(rs, "e") || !in(rs, "e", p1) {
+		return rs, false
+	}
+	stem := trim(rs, "e")
+	if len(stem) == 0 || isDutchVowel(last(stem)) {
+		return rs, false
+	}
+	return dutchUndouble(stem), true
+}
+
+// dutchUndouble removes the last letter of a final kk, dd or tt
+func dutchUndouble(rs []rune) []rune {
+	if hasSuffix(rs, "kk") || hasSuffix(rs, "dd") || hasSuffix(rs, "tt") {
+		return rs[:len(rs)-1]
+	}
+	return rs
+}
+
+// dutchMin3 adjusts R1 such that the region before it contains at least 3 bytes. Snowball's Dutch measures bytes
+// rather than letters, which makes a difference for words beginning with è.
+func dutchMin3(p int, rs []rune) int {
+	n := 0
+	for i, r := range rs {
+		if i >= p && n >= 3 {
+			return i
+		}
+		n += utf8.RuneLen(r)
+	}
+	return len(rs)
+}
+
+func isDutchVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'è':
+		return true
+	}
+	return false
+}
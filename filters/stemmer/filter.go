@@ -1,4 +1,6 @@
-// Package stemmer offers the Snowball stemmer in several languages
+// Package stemmer offers the Snowball stemmer in several languages. English, French, Norwegian, Russian, Spanish and Swedish
+// are provided by github.com/kljensen/snowball; Danish, Dutch, Finnish, German, Italian and Portuguese are implemented in this package,
+// and tested against the Snowball reference implementation.
 package stemmer
 
 import (
@@ -12,33 +14,65 @@ import (
 	"github.com/kljensen/snowball/swedish"
 )
 
+//go:generate go run -C generate .
+
 // English is a Snowball stemmer for English, implemented as a jargon.Filter
-var English jargon.Filter = newStemmer(english.Stem)
+var English jargon.Filter = newStemmer(all(english.Stem))
 
 // French is a Snowball stemmer for French, implemented as a jargon.Filter
-var French = newStemmer(french.Stem)
+var French = newStemmer(all(french.Stem))
 
 // Norwegian is a Snowball stemmer for Norwegian, implemented as a jargon.Filter
-var Norwegian = newStemmer(norwegian.Stem)
+var Norwegian = newStemmer(all(norwegian.Stem))
 
 // Russian is a Snowball stemmer for Russian, implemented as a jargon.Filter
-var Russian = newStemmer(russian.Stem)
+var Russian = newStemmer(all(russian.Stem))
 
 // Spanish is a Snowball stemmer for Spanish, implemented as a jargon.Filter
-var Spanish = newStemmer(spanish.Stem)
+var Spanish = newStemmer(all(spanish.Stem))
 
 // Swedish is a Snowball stemmer for Swedish, implemented as a jargon.Filter
-var Swedish = newStemmer(swedish.Stem)
+var Swedish = newStemmer(all(swedish.Stem))
+
+// Danish is a Snowball stemmer for Danish, implemented as a jargon.Filter
+var Danish = newStemmer(danish)
+
+// Dutch is a Snowball stemmer for Dutch, implemented as a jargon.Filter
+var Dutch = newStemmer(dutch)
+
+// Finnish is a Snowball stemmer for Finnish, implemented as a jargon.Filter
+var Finnish = newStemmer(finnish)
+
+// German is a Snowball stemmer for German, implemented as a jargon.Filter
+var German = newStemmer(german)
+
+// Italian is a Snowball stemmer for Italian, implemented as a jargon.Filter
+var Italian = newStemmer(italian)
+
+// Portuguese is a Snowball stemmer for Portuguese, implemented as a jargon.Filter
+var Portuguese = newStemmer(portuguese)
 
-// newStemmer creates a new stemmer
-func newStemmer(stem func(string, bool) string) jargon.Filter {
+// all adapts a kljensen/snowball stemmer, which may optionally skip stop words; we stem all words
+func all(stem func(string, bool) string) func(string) string {
+	return func(s string) string {
+		return stem(s, true)
+	}
+}
+
+// newStemmer creates a new stemmer. Tokens marked as keywords (see jargon.Token.IsKeyword) are not stemmed.
+func newStemmer(stem func(string) string) jargon.Filter {
 	f := func(token *jargon.Token) *jargon.Token {
 		// Only interested in stemming words
 		if token.IsPunct() || token.IsSpace() {
 			return token
 		}
 
-		stemmed := stem(token.String(), true)
+		// Protected, such as a canonical term from a previous filter
+		if token.IsKeyword() {
+			return token
+		}
+
+		stemmed := stem(token.String())
 
 		if stemmed == token.String() {
 			// Had no effect, send back the original
@@ -1,6 +1,10 @@
 package stemmer
 
 import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/clipperhouse/jargon"
@@ -34,3 +38,58 @@ func TestEnglish(t *testing.T) {
 		}
 	}
 }
+
+func TestVocabularies(t *testing.T) {
+	// Vocabularies are in the format of Snowball's diffs.txt: a word and its expected stem, per line.
+	// The stems are from the Snowball reference implementation, see generate/main.go.
+	stemmers := map[string]func(string) string{
+		"danish":     danish,
+		"dutch":      dutch,
+		"finnish":    finnish,
+		"german":     german,
+		"italian":    italian,
+		"portuguese": portuguese,
+	}
+
+	for lang, stem := range stemmers {
+		f, err := os.Open(filepath.Join("testdata", lang+".txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			fields := strings.Fields(line)
+			if len(fields) != 2 {
+				t.Fatalf("%s: expected a word and a stem, got %q", lang, line)
+			}
+
+			word, expected := fields[0], fields[1]
+			if got := stem(word); got != expected {
+				t.Errorf("%s: expected stem of %q to be %q, got %q", lang, word, expected, got)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+	}
+}
+
+func TestGerman(t *testing.T) {
+	tokens := jargon.TokenizeString("Die Häuser sind aufeinanderfolgend")
+	got, err := German(tokens).String()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := "die haus sind aufeinanderfolg"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,178 @@
+package stemmer
+
+import "strings"
+
+var finnishCaseSuffixes = []string{
+	"han", "hen", "hin", "hon", "hän", "hön", "siin", "seen", "den", "tten",
+	"a", "ä", "tta", "ttä", "ta", "tä", "ssa", "ssä", "sta", "stä", "lla", "llä", "lta", "ltä", "lle", "na", "nä", "ksi", "ine",
+	"n",
+}
+
+// finnish implements https://snowballstem.org/algorithms/finnish/stemmer.html
+func finnish(word string) string {
+	word = strings.ToLower(word)
+	rs := []rune(word)
+
+	p1 := region(rs, 0, isFinnishVowel)
+	p2 := region(rs, p1, isFinnishVowel)
+
+	// Step 1, particles
+	switch s := longest(rs, p1, "kin", "kaan", "kään", "ko", "kö", "han", "hän", "pa", "pä", "sti"); s {
+	case "":
+	case "sti":
+		if in(rs, s, p2) {
+			rs = trim(rs, s)
+		}
+	default:
+		if r := last(trim(rs, s)); r == 'n' || r == 't' || isFinnishVowel(r) {
+			rs = trim(rs, s)
+		}
+	}
+
+	// Step 2, possessives
+	switch s := longest(rs, p1, "si", "ni", "nsa", "nsä", "mme", "nne", "an", "än", "en"); s {
+	case "si":
+		if !precededBy(rs, s, "k") {
+			rs = trim(rs, s)
+		}
+	case "ni":
+		rs = trim(rs, s)
+		if hasSuffix(rs, "kse") {
+			rs = replace(rs, "kse", "ksi")
+		}
+	case "nsa", "nsä", "mme", "nne":
+		rs = trim(rs, s)
+	case "an":
+		if longest(trim(rs, s), 0, "ta", "ssa", "sta", "lla", "lta", "na") != "" {
+			rs = trim(rs, s)
+		}
+	case "än":
+		if longest(trim(rs, s), 0, "tä", "ssä", "stä", "llä", "ltä", "nä") != "" {
+			rs = trim(rs, s)
+		}
+	case "en":
+		if longest(trim(rs, s), 0, "lle", "ine") != "" {
+			rs = trim(rs, s)
+		}
+	}
+
+	// Step 3, cases
+	var removed bool
+	if s := longest(rs, p1, finnishCaseSuffixes...); s != "" {
+		// siin, den, tten and seen are conditional; if the condition fails, the ending is n
+		switch s {
+		case "siin", "den", "tten":
+			if !isFinnishVI(trim(rs, s)) {
+				s = "n"
+			}
+		case "seen":
+			if !isFinnishLongVowel(trim(rs, s)) {
+				s = "n"
+			}
+		}
+
+		stem := trim(rs, s)
+		switch s {
+		case "han", "hen", "hin", "hon", "hän", "hön":
+			// hXn, preceded by X
+			removed = last(stem) == []rune(s)[1]
+		case "siin", "den", "tten", "seen":
+			removed = true
+		case "a", "ä":
+			// preceded by a consonant and a vowel
+			removed = len(stem) >= 2 && isFinnishVowel(stem[len(stem)-1]) && isFinnishConsonant(stem[len(stem)-2])
+		case "tta", "ttä":
+			removed = last(stem) == 'e'
+		case "n":
+			removed = true
+			if isFinnishLongVowel(stem) || hasSuffix(stem, "ie") {
+				stem = stem[:len(stem)-1]
+			}
+		default:
+			removed = true
+		}
+		if removed {
+			rs = stem
+		}
+	}
+
+	// Step 4, other endings
+	switch s := longest(rs, p2, "mpi", "mpa", "mpä", "mmi", "mma", "mmä", "impi", "impa", "impä", "immi", "imma", "immä", "eja", "ejä"); s {
+	case "":
+	case "mpi", "mpa", "mpä", "mmi", "mma", "mmä":
+		if !precededBy(rs, s, "po") {
+			rs = trim(rs, s)
+		}
+	default:
+		rs = trim(rs, s)
+	}
+
+	// Step 5, plurals
+	if removed {
+		if longest(rs, p1, "i", "j") != "" {
+			rs = rs[:len(rs)-1]
+		}
+	} else if n := len(rs); n >= 2 && n-2 >= p1 && rs[n-1] == 't' && isFinnishVowel(rs[n-2]) {
+		rs = rs[:n-1]
+		switch s := longest(rs, p2, "mma", "imma"); s {
+		case "mma":
+			if !precededBy(rs, s, "po") {
+				rs = trim(rs, s)
+			}
+		case "imma":
+			rs = trim(rs, s)
+		}
+	}
+
+	// Step 6, tidying up, within R1
+	if n := len(rs); n-2 >= p1 && isFinnishLongVowel(rs) {
+		rs = rs[:n-1]
+	}
+	if n := len(rs); n-2 >= p1 && strings.ContainsRune("aäei", rs[n-1]) && isFinnishConsonant(rs[n-2]) {
+		rs = rs[:n-1]
+	}
+	if longest(rs, p1, "oj", "uj") != "" {
+		rs = rs[:len(rs)-1]
+	}
+	if longest(rs, p1, "jo") != "" {
+		rs = rs[:len(rs)-1]
+	}
+
+	// ...and in the whole word, a double consonant followed by zero or more vowels
+	i := len(rs) - 1
+	for i >= 0 && isFinnishVowel(rs[i]) {
+		i--
+	}
+	if i >= 1 && isFinnishConsonant(rs[i]) && rs[i-1] == rs[i] {
+		rs = append(rs[:i], rs[i+1:]...)
+	}
+
+	return string(rs)
+}
+
+func isFinnishVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö':
+		return true
+	}
+	return false
+}
+
+// isFinnishConsonant determines whether r is a non-vowel, which includes letters outside of a-z, such as š
+func isFinnishConsonant(r rune) bool {
+	return !isFinnishVowel(r)
+}
+
+// isFinnishLongVowel determines whether rs ends with a long (double) vowel, other than yy
+func isFinnishLongVowel(rs []rune) bool {
+	if n := len(rs); n >= 2 && rs[n-1] == rs[n-2] {
+		return strings.ContainsRune("aeiouäö", rs[n-1])
+	}
+	return false
+}
+
+// isFinnishVI determines whether rs ends with i preceded by a vowel (other than y)
+func isFinnishVI(rs []rune) bool {
+	n := len(rs)
+	return n >= 2 && rs[n-1] == 'i' && strings.ContainsRune("aeiouäö", rs[n-2])
+}
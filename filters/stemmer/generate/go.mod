@@ -0,0 +1,6 @@
+// The generator is its own module, so that the Snowball reference implementation is not a dependency of jargon
+module github.com/clipperhouse/jargon/filters/stemmer/generate
+
+go 1.23.0
+
+require github.com/blevesearch/snowballstem v0.9.0
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/blevesearch/snowballstem"
+	"github.com/blevesearch/snowballstem/danish"
+	"github.com/blevesearch/snowballstem/dutch"
+	"github.com/blevesearch/snowballstem/finnish"
+	"github.com/blevesearch/snowballstem/german"
+	"github.com/blevesearch/snowballstem/italian"
+	"github.com/blevesearch/snowballstem/portuguese"
+)
+
+// references are the stemmers compiled from Snowball, against which this package's stemmers are tested
+var references = map[string]func(*snowballstem.Env) bool{
+	"danish":     danish.Stem,
+	"dutch":      dutch.Stem,
+	"finnish":    finnish.Stem,
+	"german":     german.Stem,
+	"italian":    italian.Stem,
+	"portuguese": portuguese.Stem,
+}
+
+func main() {
+	for lang, stem := range references {
+		err := write(filepath.Join("..", "testdata", lang+".txt"), stem)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// write (re)writes the stems in a vocabulary file, from the first column of each line. Comments and blank lines are kept.
+func write(path string, stem func(*snowballstem.Env) bool) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	var out bytes.Buffer
+	err = update(in, &out, stem)
+	in.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return os.WriteFile(path, out.Bytes(), 0644)
+}
+
+func update(r io.Reader, w io.Writer, stem func(*snowballstem.Env) bool) error {
+	env := snowballstem.NewEnv("")
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			fmt.Fprintln(w, line)
+			continue
+		}
+
+		word := strings.Fields(line)[0]
+		env.SetCurrent(word)
+		stem(env)
+		fmt.Fprintln(w, word, env.Current())
+	}
+
+	return sc.Err()
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blevesearch/snowballstem/german"
+)
+
+func TestUpdate(t *testing.T) {
+	input := `# a comment
+
+häuser
+aufeinanderfolgenden wrong
+`
+	expected := `# a comment
+
+häuser haus
+aufeinanderfolgenden aufeinanderfolg
+`
+
+	var got bytes.Buffer
+	if err := update(strings.NewReader(input), &got, german.Stem); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.String() != expected {
+		t.Errorf("expected %q, got %q", expected, got.String())
+	}
+}
@@ -0,0 +1,113 @@
+package stemmer
+
+import "strings"
+
+// german implements https://snowballstem.org/algorithms/german/stemmer.html
+func german(word string) string {
+	word = strings.ToLower(word)
+	word = strings.ReplaceAll(word, "ß", "ss")
+	rs := []rune(word)
+
+	// u and y between vowels are treated as consonants
+	for i := 1; i < len(rs)-1; i++ {
+		if (rs[i] == 'u' || rs[i] == 'y') && isGermanVowel(rs[i-1]) && isGermanVowel(rs[i+1]) {
+			rs[i] -= 'a' - 'A'
+		}
+	}
+
+	p1 := region(rs, 0, isGermanVowel)
+	p2 := region(rs, p1, isGermanVowel)
+	p1 = min3(p1, rs)
+
+	// Step 1
+	if s := longest(rs, 0, "em", "ern", "er", "e", "en", "es", "s"); s != "" && in(rs, s, p1) {
+		switch s {
+		case "em", "ern", "er":
+			rs = trim(rs, s)
+		case "e", "en", "es":
+			rs = trim(rs, s)
+			if hasSuffix(rs, "niss") {
+				rs = trim(rs, "s")
+			}
+		case "s":
+			if len(rs) > 1 && isGermanSEnding(rs[len(rs)-2]) {
+				rs = trim(rs, s)
+			}
+		}
+	}
+
+	// Step 2
+	if s := longest(rs, 0, "en", "er", "est", "st"); s != "" && in(rs, s, p1) {
+		switch s {
+		case "en", "er", "est":
+			rs = trim(rs, s)
+		case "st":
+			// preceded by a valid st-ending, itself preceded by at least 3 letters
+			if len(rs) > 5 && isGermanSTEnding(rs[len(rs)-3]) {
+				rs = trim(rs, s)
+			}
+		}
+	}
+
+	// Step 3, d-suffixes
+	if s := longest(rs, 0, "end", "ung", "ig", "ik", "isch", "lich", "heit", "keit"); s != "" && in(rs, s, p2) {
+		switch s {
+		case "end", "ung":
+			rs = trim(rs, s)
+			if hasSuffix(rs, "ig") && in(rs, "ig", p2) && !precededBy(rs, "ig", "e") {
+				rs = trim(rs, "ig")
+			}
+		case "ig", "ik", "isch":
+			if !precededBy(rs, s, "e") {
+				rs = trim(rs, s)
+			}
+		case "lich", "heit":
+			rs = trim(rs, s)
+			if t := longest(rs, p1, "er", "en"); t != "" {
+				rs = trim(rs, t)
+			}
+		case "keit":
+			rs = trim(rs, s)
+			if t := longest(rs, p2, "lich", "ig"); t != "" {
+				rs = trim(rs, t)
+			}
+		}
+	}
+
+	for i, r := range rs {
+		switch r {
+		case 'U':
+			rs[i] = 'u'
+		case 'Y':
+			rs[i] = 'y'
+		case 'ä':
+			rs[i] = 'a'
+		case 'ö':
+			rs[i] = 'o'
+		case 'ü':
+			rs[i] = 'u'
+		}
+	}
+
+	return string(rs)
+}
+
+func isGermanVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö', 'ü':
+		return true
+	}
+	return false
+}
+
+func isGermanSEnding(r rune) bool {
+	switch r {
+	case 'b', 'd', 'f', 'g', 'h', 'k', 'l', 'm', 'n', 'r', 't':
+		return true
+	}
+	return false
+}
+
+func isGermanSTEnding(r rune) bool {
+	return r != 'r' && isGermanSEnding(r)
+}
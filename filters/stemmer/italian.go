@@ -0,0 +1,198 @@
+package stemmer
+
+import "strings"
+
+var italianPronouns = []string{
+	"ci", "gli", "la", "le", "li", "lo", "mi", "ne", "si", "ti", "vi", "sene", "gliela", "gliele", "glieli", "glielo", "gliene",
+	"mela", "mele", "meli", "melo", "mene", "tela", "tele", "teli", "telo", "tene", "cela", "cele", "celi", "celo", "cene",
+	"vela", "vele", "veli", "velo", "vene",
+}
+
+var italianStandardSuffixes = []string{
+	"anza", "anze", "ico", "ici", "ica", "ice", "iche", "ichi", "ismo", "ismi", "abile", "abili", "ibile", "ibili",
+	"ista", "iste", "isti", "istà", "istè", "istì", "oso", "osi", "osa", "ose", "mente", "atrice", "atrici", "ante", "anti",
+	"azione", "azioni", "atore", "atori", "logia", "logie", "uzione", "uzioni", "usione", "usioni", "enza", "enze",
+	"amento", "amenti", "imento", "imenti", "amente", "ità", "ivo", "ivi", "iva", "ive",
+}
+
+var italianVerbSuffixes = []string{
+	"ammo", "ando", "ano", "are", "arono", "asse", "assero", "assi", "assimo", "ata", "ate", "ati", "ato", "ava", "avamo",
+	"avano", "avate", "avi", "avo", "emmo", "enda", "ende", "endi", "endo", "erà", "erai", "eranno", "ere", "erebbe",
+	"erebbero", "erei", "eremmo", "eremo", "ereste", "eresti", "erete", "erò", "erono", "essero", "ete", "eva", "evamo",
+	"evano", "evate", "evi", "evo", "iamo", "immo", "irà", "irai", "iranno", "ire", "irebbe", "irebbero", "irei",
+	"iremmo", "iremo", "ireste", "iresti", "irete", "irò", "irono", "isca", "iscano", "isce", "isci", "isco", "iscono",
+	"issero", "ita", "ite", "iti", "ito", "ono", "uta", "ute", "uti", "uto", "iva", "ivamo", "ivano", "ivate", "ivi", "ivo", "ar", "ir",
+}
+
+// italian implements https://snowballstem.org/algorithms/italian/stemmer.html
+func italian(word string) string {
+	word = strings.ToLower(word)
+	rs := []rune(word)
+
+	for i, r := range rs {
+		switch r {
+		case 'á':
+			rs[i] = 'à'
+		case 'é':
+			rs[i] = 'è'
+		case 'í':
+			rs[i] = 'ì'
+		case 'ó':
+			rs[i] = 'ò'
+		case 'ú':
+			rs[i] = 'ù'
+		case 'u':
+			if i > 0 && rs[i-1] == 'q' {
+				rs[i] = 'U'
+			}
+		}
+	}
+
+	// u and i between vowels are treated as consonants
+	for i := 1; i < len(rs)-1; i++ {
+		if (rs[i] == 'u' || rs[i] == 'i') && isItalianVowel(rs[i-1]) && isItalianVowel(rs[i+1]) {
+			rs[i] -= 'a' - 'A'
+		}
+	}
+
+	pV := rv(rs, isItalianVowel)
+	p1 := region(rs, 0, isItalianVowel)
+	p2 := region(rs, p1, isItalianVowel)
+
+	// Step 0, attached pronouns
+	if s := longest(rs, 0, italianPronouns...); s != "" {
+		stem := trim(rs, s)
+		switch t := longest(stem, 0, "ando", "endo", "ar", "er", "ir"); t {
+		case "ando", "endo":
+			if in(stem, t, pV) {
+				rs = stem
+			}
+		case "ar", "er", "ir":
+			if in(stem, t, pV) {
+				rs = append(stem, 'e')
+			}
+		}
+	}
+
+	// Step 1, standard suffixes; otherwise Step 2, verb suffixes
+	var removed bool
+	rs, removed = italianStandardSuffix(rs, pV, p1, p2)
+	if !removed {
+		if s := longest(rs, pV, italianVerbSuffixes...); s != "" {
+			rs = trim(rs, s)
+		}
+	}
+
+	// Step 3a
+	if s := longest(rs, pV, "a", "e", "i", "o", "à", "è", "ì", "ò"); s != "" {
+		rs = trim(rs, s)
+		if hasSuffix(rs, "i") && in(rs, "i", pV) {
+			rs = trim(rs, "i")
+		}
+	}
+
+	// Step 3b
+	if s := longest(rs, pV, "ch", "gh"); s != "" {
+		rs = trim(rs, "h")
+	}
+
+	for i, r := range rs {
+		switch r {
+		case 'I':
+			rs[i] = 'i'
+		case 'U':
+			rs[i] = 'u'
+		}
+	}
+
+	return string(rs)
+}
+
+func italianStandardSuffix(rs []rune, pV, p1, p2 int) ([]rune, bool) {
+	s := longest(rs, 0, italianStandardSuffixes...)
+
+	switch s {
+	case "":
+		return rs, false
+	case "azione", "azioni", "atore", "atori":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		if hasSuffix(rs, "ic") && in(rs, "ic", p2) {
+			rs = trim(rs, "ic")
+		}
+	case "logia", "logie":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = replace(rs, s, "log")
+	case "uzione", "uzioni", "usione", "usioni":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = replace(rs, s, "u")
+	case "enza", "enze":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = replace(rs, s, "ente")
+	case "amento", "amenti", "imento", "imenti":
+		if !in(rs, s, pV) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+	case "amente":
+		if !in(rs, s, p1) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		switch t := longest(rs, 0, "iv", "os", "ic", "abil"); t {
+		case "iv":
+			if in(rs, t, p2) {
+				rs = trim(rs, t)
+				if hasSuffix(rs, "at") && in(rs, "at", p2) {
+					rs = trim(rs, "at")
+				}
+			}
+		case "os", "ic", "abil":
+			if in(rs, t, p2) {
+				rs = trim(rs, t)
+			}
+		}
+	case "ità":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		if t := longest(rs, 0, "abil", "ic", "iv"); t != "" && in(rs, t, p2) {
+			rs = trim(rs, t)
+		}
+	case "ivo", "ivi", "iva", "ive":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		if hasSuffix(rs, "at") && in(rs, "at", p2) {
+			rs = trim(rs, "at")
+			if hasSuffix(rs, "ic") && in(rs, "ic", p2) {
+				rs = trim(rs, "ic")
+			}
+		}
+	default:
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+	}
+
+	return rs, true
+}
+
+func isItalianVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'à', 'è', 'ì', 'ò', 'ù':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package stemmer
+
+import (
+	"sort"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/language"
+)
+
+var languages = map[string]jargon.Filter{
+	"danish":     Danish,
+	"dutch":      Dutch,
+	"english":    English,
+	"finnish":    Finnish,
+	"french":     French,
+	"german":     German,
+	"italian":    Italian,
+	"norwegian":  Norwegian,
+	"portuguese": Portuguese,
+	"russian":    Russian,
+	"spanish":    Spanish,
+	"swedish":    Swedish,
+}
+
+// Language returns the stemmer for a language, by name (such as "english") or ISO 639-1 code (such as "en").
+// found will be false if the language is not available.
+func Language(lang string) (filter jargon.Filter, found bool) {
+	filter, found = languages[language.Name(lang)]
+	return filter, found
+}
+
+// Languages returns the names of the available languages, sorted
+func Languages() []string {
+	var result []string
+	for name := range languages {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,157 @@
+package stemmer
+
+import "strings"
+
+var portugueseStandardSuffixes = []string{
+	"eza", "ezas", "ico", "ica", "icos", "icas", "ismo", "ismos", "ável", "ível", "ista", "istas", "oso", "osa", "osos",
+	"osas", "amento", "amentos", "imento", "imentos", "adora", "ador", "aça~o", "adoras", "adores", "aço~es", "ante",
+	"antes", "ância", "logia", "logias", "uça~o", "uço~es", "ência", "ências", "amente", "mente", "idade", "idades",
+	"iva", "ivo", "ivas", "ivos", "ira", "iras",
+}
+
+var portugueseVerbSuffixes = []string{
+	"ada", "ida", "ia", "aria", "eria", "iria", "ará", "ara", "erá", "era", "irá", "ava", "asse", "esse", "isse", "aste",
+	"este", "iste", "ei", "arei", "erei", "irei", "am", "iam", "ariam", "eriam", "iriam", "aram", "eram", "iram", "avam",
+	"em", "arem", "erem", "irem", "assem", "essem", "issem", "ado", "ido", "ando", "endo", "indo", "ara~o", "era~o",
+	"ira~o", "ar", "er", "ir", "as", "adas", "idas", "ias", "arias", "erias", "irias", "arás", "aras", "erás", "eras",
+	"irás", "avas", "es", "ardes", "erdes", "irdes", "ares", "eres", "ires", "asses", "esses", "isses", "astes", "estes",
+	"istes", "is", "ais", "eis", "íeis", "aríeis", "eríeis", "iríeis", "áreis", "areis", "éreis", "ereis", "íreis",
+	"ireis", "ásseis", "ésseis", "ísseis", "áveis", "ados", "idos", "ámos", "amos", "íamos", "aríamos", "eríamos",
+	"iríamos", "áramos", "éramos", "íramos", "ávamos", "emos", "aremos", "eremos", "iremos", "ássemos", "êssemos",
+	"íssemos", "imos", "armos", "ermos", "irmos", "eu", "iu", "ou", "ira", "iras",
+}
+
+// portuguese implements https://snowballstem.org/algorithms/portuguese/stemmer.html
+func portuguese(word string) string {
+	word = strings.ToLower(word)
+
+	// Nasalised vowels are treated as a vowel followed by a consonant, ~
+	rs := []rune(strings.NewReplacer("ã", "a~", "õ", "o~").Replace(word))
+
+	pV := rv(rs, isPortugueseVowel)
+	p1 := region(rs, 0, isPortugueseVowel)
+	p2 := region(rs, p1, isPortugueseVowel)
+
+	// Step 1, standard suffixes; otherwise Step 2, verb suffixes
+	var altered bool
+	rs, altered = portugueseStandardSuffix(rs, pV, p1, p2)
+	if !altered {
+		if s := longest(rs, pV, portugueseVerbSuffixes...); s != "" {
+			rs = trim(rs, s)
+			altered = true
+		}
+	}
+
+	if altered {
+		// Step 3
+		if hasSuffix(rs, "ci") && in(rs, "i", pV) {
+			rs = trim(rs, "i")
+		}
+	} else {
+		// Step 4, residual suffixes
+		if s := longest(rs, pV, "os", "a", "i", "o", "á", "í", "ó"); s != "" {
+			rs = trim(rs, s)
+		}
+	}
+
+	// Step 5
+	switch s := longest(rs, 0, "e", "é", "ê", "ç"); s {
+	case "e", "é", "ê":
+		if in(rs, s, pV) {
+			rs = trim(rs, s)
+			if (hasSuffix(rs, "gu") || hasSuffix(rs, "ci")) && len(rs)-1 >= pV {
+				rs = rs[:len(rs)-1]
+			}
+		}
+	case "ç":
+		rs = replace(rs, s, "c")
+	}
+
+	return strings.NewReplacer("a~", "ã", "o~", "õ").Replace(string(rs))
+}
+
+func portugueseStandardSuffix(rs []rune, pV, p1, p2 int) ([]rune, bool) {
+	s := longest(rs, 0, portugueseStandardSuffixes...)
+
+	switch s {
+	case "":
+		return rs, false
+	case "logia", "logias":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = replace(rs, s, "log")
+	case "uça~o", "uço~es":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = replace(rs, s, "u")
+	case "ência", "ências":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = replace(rs, s, "ente")
+	case "amente":
+		if !in(rs, s, p1) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		switch t := longest(rs, 0, "iv", "os", "ic", "ad"); t {
+		case "iv":
+			if in(rs, t, p2) {
+				rs = trim(rs, t)
+				if hasSuffix(rs, "at") && in(rs, "at", p2) {
+					rs = trim(rs, "at")
+				}
+			}
+		case "os", "ic", "ad":
+			if in(rs, t, p2) {
+				rs = trim(rs, t)
+			}
+		}
+	case "mente":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		if t := longest(rs, 0, "ante", "avel", "ível"); t != "" && in(rs, t, p2) {
+			rs = trim(rs, t)
+		}
+	case "idade", "idades":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		if t := longest(rs, 0, "abil", "ic", "iv"); t != "" && in(rs, t, p2) {
+			rs = trim(rs, t)
+		}
+	case "iva", "ivo", "ivas", "ivos":
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+		if hasSuffix(rs, "at") && in(rs, "at", p2) {
+			rs = trim(rs, "at")
+		}
+	case "ira", "iras":
+		if !in(rs, s, pV) || !precededBy(rs, s, "e") {
+			return rs, false
+		}
+		rs = replace(rs, s, "ir")
+	default:
+		if !in(rs, s, p2) {
+			return rs, false
+		}
+		rs = trim(rs, s)
+	}
+
+	return rs, true
+}
+
+func isPortugueseVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'á', 'é', 'í', 'ó', 'ú', 'â', 'ê', 'ô':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package stemmer
+
+import "unicode/utf8"
+
+// Helpers for the Snowball algorithms implemented in this package (German, Dutch, Danish, Italian, Portuguese, Finnish).
+// See https://snowballstem.org/algorithms/ for definitions of the regions R1, R2 and RV.
+
+// region returns the start of the region after the first non-vowel following a vowel, searching from start.
+// It returns len(rs), i.e. the null region, if there is no such non-vowel. Use it successively to find R1 and R2.
+func region(rs []rune, start int, isVowel func(rune) bool) int {
+	for i := start + 1; i < len(rs); i++ {
+		if isVowel(rs[i-1]) && !isVowel(rs[i]) {
+			return i + 1
+		}
+	}
+	return len(rs)
+}
+
+// rv returns the start of the region RV, as defined for Spanish, Italian and Portuguese
+func rv(rs []rune, isVowel func(rune) bool) int {
+	if len(rs) < 2 {
+		return len(rs)
+	}
+
+	switch {
+	case !isVowel(rs[1]):
+		// the region after the next following vowel
+		for i := 2; i < len(rs); i++ {
+			if isVowel(rs[i]) {
+				return i + 1
+			}
+		}
+	case isVowel(rs[0]):
+		// both vowels, the region after the next consonant
+		for i := 2; i < len(rs); i++ {
+			if !isVowel(rs[i]) {
+				return i + 1
+			}
+		}
+	default:
+		// consonant-vowel, the region after the third letter
+		if len(rs) >= 3 {
+			return 3
+		}
+	}
+
+	return len(rs)
+}
+
+// hasSuffix determines whether rs ends with s
+func hasSuffix(rs []rune, s string) bool {
+	n := utf8.RuneCountInString(s)
+	if n > len(rs) {
+		return false
+	}
+	i := len(rs) - n
+	for _, r := range s {
+		if rs[i] != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// longest returns the longest of suffixes which ends rs, and which begins at or after start, or "" if none.
+// Use a start of 0 to find the longest suffix in the whole word, and a region start to search only within that region.
+func longest(rs []rune, start int, suffixes ...string) string {
+	result, max := "", 0
+	for _, s := range suffixes {
+		n := utf8.RuneCountInString(s)
+		if n > max && len(rs)-n >= start && hasSuffix(rs, s) {
+			result, max = s, n
+		}
+	}
+	return result
+}
+
+// in determines whether the suffix s (assumed to end rs) begins at or after start, i.e. is within a region
+func in(rs []rune, s string, start int) bool {
+	return len(rs)-utf8.RuneCountInString(s) >= start
+}
+
+// trim removes the suffix s (assumed to end rs)
+func trim(rs []rune, s string) []rune {
+	return rs[:len(rs)-utf8.RuneCountInString(s)]
+}
+
+// replace replaces the suffix s (assumed to end rs) with t
+func replace(rs []rune, s, t string) []rune {
+	return append(trim(rs, s), []rune(t)...)
+}
+
+// precededBy determines whether the suffix s (assumed to end rs) is immediately preceded by p
+func precededBy(rs []rune, s, p string) bool {
+	return hasSuffix(trim(rs, s), p)
+}
+
+// last returns the last rune of rs, or 0 if empty
+func last(rs []rune) rune {
+	if len(rs) == 0 {
+		return 0
+	}
+	return rs[len(rs)-1]
+}
+
+// min3 adjusts a region start such that the region before it contains at least 3 letters, as for German and Danish
+func min3(p int, rs []rune) int {
+	if p < 3 && len(rs) >= 3 {
+		return 3
+	}
+	return p
+}
@@ -2,18 +2,20 @@
 package stopwords
 
 import (
-	"strings"
-
 	"github.com/clipperhouse/jargon"
+	"golang.org/x/text/cases"
 )
 
-// NewFilter creates a token filter for the supplied stop words
+// NewFilter creates a token filter for the supplied stop words. If ignoreCase is true, words are compared using Unicode case folding.
+// Removed words are recorded as a gap in the PositionIncrement of the following word.
 func NewFilter(stopwords []string, ignoreCase bool) jargon.Filter {
+	fold := cases.Fold()
+
 	includes := make(map[string]bool)
 	for _, s := range stopwords {
 		var key string
 		if ignoreCase {
-			key = strings.ToLower(s)
+			key = fold.String(s)
 		} else {
 			key = s
 		}
@@ -37,6 +39,8 @@ func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
 	t := tokens{
 		filter:   f,
 		incoming: incoming,
+		// A Caser is stateful, and should not be shared across streams
+		fold: cases.Fold(),
 	}
 	return jargon.NewTokenStream(t.next)
 }
@@ -44,6 +48,9 @@ func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
 type tokens struct {
 	filter   *filter
 	incoming *jargon.TokenStream
+	fold     cases.Caser
+	// positions removed, to be recorded on the next word
+	gap int
 }
 
 func (t *tokens) next() (*jargon.Token, error) {
@@ -58,14 +65,22 @@ func (t *tokens) next() (*jargon.Token, error) {
 
 		key := token.String()
 		if t.filter.ignoreCase {
-			key = strings.ToLower(token.String())
+			key = t.fold.String(token.String())
 		}
 
 		if t.filter.includes[key] {
-			// Word is stopped
+			// Word is stopped, record the gap
+			if !token.IsPunct() && !token.IsSpace() {
+				t.gap += token.PositionIncrement()
+			}
 			continue
 		}
 
+		if t.gap > 0 && !token.IsPunct() && !token.IsSpace() {
+			token = token.WithPositionIncrement(token.PositionIncrement() + t.gap)
+			t.gap = 0
+		}
+
 		return token, nil
 	}
 }
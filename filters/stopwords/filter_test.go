@@ -64,3 +64,22 @@ func TestCaseInsensitive(t *testing.T) {
 		}
 	}
 }
+
+func TestPositionIncrement(t *testing.T) {
+	stop := stopwords.NewFilter([]string{"of"}, true)
+
+	tokens, err := jargon.TokenizeString("Bank of America").Filter(stop).Words().ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := []int{1, 2}
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %q", len(expected), tokens)
+	}
+	for i, token := range tokens {
+		if token.PositionIncrement() != expected[i] {
+			t.Errorf("expected %q to have increment %d, got %d", token, expected[i], token.PositionIncrement())
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package stopwords
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/language"
+)
+
+//go:embed lists/*.txt
+var lists embed.FS
+
+// Dutch removes common Dutch stop words, ignoring case
+var Dutch = newLanguage("dutch")
+
+// English removes common English stop words, ignoring case
+var English = newLanguage("english")
+
+// French removes common French stop words, ignoring case
+var French = newLanguage("french")
+
+// German removes common German stop words, ignoring case
+var German = newLanguage("german")
+
+// Italian removes common Italian stop words, ignoring case
+var Italian = newLanguage("italian")
+
+// Norwegian removes common Norwegian stop words, ignoring case
+var Norwegian = newLanguage("norwegian")
+
+// Portuguese removes common Portuguese stop words, ignoring case
+var Portuguese = newLanguage("portuguese")
+
+// Russian removes common Russian stop words, ignoring case
+var Russian = newLanguage("russian")
+
+// Spanish removes common Spanish stop words, ignoring case
+var Spanish = newLanguage("spanish")
+
+// Swedish removes common Swedish stop words, ignoring case
+var Swedish = newLanguage("swedish")
+
+var languages = map[string]jargon.Filter{
+	"dutch":      Dutch,
+	"english":    English,
+	"french":     French,
+	"german":     German,
+	"italian":    Italian,
+	"norwegian":  Norwegian,
+	"portuguese": Portuguese,
+	"russian":    Russian,
+	"spanish":    Spanish,
+	"swedish":    Swedish,
+}
+
+// Language returns the stop words filter for a language, by name (such as "english") or ISO 639-1 code (such as "en").
+// found will be false if the language is not available.
+func Language(lang string) (filter jargon.Filter, found bool) {
+	filter, found = languages[language.Name(lang)]
+	return filter, found
+}
+
+// Languages returns the names of the available languages, sorted
+func Languages() []string {
+	var result []string
+	for name := range languages {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// List returns the stop words for a language, by name or code, as for Language. It returns an error if the language is not available.
+func List(lang string) ([]string, error) {
+	b, err := lists.ReadFile("lists/" + language.Name(lang) + ".txt")
+	if err != nil {
+		return nil, fmt.Errorf("stop words for %q are not available", lang)
+	}
+
+	var result []string
+	for _, word := range strings.Fields(string(b)) {
+		result = append(result, word)
+		if strings.Contains(word, "'") {
+			// smart quote variation
+			result = append(result, strings.ReplaceAll(word, "'", "’"))
+		}
+	}
+
+	return result, nil
+}
+
+// newLanguage creates a filter for an embedded list, which is loaded lazily, i.e. don't pay for it unless we use it
+func newLanguage(name string) jargon.Filter {
+	var (
+		once   sync.Once
+		filter jargon.Filter
+	)
+
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		once.Do(func() {
+			list, err := List(name)
+			if err != nil {
+				// Embedded at compile time, so this is a programmer error
+				panic(err)
+			}
+			filter = NewFilter(list, true)
+		})
+		return filter(incoming)
+	}
+}
@@ -0,0 +1,67 @@
+package stopwords_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/stopwords"
+)
+
+func TestLanguages(t *testing.T) {
+	type test struct {
+		filter jargon.Filter
+		input  string
+		output string
+	}
+	tests := []test{
+		{stopwords.English, "The cat sat on THE mat, didn’t it", " cat sat   mat,  "},
+		{stopwords.French, "Le chat est sur la table", " chat    table"},
+		{stopwords.German, "Der Hund und die Katze", " Hund   Katze"},
+		{stopwords.Spanish, "El perro y el gato", " perro   gato"},
+		{stopwords.Russian, "Кошка И собака", "Кошка  собака"},
+	}
+
+	for _, test := range tests {
+		output, err := jargon.TokenizeString(test.input).Filter(test.filter).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if output != test.output {
+			t.Errorf("given %q, output should have been %q, got %q", test.input, test.output, output)
+		}
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	for _, lang := range stopwords.Languages() {
+		if _, found := stopwords.Language(lang); !found {
+			t.Errorf("expected to find %q", lang)
+		}
+		list, err := stopwords.List(lang)
+		if err != nil {
+			t.Error(err)
+		}
+		if len(list) == 0 {
+			t.Errorf("expected stop words for %q", lang)
+		}
+	}
+
+	if _, found := stopwords.Language("pt"); !found {
+		t.Errorf("expected to find language by code")
+	}
+	if _, found := stopwords.Language("klingon"); found {
+		t.Errorf("did not expect to find klingon")
+	}
+}
+
+func TestCaseFolding(t *testing.T) {
+	stop := stopwords.NewFilter([]string{"straße"}, true)
+
+	output, err := jargon.TokenizeString("STRASSE Straße strasse").Filter(stop).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if output != "  " {
+		t.Errorf("expected case folding to match all variations, got %q", output)
+	}
+}
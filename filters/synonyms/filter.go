@@ -19,6 +19,8 @@ type filter struct {
 
 	trie     *trie.RuneTrie
 	maxWords int
+	// joins indicates that some synonyms have punctuation within a word, such as AT&T, so runs of words may include it
+	joins bool
 }
 
 type config struct {
@@ -27,7 +29,8 @@ type config struct {
 	ignoreRunes []rune
 }
 
-// NewFilter creates a new synonyms Filter
+// NewFilter creates a new synonyms Filter. Verbatim tokens, such as code (see jargon.Token.IsVerbatim), are passed through,
+// and are not matched as part of a multi-word synonym. Synonyms may contain punctuation within a word, such as AT&T.
 func NewFilter(mappings map[string]string, ignoreCase bool, ignoreRunes []rune) jargon.Filter {
 	// Save the parameters for lazy loading (below)
 	f := &filter{
@@ -43,6 +46,7 @@ func NewFilter(mappings map[string]string, ignoreCase bool, ignoreRunes []rune)
 func (f *filter) build() error {
 	trie := trie.New(f.config.ignoreCase, f.config.ignoreRunes)
 	maxWords := 1
+	joins := false
 	for synonyms, canonical := range f.config.mappings {
 		tokens, err := jargon.TokenizeString(synonyms).ToSlice()
 		if err != nil {
@@ -63,6 +67,7 @@ func (f *filter) build() error {
 				slice := tokens[start:i]
 				trie.Add(slice, canonical)
 				updateMaxWords(slice, &maxWords)
+				joins = joins || hasJoin(slice)
 
 				start = i + 1 // ignore the comma
 				skipSpaces = true
@@ -74,11 +79,13 @@ func (f *filter) build() error {
 		slice := tokens[start:]
 		trie.Add(slice, canonical)
 		updateMaxWords(slice, &maxWords)
+		joins = joins || hasJoin(slice)
 	}
 
 	// Populate with new values
 	f.trie = trie
 	f.maxWords = maxWords
+	f.joins = joins
 
 	// Kill the config
 	f.config = nil
@@ -98,6 +105,21 @@ func updateMaxWords(tokens []*jargon.Token, maxWords *int) {
 	}
 }
 
+// isWord determines whether the token is a word, i.e. neither space nor punct
+func isWord(token *jargon.Token) bool {
+	return !token.IsSpace() && !token.IsPunct()
+}
+
+// hasJoin determines whether the tokens have punctuation within a word, such as the & in AT&T
+func hasJoin(tokens []*jargon.Token) bool {
+	for i := 1; i < len(tokens)-1; i++ {
+		if tokens[i].IsPunct() && isWord(tokens[i-1]) && isWord(tokens[i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
 // Filter replaces tokens with their canonical terms, based on Stack Overflow tags & synonyms
 func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
 	// Lazily build the trie on first call, i.e. don't pay for the construction
@@ -173,7 +195,10 @@ func (t *tokens) next() (*jargon.Token, error) {
 		found, canonical, consumed := t.filter.trie.SearchCanonical(run...)
 		if found {
 			if canonical != "" {
-				token := jargon.NewToken(canonical, true)
+				// Canonical terms are protected from subsequent filters, such as stemmers, and take the position
+				// of the first word they replace, including any gap before it
+				head := t.buffer.Tokens[0]
+				token := jargon.NewToken(canonical, true).WithKeyword(true).WithPositionIncrement(head.PositionIncrement())
 				t.outgoing.Push(token)
 			}
 			t.buffer.Drop(consumed)
@@ -196,10 +221,10 @@ func (t *tokens) next() (*jargon.Token, error) {
 
 // fill the buffer until EOF, punctuation, or enough word tokens
 func (t *tokens) fill() error {
-	// Leading buffered space & punct should go straight out
+	// Leading buffered space & punct (and verbatim text) should go straight out
 	drop := 0
 	for _, token := range t.buffer.Tokens {
-		if token.IsSpace() || token.IsPunct() {
+		if token.IsSpace() || token.IsPunct() || token.IsVerbatim() {
 			t.outgoing.Push(token)
 			drop++
 			continue
@@ -213,7 +238,7 @@ func (t *tokens) fill() error {
 		// Leading incoming space & punct should go straight out, don't even buffer
 		for t.incoming.Scan() {
 			token := t.incoming.Token()
-			if token.IsSpace() || token.IsPunct() {
+			if token.IsSpace() || token.IsPunct() || token.IsVerbatim() {
 				t.outgoing.Push(token)
 				continue
 			}
@@ -246,9 +271,18 @@ func (t *tokens) fill() error {
 			break
 		}
 
+		var previous *jargon.Token
+		if t.buffer.Any() {
+			previous = t.buffer.Tokens[t.buffer.Len()-1]
+		}
 		t.buffer.Push(token)
 
-		if token.IsPunct() {
+		if token.IsPunct() && t.filter.joins && previous != nil && isWord(previous) && !previous.IsVerbatim() {
+			// Punctuation following a word might be within it, such as AT&T; see wordrun
+			continue
+		}
+
+		if token.IsPunct() || token.IsVerbatim() {
 			break
 		}
 
@@ -280,10 +314,19 @@ func (t *tokens) wordrun() ([]*jargon.Token, error) {
 		consumed int
 	)
 
-	for i, token := range t.buffer.Tokens {
-		if token.IsPunct() {
+	tokens := t.buffer.Tokens
+	for i, token := range tokens {
+		if token.IsPunct() && t.filter.joins && i > 0 && i+1 < len(tokens) &&
+			isWord(tokens[i-1]) && isWord(tokens[i+1]) && !tokens[i+1].IsVerbatim() {
+			// Punctuation within a word, such as AT&T, may be part of a synonym
+			end = i + 1
+			consumed++
+			continue
+		}
+
+		if token.IsPunct() || token.IsVerbatim() {
 			// fall through and send back word run we've gotten so far (if any)
-			// don't consume this punct, leave it in the buffer
+			// don't consume this punct (or verbatim text), leave it in the buffer
 			break
 		}
 
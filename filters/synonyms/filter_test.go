@@ -2,9 +2,12 @@ package synonyms
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/keywords"
+	"github.com/clipperhouse/jargon/filters/stopwords"
 	"github.com/clipperhouse/jargon/tokenqueue"
 )
 
@@ -262,6 +265,120 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+func TestFilterKeywords(t *testing.T) {
+	mappings := map[string]string{
+		"Ruby on Rails, rails": "ruby-on-rails",
+		"ruby":                 "ruby",
+		"node js, iojs":        "node.js",
+	}
+
+	synonyms := NewFilter(mappings, true, nil)
+
+	// Keywords are matched as part of multi-word synonyms, as other words are
+	original := `Ruby on Rails, and node js`
+	tokens := jargon.TokenizeString(original).Filter(keywords.NewFilter([]string{"Ruby", "node"}, true))
+
+	expected := `ruby-on-rails, and node.js`
+
+	got, err := synonyms(tokens).String()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if expected != got {
+		t.Errorf("given %q, expected %q, got %q", original, expected, got)
+	}
+}
+
+func TestFilterVerbatim(t *testing.T) {
+	mappings := map[string]string{
+		"Ruby on Rails, rails": "ruby-on-rails",
+		"nodeJS, iojs":         "node.js",
+	}
+
+	synonyms := NewFilter(mappings, true, nil)
+
+	// Verbatim text is left alone, and interrupts multi-word synonyms
+	original := `ruby on rails, Ruby <code>On</code> Rails and <code>nodejs</code>`
+	tokens := jargon.TokenizeHTML(strings.NewReader(original), jargon.HTMLOptions{Verbatim: []string{"code"}})
+
+	expected := `ruby-on-rails, Ruby <code>On</code> ruby-on-rails and <code>nodejs</code>`
+
+	got, err := synonyms(tokens).String()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if expected != got {
+		t.Errorf("given %q, expected %q, got %q", original, expected, got)
+	}
+}
+
+func TestJoins(t *testing.T) {
+	mappings := map[string]string{
+		"AT&T, AT and T": "att",
+		"R&D":            "research",
+	}
+
+	synonyms := NewFilter(mappings, true, nil)
+
+	type test struct {
+		given    *jargon.TokenStream
+		expected string
+	}
+
+	tests := []test{
+		{jargon.TokenizeString("AT&T and at&t, R&D."), "att and att, research."},
+		// Punctuation which is not within a word is not matched
+		{jargon.TokenizeString("AT & T, AT&& T, &T"), "AT & T, AT&& T, &T"},
+		// Entities are decoded, so the synonym matches; the canonical term has no escaped original
+		{jargon.TokenizeHTML(strings.NewReader("<p>AT&amp;T and AT&#38;T</p>")), "<p>att and att</p>"},
+	}
+
+	for _, test := range tests {
+		got, err := synonyms(test.given).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, got)
+		}
+	}
+}
+
+func TestPositionIncrement(t *testing.T) {
+	mappings := map[string]string{
+		"Ruby on Rails, rails": "ruby-on-rails",
+	}
+
+	synonyms := NewFilter(mappings, true, nil)
+	stop := stopwords.NewFilter([]string{"the"}, true)
+
+	// The canonical term takes the gap left by the removed stopword
+	tokens, err := jargon.TokenizeString("we use the Ruby on Rails").Filter(stop, synonyms).Words().ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := []struct {
+		value     string
+		increment int
+	}{
+		{"we", 1},
+		{"use", 1},
+		{"ruby-on-rails", 2},
+	}
+
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %q", len(expected), tokens)
+	}
+	for i, token := range tokens {
+		if token.String() != expected[i].value || token.PositionIncrement() != expected[i].increment {
+			t.Errorf("expected %q with increment %d, got %q with increment %d", expected[i].value, expected[i].increment, token, token.PositionIncrement())
+		}
+	}
+}
+
 func BenchmarkFilter(b *testing.B) {
 	mappings := map[string]string{
 		"developer, engineer, programmer,": "boffin",
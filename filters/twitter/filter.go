@@ -5,14 +5,15 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/clipperhouse/jargon"
 	"github.com/clipperhouse/jargon/filters/sigil"
 )
 
-// Handles will identify Twitter-style handles, combining the @ and name into a single token
-var Handles = sigil.NewFilter("@", legalHandle)
+// Handles will identify Twitter-style handles, combining the @ and name into a single token of kind jargon.Mention
+var Handles = sigil.NewFilterOfKind("@", jargon.Mention, legalHandle)
 
-// Hashtags will identify Twitter-style hashtags, combining the # and tag into a single token
-var Hashtags = sigil.NewFilter("#", legalHashtag)
+// Hashtags will identify Twitter-style hashtags, combining the # and tag into a single token of kind jargon.Hashtag
+var Hashtags = sigil.NewFilterOfKind("#", jargon.Hashtag, legalHashtag)
 
 // https://help.twitter.com/en/managing-your-account/twitter-username-rules
 func legalHandle(s string) bool {
@@ -20,3 +20,31 @@ func TestFilter(t *testing.T) {
 	}
 	t.Log(got)
 }
+
+func TestKind(t *testing.T) {
+	test := "Hi @handle, see #tag"
+	tokens, err := jargon.TokenizeString(test).Filter(Handles, Hashtags).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := map[string]jargon.Kind{
+		"@handle": jargon.Mention,
+		"#tag":    jargon.Hashtag,
+	}
+
+	for _, token := range tokens {
+		kind, found := expected[token.String()]
+		if !found {
+			continue
+		}
+		if token.Kind() != kind {
+			t.Errorf("expected %q to be of kind %s, got %s", token, kind, token.Kind())
+		}
+		delete(expected, token.String())
+	}
+
+	for s := range expected {
+		t.Errorf("expected to find token %q", s)
+	}
+}
@@ -0,0 +1,412 @@
+// Package urls provides filters to identify URLs and email addresses, which the tokenizer splits into several tokens, and coalesce them into single tokens
+package urls
+
+import (
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Coalesce identifies URLs and email addresses, combining their tokens into a single token of kind jargon.URL or jargon.Email. Examples:
+// https://github.com/clipperhouse/jargon
+// www.example.com/path?query=1
+// me@example.com
+//
+// Trailing punctuation, such as a period at the end of a sentence, or an unbalanced closing parenthesis, is not considered part of a URL.
+var Coalesce = newFilter(false, "", "")
+
+// Drop identifies URLs and email addresses, as Coalesce, and removes them from the token stream. Removed URLs are recorded
+// as a gap in the PositionIncrement of the following word.
+var Drop = Replace("", "")
+
+// Replace identifies URLs and email addresses, as Coalesce, and replaces them with placeholder tokens, such as "<URL>" and "<EMAIL>".
+// An empty string indicates that matches should be dropped.
+func Replace(url, email string) jargon.Filter {
+	return newFilter(true, url, email)
+}
+
+func newFilter(replace bool, url, email string) jargon.Filter {
+	f := &filter{
+		replace: replace,
+		url:     url,
+		email:   email,
+	}
+	return f.filter
+}
+
+type filter struct {
+	replace    bool
+	url, email string
+}
+
+func (f *filter) filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &stream{
+		filter:   f,
+		incoming: incoming,
+		buffer:   tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type stream struct {
+	filter *filter
+
+	incoming *jargon.TokenStream
+	// previous is the last token read, and prior the one before it
+	previous, prior *jargon.Token
+	// gap is the number of positions dropped since the last word
+	gap int
+	// a 'lookahead' buffer for incoming tokens
+	buffer *tokenqueue.TokenQueue
+}
+
+// maxTokens bounds the lookahead, to ensure constant memory on pathological input
+const maxTokens = 512
+
+func (s *stream) next() (*jargon.Token, error) {
+	token, err := s.read()
+	if err != nil || token == nil {
+		return token, err
+	}
+
+	if s.gap > 0 && !token.IsPunct() && !token.IsSpace() {
+		token = token.WithPositionIncrement(token.PositionIncrement() + s.gap)
+		s.gap = 0
+	}
+
+	return token, nil
+}
+
+// read returns the next token, coalescing (or replacing, or dropping) URLs and email addresses
+func (s *stream) read() (*jargon.Token, error) {
+	for {
+		current, err := s.peek(0)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, nil
+		}
+
+		// Previous token must not be a word
+		boundaryOK := s.previous == nil || s.previous.IsSpace() || s.previous.IsPunct()
+		if !boundaryOK {
+			s.advance(s.buffer.Pop())
+			return current, nil
+		}
+
+		consumed, kind, err := s.match()
+		if err != nil {
+			return nil, err
+		}
+		if kind == jargon.Email && s.previous != nil && isLocalJoiner(s.previous) &&
+			s.prior != nil && (isWord(s.prior) || isLocalJoiner(s.prior)) {
+			// Part of a longer local part which didn't match, e.g. the "last" in first-.last@example.com;
+			// but not a leading dash, as in -me@example.com
+			consumed = 0
+		}
+		if consumed == 0 {
+			s.advance(s.buffer.Pop())
+			return current, nil
+		}
+
+		var b strings.Builder
+		for _, token := range s.buffer.Tokens[:consumed] {
+			b.WriteString(token.String())
+		}
+		head := s.buffer.Tokens[0]
+		for _, token := range s.buffer.Tokens[:consumed] {
+			s.advance(token)
+		}
+		s.buffer.Drop(consumed)
+
+		if !s.filter.replace {
+			return jargon.NewTokenOfKind(b.String(), true, kind).WithPositionIncrement(head.PositionIncrement()), nil
+		}
+
+		replacement := s.filter.url
+		if kind == jargon.Email {
+			replacement = s.filter.email
+		}
+		if replacement == "" {
+			// Dropped, record the gap and move on to the next token
+			s.gap += head.PositionIncrement()
+			continue
+		}
+
+		return jargon.NewTokenOfKind(replacement, true, kind).WithPositionIncrement(head.PositionIncrement()), nil
+	}
+}
+
+// advance records token as the last token read
+func (s *stream) advance(token *jargon.Token) {
+	s.prior, s.previous = s.previous, token
+}
+
+// peek ensures that the buffer has been filled to index i, and returns the token at i; nil indicates EOF
+func (s *stream) peek(i int) (*jargon.Token, error) {
+	for s.buffer.Len() <= i {
+		token, err := s.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			// EOF
+			return nil, nil
+		}
+		s.buffer.Push(token)
+	}
+
+	return s.buffer.Tokens[i], nil
+}
+
+// is determines whether the token at i has the value v
+func (s *stream) is(i int, v string) (bool, error) {
+	token, err := s.peek(i)
+	if err != nil {
+		return false, err
+	}
+	return token != nil && token.String() == v, nil
+}
+
+// match determines whether the buffer begins with a URL or email address, and returns the number of tokens it comprises; 0 indicates no match
+func (s *stream) match() (int, jargon.Kind, error) {
+	head := s.buffer.Tokens[0]
+	if !isWord(head) {
+		return 0, 0, nil
+	}
+
+	scheme := strings.ToLower(head.String())
+
+	if scheme == "mailto" {
+		colon, err := s.is(1, ":")
+		if err != nil || !colon {
+			return 0, 0, err
+		}
+		end, err := s.email(2)
+		if err != nil || end == 0 {
+			return 0, 0, err
+		}
+		return end, jargon.Email, nil
+	}
+
+	if schemes[scheme] {
+		for i, v := range []string{":", "/", "/"} {
+			ok, err := s.is(i+1, v)
+			if err != nil || !ok {
+				return 0, 0, err
+			}
+		}
+		end, err := s.url(4)
+		if err != nil || end == 0 {
+			return 0, 0, err
+		}
+		return end, jargon.URL, nil
+	}
+
+	const www = "www."
+	if len(scheme) > len(www) && strings.HasPrefix(scheme, www) {
+		end, err := s.url(0)
+		if err != nil || end == 0 {
+			return 0, 0, err
+		}
+		return end, jargon.URL, nil
+	}
+
+	end, err := s.email(0)
+	if err != nil || end == 0 {
+		return 0, 0, err
+	}
+	return end, jargon.Email, nil
+}
+
+var schemes = map[string]bool{
+	"http":  true,
+	"https": true,
+	"ftp":   true,
+	"ftps":  true,
+	"ws":    true,
+	"wss":   true,
+}
+
+// host matches a host name beginning at i, such as example.com or my-host, and returns the index following it; 0 indicates no match
+func (s *stream) host(i int) (int, error) {
+	token, err := s.peek(i)
+	if err != nil || token == nil || !isWord(token) {
+		return 0, err
+	}
+	end := i + 1
+
+	// Hyphens and dots join labels, if followed by another label
+	for end < maxTokens {
+		joiner, err := s.peek(end)
+		if err != nil {
+			return 0, err
+		}
+		if joiner == nil || (joiner.String() != "-" && joiner.String() != ".") {
+			break
+		}
+
+		label, err := s.peek(end + 1)
+		if err != nil {
+			return 0, err
+		}
+		if label == nil || !isWord(label) {
+			break
+		}
+
+		end += 2
+	}
+
+	return end, nil
+}
+
+// email matches an email address beginning at i, and returns the index following it; 0 indicates no match
+func (s *stream) email(i int) (int, error) {
+	local, err := s.peek(i)
+	if err != nil || local == nil || !isWord(local) {
+		return 0, err
+	}
+	at := i + 1
+
+	// The local part may be several words, joined by . _ - or +, e.g. first-last or me+tag
+	for at < maxTokens {
+		joiner, err := s.peek(at)
+		if err != nil {
+			return 0, err
+		}
+		if joiner == nil || !isLocalJoiner(joiner) {
+			break
+		}
+
+		word, err := s.peek(at + 1)
+		if err != nil {
+			return 0, err
+		}
+		if word == nil || !isWord(word) {
+			break
+		}
+
+		at += 2
+	}
+
+	ok, err := s.is(at, "@")
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	end, err := s.host(at + 1)
+	if err != nil || end == 0 {
+		return 0, err
+	}
+
+	// Domain must have a dot, i.e. no bare host names
+	dotted := false
+	for _, token := range s.buffer.Tokens[at+1 : end] {
+		if strings.Contains(token.String(), ".") {
+			dotted = true
+			break
+		}
+	}
+	if !dotted {
+		return 0, nil
+	}
+
+	return end, nil
+}
+
+// url matches a host, port, path, query and fragment beginning at i, and returns the index following it; 0 indicates no match
+func (s *stream) url(i int) (int, error) {
+	end, err := s.host(i)
+	if err != nil || end == 0 {
+		return 0, err
+	}
+
+	// Port
+	colon, err := s.is(end, ":")
+	if err != nil {
+		return 0, err
+	}
+	if colon {
+		port, err := s.peek(end + 1)
+		if err != nil {
+			return 0, err
+		}
+		if port != nil && port.Kind() == jargon.Number {
+			end += 2
+		}
+	}
+
+	// Path, query & fragment
+	start := end
+	for end < maxTokens {
+		token, err := s.peek(end)
+		if err != nil {
+			return 0, err
+		}
+		if token == nil || !legalPath(token) {
+			break
+		}
+		end++
+	}
+
+	// Trailing punctuation is more likely to be part of the surrounding prose
+	opens, closes := 0, 0
+	for _, token := range s.buffer.Tokens[start:end] {
+		opens += strings.Count(token.String(), "(")
+		closes += strings.Count(token.String(), ")")
+	}
+	for end > start {
+		last := s.buffer.Tokens[end-1].String()
+		if last == ")" && closes > opens {
+			closes--
+			end--
+			continue
+		}
+		if strings.Trim(last, trailing) == "" {
+			end--
+			continue
+		}
+		break
+	}
+
+	return end, nil
+}
+
+// trailing is punctuation which is not considered part of a URL when it is the final character
+const trailing = `.,;:!?'"`
+
+// pathRunes are the non-word characters which are legal in a URL path, query or fragment
+const pathRunes = `/?#&=%~+-_.:;,!*'()@$[]`
+
+func legalPath(token *jargon.Token) bool {
+	if isWord(token) {
+		return true
+	}
+	if token.IsSpace() {
+		return false
+	}
+	for _, r := range token.String() {
+		if !strings.ContainsRune(pathRunes, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// localJoiners are the non-word characters which join the words of the local part of an email address
+const localJoiners = "._-+"
+
+func isLocalJoiner(token *jargon.Token) bool {
+	s := token.String()
+	return len(s) == 1 && strings.Contains(localJoiners, s)
+}
+
+func isWord(token *jargon.Token) bool {
+	switch token.Kind() {
+	case jargon.Word, jargon.Number, jargon.URL, jargon.Ideographic:
+		return true
+	}
+	return false
+}
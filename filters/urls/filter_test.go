@@ -0,0 +1,140 @@
+package urls_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/urls"
+)
+
+func TestCoalesce(t *testing.T) {
+	type test struct {
+		input    string
+		expected []string
+		kind     jargon.Kind
+	}
+
+	tests := []test{
+		{"See https://github.com/clipperhouse/jargon.", []string{"https://github.com/clipperhouse/jargon"}, jargon.URL},
+		{"Try http://localhost:8080/x?a=1&b=2#top, ok", []string{"http://localhost:8080/x?a=1&b=2#top"}, jargon.URL},
+		{"(see www.example.com/a_b-c)", []string{"www.example.com/a_b-c"}, jargon.URL},
+		{"(see https://en.wikipedia.org/wiki/Go_(programming_language))", []string{"https://en.wikipedia.org/wiki/Go_(programming_language)"}, jargon.URL},
+		{"Write me.you@ex-ample.co.uk, or mailto:a@b.com!", []string{"me.you@ex-ample.co.uk", "mailto:a@b.com"}, jargon.Email},
+		{"Ask first-last@example.com or a+b@example.com.", []string{"first-last@example.com", "a+b@example.com"}, jargon.Email},
+		{"(John.Smith+tag@mail.example.org, first_last@example.com)", []string{"John.Smith+tag@mail.example.org", "first_last@example.com"}, jargon.Email},
+		{"-me@example.com, or - you@example.com", []string{"me@example.com", "you@example.com"}, jargon.Email},
+	}
+
+	for _, test := range tests {
+		tokens, err := jargon.TokenizeString(test.input).Filter(urls.Coalesce).ToSlice()
+		if err != nil {
+			t.Error(err)
+		}
+
+		var got []string
+		for _, token := range tokens {
+			if token.IsLemma() {
+				got = append(got, token.String())
+				if token.Kind() != test.kind {
+					t.Errorf("expected %q to be of kind %s, got %s", token, test.kind, token.Kind())
+				}
+			}
+		}
+
+		if len(got) != len(test.expected) {
+			t.Fatalf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+		for i := range got {
+			if got[i] != test.expected[i] {
+				t.Errorf("given %q, expected %q, got %q", test.input, test.expected[i], got[i])
+			}
+		}
+	}
+}
+
+func TestNoMatch(t *testing.T) {
+	inputs := []string{
+		"http: not a url",
+		"foo@localhost is not an email",
+		"the @ sign and www.",
+		"xhttp://example.com",
+		"foo.-bar@example.com",
+	}
+
+	for _, input := range inputs {
+		got, err := jargon.TokenizeString(input).Filter(urls.Coalesce).Lemmas().ToSlice()
+		if err != nil {
+			t.Error(err)
+		}
+		if len(got) > 0 {
+			t.Errorf("given %q, expected no lemmas, got %q", input, got)
+		}
+
+		// Round trip
+		s, err := jargon.TokenizeString(input).Filter(urls.Coalesce).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if s != input {
+			t.Errorf("expected %q to round trip, got %q", input, s)
+		}
+	}
+}
+
+func TestReplace(t *testing.T) {
+	input := "Go to https://example.com/page or email me@example.com."
+
+	got, err := jargon.TokenizeString(input).Filter(urls.Replace("<URL>", "<EMAIL>")).String()
+	if err != nil {
+		t.Error(err)
+	}
+	expected := "Go to <URL> or email <EMAIL>."
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	// The whole address is replaced, not only its last word
+	got, err = jargon.TokenizeString("Email first-last@example.com or a+b@example.com").Filter(urls.Replace("<URL>", "<EMAIL>")).String()
+	if err != nil {
+		t.Error(err)
+	}
+	expected = "Email <EMAIL> or <EMAIL>"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	got, err = jargon.TokenizeString(input).Filter(urls.Drop).String()
+	if err != nil {
+		t.Error(err)
+	}
+	expected = "Go to  or email ."
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestDropPositions(t *testing.T) {
+	input := "see https://example.com/page now"
+
+	tokens, err := jargon.TokenizeString(input).Filter(urls.Drop).Words().ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		value     string
+		increment int
+	}{
+		{"see", 1},
+		{"now", 2},
+	}
+
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d words, got %q", len(expected), tokens)
+	}
+	for i, e := range expected {
+		if tokens[i].String() != e.value || tokens[i].PositionIncrement() != e.increment {
+			t.Errorf("expected %q with increment %d, got %q with %d", e.value, e.increment, tokens[i], tokens[i].PositionIncrement())
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Package width normalizes East Asian width, e.g. full-width Ａ → A and half-width ｶ → カ, and optionally folds
+// hiragana and katakana. Unlike norm.NFKC, it leaves other compatibility characters, such as superscripts and ligatures, alone.
+package width
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/mapper"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// Fold maps characters to their canonical width: full-width ASCII to narrow, and half-width katakana to wide. Examples:
+// ＡＢＣ１２３ → ABC123
+// ﾊﾟｿｺﾝ → パソコン
+var Fold = newFilter(func(s string) string { return compose(width.Fold.String(s)) })
+
+// Narrow maps characters to their narrow (half-width) form where one exists, e.g. ＡＢＣ → ABC, カ → ｶ.
+// Precomposed voiced kana, such as ガ, are not decomposed.
+var Narrow = newFilter(width.Narrow.String)
+
+// Widen maps characters to their wide (full-width) form where one exists, e.g. ABC → ＡＢＣ, ｶ → カ
+var Widen = newFilter(func(s string) string { return compose(width.Widen.String(s)) })
+
+// Hiragana folds katakana to hiragana, e.g. カタカナ → かたかな. Use it after Fold, to handle half-width katakana.
+var Hiragana = newFilter(func(s string) string { return shift(s, katakana, hiragana) })
+
+// Katakana folds hiragana to katakana, e.g. ひらがな → ヒラガナ
+var Katakana = newFilter(func(s string) string { return shift(s, hiragana, katakana) })
+
+func newFilter(f func(string) string) jargon.Filter {
+	return mapper.NewFilter(func(token *jargon.Token) *jargon.Token {
+		if token.IsSpace() {
+			// Leave ideographic space alone, it's still space
+			return token
+		}
+
+		s := f(token.String())
+		if s == token.String() {
+			return token
+		}
+
+		return jargon.NewToken(s, true)
+	})
+}
+
+// compose combines kana with a following (combining) voiced sound mark, as results from widening
+// half-width katakana, e.g. ｶﾞ → カ + U+3099 → ガ. Other combining sequences are left alone.
+func compose(s string) string {
+	if !strings.ContainsAny(s, "\u3099\u309A") {
+		return s
+	}
+
+	var b strings.Builder
+	for len(s) > 0 {
+		_, size := utf8.DecodeRuneInString(s)
+		mark, markSize := utf8.DecodeRuneInString(s[size:])
+		if mark == '\u3099' || mark == '\u309A' {
+			pair := s[:size+markSize]
+			if composed := norm.NFC.String(pair); utf8.RuneCountInString(composed) == 1 {
+				b.WriteString(composed)
+				s = s[len(pair):]
+				continue
+			}
+		}
+		b.WriteString(s[:size])
+		s = s[size:]
+	}
+
+	return b.String()
+}
+
+// kana ranges which correspond one-to-one, ぁ-ゖ and ァ-ヶ, and the iteration marks ゝゞ and ヽヾ
+type kana struct {
+	letters, marks rune
+}
+
+var (
+	hiragana = kana{letters: 'ぁ', marks: 'ゝ'}
+	katakana = kana{letters: 'ァ', marks: 'ヽ'}
+)
+
+const (
+	lettersLen = 'ゖ' - 'ぁ' + 1
+	marksLen   = 'ゞ' - 'ゝ' + 1
+)
+
+// shift maps kana from one syllabary to the other, leaving other runes alone
+func shift(s string, from, to kana) string {
+	var rs []rune
+	for i, r := range s {
+		var mapped rune
+		switch {
+		case r >= from.letters && r < from.letters+lettersLen:
+			mapped = r - from.letters + to.letters
+		case r >= from.marks && r < from.marks+marksLen:
+			mapped = r - from.marks + to.marks
+		default:
+			if rs != nil {
+				rs = append(rs, r)
+			}
+			continue
+		}
+
+		// Only allocate if something changes
+		if rs == nil {
+			rs = []rune(s[:i])
+		}
+		rs = append(rs, mapped)
+	}
+
+	if rs == nil {
+		return s
+	}
+	return string(rs)
+}
@@ -0,0 +1,62 @@
+package width_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/width"
+)
+
+func TestFilters(t *testing.T) {
+	type test struct {
+		filter          jargon.Filter
+		given, expected string
+	}
+
+	tests := []test{
+		{width.Fold, "ＡＢＣ１２３ and ﾊﾟｿｺﾝ", "ABC123 and パソコン"},
+		{width.Fold, "x² ﬁle", "x² ﬁle"},
+		{width.Fold, "ｶﾞｷﾞ", "ガギ"},
+		{width.Narrow, "ＡＢＣ カタカナ", "ABC ｶﾀｶﾅ"},
+		{width.Widen, "ABC ｶﾀｶﾅ", "ＡＢＣ カタカナ"},
+		{width.Hiragana, "カタカナとひらがな", "かたかなとひらがな"},
+		{width.Katakana, "ひらがなとカタカナ", "ヒラガナトカタカナ"},
+		{width.Katakana, "いすゞ", "イスヾ"},
+		{width.Hiragana, "コーヒー", "こーひー"},
+		{width.Hiragana, "漢字 and ABC", "漢字 and ABC"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(test.given).Filter(test.filter).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+
+	// Chaining, for half-width katakana to hiragana
+	got, err := jargon.TokenizeString("ﾊﾟｿｺﾝ").Filter(width.Fold, width.Hiragana).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if expected := "ぱそこん"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	tokens, err := jargon.TokenizeString("ＡＢＣ abc").Filter(width.Fold).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only changed tokens are lemmas
+	for _, token := range tokens {
+		changed := token.String() == "ABC"
+		if token.IsLemma() != changed {
+			t.Errorf("expected %q IsLemma to be %t", token, changed)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+package jargon
+
+import (
+	"bufio"
+	"html"
+	"io"
+	"sort"
+	"strings"
+)
+
+// HTMLWriter writes the tokens of an HTML document, from TokenizeHTML, as HTML, wrapping lemmas in an element, e.g.
+//
+//	<span data-original="Ruby on Rails" data-canonical="ruby-on-rails">ruby-on-rails</span>
+//
+// Tags, comments and text are passed through verbatim (see Token.Raw). Tokens created by filters are escaped, and are never wrapped inside attribute values,
+// or in title or textarea elements. Within raw text elements such as script and style, and comments, the original text is kept. Tokens which a filter
+// created from a tag, such as by folding its attributes, are written as they are, since they are markup.
+type HTMLWriter struct {
+	// Element is the name of the element which wraps lemmas, such as mark; span if empty
+	Element string
+	// Attributes are added to the wrapping element, for example class="lemma"
+	Attributes map[string]string
+	// Unwrapped writes lemmas as (escaped) text, without the wrapping element
+	Unwrapped bool
+}
+
+// WriteHTML applies filters to tokens, which should come from TokenizeHTML, and writes the result to w. It applies the filters
+// itself, rather than taking filtered tokens, in order to know the original text of each lemma.
+func (hw HTMLWriter) WriteHTML(w io.Writer, tokens *TokenStream, filters ...Filter) (int64, error) {
+	hw.Element = strings.ToLower(hw.Element)
+	if hw.Element == "" {
+		hw.Element = "span"
+	}
+
+	t := &hwriter{
+		HTMLWriter: hw,
+		writer:     bufio.NewWriter(w),
+	}
+
+	// Record incoming tokens, to be matched up with the outgoing tokens
+	incoming := NewTokenStream(func() (*Token, error) {
+		token, err := tokens.Next()
+		if token != nil {
+			t.inputs = append(t.inputs, token)
+		}
+		return token, err
+	})
+
+	outgoing := incoming.Filter(filters...)
+	for outgoing.Scan() {
+		t.write(outgoing.Token())
+	}
+	if err := outgoing.Err(); err != nil {
+		return t.written, err
+	}
+
+	// Anything remaining
+	t.flush(t.inputs)
+	t.inputs = nil
+
+	if err := t.writer.Flush(); err != nil {
+		return t.written, err
+	}
+	return t.written, t.err
+}
+
+type hwriter struct {
+	HTMLWriter
+	writer  *bufio.Writer
+	written int64
+	err     error
+
+	// inputs are incoming tokens, not yet matched with an outgoing token
+	inputs []*Token
+	// group is outgoing tokens which did not come from the input, i.e. created by filters
+	group []*Token
+	// context is the state of the original document, at the start of inputs
+	context htmlContext
+}
+
+// write handles an outgoing token. A token which was passed through from the input is written verbatim; tokens
+// created by filters are grouped, and written when the next passed-through token indicates which inputs they replaced.
+func (t *hwriter) write(token *Token) {
+	i := t.input(token)
+	if i < 0 {
+		t.group = append(t.group, token)
+		return
+	}
+
+	t.flush(t.inputs[:i])
+	t.pass(token)
+	t.inputs = t.inputs[i+1:]
+}
+
+// pass writes a token from the input verbatim
+func (t *hwriter) pass(token *Token) {
+	t.context.advance(token.Raw())
+	t.writeString(token.Raw())
+}
+
+// input finds the token among the unmatched inputs, returning -1 if it was created by a filter
+func (t *hwriter) input(token *Token) int {
+	if len(t.inputs) > 0 && t.inputs[0] == token {
+		return 0
+	}
+
+	// Common tokens, such as a space, are shared, so only match them at the head, above
+	if common[token.value][token.lemma] == token {
+		return -1
+	}
+
+	for i, input := range t.inputs {
+		if input == token {
+			return i
+		}
+	}
+	return -1
+}
+
+// flush writes the group of tokens created by filters, which replaced the original tokens
+func (t *hwriter) flush(originals []*Token) {
+	group := t.group
+	t.group = nil
+
+	// Tokens at the edges of the group which are the originals, such as (shared) spaces, are passed through
+	for len(group) > 0 && len(originals) > 0 && group[0] == originals[0] {
+		t.pass(group[0])
+		group, originals = group[1:], originals[1:]
+	}
+	var suffix []*Token
+	for len(group) > 0 && len(originals) > 0 && group[len(group)-1] == originals[len(originals)-1] {
+		suffix = append([]*Token{group[len(group)-1]}, suffix...)
+		group, originals = group[:len(group)-1], originals[:len(originals)-1]
+	}
+
+	t.replace(group, originals)
+
+	for _, token := range suffix {
+		t.pass(token)
+	}
+}
+
+// replace writes tokens created by filters, in place of the original tokens
+func (t *hwriter) replace(group, originals []*Token) {
+	var original strings.Builder
+	state := t.context.state
+	text := state == inText
+	t.context.markup = false
+	for _, token := range originals {
+		original.WriteString(token.Raw())
+		t.context.advance(token.Raw())
+		text = text && t.context.state == inText
+	}
+
+	switch state {
+	case inRawText, inComment:
+		// Don't touch scripts, styles or comments
+		t.writeString(original.String())
+		return
+	}
+
+	if t.context.markup {
+		// The filters replaced a tag (or part of one), so the replacement is markup
+		for _, token := range group {
+			t.writeString(token.String())
+		}
+		return
+	}
+
+	lemma := false
+	for _, token := range group {
+		if token.IsLemma() {
+			lemma = true
+			break
+		}
+	}
+
+	if !lemma {
+		// Unchanged text, such as the expansion of a contraction
+		for _, token := range group {
+			t.writeString(escape(token))
+		}
+		return
+	}
+
+	var canonical, content strings.Builder
+	for _, token := range group {
+		canonical.WriteString(token.String())
+		content.WriteString(escape(token))
+	}
+
+	if !text || t.Unwrapped {
+		// An attribute value, title or textarea, where markup is not allowed
+		t.writeString(content.String())
+		return
+	}
+
+	t.writeString("<" + t.Element)
+	t.writeAttribute("data-original", html.UnescapeString(original.String()))
+	t.writeAttribute("data-canonical", canonical.String())
+
+	keys := make([]string, 0, len(t.Attributes))
+	for key := range t.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		t.writeAttribute(key, t.Attributes[key])
+	}
+
+	t.writeString(">")
+	t.writeString(content.String())
+	t.writeString("</" + t.Element + ">")
+}
+
+// escape returns the token as HTML: its original text if it came from the document, otherwise its (escaped) value
+func escape(token *Token) string {
+	if token.raw != "" {
+		return token.raw
+	}
+	return html.EscapeString(token.String())
+}
+
+func (t *hwriter) writeAttribute(key, value string) {
+	t.writeString(" " + key + `="` + html.EscapeString(value) + `"`)
+}
+
+func (t *hwriter) writeString(s string) {
+	if t.err != nil {
+		return
+	}
+	n, err := t.writer.WriteString(s)
+	t.written += int64(n)
+	t.err = err
+}
+
+type htmlState int
+
+const (
+	inText htmlState = iota
+	// afterLT is following a < in text, which may or may not begin a tag
+	afterLT
+	inTag
+	inComment
+	// inRawText is the content of script or style (etc), where markup is not recognized
+	inRawText
+	// inRCData is the content of title or textarea, where markup is not recognized, but entities are
+	inRCData
+)
+
+// htmlContext is a minimal HTML state machine, to know where markup may be safely inserted
+type htmlContext struct {
+	state htmlState
+	// tag is the pending tag, from its <, while inTag
+	tag strings.Builder
+	// quote is the open quote of an attribute value, while inTag
+	quote byte
+	// equals indicates that an attribute value may follow
+	equals bool
+	// element is the element whose end tag will end raw text, e.g. script
+	element string
+	// pending is a possible end of raw text or comment, which might be split across tokens
+	pending string
+	// markup indicates that a tag or comment has begun, since it was last reset
+	markup bool
+}
+
+// advance updates the state by consuming s
+func (c *htmlContext) advance(s string) {
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+
+		switch c.state {
+		case inText:
+			if b == '<' {
+				c.state = afterLT
+			}
+		case afterLT:
+			// A tag begins with a letter, /, ! or ?; otherwise it's just text, e.g. a < b
+			isTag := b == '/' || b == '!' || b == '?' || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+			if !isTag {
+				c.state = inText
+				if b == '<' {
+					c.state = afterLT
+				}
+				continue
+			}
+			c.state = inTag
+			c.tag.Reset()
+			c.tag.WriteByte('<')
+			c.tag.WriteByte(b)
+			c.quote = 0
+			c.markup = true
+		case inTag:
+			c.tag.WriteByte(b)
+
+			if c.tag.Len() == 4 && c.tag.String() == "<!--" {
+				c.state = inComment
+				c.pending = ""
+				continue
+			}
+
+			switch {
+			case c.quote != 0:
+				if b == c.quote {
+					c.quote = 0
+				}
+			case (b == '"' || b == '\'') && c.equals:
+				c.quote = b
+			case b == '>':
+				c.endTag()
+			}
+
+			// Quotes only delimit a value following =, e.g. not <a title=don't>
+			if b == '=' {
+				c.equals = true
+			} else if b != ' ' && b != '\t' && b != '\n' && b != '\r' && b != '\f' {
+				c.equals = false
+			}
+		case inComment:
+			c.pending += string(b)
+			if strings.HasSuffix(c.pending, "-->") {
+				c.state = inText
+				c.pending = ""
+			} else if len(c.pending) > 2 {
+				c.pending = c.pending[len(c.pending)-2:]
+			}
+		case inRawText, inRCData:
+			if c.element == "plaintext" {
+				// Plaintext has no end, the rest of the document is its content
+				continue
+			}
+			c.pending += string(b)
+			end := "</" + c.element
+			if len(c.pending) > len(end) {
+				c.pending = c.pending[len(c.pending)-len(end):]
+			}
+			if strings.EqualFold(c.pending, end) {
+				c.state = inTag
+				c.tag.Reset()
+				c.tag.WriteString(c.pending)
+				c.quote = 0
+				c.pending = ""
+			}
+		}
+	}
+}
+
+// endTag determines the state following a tag
+func (c *htmlContext) endTag() {
+	c.state = inText
+
+	tag := c.tag.String()
+	if strings.HasPrefix(tag, "</") || strings.HasSuffix(tag, "/>") {
+		return
+	}
+
+	name := strings.TrimPrefix(tag, "<")
+	if i := strings.IndexAny(name, " \t\n\r\f/>"); i >= 0 {
+		name = name[:i]
+	}
+
+	name = strings.ToLower(name)
+	if rawText[name] {
+		c.state = inRawText
+		c.element = name
+		return
+	}
+
+	switch name {
+	case "title", "textarea":
+		c.state = inRCData
+		c.element = name
+	}
+}
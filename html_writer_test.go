@@ -0,0 +1,192 @@
+package jargon_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// railsFilter is a small stand-in for a synonyms filter, replacing "Rails" with the lemma "ruby-on-rails"
+func railsFilter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	return jargon.NewTokenStream(func() (*jargon.Token, error) {
+		token, err := incoming.Next()
+		if token != nil && token.String() == "Rails" {
+			return jargon.NewToken("ruby-on-rails", true), err
+		}
+		return token, err
+	})
+}
+
+func TestHTMLWriter(t *testing.T) {
+	h := `<html><head><title>Rails</title>
+<script>var Rails = "<b>";</script>
+<style>.Rails { color: red }</style></head>
+<body class='Rails'>
+<!-- Rails -->
+<p>We use Rails &amp; more Rails, 1 < 2.</p>
+<img alt="Rails logo" src="rails.png">
+<textarea>Rails</textarea>
+</body></html>`
+
+	expected := `<html><head><title>ruby-on-rails</title>
+<script>var Rails = "<b>";</script>
+<style>.Rails { color: red }</style></head>
+<body class='Rails'>
+<!-- Rails -->
+<p>We use <mark data-original="Rails" data-canonical="ruby-on-rails" class="lemma">ruby-on-rails</mark> &amp; more <mark data-original="Rails" data-canonical="ruby-on-rails" class="lemma">ruby-on-rails</mark>, 1 < 2.</p>
+<img alt="ruby-on-rails logo" src="rails.png">
+<textarea>ruby-on-rails</textarea>
+</body></html>`
+
+	hw := jargon.HTMLWriter{
+		Element:    "mark",
+		Attributes: map[string]string{"class": "lemma"},
+	}
+
+	opts := jargon.HTMLOptions{Attributes: []string{"alt"}}
+	tokens := jargon.TokenizeHTML(strings.NewReader(h), opts)
+
+	var b bytes.Buffer
+	n, err := hw.WriteHTML(&b, tokens, railsFilter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := b.String()
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+	if n != int64(len(got)) {
+		t.Errorf("expected %d bytes written, got %d", len(got), n)
+	}
+
+	// Without filters, the document is unchanged
+	b.Reset()
+	if _, err := hw.WriteHTML(&b, jargon.TokenizeHTML(strings.NewReader(h), opts)); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != h {
+		t.Errorf("expected:\n%s\ngot:\n%s", h, b.String())
+	}
+}
+
+func TestHTMLWriterRawText(t *testing.T) {
+	// Content of these elements is not markup, as in x/net/html, so is left alone
+	for _, name := range []string{"script", "style", "xmp", "iframe", "noembed", "noframes", "noscript"} {
+		h := `<p>Rails</p><` + name + `><b>Rails</b></` + name + `><p>Rails</p>`
+		expected := `<p><span data-original="Rails" data-canonical="ruby-on-rails">ruby-on-rails</span></p><` + name + `><b>Rails</b></` + name +
+			`><p><span data-original="Rails" data-canonical="ruby-on-rails">ruby-on-rails</span></p>`
+
+		var b bytes.Buffer
+		if _, err := (jargon.HTMLWriter{}).WriteHTML(&b, jargon.TokenizeHTML(strings.NewReader(h)), railsFilter); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != expected {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", name, expected, got)
+		}
+	}
+
+	// Plaintext has no end tag, the rest of the document is its content
+	h := `<p>Rails</p><plaintext><b>Rails</b></plaintext><p>Rails</p>`
+	expected := `<p><span data-original="Rails" data-canonical="ruby-on-rails">ruby-on-rails</span></p><plaintext><b>Rails</b></plaintext><p>Rails</p>`
+
+	var b bytes.Buffer
+	if _, err := (jargon.HTMLWriter{}).WriteHTML(&b, jargon.TokenizeHTML(strings.NewReader(h)), railsFilter); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != expected {
+		t.Errorf("plaintext: expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestHTMLWriterEscaping(t *testing.T) {
+	lemma := func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		return jargon.NewTokenStream(func() (*jargon.Token, error) {
+			token, err := incoming.Next()
+			if token != nil && token.String() == "evil" {
+				return jargon.NewToken(`<b onclick="x">`, true), err
+			}
+			return token, err
+		})
+	}
+
+	var b bytes.Buffer
+	_, err := jargon.HTMLWriter{}.WriteHTML(&b, jargon.TokenizeHTML(strings.NewReader("<p>an evil word</p>")), lemma)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<p>an <span data-original="evil" data-canonical="&lt;b onclick=&#34;x&#34;&gt;">&lt;b onclick=&#34;x&#34;&gt;</span> word</p>`
+	if got := b.String(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestHTMLWriterEntities(t *testing.T) {
+	// Entities are decoded for matching, and the original text is written where unchanged
+	synonyms := func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		return jargon.NewTokenStream(func() (*jargon.Token, error) {
+			token, err := incoming.Next()
+			if token != nil && token.String() == "Let’s" {
+				return jargon.NewToken("Let us", false), err
+			}
+			if token != nil && token.String() == "<" {
+				return jargon.NewToken("&", true), err
+			}
+			return token, err
+		})
+	}
+
+	h := `<p title="a &lt; b">Let&#8217;s&nbsp;go &lt; &amp;c</p>`
+	expected := `<p title="a &lt; b">Let us&nbsp;go <span data-original="&lt;" data-canonical="&amp;">&amp;</span> &amp;c</p>`
+
+	var b bytes.Buffer
+	_, err := jargon.HTMLWriter{}.WriteHTML(&b, jargon.TokenizeHTML(strings.NewReader(h)), synonyms)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := b.String(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestHTMLWriterUnwrapped(t *testing.T) {
+	// A stand-in for a folding filter, such as ascii.Fold
+	fold := func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		return jargon.NewTokenStream(func() (*jargon.Token, error) {
+			token, err := incoming.Next()
+			if token != nil {
+				s := strings.NewReplacer("“", `"`, "”", `"`, "é", "e", "＜", "<", "＞", ">").Replace(token.String())
+				if s != token.String() {
+					return jargon.NewToken(s, true), err
+				}
+			}
+			return token, err
+		})
+	}
+
+	type test struct {
+		given, expected string
+	}
+	tests := []test{
+		{`<p>&#xFF1C;script&#xFF1E;alert(1)&#xFF1C;/script&#xFF1E;</p>`, `<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>`},
+		{`<img alt="&ldquo;hi&rdquo;">`, `<img alt="&#34;hi&#34;">`},
+		// A folded tag is markup, and is not escaped
+		{`<img src="café.png" alt="café"><p>café</p>`, `<img src="cafe.png" alt="cafe"><p>cafe</p>`},
+	}
+
+	hw := jargon.HTMLWriter{Unwrapped: true}
+	opts := jargon.HTMLOptions{Attributes: []string{"alt"}}
+	for _, test := range tests {
+		var b bytes.Buffer
+		if _, err := hw.WriteHTML(&b, jargon.TokenizeHTML(strings.NewReader(test.given), opts), fold); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != test.expected {
+			t.Errorf("given %s, expected %s, got %s", test.given, test.expected, got)
+		}
+	}
+}
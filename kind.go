@@ -0,0 +1,176 @@
+package jargon
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/uax29/words"
+)
+
+// Kind is the classification of a token, such as Word, Number or URL. It is determined by the tokenizer,
+// and may be refined by filters, for example a Hashtag.
+type Kind uint8
+
+const (
+	// Word is the default kind, for tokens that are not otherwise classified
+	Word Kind = iota
+	// Space is a token consisting entirely of white space
+	Space
+	// Punct is a token consisting entirely of punctuation
+	Punct
+	// Number is a numeric token, such as 123.456 or 1,000
+	Number
+	// URL is a token which looks like a web address, such as https://example.com or www.example.com
+	URL
+	// Email is a token which looks like an email address, such as me@example.com
+	Email
+	// Emoji is a token consisting of emoji, including modifiers and joiners
+	Emoji
+	// Symbol is a token consisting of symbols (not punctuation), such as $ or +
+	Symbol
+	// Ideographic is a token consisting of Han, Hiragana or Katakana characters
+	Ideographic
+	// Hashtag is a token such as #sometag, identified by a filter, see the twitter package
+	Hashtag
+	// Mention is a token such as @somename, identified by a filter, see the twitter and social packages
+	Mention
+	// Cashtag is a token such as $AAPL, identified by a filter, see the social package
+	Cashtag
+)
+
+var kinds = [...]string{
+	Word:        "Word",
+	Space:       "Space",
+	Punct:       "Punct",
+	Number:      "Number",
+	URL:         "URL",
+	Email:       "Email",
+	Emoji:       "Emoji",
+	Symbol:      "Symbol",
+	Ideographic: "Ideographic",
+	Hashtag:     "Hashtag",
+	Mention:     "Mention",
+	Cashtag:     "Cashtag",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kinds) {
+		return kinds[k]
+	}
+	return "Unknown"
+}
+
+// classify determines the kind of a (non-space, non-punct) token
+func classify(s string) Kind {
+	var emoji, symbol = true, true
+	for _, r := range s {
+		if emoji && !isEmoji(r) {
+			emoji = false
+		}
+		if symbol && !unicode.IsSymbol(r) {
+			symbol = false
+		}
+		if !emoji && !symbol {
+			break
+		}
+	}
+
+	switch {
+	case emoji && strings.IndexFunc(s, isPictographic) >= 0:
+		return Emoji
+	case symbol:
+		return Symbol
+	case words.BleveNumeric([]byte(s)):
+		return Number
+	case isURL(s):
+		return URL
+	case isEmail(s):
+		return Email
+	case words.BleveIdeographic([]byte(s)):
+		return Ideographic
+	}
+
+	return Word
+}
+
+func isURL(s string) bool {
+	if i := strings.Index(s, "://"); i > 0 {
+		// Scheme must be letters, e.g. http, https, ftp
+		for _, r := range s[:i] {
+			if !('a' <= r && r <= 'z') && !('A' <= r && r <= 'Z') {
+				return false
+			}
+		}
+		return len(s) > i+len("://")
+	}
+
+	const www = "www."
+	return len(s) > len(www) && strings.EqualFold(s[:len(www)], www)
+}
+
+func isEmail(s string) bool {
+	at := strings.IndexByte(s, '@')
+	if at < 1 || at != strings.LastIndexByte(s, '@') {
+		return false
+	}
+
+	domain := s[at+1:]
+	dot := strings.IndexByte(domain, '.')
+	return dot > 0 && dot < len(domain)-1
+}
+
+func isEmoji(r rune) bool {
+	return isPictographic(r) || unicode.Is(emojiComponents, r)
+}
+
+func isPictographic(r rune) bool {
+	return unicode.Is(pictographic, r)
+}
+
+// pictographic is an approximation of Unicode's Extended_Pictographic property, which
+// is not available in the unicode package. © ® and ™ are omitted, they are better understood as symbols.
+var pictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+}
+
+// emojiComponents are runes which modify or join emoji, but are not emoji on their own
+var emojiComponents = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x200d, Hi: 0x200d, Stride: 1}, // zero width joiner
+		{Lo: 0x20e3, Hi: 0x20e3, Stride: 1}, // combining enclosing keycap
+		{Lo: 0xfe0e, Hi: 0xfe0f, Stride: 1}, // variation selectors
+	},
+	R32: []unicode.Range32{
+		{Lo: 0xe0020, Hi: 0xe007f, Stride: 1}, // tags
+	},
+}
@@ -8,6 +8,12 @@ import (
 type Token struct {
 	value               string
 	punct, space, lemma bool
+	keyword, verbatim   bool
+	kind                Kind
+	// gap is the number of positions removed (by filters) preceding this token; see PositionIncrement
+	gap int
+	// raw is the original text of the token, if it differs from value, e.g. escaped HTML; see Raw
+	raw string
 }
 
 // String is the string value of the token
@@ -15,6 +21,17 @@ func (t *Token) String() string {
 	return t.value
 }
 
+// Raw is the original text of the token in the source document, where it differs from String. For example, TokenizeHTML
+// decodes entities, so a token's String might be "AT&T", while its Raw is "AT&amp;T". For most tokens, Raw is the same as String.
+//
+// To reproduce a document, write the Raw of each token. Tokens created by filters have no Raw other than their value.
+func (t *Token) Raw() string {
+	if t.raw != "" {
+		return t.raw
+	}
+	return t.value
+}
+
 // IsPunct indicates that the token should be considered 'breaking' of a run of words. Mostly uses
 // Unicode's definition of punctuation, with some exceptions for our purposes.
 func (t *Token) IsPunct() bool {
@@ -33,7 +50,77 @@ func (t *Token) IsLemma() bool {
 	return t.lemma
 }
 
-// NewToken creates a new token, and calculates whether the token is space or punct.
+// IsKeyword indicates that the token is protected from modification by subsequent filters, such as stemmers.
+// Canonical terms from the synonyms (and stackoverflow) filters are keywords; see also the keywords package.
+func (t *Token) IsKeyword() bool {
+	return t.keyword
+}
+
+// WithKeyword returns a token with the same value as t, marked (or unmarked) as a keyword. See IsKeyword.
+func (t *Token) WithKeyword(keyword bool) *Token {
+	if t.keyword == keyword {
+		return t
+	}
+
+	token := t.clone()
+	token.keyword = keyword
+	return token
+}
+
+// IsVerbatim indicates that the token is text which should be kept as written, such as code within HTML or Markdown (see
+// HTMLOptions.Verbatim). Verbatim tokens are also keywords. Unlike other keywords, they interrupt multi-word synonyms.
+func (t *Token) IsVerbatim() bool {
+	return t.verbatim
+}
+
+// WithVerbatim returns a token with the same value as t, marked (or unmarked) as verbatim. See IsVerbatim.
+func (t *Token) WithVerbatim(verbatim bool) *Token {
+	if t.verbatim == verbatim {
+		return t
+	}
+
+	token := t.clone()
+	token.verbatim = verbatim
+	return token
+}
+
+// clone copies the token, to avoid mutating a shared (e.g. common) token
+func (t *Token) clone() *Token {
+	token := *t
+	return &token
+}
+
+// Kind is the classification of the token, such as Word, Number or URL.
+func (t *Token) Kind() Kind {
+	return t.kind
+}
+
+// PositionIncrement is the number of positions from the previous word to this token, for use by phrase and proximity logic.
+// It is 1 by default. A greater value indicates that words were removed by a filter (such as stopwords), i.e. a gap.
+//
+// Only words carry increments; space and punct tokens do not occupy positions.
+func (t *Token) PositionIncrement() int {
+	return t.gap + 1
+}
+
+// WithPositionIncrement returns a token with the same value as t, with the specified position increment. Filters which remove
+// words should use it to record the gap on the next word; see TokenStream.Where for an example.
+func (t *Token) WithPositionIncrement(increment int) *Token {
+	if t.PositionIncrement() == increment {
+		return t
+	}
+
+	token := t.clone()
+	token.gap = increment - 1
+	return token
+}
+
+// isWord indicates that a token occupies a position, i.e. is not space or punct
+func (t *Token) isWord() bool {
+	return !t.punct && !t.space
+}
+
+// NewToken creates a new token, and calculates whether the token is space or punct, and its Kind.
 func NewToken(s string, isLemma bool) *Token {
 	token, found := common[s][isLemma]
 
@@ -69,14 +156,38 @@ func NewToken(s string, isLemma bool) *Token {
 		}
 	}
 
+	var kind Kind
+	switch {
+	case space:
+		kind = Space
+	case punct:
+		kind = Punct
+	default:
+		kind = classify(s)
+	}
+
 	return &Token{
 		value: s,
 		punct: punct,
 		space: space,
 		lemma: isLemma,
+		kind:  kind,
 	}
 }
 
+// NewTokenOfKind creates a new token of the specified Kind, for filters which know more about a token than
+// NewToken can determine, for example a Hashtag. Whether the token is space or punct is calculated as for NewToken.
+func NewTokenOfKind(s string, isLemma bool, kind Kind) *Token {
+	token := NewToken(s, isLemma)
+	if token == nil || token.kind == kind {
+		return token
+	}
+
+	token = token.clone()
+	token.kind = kind
+	return token
+}
+
 var common = make(map[string]map[bool]*Token)
 
 func init() {
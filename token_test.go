@@ -0,0 +1,97 @@
+package jargon
+
+import (
+	"testing"
+)
+
+func TestKind(t *testing.T) {
+	type test struct {
+		value string
+		kind  Kind
+	}
+
+	tests := []test{
+		{"hello", Word},
+		{"node.js", Word},
+		{"a16z", Word},
+		{"3G", Word},
+		{"ש״ח", Word},
+
+		{" ", Space},
+		{"\n", Space},
+		{"\r\n", Space},
+
+		{".", Punct},
+		{"-", Punct},
+		{"'", Punct},
+
+		{"123", Number},
+		{"123.456", Number},
+		{"1,000", Number},
+		{"200.13", Number},
+
+		{"https://github.com/clipperhouse/jargon", URL},
+		{"http://example.com", URL},
+		{"www.example.com", URL},
+		{"http://", Word},
+
+		{"my.name@domain.com", Email},
+		{"me@localhost", Word},
+		{"@domain.com", Word},
+
+		{"😀", Emoji},
+		{"👍🏽", Emoji},
+		{"👩‍💻", Emoji},
+		{"🇺🇸", Emoji},
+
+		{"$", Symbol},
+		{"+", Symbol},
+		{"©", Symbol},
+
+		{"象", Ideographic},
+		{"ウィキペディア", Ideographic},
+	}
+
+	for _, test := range tests {
+		got := NewToken(test.value, false).Kind()
+		if got != test.kind {
+			t.Errorf("expected %q to be of kind %s, got %s", test.value, test.kind, got)
+		}
+	}
+}
+
+func TestKindTokenize(t *testing.T) {
+	text := "Pay $200.13 to 象形 😀"
+	expected := []Kind{Word, Space, Symbol, Number, Space, Word, Space, Ideographic, Ideographic, Space, Emoji}
+
+	tokens, err := TokenizeString(text).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d: %q", len(expected), len(tokens), tokens)
+	}
+
+	for i, token := range tokens {
+		if token.Kind() != expected[i] {
+			t.Errorf("expected %q to be of kind %s, got %s", token, expected[i], token.Kind())
+		}
+	}
+}
+
+func TestNewTokenOfKind(t *testing.T) {
+	token := NewTokenOfKind("#tag", true, Hashtag)
+	if token.Kind() != Hashtag {
+		t.Errorf("expected %q to be of kind %s, got %s", token, Hashtag, token.Kind())
+	}
+	if !token.IsLemma() {
+		t.Errorf("expected %q to be a lemma", token)
+	}
+
+	// Should not affect common (cached) tokens
+	token = NewTokenOfKind("a", false, Mention)
+	if NewToken("a", false).Kind() != Word {
+		t.Errorf("expected common token %q to remain of kind %s", token, Word)
+	}
+}
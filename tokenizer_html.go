@@ -1,30 +1,91 @@
 package jargon
 
 import (
+	"fmt"
 	"io"
+	"regexp"
+	"strings"
 
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 )
 
+// HTMLOptions configures TokenizeHTML
+type HTMLOptions struct {
+	// Attributes are the names of attributes whose values are tokenized as text, such as alt or title; see DefaultHTMLAttributes.
+	// The content attribute of a meta element is only tokenized if the element describes the page, e.g. name="description".
+	Attributes []string
+	// Verbatim are selectors for elements whose contents are not tokenized, such as code or pre; see DefaultHTMLVerbatim.
+	// A selector is an element name, a .class, an [attribute] or [attribute=value], or a combination, such as pre.highlight.
+	Verbatim []string
+}
+
+// DefaultHTMLAttributes are attributes which contain human-readable text
+var DefaultHTMLAttributes = []string{"alt", "title", "aria-label", "placeholder", "content"}
+
+// DefaultHTMLVerbatim are elements which typically contain code, or text which should not be altered
+var DefaultHTMLVerbatim = []string{"code", "pre", "kbd", "samp", "[translate=no]"}
+
 // TokenizeHTML tokenizes HTML. Text nodes are tokenized using jargon.Tokenize; everything else (tags, comments) are left verbatim.
 // It returns a Tokens, intended to be iterated over by calling Next(), until nil.
 // It returns all tokens (including white space), so text can be reconstructed with fidelity. Ignoring (say) whitespace is a decision for the caller.
-func TokenizeHTML(r io.Reader) *TokenStream {
+//
+// Entities in text, such as &amp; or &#8217;, are decoded, so that filters see AT&T or Let’s. The original text is retained
+// for round-tripping; see Token.Raw.
+//
+// Optionally, pass HTMLOptions to tokenize the text of selected attributes, e.g. alt. The rest of the tag is returned as punct tokens,
+// so the tag is reproduced exactly.
+//
+// Text within verbatim elements (see HTMLOptions.Verbatim), including nested elements, is returned whole, one token per text node,
+// marked as verbatim (and as a keyword) so that subsequent filters leave it alone. Their attributes are not tokenized.
+func TokenizeHTML(r io.Reader, opts ...HTMLOptions) *TokenStream {
 	t := &htokenizer{
 		htokenizer: html.NewTokenizer(r),
 	}
+
+	for _, opt := range opts {
+		for _, attr := range opt.Attributes {
+			if t.attributes == nil {
+				t.attributes = make(map[string]bool)
+			}
+			t.attributes[strings.ToLower(attr)] = true
+		}
+		for _, v := range opt.Verbatim {
+			sel, err := parseSelector(v)
+			if err != nil {
+				// Surface the error on the first call to Next
+				return NewTokenStream(func() (*Token, error) {
+					return nil, err
+				})
+			}
+			t.verbatim = append(t.verbatim, sel)
+		}
+	}
+
 	return NewTokenStream(t.next)
 }
 
 type htokenizer struct {
 	htokenizer *html.Tokenizer
 	ttokens    *TokenStream
-	parent     atom.Atom
+	// stack is the open elements, innermost last
+	stack []helement
+	// attributes to be tokenized as text
+	attributes map[string]bool
+	// verbatim are selectors for elements whose contents are not tokenized
+	verbatim []selector
+	// buffer is tokens from a tag with tokenized attributes, waiting to go out
+	buffer []*Token
 }
 
 // next is the implementation of the Tokens interface. To iterate, call until it returns nil
 func (t *htokenizer) next() (*Token, error) {
+	if len(t.buffer) > 0 {
+		token := t.buffer[0]
+		t.buffer = t.buffer[1:]
+		return token, nil
+	}
+
 	// Are we "inside" a text node?
 	if t.ttokens != nil {
 		ttoken, err := t.ttokens.Next()
@@ -50,39 +111,448 @@ func (t *htokenizer) next() (*Token, error) {
 		return nil, err
 	}
 
+	// Raw must be copied before calling Token
+	raw := string(t.htokenizer.Raw())
 	htoken := t.htokenizer.Token()
 
 	switch htoken.Type {
-	case html.StartTagToken:
-		// Record that we are entering script or style blocks; don't tokenize text
-		if htoken.DataAtom == atom.Script || htoken.DataAtom == atom.Style {
-			t.parent = htoken.DataAtom
+	case html.StartTagToken, html.SelfClosingTagToken:
+		verbatim := t.inVerbatim() || t.isVerbatim(htoken)
+		if htoken.Type == html.StartTagToken && !void[htoken.DataAtom] {
+			t.stack = append(t.stack, helement{
+				name:     htoken.Data,
+				verbatim: verbatim,
+			})
+		}
+
+		if t.attributes != nil && !verbatim && t.hasAttributes(htoken) {
+			tokens, err := t.tokenizeAttributes(raw, htoken)
+			if err != nil {
+				return nil, err
+			}
+			t.buffer = tokens
+			return t.next()
 		}
 	case html.TextToken:
-		switch t.parent {
-		case atom.Script, atom.Style:
-			// Don't tokenize script and style blocks, just return as one big string
+		if t.inRawText() {
+			// Don't tokenize script and style blocks (etc), just return as one big string
 			token := &Token{
-				value: htoken.String(),
+				value: raw,
 				punct: false,
 				space: false,
+				kind:  Word,
 			}
 			return token, nil
-		default:
-			t.ttokens = TokenizeString(htoken.String())
-			return t.ttokens.Next()
 		}
+		if t.inVerbatim() {
+			// Keep it whole, and protect it from filters
+			token := &Token{
+				value:    html.UnescapeString(raw),
+				kind:     Word,
+				keyword:  true,
+				verbatim: true,
+			}
+			if token.value != raw {
+				token.raw = raw
+			}
+			return token, nil
+		}
+		t.ttokens = tokenizeEscaped(raw)
+		return t.ttokens.Next()
 	case html.EndTagToken:
-		if htoken.DataAtom == t.parent {
-			htoken.DataAtom = 0
+		// Close the nearest matching element, and any unclosed elements within it; a stray end tag is ignored
+		for i := len(t.stack) - 1; i >= 0; i-- {
+			if t.stack[i].name == htoken.Data {
+				t.stack = t.stack[:i]
+				break
+			}
 		}
 	}
 
 	// Everything else is punct for our purposes
 	token := &Token{
-		value: htoken.String(),
+		value: raw,
 		punct: true,
 		space: false,
+		kind:  Punct,
 	}
 	return token, nil
 }
+
+// tokenizeEscaped tokenizes HTML text, decoding entities such as &amp; for the tokens' values, while retaining
+// the original (escaped) text as Raw
+func tokenizeEscaped(raw string) *TokenStream {
+	decoded, offsets := unescape(raw)
+	if decoded == raw {
+		return TokenizeString(raw)
+	}
+
+	tokens := TokenizeString(decoded)
+	start := 0 // offset of the token in decoded
+	next := func() (*Token, error) {
+		token, err := tokens.Next()
+		if token == nil || err != nil {
+			return token, err
+		}
+
+		end := start + len(token.value)
+		original := raw[offsets[start]:offsets[end]]
+		start = end
+
+		if original == token.value {
+			return token, nil
+		}
+		token = token.clone()
+		token.raw = original
+		return token, nil
+	}
+
+	return NewTokenStream(next)
+}
+
+// unescape decodes entities in s, such as &amp; or &#8217;. It returns the decoded string, and for each of its byte offsets
+// (and its length), the corresponding offset in s. An offset within a decoded entity maps to the start of the entity.
+func unescape(s string) (string, []int) {
+	if !strings.Contains(s, "&") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	offsets := make([]int, 0, len(s)+1)
+
+	for i := 0; i < len(s); {
+		if s[i] == '&' {
+			if entity := charRef.FindString(s[i:]); entity != "" {
+				if decoded := html.UnescapeString(entity); decoded != entity && !partial(decoded) {
+					b.WriteString(decoded)
+					for len(offsets) < b.Len() {
+						offsets = append(offsets, i)
+					}
+					i += len(entity)
+					continue
+				}
+			}
+		}
+
+		b.WriteByte(s[i])
+		offsets = append(offsets, i)
+		i++
+	}
+	offsets = append(offsets, len(s))
+
+	return b.String(), offsets
+}
+
+// partial determines whether a decoded character reference was only partly decoded. html.UnescapeString decodes
+// the longest legacy entity that prefixes a name it does not know, such as &not in &notanentity;, and leaves the rest
+// of the name and the semicolon as they were. A fully decoded reference never ends in a name character and a semicolon.
+func partial(decoded string) bool {
+	n := len(decoded)
+	if n < 2 || decoded[n-1] != ';' {
+		return false
+	}
+	c := decoded[n-2]
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// charRef is a single, well-formed character reference: &name;, &#NN; or &#xHH;
+var charRef = regexp.MustCompile(`^&(?:[A-Za-z][A-Za-z0-9]{0,31}|#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6});`)
+
+// helement is an open element
+type helement struct {
+	name string
+	// verbatim indicates that the element, or an ancestor, is verbatim
+	verbatim bool
+}
+
+// void elements have no end tag, so are not pushed onto the stack
+var void = map[atom.Atom]bool{
+	atom.Area: true, atom.Base: true, atom.Br: true, atom.Col: true, atom.Embed: true, atom.Hr: true, atom.Img: true,
+	atom.Input: true, atom.Link: true, atom.Meta: true, atom.Param: true, atom.Source: true, atom.Track: true, atom.Wbr: true,
+}
+
+// rawText elements' content is not parsed as markup, or entities, as in x/net/html's tokenizer. It is shared by TokenizeHTML
+// and HTMLWriter. Title and textarea are not here: their entities are decoded, so their text is tokenized.
+var rawText = map[string]bool{
+	"iframe": true, "noembed": true, "noframes": true, "noscript": true, "plaintext": true, "script": true, "style": true, "xmp": true,
+}
+
+// inRawText determines whether we are inside a raw text element, such as script or style
+func (t *htokenizer) inRawText() bool {
+	if len(t.stack) == 0 {
+		return false
+	}
+	name := t.stack[len(t.stack)-1].name
+	return rawText[name]
+}
+
+// inVerbatim determines whether we are inside a verbatim element
+func (t *htokenizer) inVerbatim() bool {
+	return len(t.stack) > 0 && t.stack[len(t.stack)-1].verbatim
+}
+
+// isVerbatim determines whether the element matches any of the verbatim selectors
+func (t *htokenizer) isVerbatim(htoken html.Token) bool {
+	for _, sel := range t.verbatim {
+		if sel.matches(htoken) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAttributes determines whether the tag has any attributes to be tokenized
+func (t *htokenizer) hasAttributes(htoken html.Token) bool {
+	for _, attr := range htoken.Attr {
+		if t.tokenizes(htoken, attr.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenizes determines whether the value of the attribute should be tokenized as text
+func (t *htokenizer) tokenizes(htoken html.Token, key string) bool {
+	if !t.attributes[key] {
+		return false
+	}
+	if key == "content" {
+		return htoken.DataAtom == atom.Meta && describes(htoken)
+	}
+	return true
+}
+
+// describes determines whether a meta element describes the page, e.g. name="description" or property="og:title"
+func describes(htoken html.Token) bool {
+	for _, attr := range htoken.Attr {
+		if attr.Key != "name" && attr.Key != "property" {
+			continue
+		}
+		switch name := strings.ToLower(attr.Val); {
+		case name == "description", name == "keywords", name == "title",
+			strings.HasSuffix(name, ":description"), strings.HasSuffix(name, ":title"):
+			return true
+		}
+	}
+	return false
+}
+
+// tokenizeAttributes splits a raw tag into punct tokens, and text tokens for the values of selected attributes.
+// It scans the raw tag, rather than using the parsed attributes, in order to reproduce it exactly.
+func (t *htokenizer) tokenizeAttributes(raw string, htoken html.Token) ([]*Token, error) {
+	var result []*Token
+	punct := func(s string) {
+		if s != "" {
+			result = append(result, &Token{
+				value: s,
+				punct: true,
+				kind:  Punct,
+			})
+		}
+	}
+
+	start := 0 // the start of pending punct
+	for _, a := range scanAttributes(raw) {
+		if a.value == "" || !t.tokenizes(htoken, strings.ToLower(a.key)) {
+			continue
+		}
+
+		punct(raw[start:a.start])
+		tokens, err := tokenizeEscaped(a.value).ToSlice()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tokens...)
+		start = a.start + len(a.value)
+	}
+	punct(raw[start:])
+
+	return result, nil
+}
+
+// rawAttribute is an attribute of a raw tag, where start is the offset of its (raw, unquoted) value
+type rawAttribute struct {
+	key, value string
+	start      int
+}
+
+// scanAttributes finds the attributes in a raw tag, following the HTML tokenization rules for attribute names and values
+func scanAttributes(raw string) []rawAttribute {
+	var result []rawAttribute
+
+	isSpace := func(c byte) bool {
+		return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+	}
+
+	// Skip the tag name
+	i := 1
+	for i < len(raw) && !isSpace(raw[i]) && raw[i] != '/' && raw[i] != '>' {
+		i++
+	}
+
+	for i < len(raw) {
+		// Skip white space and stray slashes
+		for i < len(raw) && (isSpace(raw[i]) || raw[i] == '/') {
+			i++
+		}
+		if i >= len(raw) || raw[i] == '>' {
+			break
+		}
+
+		// Name; a leading = is part of the name
+		keyStart := i
+		i++
+		for i < len(raw) && !isSpace(raw[i]) && raw[i] != '/' && raw[i] != '>' && raw[i] != '=' {
+			i++
+		}
+		attr := rawAttribute{key: raw[keyStart:i]}
+
+		for i < len(raw) && isSpace(raw[i]) {
+			i++
+		}
+		if i >= len(raw) || raw[i] != '=' {
+			// No value
+			result = append(result, attr)
+			continue
+		}
+		i++
+		for i < len(raw) && isSpace(raw[i]) {
+			i++
+		}
+
+		// Value: quoted, or unquoted to white space or >
+		if i < len(raw) && (raw[i] == '"' || raw[i] == '\'') {
+			quote := raw[i]
+			i++
+			attr.start = i
+			for i < len(raw) && raw[i] != quote {
+				i++
+			}
+			attr.value = raw[attr.start:i]
+			i++
+		} else {
+			attr.start = i
+			for i < len(raw) && !isSpace(raw[i]) && raw[i] != '>' {
+				i++
+			}
+			attr.value = raw[attr.start:i]
+		}
+
+		result = append(result, attr)
+	}
+
+	return result
+}
+
+// selector is a simple CSS-like selector, such as pre, .highlight, [translate=no] or pre.highlight
+type selector struct {
+	element    string
+	classes    []string
+	attributes []attributeSelector
+}
+
+// attributeSelector is [key] or [key=value]
+type attributeSelector struct {
+	key, value string
+	hasValue   bool
+}
+
+// parseSelector parses an element name, followed by any number of .class and [attribute] or [attribute=value] conditions
+func parseSelector(s string) (selector, error) {
+	var sel selector
+	rest := strings.TrimSpace(s)
+
+	i := strings.IndexAny(rest, ".[")
+	if i < 0 {
+		i = len(rest)
+	}
+	sel.element = strings.ToLower(rest[:i])
+	rest = rest[i:]
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			i := strings.IndexAny(rest, ".[")
+			if i < 0 {
+				i = len(rest)
+			}
+			if i == 0 {
+				return sel, fmt.Errorf("invalid selector %q: empty class", s)
+			}
+			sel.classes = append(sel.classes, rest[:i])
+			rest = rest[i:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return sel, fmt.Errorf("invalid selector %q: missing ]", s)
+			}
+			var attr attributeSelector
+			key, value, found := strings.Cut(rest[1:end], "=")
+			attr.key = strings.ToLower(strings.TrimSpace(key))
+			if attr.key == "" {
+				return sel, fmt.Errorf("invalid selector %q: empty attribute", s)
+			}
+			if found {
+				value = strings.TrimSpace(value)
+				if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+					value = value[1 : len(value)-1]
+				}
+				attr.value, attr.hasValue = value, true
+			}
+			sel.attributes = append(sel.attributes, attr)
+			rest = rest[end+1:]
+		}
+	}
+
+	if sel.element == "" && sel.classes == nil && sel.attributes == nil {
+		return sel, fmt.Errorf("invalid selector %q", s)
+	}
+	if strings.ContainsAny(sel.element, " \t\n>+~*") {
+		return sel, fmt.Errorf("invalid selector %q: only simple selectors are supported", s)
+	}
+
+	return sel, nil
+}
+
+// matches determines whether the element matches all of the selector's conditions
+func (sel selector) matches(htoken html.Token) bool {
+	if sel.element != "" && sel.element != htoken.Data {
+		return false
+	}
+
+	attr := func(key string) (string, bool) {
+		for _, a := range htoken.Attr {
+			if a.Key == key {
+				return a.Val, true
+			}
+		}
+		return "", false
+	}
+
+	if sel.classes != nil {
+		class, _ := attr("class")
+		names := strings.Fields(class)
+		for _, c := range sel.classes {
+			found := false
+			for _, name := range names {
+				if name == c {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	for _, a := range sel.attributes {
+		value, found := attr(a.key)
+		if !found || (a.hasValue && value != a.value) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -1,6 +1,8 @@
 package jargon_test
 
 import (
+	"bytes"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -55,3 +57,236 @@ Hi! Let's talk Ruby on Rails.
 		}
 	}
 }
+
+func TestTokenizeHTMLAttributes(t *testing.T) {
+	h := `<html><head>
+<meta name="description" content="Learn Ruby on Rails">
+<meta name="viewport" content="width=device-width">
+</head>
+<body>
+<img src="rails.png" alt='Ruby on Rails logo' title=Rails data-x="a b">
+<button aria-label = "Close dialog" disabled>X</button>
+</body></html>`
+
+	opts := jargon.HTMLOptions{
+		Attributes: jargon.DefaultHTMLAttributes,
+	}
+	tokens, err := jargon.TokenizeHTML(strings.NewReader(h), opts).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.String())
+	}
+	if b.String() != h {
+		t.Errorf("expected round trip of %q, got %q", h, b.String())
+	}
+
+	got := map[string]*jargon.Token{}
+	for _, token := range tokens {
+		got[token.String()] = token
+	}
+
+	words := []string{"Learn", "Ruby", "on", "Rails", "logo", "Close", "dialog", "X"}
+	for _, s := range words {
+		token, found := got[s]
+		if !found {
+			t.Errorf("expected to find word %q", s)
+			continue
+		}
+		if token.IsPunct() {
+			t.Errorf("expected %q not to be punct", s)
+		}
+	}
+
+	punct := []string{
+		`<meta name="description" content="`,
+		`<meta name="viewport" content="width=device-width">`,
+		`<img src="rails.png" alt='`,
+		`' title=`,
+		` data-x="a b">`,
+		`<button aria-label = "`,
+		`" disabled>`,
+	}
+	for _, s := range punct {
+		token, found := got[s]
+		if !found {
+			t.Errorf("expected to find punct %q", s)
+			continue
+		}
+		if !token.IsPunct() {
+			t.Errorf("expected %q to be punct", s)
+		}
+	}
+
+	// Without options, tags are whole
+	tokens, err = jargon.TokenizeHTML(strings.NewReader(h)).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, token := range tokens {
+		if token.String() == `<img src="rails.png" alt='Ruby on Rails logo' title=Rails data-x="a b">` {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected tags to be whole without options")
+	}
+}
+
+func TestTokenizeHTMLVerbatim(t *testing.T) {
+	h := `<p>Use Rails <code>Rails.new</code>, or</p>
+<pre><b>Rails</b>
+  rails new</pre>
+<p translate="no">Rails</p><div class="x snippet"><span>Rails</span><br>Rails</div>
+<p>Rails <em>Rails</em></p></div>`
+
+	opts := jargon.HTMLOptions{
+		Verbatim: append(jargon.DefaultHTMLVerbatim, "div.snippet"),
+	}
+	tokens, err := jargon.TokenizeHTML(strings.NewReader(h), opts).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.String())
+	}
+	if b.String() != h {
+		t.Errorf("expected round trip to be %q, got %q", h, b.String())
+	}
+
+	var verbatim, words []string
+	for _, token := range tokens {
+		if token.IsPunct() || token.IsSpace() {
+			continue
+		}
+		if token.IsVerbatim() && token.IsKeyword() {
+			verbatim = append(verbatim, token.String())
+			continue
+		}
+		words = append(words, token.String())
+	}
+
+	expectedVerbatim := []string{"Rails.new", "Rails", "\n  rails new", "Rails", "Rails", "Rails"}
+	if !reflect.DeepEqual(verbatim, expectedVerbatim) {
+		t.Errorf("expected verbatim tokens %q, got %q", expectedVerbatim, verbatim)
+	}
+
+	// Nesting is respected, and the stray </div> is ignored
+	expectedWords := []string{"Use", "Rails", "or", "Rails", "Rails"}
+	if !reflect.DeepEqual(words, expectedWords) {
+		t.Errorf("expected words %q, got %q", expectedWords, words)
+	}
+}
+
+func TestTokenizeHTMLSelectors(t *testing.T) {
+	invalid := []string{"", ".", "pre.", "[", "[=no]", "div p"}
+	for _, s := range invalid {
+		opts := jargon.HTMLOptions{
+			Verbatim: []string{s},
+		}
+		_, err := jargon.TokenizeHTML(strings.NewReader("<p>Hi</p>"), opts).ToSlice()
+		if err == nil {
+			t.Errorf("expected an error for selector %q", s)
+		}
+	}
+}
+
+func TestTokenizeHTMLEntities(t *testing.T) {
+	h := `<p title="AT&amp;T">Let&#8217;s talk AT&amp;T&nbsp;&amp; &lt;b&gt; &bogus; & <code>a &lt; b</code></p>`
+
+	opts := jargon.HTMLOptions{
+		Attributes: []string{"title"},
+		Verbatim:   []string{"code"},
+	}
+	tokens, err := jargon.TokenizeHTML(strings.NewReader(h), opts).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip, using the raw text
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.Raw())
+	}
+	if b.String() != h {
+		t.Errorf("expected round trip to be %q, got %q", h, b.String())
+	}
+
+	type pair struct {
+		value, raw string
+	}
+	var got []pair
+	for _, token := range tokens {
+		if token.String() != token.Raw() {
+			got = append(got, pair{token.String(), token.Raw()})
+		}
+	}
+
+	expected := []pair{
+		{"&", "&amp;"}, // title
+		{"Let’s", "Let&#8217;s"},
+		{"&", "&amp;"},
+		{"\u00a0", "&nbsp;"},
+		{"&", "&amp;"},
+		{"<", "&lt;"},
+		{">", "&gt;"},
+		{"a < b", "a &lt; b"}, // verbatim
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected decoded tokens %q, got %q", expected, got)
+	}
+}
+
+func TestTokenizeHTMLRoundTrip(t *testing.T) {
+	// Stray ampersands are not the start of an entity
+	docs := []string{
+		`<p>Tom & Jerry &mdash; friends</p>`,
+		`<p title="Tom & Jerry &mdash; friends">AT&amp T; ok</p>`,
+		`<p>&#x2014; &#8212 &amp;&amp; &#; &x; &;</p>`,
+		// Not entities, though they begin with legacy entities which may be written without a semicolon
+		`<p>a &notanentity; b &copy2024; &ampfoo;</p>`,
+		`<p title="&notanentity; &copy2024; &ampfoo;">&notin; &semi;</p>`,
+	}
+
+	opts := jargon.HTMLOptions{
+		Attributes: []string{"title"},
+	}
+	for _, h := range docs {
+		tokens, err := jargon.TokenizeHTML(strings.NewReader(h), opts).ToSlice()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var b strings.Builder
+		for _, token := range tokens {
+			b.WriteString(token.Raw())
+		}
+		if b.String() != h {
+			t.Errorf("expected round trip to be %q, got %q", h, b.String())
+		}
+
+		s, err := jargon.TokenizeHTML(strings.NewReader(h), opts).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s != h {
+			t.Errorf("expected String to be %q, got %q", h, s)
+		}
+
+		var w bytes.Buffer
+		if _, err := (jargon.HTMLWriter{}).WriteHTML(&w, jargon.TokenizeHTML(strings.NewReader(h), opts)); err != nil {
+			t.Fatal(err)
+		}
+		if w.String() != h {
+			t.Errorf("expected HTMLWriter to write %q, got %q", h, w.String())
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package jargon
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenizeMarkdown tokenizes Markdown. Prose, headings and link text are tokenized using jargon.Tokenize; markup (such as
+// heading and list markers, emphasis, and link destinations) is returned as punct tokens. Inline code and the contents of
+// fenced code blocks are returned verbatim as single tokens, marked as verbatim keywords so that subsequent filters leave them alone.
+//
+// It returns all tokens (including white space), so text can be reconstructed with fidelity ("round tripped").
+//
+// Markdown is interpreted line by line, so constructs which span lines, such as a code span broken across lines, are treated as prose.
+func TokenizeMarkdown(r io.Reader) *TokenStream {
+	t := &mdtokenizer{
+		reader: bufio.NewReader(r),
+	}
+	return NewTokenStream(t.next)
+}
+
+type mdtokenizer struct {
+	reader *bufio.Reader
+	// tokens from the current line, waiting to go out
+	buffer []*Token
+	// fence is the opening fence, such as ``` or ~~~~, while inside a fenced code block
+	fence string
+	// code is the contents of the current fenced code block
+	code strings.Builder
+}
+
+// next returns the next token. Call until it returns nil.
+func (t *mdtokenizer) next() (*Token, error) {
+	for len(t.buffer) == 0 {
+		line, err := t.reader.ReadString('\n')
+		if line != "" {
+			if err := t.line(line); err != nil {
+				return nil, err
+			}
+		}
+
+		if err == io.EOF {
+			// An unclosed fence runs to the end of the document
+			t.pushCode(t.code.String())
+			t.code.Reset()
+			if len(t.buffer) == 0 {
+				return nil, nil
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token := t.buffer[0]
+	t.buffer = t.buffer[1:]
+	return token, nil
+}
+
+var (
+	// fence is an opening code fence: up to three spaces of indentation, at least three backticks or tildes, and an info string
+	fence = regexp.MustCompile("^( {0,3})(`{3,}[^`]*|~{3,}.*)$")
+	// heading is an ATX heading marker
+	heading = regexp.MustCompile(`^#{1,6}(?:[ \t]|$)`)
+	// thematicBreak is a line of three or more -, * or _, or a setext heading underline of =
+	thematicBreak = regexp.MustCompile(`^(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,}|=+[ \t]*)$`)
+	// listMarker is a bullet or ordered list item marker, followed by white space
+	listMarker = regexp.MustCompile(`^(?:[-*+]|[0-9]{1,9}[.)])(?:[ \t]|$)`)
+	// taskBox is a task list item's check box, following a list marker
+	taskBox = regexp.MustCompile(`^\[[ xX]\](?:[ \t]|$)`)
+	// definition is a link reference definition, e.g. [foo]: https://example.com "Title"
+	definition = regexp.MustCompile(`^\[[^\]]+\]:[ \t]*\S`)
+	// autolink is a URL or email address in angle brackets
+	autolink = regexp.MustCompile(`^<(?:[A-Za-z][A-Za-z0-9+.-]{1,31}:[^<>\s]*|[A-Za-z0-9.!#$%&'*+/=?^_{|}~-]+@[A-Za-z0-9.-]+)>`)
+)
+
+// line tokenizes a line of Markdown, including its line ending
+func (t *mdtokenizer) line(line string) error {
+	body, eol := cutEOL(line)
+
+	if t.fence != "" {
+		trimmed := strings.TrimLeft(body, " ")
+		closing := len(body)-len(trimmed) <= 3 &&
+			strings.HasPrefix(trimmed, t.fence) &&
+			strings.Trim(trimmed, t.fence[:1]+" \t") == ""
+		if !closing {
+			t.code.WriteString(line)
+			return nil
+		}
+
+		t.pushCode(t.code.String())
+		t.code.Reset()
+		t.fence = ""
+		t.pushSpace(body[:len(body)-len(trimmed)])
+		t.pushPunct(trimmed)
+		t.pushSpace(eol)
+		return nil
+	}
+
+	if m := fence.FindStringSubmatch(body); m != nil {
+		t.pushSpace(m[1])
+		t.pushPunct(m[2])
+		t.pushSpace(eol)
+
+		marker := m[2][:1]
+		n := len(m[2]) - len(strings.TrimLeft(m[2], marker))
+		t.fence = strings.Repeat(marker, n)
+		return nil
+	}
+
+	rest := body
+	indent := func() {
+		trimmed := strings.TrimLeft(rest, " \t")
+		t.pushSpace(rest[:len(rest)-len(trimmed)])
+		rest = trimmed
+	}
+
+	indent()
+	if thematicBreak.MatchString(rest) || definition.MatchString(rest) {
+		t.pushPunct(rest)
+		t.pushSpace(eol)
+		return nil
+	}
+
+	// Block quotes and list items, possibly nested
+	for {
+		if strings.HasPrefix(rest, ">") {
+			t.pushPunct(">")
+			rest = rest[1:]
+			indent()
+			continue
+		}
+		if m := listMarker.FindString(rest); m != "" {
+			marker := strings.TrimRight(m, " \t")
+			t.pushPunct(marker)
+			rest = rest[len(marker):]
+			indent()
+
+			if m := taskBox.FindString(rest); m != "" {
+				box := strings.TrimRight(m, " \t")
+				t.pushPunct(box)
+				rest = rest[len(box):]
+				indent()
+			}
+			continue
+		}
+		break
+	}
+
+	if m := heading.FindString(rest); m != "" {
+		marker := strings.TrimRight(m, " \t")
+		t.pushPunct(marker)
+		rest = rest[len(marker):]
+		indent()
+	}
+
+	if err := t.inline(rest); err != nil {
+		return err
+	}
+	t.pushSpace(eol)
+
+	return nil
+}
+
+// inline tokenizes the inline content of a line: prose, code spans, links, emphasis and the like
+func (t *mdtokenizer) inline(s string) error {
+	prose := 0 // the start of pending prose
+	flush := func(end int) error {
+		if end > prose {
+			tokens, err := TokenizeString(s[prose:end]).ToSlice()
+			if err != nil {
+				return err
+			}
+			t.buffer = append(t.buffer, tokens...)
+		}
+		return nil
+	}
+
+	i := 0
+	for i < len(s) {
+		switch c := s[i]; c {
+		case '\\':
+			// Backslash escape of ASCII punctuation
+			if i+1 < len(s) && strings.IndexByte(asciiPunct, s[i+1]) >= 0 {
+				if err := flush(i); err != nil {
+					return err
+				}
+				t.pushPunct(s[i : i+2])
+				i += 2
+				prose = i
+				continue
+			}
+		case '`':
+			n := run(s[i:], '`')
+			if err := flush(i); err != nil {
+				return err
+			}
+			delimiter := s[i : i+n]
+			if end := closingRun(s[i+n:], delimiter); end >= 0 {
+				t.pushPunct(delimiter)
+				t.pushCode(s[i+n : i+n+end])
+				t.pushPunct(delimiter)
+				i += n + end + n
+			} else {
+				// No closing backticks, so literal
+				t.pushPunct(delimiter)
+				i += n
+			}
+			prose = i
+			continue
+		case '*', '~':
+			n := run(s[i:], c)
+			if err := flush(i); err != nil {
+				return err
+			}
+			t.pushPunct(s[i : i+n])
+			i += n
+			prose = i
+			continue
+		case '_':
+			// Underscores are emphasis only at the edge of a word, e.g. _this_ but not snake_case
+			n := run(s[i:], '_')
+			before, _ := utf8.DecodeLastRuneInString(s[:i])
+			after, _ := utf8.DecodeRuneInString(s[i+n:])
+			if i == 0 || i+n == len(s) || !isWordRune(before) || !isWordRune(after) {
+				if err := flush(i); err != nil {
+					return err
+				}
+				t.pushPunct(s[i : i+n])
+				i += n
+				prose = i
+				continue
+			}
+			i += n
+			continue
+		case '<':
+			if m := autolink.FindString(s[i:]); m != "" {
+				if err := flush(i); err != nil {
+					return err
+				}
+				t.pushPunct("<")
+				t.buffer = append(t.buffer, NewToken(m[1:len(m)-1], false))
+				t.pushPunct(">")
+				i += len(m)
+				prose = i
+				continue
+			}
+		case '!', '[':
+			open := "["
+			if c == '!' {
+				open = "!["
+			}
+			if !strings.HasPrefix(s[i:], open) {
+				break
+			}
+
+			text, destination, ok := link(s[i+len(open):])
+			if !ok {
+				break
+			}
+
+			if err := flush(i); err != nil {
+				return err
+			}
+			t.pushPunct(open)
+			if err := t.inline(text); err != nil {
+				return err
+			}
+			t.pushPunct("]")
+			t.pushPunct(destination)
+
+			i += len(open) + len(text) + 1 + len(destination)
+			prose = i
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+
+	return flush(len(s))
+}
+
+// link parses the remainder of a link or image, following the opening bracket: text](destination "title") or text][ref].
+// The destination is returned with its delimiters, e.g. (https://example.com).
+func link(s string) (text, destination string, ok bool) {
+	// Find the matching ], allowing for nested brackets
+	depth := 0
+	end := -1
+	for i := 0; i < len(s) && end < 0; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			if depth == 0 {
+				end = i
+			}
+			depth--
+		}
+	}
+	if end < 0 {
+		return "", "", false
+	}
+
+	text, rest := s[:end], s[end+1:]
+
+	var open, close byte
+	switch {
+	case strings.HasPrefix(rest, "("):
+		open, close = '(', ')'
+	case strings.HasPrefix(rest, "["):
+		open, close = '[', ']'
+	default:
+		return "", "", false
+	}
+
+	// Find the matching close, allowing for nested parens
+	depth = 0
+	for i := 1; i < len(rest); i++ {
+		switch rest[i] {
+		case '\\':
+			i++
+		case open:
+			depth++
+		case close:
+			if depth == 0 {
+				return text, rest[:i+1], true
+			}
+			depth--
+		}
+	}
+
+	return "", "", false
+}
+
+// run counts the leading bytes of s which are c
+func run(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n++
+	}
+	return n
+}
+
+// closingRun finds a run of backticks exactly matching the delimiter, returning its index in s, or -1
+func closingRun(s, delimiter string) int {
+	for i := 0; i < len(s); {
+		if s[i] != '`' {
+			i++
+			continue
+		}
+		n := run(s[i:], '`')
+		if n == len(delimiter) {
+			return i
+		}
+		i += n
+	}
+	return -1
+}
+
+// asciiPunct are the characters which may be backslash-escaped
+const asciiPunct = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// cutEOL splits a line from its line ending, if any
+func cutEOL(line string) (body, eol string) {
+	switch {
+	case strings.HasSuffix(line, "\r\n"):
+		return line[:len(line)-2], "\r\n"
+	case strings.HasSuffix(line, "\n"):
+		return line[:len(line)-1], "\n"
+	}
+	return line, ""
+}
+
+func (t *mdtokenizer) pushPunct(s string) {
+	if s == "" {
+		return
+	}
+	t.buffer = append(t.buffer, &Token{
+		value: s,
+		punct: true,
+		kind:  Punct,
+	})
+}
+
+func (t *mdtokenizer) pushSpace(s string) {
+	if s == "" {
+		return
+	}
+	t.buffer = append(t.buffer, NewToken(s, false))
+}
+
+// pushCode queues code verbatim, as a keyword so that subsequent filters leave it alone; see Token.IsVerbatim
+func (t *mdtokenizer) pushCode(s string) {
+	if s == "" {
+		return
+	}
+	t.buffer = append(t.buffer, &Token{
+		value:    s,
+		kind:     Word,
+		keyword:  true,
+		verbatim: true,
+	})
+}
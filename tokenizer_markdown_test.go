@@ -0,0 +1,139 @@
+package jargon_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+)
+
+func TestTokenizeMarkdown(t *testing.T) {
+	md := "# Ruby on Rails\n" +
+		"\n" +
+		"Hi! Let's talk **Ruby on Rails** and `rails new` with [the guide](https://guides.rubyonrails.org \"Guides\").\n" +
+		"\n" +
+		"> - [x] Use snake_case, _not_ ~~camelCase~~\n" +
+		"1. See <https://example.com/a_b> and ![a logo](logo.png)\n" +
+		"\n" +
+		"```ruby\n" +
+		"class Post < ApplicationRecord\n" +
+		"end\n" +
+		"```\n" +
+		"---\n" +
+		"[guide]: https://guides.rubyonrails.org\r\n" +
+		"Escaped \\*stars\\*"
+
+	tokens, err := jargon.TokenizeMarkdown(strings.NewReader(md)).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.String())
+	}
+	if b.String() != md {
+		t.Errorf("expected round trip of %q, got %q", md, b.String())
+	}
+
+	type kind int
+	const (
+		word kind = iota
+		punct
+		code
+	)
+
+	expected := map[string]kind{
+		// markup is punct
+		"#":   punct,
+		"**":  punct,
+		"`":   punct,
+		"[":   punct,
+		"]":   punct,
+		"![":  punct,
+		">":   punct,
+		"-":   punct,
+		"[x]": punct,
+		"_":   punct,
+		"~~":  punct,
+		"1.":  punct,
+		"(https://guides.rubyonrails.org \"Guides\")": punct,
+		"(logo.png)": punct,
+		"```ruby":    punct,
+		"```":        punct,
+		"---":        punct,
+		"[guide]: https://guides.rubyonrails.org": punct,
+		"\\*": punct,
+		// prose, headings and link text are words
+		"Ruby":                    word,
+		"Rails":                   word,
+		"talk":                    word,
+		"guide":                   word,
+		"snake_case":              word,
+		"not":                     word,
+		"camelCase":               word,
+		"logo":                    word,
+		"stars":                   word,
+		"https://example.com/a_b": word,
+		// code is verbatim
+		"rails new":                             code,
+		"class Post < ApplicationRecord\nend\n": code,
+	}
+
+	got := map[string]*jargon.Token{}
+	for _, token := range tokens {
+		got[token.String()] = token
+	}
+
+	for s, k := range expected {
+		token, found := got[s]
+		if !found {
+			t.Errorf("expected to find token %q", s)
+			continue
+		}
+
+		switch k {
+		case punct:
+			if !token.IsPunct() {
+				t.Errorf("expected %q to be punct", s)
+			}
+		case word:
+			if token.IsPunct() || token.IsSpace() || token.IsKeyword() {
+				t.Errorf("expected %q to be a word", s)
+			}
+		case code:
+			if token.IsPunct() || !token.IsKeyword() || !token.IsVerbatim() {
+				t.Errorf("expected %q to be code, i.e. a verbatim keyword", s)
+			}
+		}
+	}
+
+	for _, s := range []string{"ApplicationRecord", "guides.rubyonrails.org", "Guides"} {
+		if _, found := got[s]; found {
+			t.Errorf("expected %q not to be tokenized", s)
+		}
+	}
+}
+
+func TestTokenizeMarkdownUnclosed(t *testing.T) {
+	md := "Text\n~~~\ncode `here`\n"
+
+	tokens, err := jargon.TokenizeMarkdown(strings.NewReader(md)).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last := tokens[len(tokens)-1]
+	if last.String() != "code `here`\n" || !last.IsKeyword() {
+		t.Errorf("expected an unclosed fence to run to the end, got %q", last)
+	}
+
+	got, err := jargon.TokenizeMarkdown(strings.NewReader("an `unclosed code span")).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "an `unclosed code span" {
+		t.Errorf("expected round trip, got %q", got)
+	}
+}
@@ -0,0 +1,316 @@
+package jargon
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// XMLOptions configures TokenizeXML
+type XMLOptions struct {
+	// Elements are the names of elements whose text (including that of nested elements) is tokenized, such as title or description.
+	// If empty, all text is tokenized.
+	Elements []string
+	// Attributes are the names of attributes whose values are tokenized as text, such as Body in a Stack Exchange Posts.xml.
+	Attributes []string
+}
+
+// TokenizeXML tokenizes XML, such as RSS and Atom feeds, DocBook or Stack Exchange data dumps. Text is tokenized using
+// jargon.Tokenize; markup (tags, comments, processing instructions) and text outside of the selected elements are returned
+// verbatim as punct tokens. The contents of CDATA sections are tokenized, with the <![CDATA[ and ]]> delimiters as punct.
+//
+// Names in XMLOptions match elements and attributes by local name (title), by prefix (dc:title), or by namespace
+// in {uri}local form ({http://purl.org/dc/elements/1.1/}title).
+//
+// Entities, such as &amp;, are decoded; the original text is retained for round-tripping, see Token.Raw. HTML's entities,
+// such as &mdash;, are recognized, as are numeric character references. A leading byte order mark is returned as punct.
+func TokenizeXML(r io.Reader, opts ...XMLOptions) *TokenStream {
+	rec := &recorder{reader: r}
+	decoder := xml.NewDecoder(rec)
+	// Documents such as DocBook use HTML's entities, e.g. &mdash; or &nbsp;, declared by their DTDs
+	decoder.Entity = xml.HTMLEntity
+
+	t := &xtokenizer{
+		recorder: rec,
+		decoder:  decoder,
+	}
+
+	for _, opt := range opts {
+		t.elements = append(t.elements, opt.Elements...)
+		t.attributes = append(t.attributes, opt.Attributes...)
+	}
+
+	return NewTokenStream(t.next)
+}
+
+type xtokenizer struct {
+	recorder *recorder
+	decoder  *xml.Decoder
+	// offset is the end of the previous xml token in the input
+	offset int64
+	// stack is the open elements, innermost last
+	stack []xelement
+	// elements whose text is tokenized
+	elements []string
+	// attributes to be tokenized as text
+	attributes []string
+	// buffer is tokens waiting to go out
+	buffer []*Token
+}
+
+// xelement is an open element
+type xelement struct {
+	name xml.Name
+	// namespaces maps prefixes to URIs, including those inherited; "" is the default namespace
+	namespaces map[string]string
+	// text indicates that the element, or an ancestor, is selected for tokenizing
+	text bool
+}
+
+// next is the implementation of the Tokens interface. To iterate, call until it returns nil
+func (t *xtokenizer) next() (*Token, error) {
+	for len(t.buffer) == 0 {
+		if err := t.read(); err != nil {
+			if err == io.EOF {
+				// No problem
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+
+	token := t.buffer[0]
+	t.buffer = t.buffer[1:]
+	return token, nil
+}
+
+// read consumes the next xml token, and queues the resulting tokens
+func (t *xtokenizer) read() error {
+	// RawToken retains prefixes, but does not check that elements are properly nested; see EndElement below
+	xtoken, err := t.decoder.RawToken()
+	if err == io.EOF && len(t.stack) > 0 {
+		line, _ := t.decoder.InputPos()
+		return &xml.SyntaxError{Msg: "unexpected EOF", Line: line}
+	}
+	if err != nil {
+		return err
+	}
+
+	start, offset := t.offset, t.decoder.InputOffset()
+	raw := t.recorder.slice(start, offset)
+	t.offset = offset
+
+	switch xtoken := xtoken.(type) {
+	case xml.StartElement:
+		e := t.push(xtoken)
+		if !t.hasAttributes(e, xtoken) {
+			break
+		}
+
+		start := 0 // the start of pending punct
+		for _, a := range scanAttributes(raw) {
+			if a.value == "" || !t.isAttribute(e, a.key) {
+				continue
+			}
+
+			t.pushPunct(raw[start:a.start])
+			tokens, err := tokenizeEscaped(a.value).ToSlice()
+			if err != nil {
+				return err
+			}
+			t.buffer = append(t.buffer, tokens...)
+			start = a.start + len(a.value)
+		}
+		t.pushPunct(raw[start:])
+		return nil
+	case xml.EndElement:
+		// A self-closing tag is reported as a start and an (empty) end
+		if len(t.stack) == 0 || t.stack[len(t.stack)-1].name != xtoken.Name {
+			line, _ := t.decoder.InputPos()
+			return &xml.SyntaxError{Msg: "unexpected end element </" + prefixed(xtoken.Name) + ">", Line: line}
+		}
+		t.stack = t.stack[:len(t.stack)-1]
+	case xml.CharData:
+		if start == 0 && strings.HasPrefix(raw, bom) {
+			// A byte order mark is not text
+			t.pushPunct(bom)
+			raw = raw[len(bom):]
+		}
+
+		if !t.inText() {
+			break
+		}
+
+		if strings.HasPrefix(raw, "<![CDATA[") && strings.HasSuffix(raw, "]]>") {
+			t.pushPunct("<![CDATA[")
+			tokens, err := TokenizeString(raw[len("<![CDATA[") : len(raw)-len("]]>")]).ToSlice()
+			if err != nil {
+				return err
+			}
+			t.buffer = append(t.buffer, tokens...)
+			t.pushPunct("]]>")
+			return nil
+		}
+
+		tokens, err := tokenizeEscaped(raw).ToSlice()
+		if err != nil {
+			return err
+		}
+		t.buffer = append(t.buffer, tokens...)
+		return nil
+	}
+
+	// Everything else is punct for our purposes
+	t.pushPunct(raw)
+	return nil
+}
+
+// bom is the byte order mark, which may begin a document
+const bom = "\uFEFF"
+
+// push records the opening of an element, with its namespace declarations
+func (t *xtokenizer) push(xtoken xml.StartElement) xelement {
+	var parent xelement
+	if len(t.stack) > 0 {
+		parent = t.stack[len(t.stack)-1]
+	}
+
+	e := xelement{
+		name:       xtoken.Name,
+		namespaces: parent.namespaces,
+		text:       parent.text,
+	}
+
+	copied := false
+	for _, attr := range xtoken.Attr {
+		var prefix string
+		switch {
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+			prefix = ""
+		case attr.Name.Space == "xmlns":
+			prefix = attr.Name.Local
+		default:
+			continue
+		}
+
+		if !copied {
+			// Copy on write, the parent's are shared
+			namespaces := make(map[string]string, len(parent.namespaces)+1)
+			for k, v := range parent.namespaces {
+				namespaces[k] = v
+			}
+			e.namespaces = namespaces
+			copied = true
+		}
+		e.namespaces[prefix] = attr.Value
+	}
+
+	if !e.text {
+		e.text = len(t.elements) == 0 || t.matches(t.elements, e, xtoken.Name, true)
+	}
+
+	t.stack = append(t.stack, e)
+	return e
+}
+
+// inText determines whether text at the current position should be tokenized
+func (t *xtokenizer) inText() bool {
+	if len(t.stack) == 0 {
+		return len(t.elements) == 0
+	}
+	return t.stack[len(t.stack)-1].text
+}
+
+// hasAttributes determines whether the element has any attributes to be tokenized
+func (t *xtokenizer) hasAttributes(e xelement, xtoken xml.StartElement) bool {
+	if len(t.attributes) == 0 {
+		return false
+	}
+	for _, attr := range xtoken.Attr {
+		if t.matches(t.attributes, e, attr.Name, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAttribute determines whether the (raw, possibly prefixed) attribute name should be tokenized
+func (t *xtokenizer) isAttribute(e xelement, key string) bool {
+	var name xml.Name
+	if prefix, local, found := strings.Cut(key, ":"); found {
+		name = xml.Name{Space: prefix, Local: local}
+	} else {
+		name = xml.Name{Local: key}
+	}
+	return t.matches(t.attributes, e, name, false)
+}
+
+// matches determines whether the (raw, prefixed) name matches any of the given names. Unprefixed element names take
+// the default namespace; unprefixed attribute names have no namespace.
+func (t *xtokenizer) matches(names []string, e xelement, name xml.Name, element bool) bool {
+	uri := ""
+	if name.Space != "" || element {
+		uri = e.namespaces[name.Space]
+	}
+
+	prefixed := prefixed(name)
+
+	for _, s := range names {
+		switch {
+		case strings.HasPrefix(s, "{"):
+			if s == "{"+uri+"}"+name.Local {
+				return true
+			}
+		case strings.Contains(s, ":"):
+			if s == prefixed {
+				return true
+			}
+		default:
+			if s == name.Local {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// prefixed returns the name as it appears in the document, e.g. dc:title
+func prefixed(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func (t *xtokenizer) pushPunct(s string) {
+	if s == "" {
+		return
+	}
+	t.buffer = append(t.buffer, &Token{
+		value: s,
+		punct: true,
+		kind:  Punct,
+	})
+}
+
+// recorder keeps the input read by the xml decoder, so that the raw text of each xml token can be reproduced exactly
+type recorder struct {
+	reader io.Reader
+	buf    []byte
+	// base is the offset in the input of the start of buf
+	base int64
+}
+
+func (r *recorder) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.buf = append(r.buf, p[:n]...)
+	return n, err
+}
+
+// slice returns the input from start to end, and discards the input before end
+func (r *recorder) slice(start, end int64) string {
+	s := string(r.buf[start-r.base : end-r.base])
+	r.buf = r.buf[end-r.base:]
+	r.base = end
+	return s
+}
@@ -0,0 +1,158 @@
+package jargon_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+)
+
+func TestTokenizeXML(t *testing.T) {
+	x := `<?xml version="1.0" encoding="UTF-8"?>
+<!-- A feed -->
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:x="urn:x">
+<channel>
+<title>Ruby &amp; Rails</title>
+<link>https://example.com/rails</link>
+<item>
+<title><![CDATA[Rails <b>7</b>]]></title>
+<description>Upgrading <em>Rails</em></description>
+<dc:creator>Rails Team</dc:creator>
+<x:creator>Nobody</x:creator>
+<guid isPermaLink="false" />
+</item>
+</channel>
+</rss>
+`
+
+	opts := jargon.XMLOptions{
+		Elements: []string{"title", "description", "{http://purl.org/dc/elements/1.1/}creator"},
+	}
+	tokens, err := jargon.TokenizeXML(strings.NewReader(x), opts).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.Raw())
+	}
+	if b.String() != x {
+		t.Errorf("expected round trip to be %q, got %q", x, b.String())
+	}
+
+	var words, punct []string
+	for _, token := range tokens {
+		switch {
+		case token.IsPunct():
+			punct = append(punct, token.String())
+		case token.Kind() == jargon.Word:
+			words = append(words, token.String())
+		}
+	}
+
+	expectedWords := []string{"Ruby", "Rails", "Rails", "b", "b", "Upgrading", "Rails", "Rails", "Team"}
+	if !reflect.DeepEqual(words, expectedWords) {
+		t.Errorf("expected words %q, got %q", expectedWords, words)
+	}
+
+	got := map[string]bool{}
+	for _, p := range punct {
+		got[p] = true
+	}
+	expectedPunct := []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		"<!-- A feed -->",
+		`<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:x="urn:x">`,
+		"&", // decoded
+		"<![CDATA[", "]]>",
+		"<em>", "</em>",
+		// text outside of the selected elements is left whole
+		"https://example.com/rails",
+		"Nobody",
+		`<guid isPermaLink="false" />`,
+	}
+	for _, e := range expectedPunct {
+		if !got[e] {
+			t.Errorf("expected to find punct %q, but did not", e)
+		}
+	}
+}
+
+func TestTokenizeXMLAttributes(t *testing.T) {
+	x := `<posts>
+  <row Id="1" Title="Ruby on Rails" Body="&lt;p&gt;Use Rails&lt;/p&gt;" Tags="&lt;ruby&gt;" />
+</posts>`
+
+	opts := jargon.XMLOptions{
+		Elements:   []string{"none"},
+		Attributes: []string{"Title", "Body"},
+	}
+	tokens, err := jargon.TokenizeXML(strings.NewReader(x), opts).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	var words []string
+	for _, token := range tokens {
+		b.WriteString(token.Raw())
+		if token.Kind() == jargon.Word {
+			words = append(words, token.String())
+		}
+	}
+	if b.String() != x {
+		t.Errorf("expected round trip to be %q, got %q", x, b.String())
+	}
+
+	expected := []string{"Ruby", "on", "Rails", "p", "Use", "Rails", "p"}
+	if !reflect.DeepEqual(words, expected) {
+		t.Errorf("expected words %q, got %q", expected, words)
+	}
+}
+
+func TestTokenizeXMLEntities(t *testing.T) {
+	x := "\uFEFF<?xml version=\"1.0\"?>\n<para>Rails&nbsp;7&mdash;new &amp; &#233;tendu</para>\n"
+
+	tokens, err := jargon.TokenizeXML(strings.NewReader(x)).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.Raw())
+	}
+	if b.String() != x {
+		t.Errorf("expected round trip to be %q, got %q", x, b.String())
+	}
+
+	if first := tokens[0]; first.String() != "\uFEFF" || !first.IsPunct() {
+		t.Errorf("expected a leading byte order mark to be punct, got %q", first)
+	}
+
+	var words []string
+	for _, token := range tokens {
+		if !token.IsPunct() && !token.IsSpace() {
+			words = append(words, token.String())
+		}
+	}
+
+	expected := []string{"Rails", "7", "new", "étendu"}
+	if !reflect.DeepEqual(words, expected) {
+		t.Errorf("expected words %q, got %q", expected, words)
+	}
+}
+
+func TestTokenizeXMLError(t *testing.T) {
+	malformed := []string{"<a><b></a>", "<a><b></b>", "</a>", "<a b=c></a>", "<a>&undeclared;</a>"}
+	for _, x := range malformed {
+		_, err := jargon.TokenizeXML(strings.NewReader(x)).ToSlice()
+		if err == nil {
+			t.Errorf("expected an error for malformed XML %q", x)
+		}
+	}
+}
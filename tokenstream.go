@@ -62,9 +62,12 @@ func (stream *TokenStream) Err() error {
 type where struct {
 	stream    *TokenStream
 	predicate func(*Token) bool
+	// positions removed, to be recorded on the next word
+	gap int
 }
 
-// Where filters a stream of Tokens that match a predicate
+// Where filters a stream of Tokens that match a predicate. Where words are removed, the gap is
+// recorded on the next word's PositionIncrement.
 func (stream *TokenStream) Where(predicate func(*Token) bool) *TokenStream {
 	w := &where{
 		stream:    stream,
@@ -83,9 +86,19 @@ func (w *where) next() (*Token, error) {
 			break
 		}
 
-		if w.predicate(token) {
-			return token, nil
+		if !w.predicate(token) {
+			if token.isWord() {
+				w.gap += token.PositionIncrement()
+			}
+			continue
 		}
+
+		if w.gap > 0 && token.isWord() {
+			token = token.WithPositionIncrement(token.PositionIncrement() + w.gap)
+			w.gap = 0
+		}
+
+		return token, nil
 	}
 
 	return nil, nil
@@ -115,12 +128,14 @@ func (stream *TokenStream) Filter(filters ...Filter) *TokenStream {
 	return outgoing
 }
 
+// String concatenates all tokens. It uses each token's Raw text, so that a document (e.g. escaped HTML) is reproduced as written.
+// Tokens created by filters are written as their (unescaped) value, so after filtering, the result is not HTML-safe; use HTMLWriter.
 func (stream *TokenStream) String() (string, error) {
 	var b strings.Builder
 
 	for stream.Scan() {
 		token := stream.Token()
-		b.WriteString(token.String())
+		b.WriteString(token.Raw())
 	}
 
 	if err := stream.Err(); err != nil {
@@ -130,12 +145,12 @@ func (stream *TokenStream) String() (string, error) {
 	return b.String(), nil
 }
 
-// WriteTo writes all token string values to w
+// WriteTo writes all tokens to w, using each token's Raw text. As with String, the result is not HTML-safe after filtering.
 func (stream *TokenStream) WriteTo(w io.Writer) (int64, error) {
 	var written int64
 	for stream.Scan() {
 		token := stream.Token()
-		n, err := w.Write([]byte(token.String()))
+		n, err := w.Write([]byte(token.Raw()))
 		written += int64(n)
 
 		if err != nil {
@@ -152,12 +167,9 @@ func (stream *TokenStream) WriteTo(w io.Writer) (int64, error) {
 
 // Words returns only all non-punctuation and non-space tokens
 func (stream *TokenStream) Words() *TokenStream {
-	isWord := func(t *Token) bool {
-		return !t.IsPunct() && !t.IsSpace()
-	}
 	w := &where{
 		stream:    stream,
-		predicate: isWord,
+		predicate: (*Token).isWord,
 	}
 	return NewTokenStream(w.next)
 }
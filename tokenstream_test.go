@@ -74,3 +74,37 @@ func TestDistinct(t *testing.T) {
 		t.Errorf("expected %q, got %q", expected, got)
 	}
 }
+
+func TestPositionIncrement(t *testing.T) {
+	text := "Bank of the America, and friends"
+	stop := func(t *jargon.Token) bool {
+		s := t.String()
+		return s != "of" && s != "the" && s != "and"
+	}
+
+	tokens, err := jargon.TokenizeString(text).Where(stop).Words().ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	type test struct {
+		value     string
+		increment int
+	}
+	expected := []test{
+		{"Bank", 1},
+		{"America", 3},
+		{"friends", 2},
+	}
+
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %q", len(expected), tokens)
+	}
+
+	for i, e := range expected {
+		got := tokens[i]
+		if got.String() != e.value || got.PositionIncrement() != e.increment {
+			t.Errorf("expected %q with increment %d, got %q with increment %d", e.value, e.increment, got, got.PositionIncrement())
+		}
+	}
+}
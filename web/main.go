@@ -48,7 +48,11 @@ func jargonHandler(w http.ResponseWriter, r *http.Request) {
 	case "text":
 		tokens = jargon.Tokenize(r.Body)
 	case "html":
-		tokens = jargon.TokenizeHTML(r.Body)
+		// Leave code alone
+		opts := jargon.HTMLOptions{
+			Verbatim: jargon.DefaultHTMLVerbatim,
+		}
+		tokens = jargon.TokenizeHTML(r.Body, opts)
 	default:
 		http.NotFound(w, r)
 		return
@@ -56,6 +60,9 @@ func jargonHandler(w http.ResponseWriter, r *http.Request) {
 
 	lemmatized := tokens.Filter(stackoverflow.Tags)
 
+	// The result is the escaped source, with lemmas wrapped in markup
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
 	var b bytes.Buffer
 
 	for {
@@ -74,7 +81,8 @@ func jargonHandler(w http.ResponseWriter, r *http.Request) {
 		if t.IsLemma() {
 			err = lemma.Execute(&b, t)
 		} else {
-			err = plain.Execute(&b, t)
+			// Raw, so that the source's entities are shown as written
+			err = plain.Execute(&b, t.Raw())
 		}
 
 		if err != nil {
@@ -38,3 +38,42 @@ func TestHandler(t *testing.T) {
 		t.Errorf(`should have found <span class="lemma">objective-c</span> in result, got %q`, got)
 	}
 }
+
+func TestHTMLHandler(t *testing.T) {
+	body := strings.NewReader(`<p>Experience with <b>ObjC</b> &amp; Rails</p><script>alert("cpp")</script><code>cpp</code>`)
+
+	req := httptest.NewRequest("POST", "/html", body)
+	w := httptest.NewRecorder()
+
+	jargonHandler(w, req)
+
+	resp := w.Result()
+	result, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf(`should have a text/html Content-Type, got %q`, ct)
+	}
+
+	got := string(result)
+
+	// The caller's markup is escaped, not returned as markup
+	if strings.Contains(got, "<script>") || !strings.Contains(got, "&lt;script&gt;") {
+		t.Errorf(`should have escaped <script>, got %q`, got)
+	}
+
+	if !strings.Contains(got, `&amp;amp;`) {
+		t.Errorf(`should have escaped the source's &amp;, got %q`, got)
+	}
+
+	if !strings.Contains(got, `<span class="lemma">objective-c</span>`) {
+		t.Errorf(`should have found <span class="lemma">objective-c</span> in result, got %q`, got)
+	}
+
+	if !strings.Contains(got, `&lt;code&gt;cpp&lt;/code&gt;`) {
+		t.Errorf(`should have left code alone, got %q`, got)
+	}
+}